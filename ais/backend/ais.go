@@ -10,12 +10,14 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"os"
 	"regexp"
 	"sync"
 	"time"
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/api/env"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
@@ -622,7 +624,7 @@ func (m *AISbp) GetObjReader(_ context.Context, lom *core.LOM, offset, length in
 }
 
 // TODO: retry upon 'unreachable' or timeout
-func (m *AISbp) PutObj(r io.ReadCloser, lom *core.LOM, _ *http.Request) (ecode int, err error) {
+func (m *AISbp) PutObj(r io.ReadCloser, lom *core.LOM, oreq *http.Request) (ecode int, err error) {
 	var (
 		oah       api.ObjAttrs
 		remAis    *remAis
@@ -642,6 +644,13 @@ func (m *AISbp) PutObj(r io.ReadCloser, lom *core.LOM, _ *http.Request) (ecode i
 		Reader:     r.(cos.ReadOpenCloser),
 		Size:       uint64(size),
 	}
+	if oreq != nil {
+		// cross-cluster replication (see ais/tgtrepl.go)
+		if stamp := oreq.Header.Get(apc.HdrReplStamp); stamp != "" {
+			args.Header = http.Header{apc.HdrReplStamp: []string{stamp}}
+			args.BaseParams.Token = replToken()
+		}
+	}
 	if oah, err = api.PutObject(&args); err != nil {
 		ecode, err = extractErrCode(err, remAis.uuid)
 		return
@@ -669,3 +678,33 @@ func (m *AISbp) DeleteObj(lom *core.LOM) (ecode int, err error) {
 	err = api.DeleteObject(remAis.bp, remoteBck, lom.ObjName)
 	return extractErrCode(err, remAis.uuid)
 }
+
+// replicated PUT and DELETE get accepted only from peers authenticated as admin
+// (the token is issued by the destination cluster's AuthN)
+func replToken() string { return os.Getenv(env.AisAuthToken) }
+
+// cross-cluster replication: DELETE that carries the origin's replication stamp
+// (see ais/tgtrepl.go)
+func (m *AISbp) DeleteObjRepl(lom *core.LOM, stamp string) (ecode int, err error) {
+	var (
+		remAis    *remAis
+		remoteBck = lom.Bck().Clone()
+	)
+	if remAis, err = m.getRemAis(remoteBck.Ns.UUID); err != nil {
+		return
+	}
+	unsetUUID(&remoteBck)
+	bp := remAis.bp
+	bp.Method = http.MethodDelete
+	bp.Token = replToken()
+	reqParams := api.AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathObjects.Join(remoteBck.Name, lom.ObjName)
+		reqParams.Query = remoteBck.NewQuery()
+		reqParams.Header = http.Header{apc.HdrReplStamp: []string{stamp}}
+	}
+	err = reqParams.DoRequest()
+	api.FreeRp(reqParams)
+	return extractErrCode(err, remAis.uuid)
+}
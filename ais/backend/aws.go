@@ -34,6 +34,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
 	s3manager "github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
@@ -48,13 +49,17 @@ type (
 	}
 	sessConf struct {
 		bck    *cmn.Bck
+		prof   *cmn.BackendProfile // named backend profile (cmn/backendprof.go), if any
 		region string
 	}
 )
 
+const profPrefix = "@" // (clients map below)
+
 var (
 	// map[string]*s3.Client, with one s3.Client a.k.a. "svc"
 	// per (profile, region, endpoint) triplet
+	// (named backend profiles are keyed as "@<name>#<region>#<endpoint>")
 	clients sync.Map
 
 	s3Endpoint string
@@ -78,7 +83,8 @@ func NewAWS(t core.TargetPut, tstats stats.Tracker, startingUp bool) (core.Backe
 	bp.base.init(t.Snode(), tstats, startingUp)
 	// reset clients map
 	clients.Clear()
-	return bp, nil
+	resetSQS()
+	return newThrottled(bp, t, tstats), nil
 }
 
 // as core.Backend --------------------------------------------------------------
@@ -121,11 +127,15 @@ func (*s3bp) HeadBucket(_ context.Context, bck *meta.Bck) (bckProps cos.StrKVs,
 	bckProps[apc.HdrS3Endpoint] = ""
 	if bck.Props != nil {
 		bckProps[apc.HdrS3Endpoint] = bck.Props.Extra.AWS.Endpoint
+		bckProps[apc.HdrS3BackendProfile] = bck.Props.Extra.AWS.BackendProfile
 	}
-	versioned, errV := getBucketVersioning(svc, cloudBck)
-	if errV != nil {
-		ecode, err = awsErrorToAISError(errV, cloudBck, "")
-		return nil, ecode, err
+	var versioned bool
+	if sessConf.prof == nil || sessConf.prof.Capabilities().Versioning {
+		var errV error
+		if versioned, errV = getBucketVersioning(svc, cloudBck); errV != nil {
+			ecode, err = awsErrorToAISError(errV, cloudBck, "")
+			return nil, ecode, err
+		}
 	}
 	bckProps[apc.HdrBucketVerEnabled] = strconv.FormatBool(versioned)
 	return bckProps, 0, nil
@@ -136,146 +146,43 @@ func (*s3bp) HeadBucket(_ context.Context, bck *meta.Bck) (bckProps cos.StrKVs,
 //
 
 // when successful, returns w/ rlock held and inventory's (lom, lmfh) in the context;
-// otherwise, always unlocks and frees
+// otherwise, always unlocks and frees (see getBucketInv)
 func (s3bp *s3bp) GetBucketInv(bck *meta.Bck, ctx *core.LsoInvCtx) (int, error) {
-	debug.Assert(ctx != nil && ctx.Lom == nil)
 	var (
-		cloudBck = bck.RemoteBck()
-		sessConf = sessConf{bck: cloudBck}
+		cloudBck      = bck.RemoteBck()
+		sessConf      = sessConf{bck: cloudBck}
+		lsV2resp      *s3.ListObjectsV2Output
+		csv, manifest invT
 	)
 	svc, err := sessConf.s3client("[get_bucket_inv]")
 	if err != nil {
 		return 0, err
 	}
-
-	// one bucket, one inventory, one statically defined name
-	prefix, objName := aiss3.InvPrefObjname(bck.Bucket(), ctx.Name, ctx.ID)
-	lom := core.AllocLOM(objName)
-	if err = lom.InitBck(bck.Bucket()); err != nil {
-		core.FreeLOM(lom)
-		return 0, err
+	if sessConf.prof != nil && !sessConf.prof.Capabilities().Inventory {
+		return http.StatusNotImplemented, cmn.NewErrUnsupp("list inventory of", cloudBck.Cname("")+
+			" (backend profile "+cloudBck.Props.Extra.AWS.BackendProfile+")")
 	}
-	if !lom.TryLock(false) {
-		err = cmn.NewErrBusy(invTag, lom.Cname(), "likely getting updated")
-		core.FreeLOM(lom)
-		return 0, err
+	latest := func(prefix string) (mtime time.Time, ecode int, err error) {
+		lsV2resp, csv, manifest, ecode, err = s3bp.initInventory(cloudBck, svc, ctx, prefix)
+		return csv.mtime, ecode, err
 	}
-
-	lsV2resp, csv, manifest, ecode, err := s3bp.initInventory(cloudBck, svc, ctx, prefix)
-	if err != nil {
-		lom.Unlock(false)
-		core.FreeLOM(lom)
-		return ecode, err
-	}
-	ctx.Lom = lom
-	mtime, usable := checkInvLom(csv.mtime, ctx)
-	if usable {
-		if ctx.Lmfh, err = ctx.Lom.Open(); err != nil {
-			lom.Unlock(false)
-			core.FreeLOM(lom)
-			ctx.Lom = nil
-			return 0, _errInv("usable-inv-open", err)
-		}
-
-		return 0, nil // w/ rlock
-	}
-
-	// rlock -> wlock
-
-	lom.Unlock(false)
-	err = cmn.NewErrBusy(invTag, lom.Cname(), "timed out waiting to acquire write access") // prelim
-	sleep, total := time.Second, invBusyTimeout
-	for total >= 0 {
-		if lom.TryLock(true) {
-			err = nil
-			break
-		}
-		time.Sleep(sleep)
-		total -= sleep
-	}
-	if err != nil {
-		core.FreeLOM(lom)
-		ctx.Lom = nil
-		return 0, err // busy
+	get := func() error {
+		cleanupOldInventory(cloudBck, svc, lsV2resp, csv, manifest)
+		return s3bp.getInventory(cloudBck, ctx, csv)
 	}
-
-	// acquired wlock: check for write/write race
-
-	_, _, newMtime, err := ctx.Lom.Fstat(false /*get-atime*/)
-	if err == nil && newMtime.Sub(mtime) > time.Hour {
-		// updated by smbd else
-		// reload the lom and return
-		ctx.Lom.Uncache()
-		_, usable = checkInvLom(newMtime, ctx)
-		debug.Assert(usable)
-
-		// wlock --> rlock must succeed
-		lom.Unlock(true)
-		lom.Lock(false)
-
-		if ctx.Lmfh, err = ctx.Lom.Open(); err != nil {
-			lom.Unlock(false)
-			core.FreeLOM(lom)
-			ctx.Lom = nil
-			return 0, _errInv("reload-inv-open", err)
-		}
-		return 0, nil // ok
-	}
-
-	// still under wlock: cleanup old, read and write as ctx.Lom
-
-	cleanupOldInventory(cloudBck, svc, lsV2resp, csv, manifest)
-
-	err = s3bp.getInventory(cloudBck, ctx, csv)
-
-	// wlock --> rlock
-
-	lom.Unlock(true)
-
-	if err != nil {
-		core.FreeLOM(lom)
-		ctx.Lom = nil
-		return 0, err
-	}
-
-	lom.Lock(false) // must succeed
-	if ctx.Lmfh, err = ctx.Lom.Open(); err != nil {
-		lom.Unlock(false)
-		core.FreeLOM(lom)
-		ctx.Lom = nil
-		return 0, _errInv("get-inv-open", err)
-	}
-
-	return 0, nil // ok
+	return getBucketInv(bck, ctx, latest, get)
 }
 
 // using local(ized) .csv
 func (s3bp *s3bp) ListObjectsInv(bck *meta.Bck, msg *apc.LsoMsg, lst *cmn.LsoRes, ctx *core.LsoInvCtx) (err error) {
 	debug.Assert(ctx.Lom != nil && ctx.Lmfh != nil, ctx.Lom, " ", ctx.Lmfh)
 
-	cloudBck := bck.RemoteBck()
-
-	if ctx.SGL == nil {
-		if ctx.EOF {
-			debug.Assert(false) // (unlikely)
-			goto none
+	if invSGL(s3bp.mm, ctx, invSwapSGL) {
+		err = s3bp.listInventory(bck.RemoteBck(), ctx, msg, lst)
+		if err == nil || err == io.EOF {
+			return nil
 		}
-		ctx.SGL = s3bp.mm.NewSGL(invPageSGL, memsys.DefaultBuf2Size)
-	} else if l := ctx.SGL.Len(); l > 0 && l < invSwapSGL && !ctx.EOF {
-		// swap SGLs
-		sgl := s3bp.mm.NewSGL(invPageSGL, memsys.DefaultBuf2Size)
-		written, err := io.Copy(sgl, ctx.SGL) // buffering not needed - gets executed via sgl WriteTo()
-		debug.AssertNoErr(err)
-		debug.Assert(written == l && sgl.Len() == l, written, " vs ", l, " vs ", sgl.Len())
-		ctx.SGL.Free()
-		ctx.SGL = sgl
 	}
-	err = s3bp.listInventory(cloudBck, ctx, msg, lst)
-
-	if err == nil || err == io.EOF {
-		return nil
-	}
-none:
 	lst.Entries = lst.Entries[:0]
 	return err
 }
@@ -636,6 +543,7 @@ func (*s3bp) PutObj(r io.ReadCloser, lom *core.LOM, oreq *http.Request) (ecode i
 		svc                   *s3.Client
 		uploader              *s3manager.Uploader
 		uploadOutput          *s3manager.UploadOutput
+		input                 *s3.PutObjectInput
 		h                     = cmn.BackendHelpers.Amazon
 		cksumType, cksumValue = lom.Checksum().Get()
 		cloudBck              = lom.Bck().RemoteBck()
@@ -671,13 +579,29 @@ func (*s3bp) PutObj(r io.ReadCloser, lom *core.LOM, oreq *http.Request) (ecode i
 		}
 	}
 
-	uploader = s3manager.NewUploader(svc)
-	uploadOutput, err = uploader.Upload(context.Background(), &s3.PutObjectInput{
+	input = &s3.PutObjectInput{
 		Bucket:   aws.String(cloudBck.Name),
 		Key:      aws.String(lom.ObjName),
 		Body:     r,
 		Metadata: md,
-	})
+	}
+	if prof := sessConf.prof; prof != nil {
+		if prof.Checksum != "" && prof.Checksum != cos.ChecksumNone {
+			input.ChecksumAlgorithm = types.ChecksumAlgorithm(strings.ToUpper(prof.Checksum))
+		}
+		if !prof.Capabilities().MPT {
+			// single PUT regardless of size (the endpoint does not support multipart)
+			var output *s3.PutObjectOutput
+			input.ContentLength = aws.Int64(lom.Lsize(true))
+			if output, err = svc.PutObject(context.Background(), input); err == nil {
+				uploadOutput = &s3manager.UploadOutput{VersionID: output.VersionId, ETag: output.ETag}
+			}
+			goto rerr
+		}
+	}
+	uploader = s3manager.NewUploader(svc)
+	uploadOutput, err = uploader.Upload(context.Background(), input)
+rerr:
 	if err != nil {
 		ecode, err = awsErrorToAISError(err, cloudBck, lom.ObjName)
 		cos.Close(r)
@@ -763,11 +687,24 @@ func (sessConf *sessConf) s3client(tag string) (*s3.Client, error) {
 		if sessConf.region == "" {
 			sessConf.region = sessConf.bck.Props.Extra.AWS.CloudRegion
 		}
-		if sessConf.bck.Props.Extra.AWS.Endpoint != "" {
-			endpoint = sessConf.bck.Props.Extra.AWS.Endpoint
-		}
-		if sessConf.bck.Props.Extra.AWS.Profile != "" {
-			profile = sessConf.bck.Props.Extra.AWS.Profile
+		if name := sessConf.bck.Props.Extra.AWS.BackendProfile; name != "" {
+			// named backend profile takes precedence
+			prof, err := cmn.GCO.Get().Backend.Profile(apc.AWS, name)
+			if err != nil {
+				return nil, err
+			}
+			sessConf.prof = prof
+			if sessConf.region == "" {
+				sessConf.region = prof.Region
+			}
+			endpoint, profile = prof.Endpoint, profPrefix+name
+		} else {
+			if sessConf.bck.Props.Extra.AWS.Endpoint != "" {
+				endpoint = sessConf.bck.Props.Extra.AWS.Endpoint
+			}
+			if sessConf.bck.Props.Extra.AWS.Profile != "" {
+				profile = sessConf.bck.Props.Extra.AWS.Profile
+			}
 		}
 	}
 
@@ -780,7 +717,15 @@ func (sessConf *sessConf) s3client(tag string) (*s3.Client, error) {
 	}
 
 	// slow path
-	cfg, err := loadConfig(endpoint, profile)
+	var (
+		cfg aws.Config
+		err error
+	)
+	if sessConf.prof != nil {
+		cfg, err = loadProfConfig(sessConf.prof)
+	} else {
+		cfg, err = loadConfig(endpoint, profile)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -811,6 +756,10 @@ func (sessConf *sessConf) options(options *s3.Options) {
 	} else {
 		sessConf.region = options.Region
 	}
+	if sessConf.prof != nil {
+		options.UsePathStyle = sessConf.prof.PathStyle
+		return
+	}
 	if bck := sessConf.bck; bck != nil {
 		if bck.Props != nil {
 			options.UsePathStyle = bck.Props.Features.IsSet(feat.S3UsePathStyle)
@@ -857,6 +806,55 @@ func loadConfig(endpoint, profile string) (aws.Config, error) {
 	return cfg, nil
 }
 
+// loadProfConfig creates config for a given named backend profile
+func loadProfConfig(prof *cmn.BackendProfile) (aws.Config, error) {
+	opts := []func(*config.LoadOptions) error{
+		config.WithHTTPClient(tracing.NewTraceableClient(cmn.NewClient(cmn.TransportArgs{}))),
+	}
+	kind, val, err := cmn.ParseCredsSrc(prof.Creds)
+	if err != nil {
+		return aws.Config{}, err
+	}
+	switch kind {
+	case cmn.CredsProfile:
+		opts = append(opts, config.WithSharedConfigProfile(val))
+	case cmn.CredsFile:
+		opts = append(opts, config.WithSharedCredentialsFiles([]string{val}), config.WithSharedConfigProfile("default"))
+	case cmn.CredsEnv:
+		var (
+			id     = os.Getenv(val + "_ACCESS_KEY_ID")
+			secret = os.Getenv(val + "_SECRET_ACCESS_KEY")
+		)
+		if id == "" || secret == "" {
+			return aws.Config{}, fmt.Errorf("missing %s_ACCESS_KEY_ID and/or %s_SECRET_ACCESS_KEY environment", val, val)
+		}
+		creds := credentials.NewStaticCredentialsProvider(id, secret, os.Getenv(val+"_SESSION_TOKEN"))
+		opts = append(opts, config.WithCredentialsProvider(creds))
+	}
+	cfg, err := config.LoadDefaultConfig(context.Background(), opts...)
+	if err != nil {
+		return cfg, err
+	}
+	cfg.BaseEndpoint = aws.String(prof.Endpoint)
+	return cfg, nil
+}
+
+// drop cached clients of a given named backend profile
+// (to be recreated with updated credentials upon next access)
+func ReloadS3Profile(name string) error {
+	if _, err := cmn.GCO.Get().Backend.Profile(apc.AWS, name); err != nil {
+		return err
+	}
+	prefix := profPrefix + name + "#"
+	clients.Range(func(k, _ any) bool {
+		if strings.HasPrefix(k.(string), prefix) {
+			clients.Delete(k)
+		}
+		return true
+	})
+	return nil
+}
+
 func getBucketVersioning(svc *s3.Client, bck *cmn.Bck) (enabled bool, errV error) {
 	input := &s3.GetBucketVersioningInput{Bucket: aws.String(bck.Name)}
 	result, err := svc.GetBucketVersioning(context.Background(), input)
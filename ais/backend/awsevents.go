@@ -0,0 +1,63 @@
+//go:build aws
+
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/env"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/config"
+)
+
+// SQS (change notifications) request signing - see events.go
+
+var sqsCfg struct {
+	cfg *aws.Config
+	mu  sync.Mutex
+}
+
+func init() { sqsSign = signSQS }
+
+func signSQS(req *http.Request, body []byte, region string) error {
+	sqsCfg.mu.Lock()
+	if sqsCfg.cfg == nil {
+		// (signing only - the request itself is sent by the caller's client)
+		cfg, err := config.LoadDefaultConfig(req.Context(), config.WithSharedConfigProfile(awsProfile))
+		if err != nil {
+			sqsCfg.mu.Unlock()
+			return err
+		}
+		sqsCfg.cfg = &cfg
+	}
+	cfg := sqsCfg.cfg
+	sqsCfg.mu.Unlock()
+
+	creds, err := cfg.Credentials.Retrieve(req.Context())
+	if err != nil {
+		return err
+	}
+	if region == "" {
+		if region = cfg.Region; region == "" {
+			region = env.AwsDefaultRegion()
+		}
+	}
+	hash := sha256.Sum256(body)
+	return v4.NewSigner().SignHTTP(req.Context(), creds, req, hex.EncodeToString(hash[:]), "sqs", region, time.Now())
+}
+
+// (upon reloading backend credentials)
+func resetSQS() {
+	sqsCfg.mu.Lock()
+	sqsCfg.cfg = nil
+	sqsCfg.mu.Unlock()
+}
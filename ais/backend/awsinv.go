@@ -39,21 +39,9 @@ import (
 // TODO:
 // - LsoMsg.StartAfter (a.k.a. ListObjectsV2Input.StartAfter); see also "expecting to resume" below
 
-// constant and tunables (see also: ais/s3/inventory)
+// constant and tunables (see also: ais/s3/inventory and inventory.go)
 const numBlobWorkers = 10
 
-const invTag = "bucket-inventory"
-
-const invBusyTimeout = 10 * time.Second
-
-const (
-	invMaxLine = cos.KiB >> 1 // line buf
-	invSwapSGL = invMaxLine
-
-	invMaxPage = 8 * apc.MaxPageSizeAWS
-	invPageSGL = max(invMaxPage*invMaxLine, 2*cos.MiB)
-)
-
 // NOTE: hardcoding two groups of constants - cannot find any of them in https://github.com/aws/aws-sdk-go-v2
 // Generally, instead of reading inventory manifest line by line (and worrying about duplicated constants)
 // it'd be much nicer to have an official JSON.
@@ -164,33 +152,6 @@ func cleanupOldInventory(cloudBck *cmn.Bck, svc *s3.Client, lsV2resp *s3.ListObj
 	}
 }
 
-func checkInvLom(latest time.Time, ctx *core.LsoInvCtx) (time.Time, bool) {
-	size, _, mtime, err := ctx.Lom.Fstat(false /*get-atime*/)
-	if err != nil {
-		debug.Assert(os.IsNotExist(err), err)
-		nlog.Infoln(invTag, "does not exist, getting a new one for the timestamp:", latest)
-		return time.Time{}, false
-	}
-
-	if cmn.Rom.FastV(5, cos.SmoduleBackend) {
-		nlog.Infoln(core.T.String(), "checking", ctx.Lom.String(), ctx.Lom.FQN, ctx.Lom.HrwFQN)
-	}
-	abs := _sinceAbs(mtime, latest)
-	if abs < time.Second {
-		debug.Assert(ctx.Size == 0 || ctx.Size == size)
-		ctx.Size = size
-
-		// start (or rather, keep) using this one
-		errN := ctx.Lom.Load(true, true)
-		debug.AssertNoErr(errN)
-		debug.Assert(ctx.Lom.Lsize() == size, ctx.Lom.Lsize(), size)
-		return time.Time{}, true
-	}
-
-	nlog.Infoln(invTag, ctx.Lom.Cname(), "is likely being updated: [", mtime.String(), latest.String(), abs, "]")
-	return mtime, false
-}
-
 // get+unzip and write lom
 func (s3bp *s3bp) getInventory(cloudBck *cmn.Bck, ctx *core.LsoInvCtx, csv invT) error {
 	lom := &core.LOM{ObjName: csv.oname}
@@ -243,23 +204,13 @@ func (s3bp *s3bp) getInventory(cloudBck *cmn.Bck, ctx *core.LsoInvCtx, csv invT)
 	wfh.Close()
 	gzr.Close()
 
-	// finalize (NOTE a lighter version of FinalizeObj - no redundancy, no locks)
+	// finalize
 	if err == nil {
-		lom := ctx.Lom
-		if err = lom.RenameFinalize(wfqn); err == nil {
-			if err = os.Chtimes(lom.FQN, csv.mtime, csv.mtime); err == nil {
-				nlog.Infoln("new", invTag+":", lom.Cname(), ctx.Schema)
-
-				lom.SetSize(ctx.Size)
-				lom.SetAtimeUnix(csv.mtime.UnixNano())
-				if errN := lom.PersistMain(); errN != nil {
-					debug.AssertNoErr(errN) // (unlikely)
-					nlog.Errorln("failed to persist", lom.Cname(), "err:", err, "- proceeding anyway...")
-				} else if cmn.Rom.FastV(4, cos.SmoduleBackend) {
-					nlog.Infoln("done", xblob.String(), "->", lom.Cname(), ctx.Size)
-				}
-				return nil
+		if err = finalizeInv(ctx, wfqn, csv.mtime); err == nil {
+			if cmn.Rom.FastV(4, cos.SmoduleBackend) {
+				nlog.Infoln("done", xblob.String(), "->", ctx.Lom.Cname(), ctx.Size)
 			}
+			return nil
 		}
 	}
 
@@ -286,18 +237,8 @@ func (*s3bp) listInventory(cloudBck *cmn.Bck, ctx *core.LsoInvCtx, msg *apc.LsoM
 
 	// when little remains: read some more unless eof
 	sgl := ctx.SGL
-	if sgl.Len() < 2*invSwapSGL && !ctx.EOF {
-		_, err = io.CopyN(sgl, ctx.Lmfh, invPageSGL-sgl.Len()-256)
-		if err != nil {
-			ctx.EOF = err == io.EOF
-			if !ctx.EOF {
-				nlog.Errorln("Warning: error reading csv", err)
-				return err
-			}
-			if sgl.Len() == 0 {
-				return err
-			}
-		}
+	if err = invFill(ctx, invSwapSGL); err != nil {
+		return err
 	}
 
 	if msg.WantProp(apc.GetPropsCustom) {
@@ -477,17 +418,6 @@ func _bhead(sgl *memsys.SGL, lbuf []byte) (s string) {
 	return s
 }
 
-func _errInv(tag string, err error) error {
-	return fmt.Errorf("%s: %s: %v", invTag, tag, err)
-}
-
-func _sinceAbs(t1, t2 time.Time) time.Duration {
-	if t1.After(t2) {
-		return t1.Sub(t2)
-	}
-	return t2.Sub(t1)
-}
-
 //
 // chunk reader; serial reader; unzip unzipWriter
 //
@@ -32,7 +32,42 @@ func decodeXML[T any](body []byte) (result T, _ error) {
 	return result, nil
 }
 
-func StartMpt(lom *core.LOM, oreq *http.Request, oq url.Values) (id string, ecode int, _ error) {
+// S3 multipart upload API (rate-limited and, when throttled, retried - see throttle.go)
+
+func StartMpt(lom *core.LOM, oreq *http.Request, oq url.Values) (id string, ecode int, err error) {
+	ecode, err = throttleDo(context.Background(), lom.Bck(), 0, true, func() (code int, e error) {
+		id, code, e = startMpt(lom, oreq, oq)
+		return code, e
+	})
+	return id, ecode, err
+}
+
+// (not retrying: the part is read from the original request)
+func PutMptPart(lom *core.LOM, r io.ReadCloser, oreq *http.Request, oq url.Values, uploadID string, size int64, partNum int32) (etag string,
+	ecode int, err error) {
+	ecode, err = throttleDo(context.Background(), lom.Bck(), size, false, func() (code int, e error) {
+		etag, code, e = putMptPart(lom, r, oreq, oq, uploadID, size, partNum)
+		return code, e
+	})
+	return etag, ecode, err
+}
+
+func CompleteMpt(lom *core.LOM, oreq *http.Request, oq url.Values, uploadID string, obody []byte, parts *aiss3.CompleteMptUpload) (version, etag string,
+	ecode int, err error) {
+	ecode, err = throttleDo(context.Background(), lom.Bck(), 0, true, func() (code int, e error) {
+		version, etag, code, e = completeMpt(lom, oreq, oq, uploadID, obody, parts)
+		return code, e
+	})
+	return version, etag, ecode, err
+}
+
+func AbortMpt(lom *core.LOM, oreq *http.Request, oq url.Values, uploadID string) (int, error) {
+	return throttleDo(context.Background(), lom.Bck(), 0, true, func() (int, error) {
+		return abortMpt(lom, oreq, oq, uploadID)
+	})
+}
+
+func startMpt(lom *core.LOM, oreq *http.Request, oq url.Values) (id string, ecode int, _ error) {
 	if lom.IsFeatureSet(feat.S3PresignedRequest) && oreq != nil {
 		pts := aiss3.NewPresignedReq(oreq, lom, nil, oq)
 		resp, err := pts.Do(core.T.DataClient())
@@ -75,7 +110,7 @@ func StartMpt(lom *core.LOM, oreq *http.Request, oq url.Values) (id string, ecod
 	return id, ecode, err
 }
 
-func PutMptPart(lom *core.LOM, r io.ReadCloser, oreq *http.Request, oq url.Values, uploadID string, size int64, partNum int32) (etag string,
+func putMptPart(lom *core.LOM, r io.ReadCloser, oreq *http.Request, oq url.Values, uploadID string, size int64, partNum int32) (etag string,
 	ecode int, _ error) {
 	h := cmn.BackendHelpers.Amazon
 
@@ -119,7 +154,7 @@ func PutMptPart(lom *core.LOM, r io.ReadCloser, oreq *http.Request, oq url.Value
 	return etag, ecode, err
 }
 
-func CompleteMpt(lom *core.LOM, oreq *http.Request, oq url.Values, uploadID string, obody []byte, parts *aiss3.CompleteMptUpload) (version, etag string,
+func completeMpt(lom *core.LOM, oreq *http.Request, oq url.Values, uploadID string, obody []byte, parts *aiss3.CompleteMptUpload) (version, etag string,
 	ecode int, _ error) {
 	h := cmn.BackendHelpers.Amazon
 
@@ -169,7 +204,7 @@ func CompleteMpt(lom *core.LOM, oreq *http.Request, oq url.Values, uploadID stri
 	return version, etag, ecode, err
 }
 
-func AbortMpt(lom *core.LOM, oreq *http.Request, oq url.Values, uploadID string) (ecode int, err error) {
+func abortMpt(lom *core.LOM, oreq *http.Request, oq url.Values, uploadID string) (ecode int, err error) {
 	if lom.IsFeatureSet(feat.S3PresignedRequest) && oreq != nil {
 		pts := aiss3.NewPresignedReq(oreq, lom, oreq.Body, oq)
 		resp, err := pts.Do(core.T.DataClient())
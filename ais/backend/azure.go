@@ -24,6 +24,7 @@ import (
 	"os"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
@@ -115,7 +116,7 @@ func NewAzure(t core.TargetPut, tstats stats.Tracker, startingUp bool) (core.Bac
 	// register metrics
 	bp.base.init(t.Snode(), tstats, startingUp)
 
-	return bp, nil
+	return newThrottled(bp, t, tstats), nil
 }
 
 // (compare w/ cmn/backend)
@@ -482,25 +483,39 @@ func (azbp *azbp) PutObj(r io.ReadCloser, lom *core.LOM, _ *http.Request) (int,
 	if err != nil {
 		return azureErrorToAISError(err, &cmn.Bck{Provider: apc.Azure}, "")
 	}
-	cloudBck := lom.Bck().RemoteBck()
-
-	opts := azblob.UploadStreamOptions{}
-	if size := lom.Lsize(true); size > cos.MiB {
-		opts.Concurrency = int(min((size+cos.MiB-1)/cos.MiB, 8))
+	var (
+		etag     *azcore.ETag
+		lastMod  *time.Time
+		cloudBck = lom.Bck().RemoteBck()
+		size     = lom.Lsize(true)
+		mpconf   = cmn.GCO.Get().Backend.Multipart(apc.Azure)
+	)
+	if size >= int64(mpconf.Threshold) {
+		// large object: stage blocks and commit (see azuremp.go)
+		resp, ecode, err := azbp.putBlocks(client, r, lom, size, &mpconf)
+		if err != nil {
+			return ecode, err
+		}
+		etag, lastMod = resp.ETag, resp.LastModified
+	} else {
+		opts := azblob.UploadStreamOptions{}
+		if size > cos.MiB {
+			opts.Concurrency = int(min((size+cos.MiB-1)/cos.MiB, 8))
+		}
+		resp, err := client.UploadStream(context.Background(), cloudBck.Name, lom.ObjName, r, &opts)
+		if err != nil {
+			return azureErrorToAISError(err, cloudBck, lom.ObjName)
+		}
+		etag, lastMod = resp.ETag, resp.LastModified
 	}
 
-	resp, err := client.UploadStream(context.Background(), cloudBck.Name, lom.ObjName, r, &opts)
-	if err != nil {
-		return azureErrorToAISError(err, cloudBck, lom.ObjName)
+	if etag != nil {
+		v := azEncodeEtag(*etag)
+		lom.SetCustomKey(cmn.ETag, v)
+		lom.SetVersion(v) // TODO #200224
 	}
-
-	etag := azEncodeEtag(*resp.ETag)
-	lom.SetCustomKey(cmn.ETag, etag)
-
-	lom.SetVersion(etag) // TODO #200224
-
-	if v := resp.LastModified; v != nil {
-		lom.SetCustomKey(cmn.LastModified, fmtTime(*v))
+	if lastMod != nil {
+		lom.SetCustomKey(cmn.LastModified, fmtTime(*lastMod))
 	}
 	if cmn.Rom.FastV(5, cos.SmoduleBackend) {
 		nlog.Infof("[put_object] %s", lom)
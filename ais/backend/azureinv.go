@@ -0,0 +1,279 @@
+//go:build azure
+
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Azure Blob Inventory
+// https://learn.microsoft.com/en-us/azure/storage/blobs/blob-inventory
+//
+// Inventory runs are written into the rule's destination container as:
+// "<yyyy>/<MM>/<dd>/<HH-mm-ss>/<rule-name>/" (CSV or Parquet files + "<rule-name>-manifest.json")
+//
+// NOTE assumptions/requirements:
+// - destination container is the inventory name (`--inv-name`), or this same container if unspecified
+// - rule name is the inventory ID (`--inv-id`); if unspecified, the first blob (not container) rule
+//   found in the latest run is used
+// - the rule (its `prefixMatch` filter) covers this container; inventoried blob names are
+//   "<container>/<blob>" - records that belong to other containers are skipped
+// - the latest completed run is used (older runs are not removed)
+
+const (
+	azInvManifestSuffix = "-manifest.json"
+	azInvSucceeded      = "Succeeded"
+)
+
+// date/time hierarchy of inventory runs
+var azInvLevels = []*regexp.Regexp{
+	regexp.MustCompile(`^\d{4}/$`),
+	regexp.MustCompile(`^\d{2}/$`),
+	regexp.MustCompile(`^\d{2}/$`),
+	regexp.MustCompile(`^\d{2}-\d{2}-\d{2}/$`),
+}
+
+type (
+	azInvManifest struct {
+		Files []struct {
+			Blob string `json:"blob"`
+			Size int64  `json:"size"`
+		} `json:"files"`
+		RuleDefinition struct {
+			Format       string   `json:"format"`
+			ObjectType   string   `json:"objectType"`
+			SchemaFields []string `json:"schemaFields"`
+		} `json:"ruleDefinition"`
+		RuleName string `json:"ruleName"`
+		Status   string `json:"status"`
+	}
+	azInv struct {
+		client   *container.Client
+		manifest *azInvManifest
+		dst      string // destination container
+		mname    string
+		mtime    time.Time
+	}
+)
+
+// when successful, returns w/ rlock held and inventory's (lom, lmfh) in the context;
+// otherwise, always unlocks and frees (see getBucketInv)
+func (azbp *azbp) GetBucketInv(bck *meta.Bck, ctx *core.LsoInvCtx) (int, error) {
+	var (
+		cloudBck = bck.RemoteBck()
+		inv      = &azInv{dst: cloudBck.Name}
+	)
+	if ctx.Name != "" {
+		inv.dst = ctx.Name
+	}
+	client, err := container.NewClientWithSharedKeyCredential(azbp.u+"/"+inv.dst, azbp.creds, nil)
+	if err != nil {
+		_, err = azureErrorToAISError(err, cloudBck, "")
+		return 0, err
+	}
+	inv.client = client
+
+	latest := func(string) (time.Time, int, error) {
+		ecode, err := inv.latest(cloudBck, ctx.ID)
+		if err != nil {
+			return time.Time{}, ecode, err
+		}
+		ctx.Schema = inv.manifest.RuleDefinition.SchemaFields
+		return inv.mtime, 0, nil
+	}
+	get := func() error {
+		return inv.get(cloudBck, ctx)
+	}
+	return getBucketInv(bck, ctx, latest, get)
+}
+
+// using local normalized .csv
+func (azbp *azbp) ListObjectsInv(_ *meta.Bck, msg *apc.LsoMsg, lst *cmn.LsoRes, ctx *core.LsoInvCtx) error {
+	return listNormInv(azbp.t.PageMM(), msg, lst, ctx)
+}
+
+///////////
+// azInv //
+///////////
+
+// find the latest run that contains a (completed) inventory for a given rule
+func (inv *azInv) latest(cloudBck *cmn.Bck, rule string) (int, error) {
+	ecode, err := inv.walk(cloudBck, "", 0, rule)
+	if err != nil {
+		return ecode, err
+	}
+	if inv.mname == "" {
+		what := inv.dst
+		if rule != "" {
+			what += "/" + rule
+		}
+		return http.StatusNotFound, cos.NewErrNotFound(cloudBck, invTag+":"+what)
+	}
+	return 0, nil
+}
+
+// depth-first, latest first
+func (inv *azInv) walk(cloudBck *cmn.Bck, prefix string, level int, rule string) (int, error) {
+	if level == len(azInvLevels) {
+		return inv.run(cloudBck, prefix, rule)
+	}
+	dirs, ecode, err := inv.lsDirs(cloudBck, prefix)
+	if err != nil {
+		return ecode, err
+	}
+	for i := len(dirs) - 1; i >= 0 && inv.mname == ""; i-- {
+		if !azInvLevels[level].MatchString(dirs[i][len(prefix):]) {
+			continue
+		}
+		if ecode, err = inv.walk(cloudBck, dirs[i], level+1, rule); err != nil {
+			return ecode, err
+		}
+	}
+	return 0, nil
+}
+
+// given inventory run (directory), look for the rule's manifest
+func (inv *azInv) run(cloudBck *cmn.Bck, prefix, rule string) (int, error) {
+	var rules []string
+	if rule != "" {
+		rules = []string{rule}
+	} else {
+		dirs, ecode, err := inv.lsDirs(cloudBck, prefix)
+		if err != nil {
+			return ecode, err
+		}
+		for _, dir := range dirs {
+			rules = append(rules, strings.TrimSuffix(dir[len(prefix):], "/"))
+		}
+	}
+	for _, rule := range rules {
+		mname := prefix + rule + "/" + rule + azInvManifestSuffix
+		blob := inv.client.NewBlobClient(mname)
+		props, err := blob.GetProperties(context.Background(), nil)
+		if err != nil {
+			ecode, e := azureErrorToAISError(err, cloudBck, mname)
+			if ecode == http.StatusNotFound {
+				continue // (in progress)
+			}
+			return ecode, e
+		}
+		resp, err := blob.DownloadStream(context.Background(), nil)
+		if err != nil {
+			return azureErrorToAISError(err, cloudBck, mname)
+		}
+		manifest := &azInvManifest{}
+		err = jsoniter.NewDecoder(resp.Body).Decode(manifest)
+		cos.Close(resp.Body)
+		if err != nil {
+			return 0, _errInv("parse-manifest "+inv.dst+"/"+mname, err)
+		}
+		if manifest.Status != "" && manifest.Status != azInvSucceeded {
+			nlog.Warningln(invTag, inv.dst+"/"+mname, "status:", manifest.Status, "- skipping")
+			continue
+		}
+		if ot := manifest.RuleDefinition.ObjectType; ot != "" && !strings.EqualFold(ot, "blob") {
+			continue
+		}
+		inv.manifest, inv.mname = manifest, mname
+		if props.LastModified != nil {
+			inv.mtime = *props.LastModified
+		}
+		if cmn.Rom.FastV(4, cos.SmoduleBackend) {
+			nlog.Infoln("parsed manifest", inv.dst+"/"+mname, "files:", len(manifest.Files))
+		}
+		return 0, nil
+	}
+	return 0, nil
+}
+
+// virtual subdirectories, sorted
+func (inv *azInv) lsDirs(cloudBck *cmn.Bck, prefix string) (dirs []string, _ int, _ error) {
+	opts := &container.ListBlobsHierarchyOptions{}
+	if prefix != "" {
+		opts.Prefix = apc.Ptr(prefix)
+	}
+	pager := inv.client.NewListBlobsHierarchyPager("/", opts)
+	for pager.More() {
+		resp, err := pager.NextPage(context.Background())
+		if err != nil {
+			ecode, e := azureErrorToAISError(err, cloudBck, "")
+			return nil, ecode, e
+		}
+		for _, p := range resp.Segment.BlobPrefixes {
+			if p.Name != nil {
+				dirs = append(dirs, *p.Name)
+			}
+		}
+	}
+	slices.Sort(dirs)
+	return dirs, 0, nil
+}
+
+func (inv *azInv) get(cloudBck *cmn.Bck, ctx *core.LsoInvCtx) error {
+	var (
+		manifest = inv.manifest
+		shards   = make([]string, 0, len(manifest.Files))
+		conv     = azInvConv(manifest, cloudBck.Name)
+	)
+	for _, f := range manifest.Files {
+		shards = append(shards, f.Blob)
+	}
+	open := func(oname string) (io.ReadCloser, error) {
+		resp, err := inv.client.NewBlobClient(oname).DownloadStream(context.Background(), nil)
+		if err != nil {
+			_, err = azureErrorToAISError(err, cloudBck, oname)
+			return nil, err
+		}
+		return resp.Body, nil
+	}
+	return conv.getInv(ctx, shards, open, inv.mtime)
+}
+
+// inventoried blob names are "<container>/<blob>"
+func azInvConv(manifest *azInvManifest, container string) *invConv {
+	conv := &invConv{delim: ',', fmt: invFormatCSV, fix: azInvFix, trim: container + "/"}
+	if strings.EqualFold(manifest.RuleDefinition.Format, invFormatParquet) {
+		conv.fmt = invFormatParquet
+	}
+	conv.cols = [ninvNum]string{
+		ninvName:    "Name",
+		ninvSize:    "Content-Length",
+		ninvETag:    "Etag",
+		ninvMtime:   "Last-Modified",
+		ninvVersion: "Etag", // (see azure.go TODO #200224)
+		ninvMD5:     "Content-MD5",
+	}
+	return conv
+}
+
+// (compare w/ ListObjects)
+func azInvFix(rec []string) {
+	rec[ninvETag] = cmn.UnquoteCEV(rec[ninvETag])
+	rec[ninvVersion] = rec[ninvETag]
+	if v := rec[ninvMD5]; v != "" {
+		if b, err := base64.StdEncoding.DecodeString(v); err == nil {
+			rec[ninvMD5] = azEncodeChecksum(b)
+		}
+	}
+	rec[ninvMtime] = invTime(rec[ninvMtime])
+}
@@ -0,0 +1,84 @@
+//go:build azure
+
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/parquet"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	jsoniter "github.com/json-iterator/go"
+)
+
+func TestAzInvConv(t *testing.T) {
+	const (
+		md5B64 = "XUFAKrxLKna5cZ2REBfFkg==" // md5("hello")
+		md5Hex = "5d41402abc4b2a76b9719d911017c592"
+	)
+	tests := []struct {
+		name     string
+		manifest string
+		src      string
+		exp      [][]string
+	}{
+		{
+			name:     "csv",
+			manifest: `{"ruleDefinition": {"format": "Csv"}, "ruleName": "r1", "status": "Succeeded"}`,
+			src: "Name,Creation-Time,Last-Modified,Etag,Content-Length,Content-MD5,BlobType\n" +
+				"cont/a/b.txt,x,\"Thu, 02 Jan 2025 03:04:05 GMT\",\"\"\"0x8DD2B\"\"\",123," + md5B64 + ",BlockBlob\n" +
+				"other/c.txt,x,\"Thu, 02 Jan 2025 03:04:05 GMT\",0x1,1,,BlockBlob\n" + // (other container)
+				"cont/dir/,x,\"Thu, 02 Jan 2025 03:04:05 GMT\",0x2,0,,BlockBlob\n" + // (directory marker)
+				"cont/d,x,2025-01-02T03:04:05.1234567Z,0x3,0,%%%,BlockBlob\n",
+			exp: [][]string{
+				{"a/b.txt", "123", "0x8DD2B", "2025-01-02T03:04:05Z", "0x8DD2B", md5Hex},
+				{"d", "0", "0x3", "2025-01-02T03:04:05Z", "0x3", "%%%"},
+			},
+		},
+		{
+			name:     "csv-name-only",
+			manifest: `{"ruleDefinition": {"format": "csv", "schemaFields": ["Name"]}}`,
+			src:      "Name\ncont/x\ncont/y\n",
+			exp: [][]string{
+				{"x", "", "", "", "", ""},
+				{"y", "", "", "", "", ""},
+			},
+		},
+	}
+	for _, test := range tests {
+		manifest := &azInvManifest{}
+		tassert.CheckFatal(t, jsoniter.Unmarshal([]byte(test.manifest), manifest))
+		c := azInvConv(manifest, "cont")
+		tassert.Fatalf(t, c.fmt == invFormatCSV, "%s: expected CSV, got %q", test.name, c.fmt)
+		recs := invConvert(t, c, []byte(test.src))
+		invCheckRecs(t, test.name, recs, test.exp)
+	}
+}
+
+func TestAzInvConvParquet(t *testing.T) {
+	manifest := &azInvManifest{}
+	tassert.CheckFatal(t, jsoniter.Unmarshal([]byte(`{"ruleDefinition": {"format": "Parquet"}}`), manifest))
+	c := azInvConv(manifest, "cont")
+	tassert.Fatalf(t, c.fmt == invFormatParquet, "expected parquet, got %q", c.fmt)
+
+	cols := []parquet.Column{
+		{Name: "Name", Type: parquet.String},
+		{Name: "Content-Length", Type: parquet.Int64},
+		{Name: "Last-Modified", Type: parquet.TimestampMicros},
+		{Name: "Etag", Type: parquet.String},
+	}
+	ts := int64(1735787045000000) // 2025-01-02T03:04:05Z
+	src := invParquet(t, cols,
+		[]any{"cont/a", int64(10), ts, "0x1"},
+		[]any{"cont2/b", int64(20), ts, "0x2"}, // (other container: same prefix, no slash)
+		[]any{"cont/c/d", int64(1 << 33), ts, "0x3"},
+	)
+	recs := invConvert(t, c, src)
+	invCheckRecs(t, "parquet", recs, [][]string{
+		{"a", "10", "0x1", "2025-01-02T03:04:05Z", "0x1", ""},
+		{"c/d", "8589934592", "0x3", "2025-01-02T03:04:05Z", "0x3", ""},
+	})
+}
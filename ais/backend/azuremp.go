@@ -0,0 +1,228 @@
+//go:build azure
+
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"sync"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/streaming"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blockblob"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/OneOfOne/xxhash"
+)
+
+// Chunked uploads to Azure block blobs (see cmn/multipart.go):
+// - large objects are uploaded as a sequence of blocks (StageBlock) followed by CommitBlockList
+// - up to `parallelism` blocks are staged concurrently; a failed block gets retried
+//   (with jittered exponential backoff) without restarting the entire upload
+// - the source is read at offsets when it supports io.ReaderAt (e.g., local file);
+//   otherwise, each chunk is buffered in memory
+// - S3 multipart upload API (ais/tgts3mpt.go) maps onto the same: each part is a staged block
+//   (StageMptBlock), and completing the upload commits the block list (CommitMptBlocks);
+//   uncommitted blocks of aborted uploads are garbage-collected by Azure
+
+const azMaxBlocks = 50000 // max blocks per blob
+
+type (
+	azChunk struct {
+		r    io.ReadSeekCloser
+		sgl  *memsys.SGL // when buffered
+		id   string      // base64 block ID
+		size int64
+	}
+	azUpload struct {
+		bb    *blockblob.Client
+		mm    *memsys.MMSA
+		conf  cmn.MultipartConf
+		src   io.Reader
+		cname string
+	}
+)
+
+// all block IDs of a given blob must have the same length
+func azBlockID(uploadID string, num int) string {
+	s := fmt.Sprintf("%016x%05d", xxhash.Checksum64S(cos.UnsafeB(uploadID), cos.MLCG32), num)
+	return base64.StdEncoding.EncodeToString(cos.UnsafeB(s))
+}
+
+// 4xx (other than timeout and throttling) is not worth retrying
+func isTransient(ecode int) bool {
+	return ecode >= http.StatusInternalServerError || ecode == http.StatusRequestTimeout ||
+		ecode == http.StatusTooManyRequests || ecode == 0
+}
+
+func (azbp *azbp) putBlocks(client *azblob.Client, r io.Reader, lom *core.LOM, size int64,
+	conf *cmn.MultipartConf) (*blockblob.CommitBlockListResponse, int, error) {
+	var (
+		cloudBck = lom.Bck().RemoteBck()
+		bb       = client.ServiceClient().NewContainerClient(cloudBck.Name).NewBlockBlobClient(lom.ObjName)
+	)
+	conf.ChunkSize = cos.SizeIEC(conf.ChunkSizeFor(size, azMaxBlocks))
+	u := &azUpload{bb: bb, mm: azbp.t.PageMM(), conf: *conf, src: r, cname: lom.Cname()}
+
+	var (
+		ids      []string
+		errs     cos.Errs
+		wg       sync.WaitGroup
+		ctx      = context.Background()
+		sema     = make(chan struct{}, conf.Parallelism)
+		uploadID = cos.GenUUID() // (block IDs are scoped to the blob)
+	)
+	for off, num := int64(0), 0; off < size; num++ {
+		ch, err := u.chunk(off, min(int64(conf.ChunkSize), size-off), azBlockID(uploadID, num))
+		if err != nil {
+			errs.Add(err)
+			break
+		}
+		if errs.Cnt() > 0 {
+			ch.free()
+			break
+		}
+		ids = append(ids, ch.id)
+		off += ch.size
+
+		sema <- struct{}{}
+		wg.Add(1)
+		go func(ch *azChunk) {
+			if _, err := u.stage(ctx, ch); err != nil {
+				errs.Add(err)
+			}
+			ch.free()
+			<-sema
+			wg.Done()
+		}(ch)
+	}
+	wg.Wait()
+	if _, err := errs.JoinErr(); err != nil {
+		return nil, 0, err
+	}
+
+	resp, err := bb.CommitBlockList(ctx, ids, nil)
+	if err != nil {
+		ecode, err := azureErrorToAISError(err, cloudBck, lom.ObjName)
+		return nil, ecode, err
+	}
+	if cmn.Rom.FastV(4, cos.SmoduleBackend) {
+		nlog.Infoln("[put_object] committed", len(ids), "blocks:", lom.Cname())
+	}
+	return &resp, 0, nil
+}
+
+func (u *azUpload) chunk(off, size int64, id string) (*azChunk, error) {
+	if ra, ok := u.src.(io.ReaderAt); ok {
+		return &azChunk{r: streaming.NopCloser(io.NewSectionReader(ra, off, size)), id: id, size: size}, nil
+	}
+	sgl := u.mm.NewSGL(size)
+	n, err := io.CopyN(sgl, u.src, size)
+	if err != nil {
+		sgl.Free()
+		return nil, fmt.Errorf("%s: failed to read chunk [%d, %d): %v", u.cname, off, off+n, err)
+	}
+	return &azChunk{r: memsys.NewReader(sgl), sgl: sgl, id: id, size: size}, nil
+}
+
+func (ch *azChunk) free() {
+	if ch.sgl != nil {
+		ch.sgl.Free()
+		ch.sgl = nil
+	}
+}
+
+// stage with retries
+func (u *azUpload) stage(ctx context.Context, ch *azChunk) (ecode int, err error) {
+	backoff := backoffBase
+	for i := 0; ; i++ {
+		if _, err = u.bb.StageBlock(ctx, ch.id, ch.r, nil); err == nil {
+			return 0, nil
+		}
+		ecode, err = azureErrorToAISError(err, &cmn.Bck{Provider: apc.Azure}, "")
+		if !isTransient(ecode) || i >= u.conf.MaxRetries {
+			return ecode, fmt.Errorf("%s: failed to stage block (size %d, tries %d): %w", u.cname, ch.size, i+1, err)
+		}
+		if _, errS := ch.r.Seek(0, io.SeekStart); errS != nil {
+			return 0, errS
+		}
+		d := backoff/2 + rand.N(backoff/2+1)
+		if errS := sleepCtx(ctx, d); errS != nil {
+			return 0, errS
+		}
+		backoff = min(backoff*2, backoffMax)
+		nlog.Warningln("retrying", u.cname, "block [", ecode, err, i+1, "]")
+	}
+}
+
+//
+// S3 multipart upload API => Azure blocks
+//
+
+func azMptClient(lom *core.LOM) (*blockblob.Client, error) {
+	creds, err := azblob.NewSharedKeyCredential(azAccName(), azAccKey())
+	if err != nil {
+		return nil, err
+	}
+	client, err := azblob.NewClientWithSharedKeyCredential(asEndpoint(), creds, nil)
+	if err != nil {
+		return nil, err
+	}
+	cloudBck := lom.Bck().RemoteBck()
+	return client.ServiceClient().NewContainerClient(cloudBck.Name).NewBlockBlobClient(lom.ObjName), nil
+}
+
+// stage part `partNum` of a given upload; `r` is expected to be a local (part) file
+// (rate-limited but not retried by the throttle - stage() retries on its own)
+func StageMptBlock(lom *core.LOM, r io.ReadSeekCloser, uploadID string, size int64, partNum int32) (int, error) {
+	bb, err := azMptClient(lom)
+	if err != nil {
+		return azureErrorToAISError(err, &cmn.Bck{Provider: apc.Azure}, "")
+	}
+	u := &azUpload{bb: bb, conf: cmn.GCO.Get().Backend.Multipart(apc.Azure), cname: lom.Cname()}
+	ch := &azChunk{r: r, id: azBlockID(uploadID, int(partNum)), size: size}
+	return throttleDo(context.Background(), lom.Bck(), size, false, func() (int, error) {
+		return u.stage(context.Background(), ch)
+	})
+}
+
+// commit (sorted) parts of a given upload
+func CommitMptBlocks(lom *core.LOM, uploadID string, partNums []int32) (version, etag string, _ int, _ error) {
+	bb, err := azMptClient(lom)
+	if err != nil {
+		ecode, err := azureErrorToAISError(err, &cmn.Bck{Provider: apc.Azure}, "")
+		return "", "", ecode, err
+	}
+	ids := make([]string, len(partNums))
+	for i, num := range partNums {
+		ids[i] = azBlockID(uploadID, int(num))
+	}
+	var resp blockblob.CommitBlockListResponse
+	ecode, err := throttleDo(context.Background(), lom.Bck(), 0, true, func() (int, error) {
+		var e error
+		if resp, e = bb.CommitBlockList(context.Background(), ids, nil); e != nil {
+			return azureErrorToAISError(e, lom.Bck().RemoteBck(), lom.ObjName)
+		}
+		return 0, nil
+	})
+	if err != nil {
+		return "", "", ecode, err
+	}
+	if resp.ETag != nil {
+		etag = azEncodeEtag(*resp.ETag)
+		version = etag // TODO #200224
+	}
+	return version, etag, http.StatusOK, nil
+}
@@ -0,0 +1,152 @@
+//go:build azure
+
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+// block blob service that stages blocks, commits block lists, and fails
+// a given number of attempts to stage a given block
+type azFakeBlob struct {
+	blocks  map[string][]byte // by block ID
+	fail    map[int]int       // block number => number of failures
+	blob    []byte            // committed
+	status  int               // failure status
+	staged  int               // attempts
+	commits int
+	mu      sync.Mutex
+}
+
+func (fb *azFakeBlob) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	fb.mu.Lock()
+	defer fb.mu.Unlock()
+	q := r.URL.Query()
+	switch q.Get("comp") {
+	case "block":
+		fb.staged++
+		id := q.Get("blockid")
+		s, err := base64.StdEncoding.DecodeString(id)
+		if err != nil || len(s) < 5 {
+			http.Error(w, "bad block ID", http.StatusBadRequest)
+			return
+		}
+		num, _ := strconv.Atoi(string(s[len(s)-5:]))
+		if fb.fail[num] > 0 {
+			fb.fail[num]--
+			w.Header().Set("x-ms-error-code", "ServerBusy")
+			w.WriteHeader(fb.status)
+			io.WriteString(w, `<?xml version="1.0" encoding="utf-8"?><Error><Code>ServerBusy</Code><Message>busy</Message></Error>`)
+			return
+		}
+		b, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		fb.blocks[id] = b
+		w.WriteHeader(http.StatusCreated)
+	case "blocklist":
+		fb.commits++
+		var list struct {
+			Latest []string `xml:"Latest"`
+		}
+		if err := xml.NewDecoder(r.Body).Decode(&list); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		var blob []byte
+		for _, id := range list.Latest {
+			blob = append(blob, fb.blocks[id]...)
+		}
+		fb.blob = blob
+		w.Header().Set("ETag", `"0x8D4BCC2E4835CD0"`)
+		w.Header().Set("Last-Modified", time.Now().UTC().Format(http.TimeFormat))
+		w.WriteHeader(http.StatusCreated)
+	default:
+		http.Error(w, "unexpected "+r.Method+" "+r.URL.String(), http.StatusBadRequest)
+	}
+}
+
+func newAzFake(t *testing.T, status int, fail map[int]int) (*azFakeBlob, *azblob.Client) {
+	fb := &azFakeBlob{blocks: make(map[string][]byte), fail: fail, status: status}
+	srv := httptest.NewServer(fb)
+	t.Cleanup(srv.Close)
+	// (retries are ours - not the SDK's)
+	opts := &azblob.ClientOptions{ClientOptions: azcore.ClientOptions{Retry: policy.RetryOptions{MaxRetries: -1}}}
+	client, err := azblob.NewClientWithNoCredential(srv.URL+"/", opts)
+	tassert.CheckFatal(t, err)
+	return fb, client
+}
+
+func TestAzurePutBlocks(t *testing.T) {
+	mpconf := &cmn.MultipartConf{Threshold: cos.MiB, ChunkSize: cos.MiB, Parallelism: 2, MaxRetries: 3}
+	tgt := mptTestInit(t, apc.Azure, mpconf)
+	azbp := &azbp{t: tgt, base: base{provider: apc.Azure}}
+
+	data := mptTestData(3*cos.MiB + cos.MiB/2)
+	for _, readerAt := range []bool{true, false} {
+		t.Run("reader-at="+strconv.FormatBool(readerAt), func(t *testing.T) {
+			// blocks #1 and #3 fail (503) twice and once, respectively, and get retried
+			fb, client := newAzFake(t, http.StatusServiceUnavailable, map[int]int{1: 2, 3: 1})
+			var r io.Reader = bytes.NewReader(data)
+			if !readerAt {
+				r = io.NopCloser(r)
+			}
+			lom := mptTestLOM(t, apc.Azure, "large", int64(len(data)))
+			conf := cmn.GCO.Get().Backend.Multipart(apc.Azure)
+
+			resp, _, err := azbp.putBlocks(client, r, lom, int64(len(data)), &conf)
+			tassert.CheckFatal(t, err)
+			tassert.Errorf(t, resp.ETag != nil, "expected ETag")
+			tassert.Errorf(t, fb.staged == 4+3, "expected 7 attempts to stage 4 blocks, got %d", fb.staged)
+			tassert.Errorf(t, fb.commits == 1, "expected a single commit, got %d", fb.commits)
+			tassert.Fatalf(t, bytes.Equal(fb.blob, data), "committed blob differs (size %d vs %d)", len(fb.blob), len(data))
+		})
+	}
+}
+
+func TestAzurePutBlocksNoRetry(t *testing.T) {
+	mpconf := &cmn.MultipartConf{Threshold: cos.MiB, ChunkSize: cos.MiB, Parallelism: 1, MaxRetries: 3}
+	tgt := mptTestInit(t, apc.Azure, mpconf)
+	azbp := &azbp{t: tgt, base: base{provider: apc.Azure}}
+	data := mptTestData(2 * cos.MiB)
+
+	// 4xx: not retried (single block)
+	fb, client := newAzFake(t, http.StatusForbidden, map[int]int{0: 1})
+	lom := mptTestLOM(t, apc.Azure, "forbidden", cos.MiB)
+	conf := cmn.GCO.Get().Backend.Multipart(apc.Azure)
+	_, _, err := azbp.putBlocks(client, bytes.NewReader(data[:cos.MiB]), lom, cos.MiB, &conf)
+	tassert.Errorf(t, err != nil, "expected error")
+	tassert.Errorf(t, fb.staged == 1, "expected a single attempt, got %d", fb.staged)
+	tassert.Errorf(t, fb.commits == 0 && fb.blob == nil, "expected no commit")
+
+	// retries exhausted
+	fb, client = newAzFake(t, http.StatusServiceUnavailable, map[int]int{1: mpconf.MaxRetries + 1})
+	lom = mptTestLOM(t, apc.Azure, "busy", int64(len(data)))
+	_, _, err = azbp.putBlocks(client, bytes.NewReader(data), lom, int64(len(data)), &conf)
+	tassert.Errorf(t, err != nil, "expected error")
+	tassert.Errorf(t, fb.commits == 0, "expected no commit")
+	tassert.Errorf(t, fb.staged == 1+mpconf.MaxRetries+1, "expected %d attempts, got %d", 1+mpconf.MaxRetries+1, fb.staged)
+}
@@ -19,7 +19,7 @@ import (
 	"github.com/NVIDIA/aistore/stats"
 )
 
-const numBackendMetricks = 12
+const numBackendMetricks = 14
 
 type base struct {
 	metrics  cos.StrKVs // this backend's metric names (below)
@@ -200,6 +200,36 @@ func (b *base) init(snode *meta.Snode, tr stats.Tracker, startingUp bool) {
 			},
 		)
 	}
+
+	// client-side rate limiting and backoff (cloud backends only - see throttle.go)
+	if !apc.IsCloudProvider(b.provider) {
+		return
+	}
+	b.metrics[stats.ThrottleCount] = prefix + "." + stats.ThrottleCount
+	b.metrics[stats.ThrottleLatencyTotal] = prefix + "." + stats.ThrottleLatencyTotal
+
+	if regExt {
+		tr.RegExtMetric(snode,
+			b.metrics[stats.ThrottleCount],
+			stats.KindCounter,
+			&stats.Extra{
+				Help:    "number of remote requests throttled by the backend (429 Too Many Requests, 503 SlowDown)",
+				StrName: "remote_throttle_count",
+				Labels:  labels,
+				VarLabs: stats.BckVarlabs,
+			},
+		)
+		tr.RegExtMetric(snode,
+			b.metrics[stats.ThrottleLatencyTotal],
+			stats.KindTotal,
+			&stats.Extra{
+				Help:    "total cumulative time (nanoseconds) remote requests were delayed by client-side rate limiting and backoff",
+				StrName: "remote_throttle_ns_total",
+				Labels:  labels,
+				VarLabs: stats.BckVarlabs,
+			},
+		)
+	}
 }
 
 func (b *base) Provider() string { return b.provider }
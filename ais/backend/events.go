@@ -0,0 +1,478 @@
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Remote bucket change notifications (see cmn/bckevents.go):
+// - parse provider-specific event formats into normalized cmn.BckEvent
+// - poll SQS (JSON protocol) and pull Pub/Sub (REST) via plain HTTP - the same code
+//   works with local stand-ins (e.g., ElasticMQ, LocalStack, Pub/Sub emulator)
+// - authentication is provided by the respective (build-tagged) backends: see sqsSign and pubsubClient
+
+const (
+	sqsMaxMessages = 10  // (SQS max)
+	sqsWaitTime    = 20  // long polling, seconds (SQS max)
+	sqsVisibility  = 120 // seconds
+
+	pubsubMaxMessages = 100
+	pubsubPullTime    = 30 * time.Second
+	pubsubEndpoint    = "https://pubsub.googleapis.com"
+	pubsubEmulatorEnv = "PUBSUB_EMULATOR_HOST"
+)
+
+type (
+	EventMsg struct {
+		ID     string // SQS receipt handle or Pub/Sub ack ID
+		Events []cmn.BckEvent
+	}
+	EventSource interface {
+		// blocks (long-polls) until there are messages, or timeout, or canceled context
+		Receive(ctx context.Context) ([]EventMsg, error)
+		// delete received messages from the queue (subscription)
+		Ack(ctx context.Context, msgs []EventMsg) error
+		String() string
+	}
+
+	sqsSrc struct {
+		client *http.Client
+		queue  string // queue URL
+		url    string // service endpoint
+		region string
+	}
+	pubsubSrc struct {
+		client *http.Client
+		sub    string // projects/<project>/subscriptions/<name>
+		url    string // service endpoint
+	}
+)
+
+// interface guard
+var (
+	_ EventSource = (*sqsSrc)(nil)
+	_ EventSource = (*pubsubSrc)(nil)
+)
+
+var (
+	// set by the aws backend: sign SQS request (SigV4)
+	sqsSign func(req *http.Request, body []byte, region string) error
+	// set by the gcp backend: authenticated client
+	pubsubClient func(ctx context.Context) (*http.Client, error)
+)
+
+func NewEventSource(conf *cmn.EventsConf) (EventSource, error) {
+	switch conf.Source {
+	case cmn.EventsSQS:
+		return newSQS(conf)
+	case cmn.EventsPubSub:
+		return newPubSub(conf)
+	default:
+		return nil, fmt.Errorf("events: source %q is not polled", conf.Source)
+	}
+}
+
+/////////
+// SQS //
+/////////
+
+func newSQS(conf *cmn.EventsConf) (*sqsSrc, error) {
+	u, err := url.Parse(conf.Queue)
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("events: invalid SQS queue URL %q", conf.Queue)
+	}
+	s := &sqsSrc{
+		client: cmn.NewClient(cmn.TransportArgs{Timeout: (sqsWaitTime + 10) * time.Second}),
+		queue:  conf.Queue,
+		url:    u.Scheme + "://" + u.Host,
+	}
+	if conf.Endpoint != "" {
+		s.url = conf.Endpoint
+	} else if sqsSign == nil {
+		return nil, errors.New("events: SQS requires aws backend (hint: build with 'aws' tag or specify endpoint of a local stand-in)")
+	}
+	// e.g. https://sqs.us-east-2.amazonaws.com/123456789012/name
+	if h := strings.Split(u.Hostname(), "."); len(h) > 2 && h[0] == "sqs" {
+		s.region = h[1]
+	}
+	return s, nil
+}
+
+func (s *sqsSrc) String() string { return "sqs[" + s.queue + "]" }
+
+func (s *sqsSrc) Receive(ctx context.Context) ([]EventMsg, error) {
+	var (
+		out struct {
+			Messages []struct {
+				ReceiptHandle string
+				Body          string
+			}
+		}
+		in = map[string]any{
+			"QueueUrl":            s.queue,
+			"MaxNumberOfMessages": sqsMaxMessages,
+			"WaitTimeSeconds":     sqsWaitTime,
+			"VisibilityTimeout":   sqsVisibility,
+		}
+	)
+	if err := s.call(ctx, "ReceiveMessage", in, &out); err != nil {
+		return nil, err
+	}
+	msgs := make([]EventMsg, 0, len(out.Messages))
+	for _, m := range out.Messages {
+		events, err := ParseS3Events([]byte(m.Body))
+		if err != nil {
+			// (not retrying: the message won't parse next time either)
+			nlog.Warningln(s.String(), "failed to parse message:", err)
+		}
+		msgs = append(msgs, EventMsg{ID: m.ReceiptHandle, Events: events})
+	}
+	return msgs, nil
+}
+
+func (s *sqsSrc) Ack(ctx context.Context, msgs []EventMsg) error {
+	for len(msgs) > 0 {
+		n := min(len(msgs), sqsMaxMessages)
+		entries := make([]map[string]string, n)
+		for i := range n {
+			entries[i] = map[string]string{"Id": strconv.Itoa(i), "ReceiptHandle": msgs[i].ID}
+		}
+		var out struct {
+			Failed []struct {
+				ID      string `json:"Id"`
+				Message string `json:"Message"`
+			}
+		}
+		if err := s.call(ctx, "DeleteMessageBatch", map[string]any{"QueueUrl": s.queue, "Entries": entries}, &out); err != nil {
+			return err
+		}
+		if len(out.Failed) > 0 {
+			return fmt.Errorf("%s: failed to delete %d message(s): %s", s, len(out.Failed), out.Failed[0].Message)
+		}
+		msgs = msgs[n:]
+	}
+	return nil
+}
+
+// SQS JSON protocol
+func (s *sqsSrc) call(ctx context.Context, action string, in, out any) error {
+	body := cos.MustMarshal(in)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set(cos.HdrContentType, "application/x-amz-json-1.0")
+	req.Header.Set("X-Amz-Target", "AmazonSQS."+action)
+	if sqsSign != nil {
+		if err := sqsSign(req, body, s.region); err != nil {
+			return err
+		}
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer cos.DrainReader(resp.Body)
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		var e struct {
+			Type    string `json:"__type"`
+			Message string `json:"message"`
+		}
+		_ = jsoniter.NewDecoder(resp.Body).Decode(&e)
+		return fmt.Errorf("%s: %s failed: %s (%s, status %d)", s, action, e.Message, e.Type, resp.StatusCode)
+	}
+	return jsoniter.NewDecoder(resp.Body).Decode(out)
+}
+
+/////////////
+// Pub/Sub //
+/////////////
+
+func newPubSub(conf *cmn.EventsConf) (*pubsubSrc, error) {
+	s := &pubsubSrc{sub: conf.Queue, url: conf.Endpoint}
+	if s.url == "" {
+		if host := os.Getenv(pubsubEmulatorEnv); host != "" {
+			s.url = "http://" + host
+		}
+	}
+	if s.url != "" {
+		// emulator or local stand-in: no authentication
+		s.client = cmn.NewClient(cmn.TransportArgs{Timeout: pubsubPullTime + 10*time.Second})
+		return s, nil
+	}
+	if pubsubClient == nil {
+		return nil, errors.New("events: Pub/Sub requires gcp backend (hint: build with 'gcp' tag or specify endpoint of the emulator)")
+	}
+	client, err := pubsubClient(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	s.client, s.url = client, pubsubEndpoint
+	return s, nil
+}
+
+func (s *pubsubSrc) String() string { return "pubsub[" + s.sub + "]" }
+
+func (s *pubsubSrc) Receive(ctx context.Context) ([]EventMsg, error) {
+	var out struct {
+		ReceivedMessages []struct {
+			AckID   string `json:"ackId"`
+			Message struct {
+				Data       string            `json:"data"`
+				Attributes map[string]string `json:"attributes"`
+			} `json:"message"`
+		} `json:"receivedMessages"`
+	}
+	// pull blocks until there are messages (or server-side timeout)
+	ctx, cancel := context.WithTimeout(ctx, pubsubPullTime)
+	defer cancel()
+	if err := s.call(ctx, "pull", map[string]any{"maxMessages": pubsubMaxMessages}, &out); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	msgs := make([]EventMsg, 0, len(out.ReceivedMessages))
+	for _, m := range out.ReceivedMessages {
+		msg := EventMsg{ID: m.AckID}
+		data, err := base64.StdEncoding.DecodeString(m.Message.Data)
+		if err == nil {
+			var ev *cmn.BckEvent
+			if ev, err = ParseGCSEvent(m.Message.Attributes, data); ev != nil {
+				msg.Events = []cmn.BckEvent{*ev}
+			}
+		}
+		if err != nil {
+			nlog.Warningln(s.String(), "failed to parse message:", err)
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs, nil
+}
+
+func (s *pubsubSrc) Ack(ctx context.Context, msgs []EventMsg) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+	ids := make([]string, len(msgs))
+	for i := range msgs {
+		ids[i] = msgs[i].ID
+	}
+	return s.call(ctx, "acknowledge", map[string]any{"ackIds": ids}, nil)
+}
+
+// REST: POST /v1/{subscription}:{verb}
+func (s *pubsubSrc) call(ctx context.Context, verb string, in, out any) error {
+	u := s.url + "/v1/" + s.sub + ":" + verb
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewReader(cos.MustMarshal(in)))
+	if err != nil {
+		return err
+	}
+	req.Header.Set(cos.HdrContentType, cos.ContentJSON)
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer cos.DrainReader(resp.Body)
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		var e struct {
+			Error struct {
+				Message string `json:"message"`
+				Status  string `json:"status"`
+			} `json:"error"`
+		}
+		_ = jsoniter.NewDecoder(resp.Body).Decode(&e)
+		return fmt.Errorf("%s: %s failed: %s (%s, status %d)", s, verb, e.Error.Message, e.Error.Status, resp.StatusCode)
+	}
+	if out == nil {
+		return nil
+	}
+	return jsoniter.NewDecoder(resp.Body).Decode(out)
+}
+
+/////////////
+// parsing //
+/////////////
+
+// S3 event notification (including s3:TestEvent, SNS envelope, and EventBridge format)
+// - see https://docs.aws.amazon.com/AmazonS3/latest/userguide/notification-content-structure.html
+func ParseS3Events(body []byte) ([]cmn.BckEvent, error) {
+	var msg struct {
+		Records []struct {
+			EventName string `json:"eventName"`
+			S3        struct {
+				Bucket struct {
+					Name string `json:"name"`
+				} `json:"bucket"`
+				Object struct {
+					Key       string `json:"key"`
+					Size      int64  `json:"size"`
+					ETag      string `json:"eTag"`
+					VersionID string `json:"versionId"`
+				} `json:"object"`
+			} `json:"s3"`
+		} `json:"Records"`
+
+		// SNS
+		Type    string `json:"Type"`
+		Message string `json:"Message"`
+
+		// EventBridge
+		DetailType string `json:"detail-type"`
+		Detail     struct {
+			Bucket struct {
+				Name string `json:"name"`
+			} `json:"bucket"`
+			Object struct {
+				Key       string `json:"key"`
+				Size      int64  `json:"size"`
+				ETag      string `json:"etag"`
+				VersionID string `json:"version-id"`
+			} `json:"object"`
+		} `json:"detail"`
+	}
+	if err := jsoniter.Unmarshal(body, &msg); err != nil {
+		return nil, err
+	}
+	if msg.Type == "Notification" && msg.Message != "" {
+		return ParseS3Events([]byte(msg.Message))
+	}
+	if msg.DetailType != "" {
+		ev := cmn.BckEvent{
+			Bucket:  msg.Detail.Bucket.Name,
+			ObjName: msg.Detail.Object.Key,
+			ETag:    msg.Detail.Object.ETag,
+			Version: msg.Detail.Object.VersionID,
+			Size:    msg.Detail.Object.Size,
+		}
+		switch msg.DetailType {
+		case "Object Created":
+		case "Object Deleted":
+			ev.Deleted = true
+		default:
+			return nil, nil
+		}
+		return []cmn.BckEvent{ev}, nil
+	}
+
+	events := make([]cmn.BckEvent, 0, len(msg.Records))
+	for i := range msg.Records {
+		rec := &msg.Records[i]
+		op, _, _ := strings.Cut(rec.EventName, ":")
+		var deleted bool
+		switch op {
+		case "ObjectCreated":
+		case "ObjectRemoved", "LifecycleExpiration":
+			deleted = true
+		default:
+			continue
+		}
+		// object keys are URL-encoded
+		key, err := url.QueryUnescape(rec.S3.Object.Key)
+		if err != nil {
+			return events, fmt.Errorf("invalid object key %q: %v", rec.S3.Object.Key, err)
+		}
+		events = append(events, cmn.BckEvent{
+			Bucket:  rec.S3.Bucket.Name,
+			ObjName: key,
+			ETag:    rec.S3.Object.ETag,
+			Version: rec.S3.Object.VersionID,
+			Size:    rec.S3.Object.Size,
+			Deleted: deleted,
+		})
+	}
+	return events, nil
+}
+
+// GCS Pub/Sub notification (nil event when the event type is not of interest)
+// - see https://cloud.google.com/storage/docs/pubsub-notifications
+func ParseGCSEvent(attrs map[string]string, data []byte) (*cmn.BckEvent, error) {
+	ev := &cmn.BckEvent{Bucket: attrs["bucketId"], ObjName: attrs["objectId"], Version: attrs["objectGeneration"]}
+	switch attrs["eventType"] {
+	case "OBJECT_FINALIZE":
+	case "OBJECT_DELETE", "OBJECT_ARCHIVE":
+		ev.Deleted = true
+	default:
+		return nil, nil
+	}
+	if ev.Bucket == "" || ev.ObjName == "" {
+		return nil, fmt.Errorf("missing bucketId and/or objectId in %v", attrs)
+	}
+	if attrs["payloadFormat"] == "JSON_API_V1" && len(data) > 0 {
+		var obj struct {
+			ETag string `json:"etag"`
+			Size string `json:"size"` // (int64 as string)
+		}
+		if err := jsoniter.Unmarshal(data, &obj); err != nil {
+			return nil, err
+		}
+		ev.ETag = obj.ETag
+		ev.Size, _ = strconv.ParseInt(obj.Size, 10, 64)
+	}
+	return ev, nil
+}
+
+// Azure Event Grid (Event Grid schema);
+// returns non-empty validation code when the request is a subscription validation handshake
+// - see https://learn.microsoft.com/en-us/azure/event-grid/event-schema-blob-storage
+func ParseEventGrid(body []byte) (events []cmn.BckEvent, validationCode string, _ error) {
+	var all []struct {
+		EventType string `json:"eventType"`
+		Subject   string `json:"subject"`
+		Data      struct {
+			ETag           string `json:"eTag"`
+			ContentLength  int64  `json:"contentLength"`
+			ValidationCode string `json:"validationCode"`
+		} `json:"data"`
+	}
+	if err := jsoniter.Unmarshal(body, &all); err != nil {
+		return nil, "", err
+	}
+	for i := range all {
+		ge := &all[i]
+		var deleted bool
+		switch ge.EventType {
+		case "Microsoft.EventGrid.SubscriptionValidationEvent":
+			return nil, ge.Data.ValidationCode, nil
+		case "Microsoft.Storage.BlobCreated":
+		case "Microsoft.Storage.BlobDeleted":
+			deleted = true
+		default:
+			continue
+		}
+		// e.g. "/blobServices/default/containers/<container>/blobs/<name>"
+		s, ok := strings.CutPrefix(ge.Subject, "/blobServices/default/containers/")
+		container, name, found := strings.Cut(s, "/blobs/")
+		if !ok || !found || container == "" || name == "" {
+			return events, "", fmt.Errorf("invalid event subject %q", ge.Subject)
+		}
+		events = append(events, cmn.BckEvent{
+			Bucket:  container,
+			ObjName: name,
+			ETag:    cmn.UnquoteCEV(ge.Data.ETag),
+			Size:    ge.Data.ContentLength,
+			Deleted: deleted,
+		})
+	}
+	return events, "", nil
+}
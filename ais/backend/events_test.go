@@ -0,0 +1,204 @@
+// Package backend_test contains tests for backend providers.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend_test
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/NVIDIA/aistore/ais/backend"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	jsoniter "github.com/json-iterator/go"
+)
+
+const s3Record = `{"Records":[
+	{"eventName":"ObjectCreated:Put","s3":{"bucket":{"name":"abc"},"object":{"key":"dir/a+b%3D1.txt","size":10,"eTag":"e1","versionId":"v1"}}},
+	{"eventName":"ObjectRemoved:Delete","s3":{"bucket":{"name":"abc"},"object":{"key":"x"}}},
+	{"eventName":"ObjectRestore:Completed","s3":{"bucket":{"name":"abc"},"object":{"key":"y"}}}
+]}`
+
+func TestParseS3Events(t *testing.T) {
+	events, err := backend.ParseS3Events([]byte(s3Record))
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(events) == 2, "expected 2 events, got %d", len(events))
+	tassert.Errorf(t, events[0].ObjName == "dir/a b=1.txt" && events[0].Version == "v1" && !events[0].Deleted,
+		"unexpected %+v", events[0])
+	tassert.Errorf(t, events[1].ObjName == "x" && events[1].Deleted, "unexpected %+v", events[1])
+
+	// SNS envelope
+	sns := cos.MustMarshal(map[string]string{"Type": "Notification", "Message": s3Record})
+	events, err = backend.ParseS3Events(sns)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(events) == 2, "expected 2 events, got %d", len(events))
+
+	// EventBridge
+	eb := `{"detail-type":"Object Deleted","detail":{"bucket":{"name":"abc"},"object":{"key":"z","version-id":"v2"}}}`
+	events, err = backend.ParseS3Events([]byte(eb))
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(events) == 1 && events[0].Deleted && events[0].Version == "v2", "unexpected %+v", events)
+
+	// test event
+	events, err = backend.ParseS3Events([]byte(`{"Service":"Amazon S3","Event":"s3:TestEvent","Bucket":"abc"}`))
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(events) == 0, "expected no events, got %+v", events)
+}
+
+func TestParseGCSEvent(t *testing.T) {
+	attrs := map[string]string{
+		"eventType": "OBJECT_FINALIZE", "bucketId": "abc", "objectId": "a/b", "objectGeneration": "17",
+		"payloadFormat": "JSON_API_V1",
+	}
+	ev, err := backend.ParseGCSEvent(attrs, []byte(`{"name":"a/b","etag":"CJ+x","size":"1024"}`))
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, ev != nil && ev.Version == "17" && ev.ETag == "CJ+x" && ev.Size == 1024 && !ev.Deleted, "unexpected %+v", ev)
+
+	attrs["eventType"] = "OBJECT_ARCHIVE"
+	ev, err = backend.ParseGCSEvent(attrs, nil)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, ev != nil && ev.Deleted, "unexpected %+v", ev)
+
+	attrs["eventType"] = "OBJECT_METADATA_UPDATE"
+	ev, err = backend.ParseGCSEvent(attrs, nil)
+	tassert.Errorf(t, ev == nil && err == nil, "expected nothing, got (%+v, %v)", ev, err)
+}
+
+func TestParseEventGrid(t *testing.T) {
+	body := `[
+		{"eventType":"Microsoft.Storage.BlobCreated","subject":"/blobServices/default/containers/abc/blobs/dir/obj",
+		 "data":{"eTag":"\"0x8D4BCC2E4835CD0\"","contentLength":524288}},
+		{"eventType":"Microsoft.Storage.BlobDeleted","subject":"/blobServices/default/containers/abc/blobs/x","data":{}}
+	]`
+	events, code, err := backend.ParseEventGrid([]byte(body))
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, code == "", "unexpected validation code %q", code)
+	tassert.Fatalf(t, len(events) == 2, "expected 2 events, got %d", len(events))
+	tassert.Errorf(t, events[0].Bucket == "abc" && events[0].ObjName == "dir/obj" && events[0].ETag == "0x8D4BCC2E4835CD0",
+		"unexpected %+v", events[0])
+	tassert.Errorf(t, events[1].ObjName == "x" && events[1].Deleted, "unexpected %+v", events[1])
+
+	validation := `[{"eventType":"Microsoft.EventGrid.SubscriptionValidationEvent","data":{"validationCode":"512d38b6"}}]`
+	_, code, err = backend.ParseEventGrid([]byte(validation))
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, code == "512d38b6", "expected validation code, got %q", code)
+}
+
+// local stand-in for SQS (JSON protocol)
+type sqsStandin struct {
+	msgs    []string
+	deleted []string
+	mu      sync.Mutex
+}
+
+func (q *sqsStandin) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var in struct {
+		QueueURL string `json:"QueueUrl"`
+		Entries  []struct {
+			ID            string `json:"Id"`
+			ReceiptHandle string
+		}
+	}
+	if err := jsoniter.NewDecoder(r.Body).Decode(&in); err != nil || in.QueueURL == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	switch r.Header.Get("X-Amz-Target") {
+	case "AmazonSQS.ReceiveMessage":
+		type msg struct{ ReceiptHandle, Body string }
+		out := struct{ Messages []msg }{}
+		for i, body := range q.msgs {
+			out.Messages = append(out.Messages, msg{ReceiptHandle: "rh-" + string(rune('0'+i)), Body: body})
+		}
+		w.Write(cos.MustMarshal(out))
+	case "AmazonSQS.DeleteMessageBatch":
+		for _, e := range in.Entries {
+			q.deleted = append(q.deleted, e.ReceiptHandle)
+		}
+		q.msgs = nil
+		w.Write([]byte(`{"Successful":[]}`))
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"__type":"com.amazonaws.sqs#InvalidAction","message":"unknown action"}`))
+	}
+}
+
+func TestSQSStandin(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "test")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "test")
+
+	q := &sqsStandin{msgs: []string{s3Record, "not json"}}
+	srv := httptest.NewServer(q)
+	defer srv.Close()
+
+	src, err := backend.NewEventSource(&cmn.EventsConf{Source: cmn.EventsSQS, Queue: srv.URL + "/000000000000/abc", Endpoint: srv.URL})
+	tassert.CheckFatal(t, err)
+	msgs, err := src.Receive(context.Background())
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(msgs) == 2, "expected 2 messages, got %d", len(msgs))
+	tassert.Errorf(t, len(msgs[0].Events) == 2, "expected 2 events, got %d", len(msgs[0].Events))
+	tassert.Errorf(t, len(msgs[1].Events) == 0, "expected no events (unparsable), got %d", len(msgs[1].Events))
+
+	tassert.CheckFatal(t, src.Ack(context.Background(), msgs))
+	tassert.Errorf(t, len(q.deleted) == 2 && q.deleted[0] == "rh-0", "unexpected deleted %v", q.deleted)
+
+	msgs, err = src.Receive(context.Background())
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(msgs) == 0, "expected empty queue, got %d", len(msgs))
+}
+
+// local stand-in for Pub/Sub (REST)
+func TestPubSubStandin(t *testing.T) {
+	const sub = "projects/p/subscriptions/s"
+	var (
+		acked []string
+		data  = base64.StdEncoding.EncodeToString([]byte(`{"etag":"CJ+x","size":"5"}`))
+	)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/", func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/v1/")
+		switch path {
+		case sub + ":pull":
+			w.Write([]byte(`{"receivedMessages":[{"ackId":"a1","message":{"data":"` + data + `","attributes":{
+				"eventType":"OBJECT_FINALIZE","bucketId":"abc","objectId":"obj","objectGeneration":"3","payloadFormat":"JSON_API_V1"}}}]}`))
+		case sub + ":acknowledge":
+			var in struct {
+				AckIDs []string `json:"ackIds"`
+			}
+			_ = jsoniter.NewDecoder(r.Body).Decode(&in)
+			acked = append(acked, in.AckIDs...)
+			w.Write([]byte("{}"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":{"message":"subscription not found","status":"NOT_FOUND"}}`))
+		}
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	src, err := backend.NewEventSource(&cmn.EventsConf{Source: cmn.EventsPubSub, Queue: sub, Endpoint: srv.URL})
+	tassert.CheckFatal(t, err)
+	msgs, err := src.Receive(context.Background())
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(msgs) == 1 && len(msgs[0].Events) == 1, "expected one event, got %+v", msgs)
+	ev := msgs[0].Events[0]
+	tassert.Errorf(t, ev.ObjName == "obj" && ev.Version == "3" && ev.Size == 5, "unexpected %+v", ev)
+
+	tassert.CheckFatal(t, src.Ack(context.Background(), msgs))
+	tassert.Errorf(t, len(acked) == 1 && acked[0] == "a1", "unexpected acked %v", acked)
+
+	// missing subscription
+	src, err = backend.NewEventSource(&cmn.EventsConf{Source: cmn.EventsPubSub, Queue: "projects/p/subscriptions/x", Endpoint: srv.URL})
+	tassert.CheckFatal(t, err)
+	_, err = src.Receive(context.Background())
+	tassert.Errorf(t, err != nil && strings.Contains(err.Error(), "NOT_FOUND"), "expected not-found error, got %v", err)
+}
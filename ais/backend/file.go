@@ -0,0 +1,628 @@
+//go:build file
+
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"context"
+	"fmt"
+	"io"
+	iofs "io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// file:// backend maps a bucket to a directory tree on a local or shared
+// (NFS, Lustre, etc.) filesystem:
+// - bucket "file://abc" is the directory <root>/abc, where root is the
+//   backend configuration (cmn.BackendConfFile)
+// - object name is the file's path relative to its bucket directory
+// - there are no checksums; version and ETag are derived from mtime and size
+// - all targets are expected to see the same directory tree at the same root
+//
+// NOTE: listing is sorted lexicographically by object name; symbolic links must resolve
+// within the bucket directory (and are otherwise ignored); symbolic links to directories
+// are not followed.
+
+const fileTmpSuffix = ".ais.tmp"
+
+type fsbp struct {
+	t core.TargetPut
+	base
+}
+
+// interface guard
+var _ core.Backend = (*fsbp)(nil)
+
+func NewFile(t core.TargetPut, tstats stats.Tracker, startingUp bool) (core.Backend, error) {
+	bp := &fsbp{
+		t:    t,
+		base: base{provider: apc.File},
+	}
+	bp.init(t.Snode(), tstats, startingUp)
+	return bp, nil
+}
+
+func fileConf() (conf cmn.BackendConfFile, err error) {
+	v := cmn.GCO.Get().Backend.Get(apc.File)
+	switch c := v.(type) {
+	case cmn.BackendConfFile:
+		conf = c
+	case nil:
+		return conf, &cmn.ErrMissingBackend{Provider: apc.File}
+	default:
+		if err = cos.MorphMarshal(v, &conf); err != nil {
+			return conf, err
+		}
+	}
+	return conf, conf.Validate()
+}
+
+func fileBckDir(bck *cmn.Bck) (string, error) {
+	conf, err := fileConf()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(conf.Root, bck.Name), nil
+}
+
+// (bucket directory, file path)
+func filePath(lom *core.LOM) (string, string, error) {
+	dir, err := fileBckDir(lom.Bck().RemoteBck())
+	if err != nil {
+		return "", "", err
+	}
+	fqn := filepath.Join(dir, lom.ObjName)
+	if !strings.HasPrefix(fqn, dir+string(filepath.Separator)) {
+		return "", "", fmt.Errorf("invalid object name %q (resolves outside bucket directory)", lom.ObjName)
+	}
+	if err := fileCheckLinks(dir, fqn); err != nil {
+		return "", "", err
+	}
+	return dir, fqn, nil
+}
+
+// the path, including symbolic links (if any) in its existing part, must resolve within
+// the bucket directory
+func fileCheckLinks(dir, fqn string) error {
+	rdir, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return err
+	}
+	for p := fqn; len(p) > len(dir); p = filepath.Dir(p) {
+		real, err := filepath.EvalSymlinks(p)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return err
+		}
+		if real != rdir && !strings.HasPrefix(real, rdir+string(filepath.Separator)) {
+			return &os.PathError{Op: "resolve", Path: fqn, Err: os.ErrPermission}
+		}
+		break
+	}
+	return nil
+}
+
+func fileVersion(finfo os.FileInfo) string {
+	return strconv.FormatInt(finfo.ModTime().UnixNano(), 36) + "-" + strconv.FormatInt(finfo.Size(), 36)
+}
+
+func fileErr(err error, bck *cmn.Bck, objName string) (int, error) {
+	switch {
+	case os.IsNotExist(err):
+		if objName == "" {
+			return http.StatusNotFound, cmn.NewErrRemoteBckNotFound(bck)
+		}
+		return http.StatusNotFound, cos.NewErrNotFound(nil, bck.Cname(objName))
+	case os.IsPermission(err):
+		return http.StatusForbidden, err
+	case cmn.IsErrInitMissingBackend(err):
+		return http.StatusNotFound, err
+	default:
+		return 0, err
+	}
+}
+
+func setCustomFile(attrs *cmn.ObjAttrs, finfo os.FileInfo) {
+	v := fileVersion(finfo)
+	attrs.SetCustomKey(cmn.SourceObjMD, apc.File)
+	attrs.SetCustomKey(cmn.VersionObjMD, v)
+	attrs.SetCustomKey(cmn.ETag, v)
+	attrs.SetCustomKey(cmn.LastModified, fmtTime(finfo.ModTime()))
+	attrs.SetVersion(v)
+}
+
+//
+// HEAD BUCKET
+//
+
+func (*fsbp) HeadBucket(_ context.Context, bck *meta.Bck) (bckProps cos.StrKVs, ecode int, err error) {
+	var (
+		finfo    os.FileInfo
+		cloudBck = bck.RemoteBck()
+		dir      string
+	)
+	if dir, err = fileBckDir(cloudBck); err != nil {
+		ecode, err = fileErr(err, cloudBck, "")
+		return
+	}
+	if finfo, err = os.Stat(dir); err != nil {
+		ecode, err = fileErr(err, cloudBck, "")
+		return
+	}
+	if !finfo.IsDir() {
+		return nil, http.StatusNotFound, cmn.NewErrRemoteBckNotFound(cloudBck)
+	}
+	bckProps = make(cos.StrKVs, 2)
+	bckProps[apc.HdrBackendProvider] = apc.File
+	bckProps[apc.HdrBucketVerEnabled] = "false"
+	return
+}
+
+//
+// LIST OBJECTS
+//
+
+// - continuation token is the last listed name
+// - non-recursive listing (apc.LsNoRecursion) returns a single level of the directory tree
+func (*fsbp) ListObjects(bck *meta.Bck, msg *apc.LsoMsg, lst *cmn.LsoRes) (ecode int, err error) {
+	var (
+		cloudBck = bck.RemoteBck()
+		dir      string
+	)
+	if dir, err = fileBckDir(cloudBck); err != nil {
+		return fileErr(err, cloudBck, "")
+	}
+	if _, err = os.Stat(dir); err != nil {
+		return fileErr(err, cloudBck, "")
+	}
+	msg.PageSize = calcPageSize(msg.PageSize, bck.MaxPageSize())
+
+	w := &fileWalk{
+		msg:   msg,
+		lst:   lst,
+		dir:   dir,
+		token: msg.ContinuationToken,
+		max:   int(msg.PageSize),
+	}
+	lst.Entries = lst.Entries[:0]
+	lst.ContinuationToken = ""
+
+	// start from the deepest directory that contains the prefix
+	start, err := fileStart(dir, msg.Prefix)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+	if start != dir {
+		err = fileCheckLinks(dir, start)
+	}
+	if err == nil {
+		if msg.IsFlagSet(apc.LsNoRecursion) {
+			err = w.level(start)
+		} else {
+			err = w.walk(start)
+			if err == iofs.SkipAll {
+				err = nil
+			}
+		}
+	}
+	if err != nil && !os.IsNotExist(err) { // (non-existing prefix is not an error)
+		return fileErr(err, cloudBck, "")
+	}
+	if w.full {
+		lst.ContinuationToken = w.last
+	}
+	if cmn.Rom.FastV(4, cos.SmoduleBackend) {
+		nlog.Infoln("[list_objects]", cloudBck.Cname(msg.Prefix), len(lst.Entries))
+	}
+	return 0, nil
+}
+
+// the deepest bucket (sub)directory that contains the prefix
+func fileStart(dir, prefix string) (string, error) {
+	if err := cmn.ValidatePrefix("list-objects", prefix); err != nil {
+		return "", err
+	}
+	for _, elem := range strings.Split(prefix, "/") {
+		if elem == ".." {
+			return "", fmt.Errorf("invalid prefix %q (must not contain %q)", prefix, elem)
+		}
+	}
+	i := strings.LastIndexByte(prefix, '/')
+	if i <= 0 {
+		return dir, nil
+	}
+	start := filepath.Join(dir, prefix[:i])
+	if start != dir && !strings.HasPrefix(start, dir+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid prefix %q (resolves outside bucket directory)", prefix)
+	}
+	return start, nil
+}
+
+type fileWalk struct {
+	msg   *apc.LsoMsg
+	lst   *cmn.LsoRes
+	dir   string // bucket directory
+	token string // continuation
+	last  string
+	max   int
+	full  bool
+}
+
+// directory entries in lexicographical order of the resulting object names
+// (where directory "a" contributes "a/...", so that "a-b" < "a/x" < "a0")
+func fileReadDir(dir string) ([]iofs.DirEntry, error) {
+	des, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(des, func(a, b iofs.DirEntry) int { return strings.Compare(fileKey(a), fileKey(b)) })
+	return des, nil
+}
+
+func fileKey(de iofs.DirEntry) string {
+	if de.IsDir() {
+		return de.Name() + "/"
+	}
+	return de.Name()
+}
+
+// regular file or symbolic link to one (within the bucket directory)
+func (w *fileWalk) stat(fqn string, de iofs.DirEntry) (os.FileInfo, bool) {
+	var (
+		finfo os.FileInfo
+		err   error
+	)
+	if de.Type()&os.ModeSymlink != 0 {
+		if err = fileCheckLinks(w.dir, fqn); err == nil {
+			finfo, err = os.Stat(fqn)
+		}
+	} else {
+		finfo, err = de.Info()
+	}
+	if err != nil || !finfo.Mode().IsRegular() {
+		return nil, false
+	}
+	return finfo, true
+}
+
+// recursive, depth-first in lexicographical order
+func (w *fileWalk) walk(dir string) error {
+	des, err := fileReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) && dir != w.dir {
+			return nil
+		}
+		return err
+	}
+	rel := ""
+	if dir != w.dir {
+		name, ok := w.rel(dir)
+		if !ok {
+			return fmt.Errorf("%q is outside bucket directory %q", dir, w.dir)
+		}
+		rel = name + "/"
+	}
+	for _, de := range des {
+		name := rel + de.Name()
+		if de.IsDir() {
+			// prune subtrees that are either out of prefix or entirely before the continuation token
+			pfx := name + "/"
+			if !strings.HasPrefix(pfx, w.msg.Prefix) && !strings.HasPrefix(w.msg.Prefix, pfx) {
+				continue
+			}
+			if w.token != "" && pfx < w.token && !strings.HasPrefix(w.token, pfx) {
+				continue
+			}
+			if err := w.walk(filepath.Join(dir, de.Name())); err != nil {
+				return err
+			}
+			continue
+		}
+		if !strings.HasPrefix(name, w.msg.Prefix) || strings.HasSuffix(name, fileTmpSuffix) {
+			continue
+		}
+		if w.token != "" && name <= w.token {
+			continue
+		}
+		finfo, ok := w.stat(filepath.Join(dir, de.Name()), de)
+		if !ok {
+			continue
+		}
+		if err := w.add(name, finfo, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// non-recursive
+func (w *fileWalk) level(dir string) error {
+	des, err := fileReadDir(dir)
+	if err != nil {
+		return err
+	}
+	rel := ""
+	if dir != w.dir {
+		name, ok := w.rel(dir)
+		if !ok {
+			return fmt.Errorf("%q is outside bucket directory %q", dir, w.dir)
+		}
+		rel = name + "/"
+	}
+	for _, de := range des {
+		name := rel + de.Name()
+		if !strings.HasPrefix(name, w.msg.Prefix) || strings.HasSuffix(name, fileTmpSuffix) {
+			continue
+		}
+		if w.token != "" && rel+fileKey(de) <= w.token {
+			continue
+		}
+		if de.IsDir() {
+			if w.msg.IsFlagSet(apc.LsNoDirs) {
+				continue
+			}
+			err = w.add(name, nil, true)
+		} else if finfo, ok := w.stat(filepath.Join(dir, de.Name()), de); ok {
+			err = w.add(name, finfo, false)
+		}
+		if err != nil { // (page is full)
+			break
+		}
+	}
+	return nil
+}
+
+// object name relative to the bucket directory
+func (w *fileWalk) rel(fqn string) (string, bool) {
+	name, ok := strings.CutPrefix(fqn, w.dir+string(filepath.Separator))
+	if !ok || name == "" {
+		return "", false
+	}
+	return filepath.ToSlash(name), true
+}
+
+func (w *fileWalk) add(name string, finfo os.FileInfo, isDir bool) error {
+	if len(w.lst.Entries) >= w.max {
+		w.full = true
+		return iofs.SkipAll
+	}
+	en := &cmn.LsoEnt{Name: name}
+	if isDir {
+		en.Name += "/"
+		en.Flags = apc.EntryIsDir
+	} else {
+		en.Size = finfo.Size()
+		if !w.msg.IsFlagSet(apc.LsNameOnly) && !w.msg.IsFlagSet(apc.LsNameSize) {
+			v := fileVersion(finfo)
+			en.Version = v
+			if w.msg.WantProp(apc.GetPropsCustom) {
+				en.Custom = cmn.CustomProps2S(cmn.ETag, v, cmn.LastModified, fmtTime(finfo.ModTime()))
+			}
+		}
+	}
+	w.lst.Entries = append(w.lst.Entries, en)
+	w.last = en.Name
+	return nil
+}
+
+//
+// LIST BUCKETS
+//
+
+func (*fsbp) ListBuckets(cmn.QueryBcks) (bcks cmn.Bcks, ecode int, err error) {
+	conf, err := fileConf()
+	if err != nil {
+		ecode, err = fileErr(err, &cmn.Bck{Provider: apc.File}, "")
+		return nil, ecode, err
+	}
+	des, err := os.ReadDir(conf.Root)
+	if err != nil {
+		return nil, 0, err
+	}
+	bcks = make(cmn.Bcks, 0, len(des))
+	for _, de := range des {
+		if !de.IsDir() || strings.HasPrefix(de.Name(), ".") {
+			continue
+		}
+		bck := cmn.Bck{Name: de.Name(), Provider: apc.File}
+		if bck.ValidateName() != nil {
+			continue
+		}
+		bcks = append(bcks, bck)
+	}
+	return bcks, 0, nil
+}
+
+//
+// HEAD OBJECT
+//
+
+func (*fsbp) HeadObj(_ context.Context, lom *core.LOM, _ *http.Request) (oa *cmn.ObjAttrs, ecode int, err error) {
+	var (
+		finfo    os.FileInfo
+		cloudBck = lom.Bck().RemoteBck()
+	)
+	_, fqn, err := filePath(lom)
+	if err == nil {
+		finfo, err = os.Stat(fqn)
+	}
+	if err != nil {
+		ecode, err = fileErr(err, cloudBck, lom.ObjName)
+		return
+	}
+	if !finfo.Mode().IsRegular() {
+		return nil, http.StatusNotFound, cos.NewErrNotFound(nil, cloudBck.Cname(lom.ObjName))
+	}
+	oa = &cmn.ObjAttrs{Size: finfo.Size()}
+	oa.CustomMD = make(cos.StrKVs, 4)
+	setCustomFile(oa, finfo)
+	if cmn.Rom.FastV(5, cos.SmoduleBackend) {
+		nlog.Infoln("[head_object]", cloudBck.Cname(lom.ObjName))
+	}
+	return
+}
+
+//
+// GET OBJECT
+//
+
+func (bp *fsbp) GetObj(ctx context.Context, lom *core.LOM, owt cmn.OWT, _ *http.Request) (int, error) {
+	res := bp.GetObjReader(ctx, lom, 0, 0)
+	if res.Err != nil {
+		return res.ErrCode, res.Err
+	}
+	params := allocPutParams(res, owt)
+	err := bp.t.PutObject(lom, params)
+	core.FreePutParams(params)
+	if cmn.Rom.FastV(5, cos.SmoduleBackend) {
+		nlog.Infoln("[get_object]", lom.String(), err)
+	}
+	return 0, err
+}
+
+func (*fsbp) GetObjReader(_ context.Context, lom *core.LOM, offset, length int64) (res core.GetReaderResult) {
+	var (
+		finfo    os.FileInfo
+		cloudBck = lom.Bck().RemoteBck()
+	)
+	_, fqn, err := filePath(lom)
+	if err == nil {
+		finfo, err = os.Stat(fqn)
+	}
+	if err == nil && !finfo.Mode().IsRegular() {
+		err = os.ErrNotExist
+	}
+	if err != nil {
+		res.ErrCode, res.Err = fileErr(err, cloudBck, lom.ObjName)
+		return res
+	}
+	size := finfo.Size()
+	if length > 0 {
+		if offset >= size {
+			res.ErrCode = http.StatusRequestedRangeNotSatisfiable
+			res.Err = cmn.NewErrRangeNotSatisfiable(nil, nil, size)
+			return res
+		}
+		length = min(length, size-offset)
+		res.R, res.Err = cos.NewFileSectionHandle(fqn, offset, length)
+		res.Size = length
+	} else {
+		res.R, res.Err = cos.NewFileHandle(fqn)
+		res.Size = size
+		if res.Err == nil {
+			setCustomFile(lom.ObjAttrs(), finfo)
+		}
+	}
+	if res.Err != nil {
+		res.ErrCode, res.Err = fileErr(res.Err, cloudBck, lom.ObjName)
+	}
+	return res
+}
+
+//
+// PUT OBJECT
+//
+
+func (bp *fsbp) PutObj(r io.ReadCloser, lom *core.LOM, _ *http.Request) (ecode int, err error) {
+	var (
+		finfo    os.FileInfo
+		wfh      *os.File
+		cloudBck = lom.Bck().RemoteBck()
+		conf     cmn.BackendConfFile
+		fqn      string
+	)
+	defer cos.Close(r)
+	if conf, err = fileConf(); err == nil && conf.ReadOnly {
+		return http.StatusMethodNotAllowed, cmn.NewErrUnsupp("PUT", cloudBck.Cname("")+" (read-only)")
+	}
+	if err == nil {
+		_, fqn, err = filePath(lom)
+	}
+	if err != nil {
+		return fileErr(err, cloudBck, lom.ObjName)
+	}
+
+	// write and rename
+	tmp := fqn + "." + bp.t.SID() + cos.GenTie() + fileTmpSuffix
+	if wfh, err = cos.CreateFile(tmp); err != nil {
+		return fileErr(err, cloudBck, lom.ObjName)
+	}
+	buf, slab := bp.t.PageMM().Alloc()
+	_, err = io.CopyBuffer(wfh, r, buf)
+	slab.Free(buf)
+	if err == nil {
+		err = wfh.Sync()
+	}
+	if errC := wfh.Close(); err == nil {
+		err = errC
+	}
+	if err == nil {
+		err = os.Rename(tmp, fqn)
+	}
+	if err == nil {
+		finfo, err = os.Stat(fqn)
+	}
+	if err != nil {
+		if errR := os.Remove(tmp); errR != nil && !os.IsNotExist(errR) {
+			nlog.Errorln("failed to remove", tmp, "[", errR, "]")
+		}
+		return fileErr(err, cloudBck, lom.ObjName)
+	}
+	setCustomFile(lom.ObjAttrs(), finfo)
+	if cmn.Rom.FastV(5, cos.SmoduleBackend) {
+		nlog.Infoln("[put_object]", lom.String(), finfo.Size())
+	}
+	return 0, nil
+}
+
+//
+// DELETE OBJECT
+//
+
+func (*fsbp) DeleteObj(lom *core.LOM) (ecode int, err error) {
+	var (
+		cloudBck = lom.Bck().RemoteBck()
+		conf     cmn.BackendConfFile
+		dir, fqn string
+	)
+	if conf, err = fileConf(); err == nil && conf.ReadOnly {
+		return http.StatusMethodNotAllowed, cmn.NewErrUnsupp("DELETE", cloudBck.Cname("")+" (read-only)")
+	}
+	if err == nil {
+		dir, fqn, err = filePath(lom)
+	}
+	if err == nil {
+		err = os.Remove(fqn)
+	}
+	if err != nil {
+		return fileErr(err, cloudBck, lom.ObjName)
+	}
+	// cleanup empty parent directories (best effort)
+	for pdir := filepath.Dir(fqn); pdir != dir && strings.HasPrefix(pdir, dir); pdir = filepath.Dir(pdir) {
+		if os.Remove(pdir) != nil {
+			break
+		}
+	}
+	if cmn.Rom.FastV(5, cos.SmoduleBackend) {
+		nlog.Infoln("[delete_object]", lom.String())
+	}
+	return 0, nil
+}
@@ -0,0 +1,314 @@
+//go:build file
+
+// Package backend_test contains tests for backend providers.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/ais/backend"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+const fileBucket = "abc"
+
+// root/abc is the bucket; root/secret is a sibling that must never be reachable
+func newFileBackend(t *testing.T) (core.Backend, string) {
+	var (
+		tmp   = t.TempDir()
+		root  = filepath.Join(tmp, "root")
+		mpath = filepath.Join(tmp, "mpath")
+	)
+	for _, dir := range []string{filepath.Join(root, fileBucket), filepath.Join(root, "secret"), mpath} {
+		tassert.CheckFatal(t, cos.CreateDir(dir))
+	}
+	tassert.CheckFatal(t, os.WriteFile(filepath.Join(root, "secret", "passwd"), []byte("secret"), cos.PermRWR))
+
+	config := cmn.GCO.BeginUpdate()
+	config.Backend.Conf = map[string]any{apc.File: cmn.BackendConfFile{Root: root}}
+	config.Backend.Providers = map[string]cmn.Ns{apc.File: cmn.NsGlobal}
+	cmn.GCO.CommitUpdate(config)
+
+	fs.TestNew(nil)
+	_, err := fs.Add(mpath, "daeID")
+	tassert.CheckFatal(t, err)
+	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}, true)
+	fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{}, true)
+
+	bck := meta.NewBck(fileBucket, apc.File, cmn.NsGlobal, &cmn.Bprops{Cksum: cmn.CksumConf{Type: cos.ChecksumNone}})
+	tgt := mock.NewTarget(mock.NewBaseBownerMock(bck))
+	bp, err := backend.NewFile(tgt, mock.NewStatsTracker(), true /*starting up*/)
+	tassert.CheckFatal(t, err)
+	return bp, root
+}
+
+func fileLOM(t *testing.T, objName string) *core.LOM {
+	lom := core.AllocLOM(objName)
+	tassert.CheckFatal(t, lom.InitBck(&cmn.Bck{Name: fileBucket, Provider: apc.File, Ns: cmn.NsGlobal}))
+	t.Cleanup(func() { core.FreeLOM(lom) })
+	return lom
+}
+
+func fileList(t *testing.T, bp core.Backend, msg *apc.LsoMsg) []string {
+	lom := fileLOM(t, "")
+	lst := &cmn.LsoRes{}
+	_, err := bp.ListObjects(lom.Bck(), msg, lst)
+	tassert.CheckFatal(t, err)
+	names := make([]string, 0, len(lst.Entries))
+	for _, en := range lst.Entries {
+		names = append(names, en.Name)
+	}
+	return names
+}
+
+func TestFilePutGetDelete(t *testing.T) {
+	bp, root := newFileBackend(t)
+	const (
+		objName = "dir/sub/obj.txt"
+		content = "hello, file backend"
+	)
+
+	// PUT
+	lom := fileLOM(t, objName)
+	_, err := bp.PutObj(io.NopCloser(strings.NewReader(content)), lom, nil)
+	tassert.CheckFatal(t, err)
+	b, err := os.ReadFile(filepath.Join(root, fileBucket, objName))
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, string(b) == content, "expected %q, got %q", content, b)
+	tassert.Errorf(t, lom.Version() != "", "expected version")
+
+	// HEAD
+	oa, _, err := bp.HeadObj(context.Background(), fileLOM(t, objName), nil)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, oa.Size == int64(len(content)), "expected size %d, got %d", len(content), oa.Size)
+	tassert.Errorf(t, oa.Version() == lom.Version(), "expected version %q, got %q", lom.Version(), oa.Version())
+
+	// GET (entire object and range)
+	res := bp.GetObjReader(context.Background(), fileLOM(t, objName), 0, 0)
+	tassert.CheckFatal(t, res.Err)
+	b, err = io.ReadAll(res.R)
+	res.R.Close()
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, string(b) == content, "expected %q, got %q", content, b)
+
+	res = bp.GetObjReader(context.Background(), fileLOM(t, objName), 7, 4)
+	tassert.CheckFatal(t, res.Err)
+	b, err = io.ReadAll(res.R)
+	res.R.Close()
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, string(b) == "file", "expected %q, got %q", "file", b)
+
+	res = bp.GetObjReader(context.Background(), fileLOM(t, "dir/none"), 0, 0)
+	tassert.Errorf(t, res.ErrCode == http.StatusNotFound, "expected 404, got %d (%v)", res.ErrCode, res.Err)
+
+	// DELETE (removes emptied parent directories but not the bucket itself)
+	_, err = bp.DeleteObj(fileLOM(t, objName))
+	tassert.CheckFatal(t, err)
+	_, err = os.Stat(filepath.Join(root, fileBucket, "dir"))
+	tassert.Errorf(t, os.IsNotExist(err), "expected empty parent directories to be removed, got %v", err)
+	_, err = os.Stat(filepath.Join(root, fileBucket))
+	tassert.CheckFatal(t, err)
+
+	ecode, err := bp.DeleteObj(fileLOM(t, objName))
+	tassert.Errorf(t, err != nil && ecode == http.StatusNotFound, "expected 404, got %d (%v)", ecode, err)
+}
+
+func TestFileListObjects(t *testing.T) {
+	bp, root := newFileBackend(t)
+	for _, name := range []string{"a/x", "a/y", "a/b/z", "c", "a/w" + ".ais.tmp"} {
+		fqn := filepath.Join(root, fileBucket, name)
+		tassert.CheckFatal(t, cos.CreateDir(filepath.Dir(fqn)))
+		tassert.CheckFatal(t, os.WriteFile(fqn, []byte(name), cos.PermRWR))
+	}
+
+	names := fileList(t, bp, &apc.LsoMsg{})
+	tassert.Errorf(t, strings.Join(names, ",") == "a/b/z,a/x,a/y,c", "unexpected %v", names)
+
+	names = fileList(t, bp, &apc.LsoMsg{Prefix: "a/"})
+	tassert.Errorf(t, strings.Join(names, ",") == "a/b/z,a/x,a/y", "unexpected %v", names)
+
+	// paging
+	msg := &apc.LsoMsg{PageSize: 2}
+	lom := fileLOM(t, "")
+	lst := &cmn.LsoRes{}
+	_, err := bp.ListObjects(lom.Bck(), msg, lst)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(lst.Entries) == 2 && lst.ContinuationToken == "a/x", "unexpected %d, %q", len(lst.Entries), lst.ContinuationToken)
+	msg.ContinuationToken = lst.ContinuationToken
+	_, err = bp.ListObjects(lom.Bck(), msg, lst)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(lst.Entries) == 2 && lst.Entries[0].Name == "a/y", "unexpected %+v", lst.Entries)
+
+	// non-recursive
+	msg = &apc.LsoMsg{Prefix: "a/"}
+	msg.SetFlag(apc.LsNoRecursion)
+	names = fileList(t, bp, msg)
+	tassert.Errorf(t, strings.Join(names, ",") == "a/b/,a/x,a/y", "unexpected %v", names)
+}
+
+func TestFileListLexicalOrder(t *testing.T) {
+	bp, root := newFileBackend(t)
+	// (directory walk order would be: a/x, a/y/z, a-b, a.txt, a0, ab)
+	objs := []string{"a/x", "a/y/z", "a-b", "a.txt", "a0", "ab", "a/y-1", "a/y.d/q"}
+	for _, name := range objs {
+		fqn := filepath.Join(root, fileBucket, name)
+		tassert.CheckFatal(t, cos.CreateDir(filepath.Dir(fqn)))
+		tassert.CheckFatal(t, os.WriteFile(fqn, []byte(name), cos.PermRWR))
+	}
+	exp := slices.Clone(objs)
+	slices.Sort(exp)
+
+	names := fileList(t, bp, &apc.LsoMsg{})
+	tassert.Errorf(t, strings.Join(names, ",") == strings.Join(exp, ","), "expected %v, got %v", exp, names)
+
+	// paging: resume from each position
+	for pageSize := 1; pageSize <= 3; pageSize++ {
+		var (
+			all []string
+			msg = &apc.LsoMsg{PageSize: int64(pageSize)}
+			lom = fileLOM(t, "")
+		)
+		for {
+			lst := &cmn.LsoRes{}
+			_, err := bp.ListObjects(lom.Bck(), msg, lst)
+			tassert.CheckFatal(t, err)
+			for _, en := range lst.Entries {
+				all = append(all, en.Name)
+			}
+			if lst.ContinuationToken == "" {
+				break
+			}
+			tassert.Fatalf(t, len(all) <= len(exp), "runaway listing %v", all)
+			msg.ContinuationToken = lst.ContinuationToken
+		}
+		tassert.Errorf(t, strings.Join(all, ",") == strings.Join(exp, ","), "page size %d: expected %v, got %v", pageSize, exp, all)
+	}
+
+	// non-recursive
+	msg := &apc.LsoMsg{Prefix: "a/"}
+	msg.SetFlag(apc.LsNoRecursion)
+	names = fileList(t, bp, msg)
+	tassert.Errorf(t, strings.Join(names, ",") == "a/x,a/y-1,a/y.d/,a/y/", "unexpected %v", names)
+
+	msg = &apc.LsoMsg{Prefix: "a/", PageSize: 2}
+	msg.SetFlag(apc.LsNoRecursion)
+	lom := fileLOM(t, "")
+	lst := &cmn.LsoRes{}
+	_, err := bp.ListObjects(lom.Bck(), msg, lst)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, lst.ContinuationToken == "a/y-1", "unexpected token %q", lst.ContinuationToken)
+	msg.ContinuationToken = lst.ContinuationToken
+	_, err = bp.ListObjects(lom.Bck(), msg, lst)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(lst.Entries) == 2 && lst.Entries[0].Name == "a/y.d/" && lst.Entries[1].Name == "a/y/",
+		"unexpected %+v", lst.Entries)
+}
+
+func TestFileSymlinks(t *testing.T) {
+	bp, root := newFileBackend(t)
+	bdir := filepath.Join(root, fileBucket)
+	tassert.CheckFatal(t, os.WriteFile(filepath.Join(bdir, "obj"), []byte("obj"), cos.PermRWR))
+	tassert.CheckFatal(t, cos.CreateDir(filepath.Join(bdir, "dir")))
+	tassert.CheckFatal(t, os.WriteFile(filepath.Join(bdir, "dir", "x"), []byte("x"), cos.PermRWR))
+
+	for link, target := range map[string]string{
+		"in":      "obj",                                   // within the bucket
+		"out":     filepath.Join(root, "secret", "passwd"), // outside
+		"outrel":  filepath.Join("..", "secret", "passwd"), // ditto
+		"outdir":  filepath.Join(root, "secret"),           // directory outside
+		"indir":   "dir",                                   // directory within (not followed)
+		"dangled": "none",
+	} {
+		tassert.CheckFatal(t, os.Symlink(target, filepath.Join(bdir, link)))
+	}
+
+	names := fileList(t, bp, &apc.LsoMsg{})
+	tassert.Errorf(t, strings.Join(names, ",") == "dir/x,in,obj", "unexpected %v", names)
+
+	msg := &apc.LsoMsg{}
+	msg.SetFlag(apc.LsNoRecursion)
+	names = fileList(t, bp, msg)
+	tassert.Errorf(t, strings.Join(names, ",") == "dir/,in,obj", "unexpected %v", names)
+
+	for _, prefix := range []string{"outdir/", "outdir/pass"} {
+		for _, norec := range []bool{false, true} {
+			msg := &apc.LsoMsg{Prefix: prefix}
+			if norec {
+				msg.SetFlag(apc.LsNoRecursion)
+			}
+			lst := &cmn.LsoRes{}
+			_, err := bp.ListObjects(fileLOM(t, "").Bck(), msg, lst)
+			tassert.Errorf(t, err != nil || len(lst.Entries) == 0, "prefix %q (non-recursive %t): listed %d entries outside bucket",
+				prefix, norec, len(lst.Entries))
+		}
+	}
+
+	// GET
+	res := bp.GetObjReader(context.Background(), fileLOM(t, "in"), 0, 0)
+	tassert.CheckFatal(t, res.Err)
+	b, err := io.ReadAll(res.R)
+	res.R.Close()
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, string(b) == "obj", "expected %q, got %q", "obj", b)
+
+	for _, objName := range []string{"out", "outrel", "outdir/passwd"} {
+		res := bp.GetObjReader(context.Background(), fileLOM(t, objName), 0, 0)
+		tassert.Errorf(t, res.Err != nil && res.R == nil, "GET %q: expected error", objName)
+		_, _, err := bp.HeadObj(context.Background(), fileLOM(t, objName), nil)
+		tassert.Errorf(t, err != nil, "HEAD %q: expected error", objName)
+	}
+
+	// PUT via symlinked directory
+	_, err = bp.PutObj(io.NopCloser(strings.NewReader("x")), fileLOM(t, "outdir/new"), nil)
+	tassert.Errorf(t, err != nil, "PUT %q: expected error", "outdir/new")
+	_, err = os.Stat(filepath.Join(root, "secret", "new"))
+	tassert.Errorf(t, os.IsNotExist(err), "expected no file written outside bucket, got %v", err)
+	b, err = os.ReadFile(filepath.Join(root, "secret", "passwd"))
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, string(b) == "secret", "expected %q, got %q", "secret", b)
+}
+
+func TestFilePathTraversal(t *testing.T) {
+	bp, _ := newFileBackend(t)
+	lom := fileLOM(t, "")
+
+	for _, prefix := range []string{"../secret/", "../secret/pass", "a/../../secret/", "../", "~/x/", "a/../b"} {
+		for _, norec := range []bool{false, true} {
+			msg := &apc.LsoMsg{Prefix: prefix}
+			if norec {
+				msg.SetFlag(apc.LsNoRecursion)
+			}
+			lst := &cmn.LsoRes{}
+			ecode, err := bp.ListObjects(lom.Bck(), msg, lst)
+			tassert.Errorf(t, err != nil && ecode == http.StatusBadRequest,
+				"prefix %q (non-recursive %t): expected 400, got %d (%v)", prefix, norec, ecode, err)
+			tassert.Errorf(t, len(lst.Entries) == 0, "prefix %q: listed %d entries outside bucket", prefix, len(lst.Entries))
+		}
+	}
+
+	for _, objName := range []string{"../secret/passwd", "a/../../secret/passwd"} {
+		res := bp.GetObjReader(context.Background(), fileLOM(t, objName), 0, 0)
+		tassert.Errorf(t, res.Err != nil && res.R == nil, "GET %q: expected error", objName)
+		_, err := bp.PutObj(io.NopCloser(strings.NewReader("x")), fileLOM(t, objName), nil)
+		tassert.Errorf(t, err != nil, "PUT %q: expected error", objName)
+		_, err = bp.DeleteObj(fileLOM(t, objName))
+		tassert.Errorf(t, err != nil, "DELETE %q: expected error", objName)
+	}
+}
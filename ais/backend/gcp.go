@@ -14,6 +14,7 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"time"
 
 	"cloud.google.com/go/storage"
 	"github.com/NVIDIA/aistore/api/apc"
@@ -36,6 +37,8 @@ const (
 	gcpChecksumType = "x-goog-meta-ais-cksum-type"
 	gcpChecksumVal  = "x-goog-meta-ais-cksum-val"
 
+	gcpChunkRetryDeadline = 5 * time.Minute // (the default is 32s)
+
 	projectIDField  = "project_id"
 	projectIDEnvVar = "GOOGLE_CLOUD_PROJECT"
 	credPathEnvVar  = "GOOGLE_APPLICATION_CREDENTIALS" //nolint:gosec // false positive G101
@@ -95,7 +98,7 @@ func NewGCP(t core.TargetPut, tstats stats.Tracker, startingUp bool) (_ core.Bac
 	gctx = context.Background()
 	gcpClient, err = bp.createClient(gctx)
 
-	return bp, err
+	return newThrottled(bp, t, tstats), err
 }
 
 // TODO: use config.Net.HTTP.IdleConnTimeout and friends
@@ -404,8 +407,22 @@ func (gsbp *gsbp) PutObj(r io.ReadCloser, lom *core.LOM, _ *http.Request) (ecode
 		cloudBck = lom.Bck().RemoteBck()
 		md       = make(cos.StrKVs, 2)
 		gcpObj   = gcpClient.Bucket(cloudBck.Name).Object(lom.ObjName)
-		wc       = gcpObj.NewWriter(gctx)
+		mpconf   = cmn.GCO.Get().Backend.Multipart(apc.GCP)
+		large    = lom.Lsize(true) >= int64(mpconf.Threshold)
+		wc       *storage.Writer
 	)
+	if large {
+		// resumable upload in chunks (see cmn/multipart.go):
+		// retry failed chunks - not the entire upload (NOTE: not idempotent w/o preconditions,
+		// hence not retried by default)
+		gcpObj = gcpObj.Retryer(storage.WithPolicy(storage.RetryAlways), storage.WithMaxAttempts(mpconf.MaxRetries+1),
+			storage.WithErrorFunc(gcpChunkRetry(mpconf.MaxRetries)))
+	}
+	wc = gcpObj.NewWriter(gctx)
+	if large {
+		wc.ChunkSize = int(mpconf.ChunkSize)
+		wc.ChunkRetryDeadline = gcpChunkRetryDeadline
+	}
 	md[gcpChecksumType], md[gcpChecksumVal] = lom.Checksum().Get()
 
 	wc.Metadata = md
@@ -432,6 +449,24 @@ func (gsbp *gsbp) PutObj(r io.ReadCloser, lom *core.LOM, _ *http.Request) (ecode
 	return
 }
 
+// The SDK retries a failed chunk until `ChunkRetryDeadline` (ignoring max attempts);
+// the error func, though, gets called after each attempt, successful or not -
+// use it to also limit the number of consecutive retries.
+func gcpChunkRetry(maxRetries int) func(error) bool {
+	var n int
+	return func(err error) bool {
+		if err == nil {
+			n = 0
+			return false
+		}
+		if n >= maxRetries || !storage.ShouldRetry(err) {
+			return false
+		}
+		n++
+		return true
+	}
+}
+
 //
 // DELETE OBJECT
 //
@@ -505,6 +540,11 @@ func _gcpErr(gcpError error) error {
 
 func handleObjectError(ctx context.Context, gcpClient *storage.Client, objErr error, bck *cmn.Bck) (int, error) {
 	if objErr != storage.ErrObjectNotExist {
+		// preserve throttling (see throttle.go)
+		var apiErr *googleapi.Error
+		if errors.As(objErr, &apiErr) && isThrottled(apiErr.Code) {
+			return apiErr.Code, _gcpErr(objErr)
+		}
 		return http.StatusBadRequest, _gcpErr(objErr)
 	}
 
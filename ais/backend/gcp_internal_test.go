@@ -0,0 +1,190 @@
+//go:build gcp
+
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"google.golang.org/api/option"
+)
+
+const gcsFakeSession = "/upload/session"
+
+// GCS JSON API subset: single-request (multipart) and resumable uploads, and object attributes;
+// fails a given number of attempts to upload the chunk at a given offset
+type gcsFake struct {
+	url       string
+	data      []byte
+	fail      map[int64]int // chunk offset => number of failures
+	single    int           // single-request uploads
+	chunks    int           // resumable upload (chunk) attempts
+	completed bool
+	mu        sync.Mutex
+}
+
+func (fg *gcsFake) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	fg.mu.Lock()
+	defer fg.mu.Unlock()
+	q := r.URL.Query()
+	switch {
+	case r.Method == http.MethodPost && q.Get("uploadType") == "resumable":
+		io.Copy(io.Discard, r.Body)
+		w.Header().Set("Location", fg.url+gcsFakeSession)
+		w.WriteHeader(http.StatusOK)
+	case r.Method == http.MethodPost && q.Get("uploadType") == "multipart":
+		fg.single++
+		_, params, err := mime.ParseMediaType(r.Header.Get(cos.HdrContentType))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		mr := multipart.NewReader(r.Body, params["boundary"])
+		for range 2 { // metadata, media
+			part, err := mr.NextPart()
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			fg.data, _ = io.ReadAll(part)
+		}
+		fg.completed = true
+		fg.writeObj(w)
+	case r.URL.Path == gcsFakeSession:
+		fg.chunks++
+		// e.g.: "bytes 0-262143/*", "bytes 524288-614399/614400", "bytes */614400"
+		var (
+			start, total int64 = 0, -1
+			cr                 = strings.TrimPrefix(r.Header.Get("Content-Range"), "bytes ")
+			rng, tot, _        = strings.Cut(cr, "/")
+		)
+		if s, _, ok := strings.Cut(rng, "-"); ok {
+			start, _ = strconv.ParseInt(s, 10, 64)
+		}
+		if tot != "*" {
+			total, _ = strconv.ParseInt(tot, 10, 64)
+		}
+		b, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if fg.fail[start] > 0 {
+			fg.fail[start]--
+			http.Error(w, "backend error", http.StatusServiceUnavailable)
+			return
+		}
+		if rng != "*" {
+			if start != int64(len(fg.data)) {
+				http.Error(w, fmt.Sprintf("unexpected offset %d (have %d)", start, len(fg.data)), http.StatusBadRequest)
+				return
+			}
+			fg.data = append(fg.data, b...)
+		}
+		if total >= 0 && total == int64(len(fg.data)) {
+			fg.completed = true
+			fg.writeObj(w)
+			return
+		}
+		// (incomplete - see "X-GUploader-No-308" in the SDK)
+		w.Header().Set("X-Http-Status-Code-Override", "308")
+		w.Header().Set("Range", fmt.Sprintf("bytes=0-%d", len(fg.data)-1))
+		w.WriteHeader(http.StatusOK)
+	case r.Method == http.MethodGet && fg.completed:
+		fg.writeObj(w)
+	default:
+		http.Error(w, "unexpected "+r.Method+" "+r.URL.String(), http.StatusBadRequest)
+	}
+}
+
+func (fg *gcsFake) writeObj(w http.ResponseWriter) {
+	w.Header().Set(cos.HdrContentType, "application/json")
+	json.NewEncoder(w).Encode(map[string]string{
+		"bucket":     mptTestBucket,
+		"name":       "obj",
+		"size":       strconv.Itoa(len(fg.data)),
+		"generation": "17",
+		"etag":       "CJ+x",
+		"updated":    time.Now().UTC().Format(time.RFC3339),
+	})
+}
+
+func newGcsFake(t *testing.T, fail map[int64]int) *gcsFake {
+	fg := &gcsFake{fail: fail}
+	srv := httptest.NewServer(fg)
+	t.Cleanup(srv.Close)
+	fg.url = srv.URL
+
+	client, err := storage.NewClient(context.Background(), option.WithEndpoint(srv.URL+"/storage/v1/"),
+		option.WithoutAuthentication())
+	tassert.CheckFatal(t, err)
+	gctx, gcpClient = context.Background(), client
+	t.Cleanup(func() { client.Close() })
+	return fg
+}
+
+func gcsPut(t *testing.T, bp *gsbp, data []byte) error {
+	lom := mptTestLOM(t, apc.GCP, "obj", int64(len(data)))
+	_, err := bp.PutObj(io.NopCloser(bytes.NewReader(data)), lom, nil)
+	if err == nil {
+		tassert.Errorf(t, lom.Version() == "17", "expected version %q, got %q", "17", lom.Version())
+	}
+	return err
+}
+
+func TestGCPResumableUpload(t *testing.T) {
+	const chunk = 256 * cos.KiB
+	var (
+		mpconf = &cmn.MultipartConf{Threshold: chunk, ChunkSize: chunk, MaxRetries: 2}
+		data   = mptTestData(2*chunk + 100*cos.KiB)
+	)
+	bp := &gsbp{t: mptTestInit(t, apc.GCP, mpconf), base: base{provider: apc.GCP}}
+
+	t.Run("small", func(t *testing.T) {
+		small := data[:chunk-1]
+		fg := newGcsFake(t, nil)
+		err := gcsPut(t, bp, small)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, fg.single == 1 && fg.chunks == 0, "expected single-request upload, got (%d, %d)", fg.single, fg.chunks)
+		tassert.Errorf(t, bytes.Equal(fg.data, small), "uploaded object differs (size %d vs %d)", len(fg.data), len(small))
+	})
+
+	t.Run("large", func(t *testing.T) {
+		// the second chunk fails once and gets retried (without restarting the upload)
+		fg := newGcsFake(t, map[int64]int{chunk: 1})
+		err := gcsPut(t, bp, data)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, fg.single == 0, "expected resumable upload")
+		tassert.Errorf(t, fg.chunks == 3+1, "expected 4 attempts to upload 3 chunks, got %d", fg.chunks)
+		tassert.Errorf(t, bytes.Equal(fg.data, data), "uploaded object differs (size %d vs %d)", len(fg.data), len(data))
+	})
+
+	t.Run("retries-exhausted", func(t *testing.T) {
+		fg := newGcsFake(t, map[int64]int{0: mpconf.MaxRetries + 1})
+		err := gcsPut(t, bp, data)
+		tassert.Errorf(t, err != nil, "expected error")
+		tassert.Errorf(t, !fg.completed, "expected incomplete upload")
+		tassert.Errorf(t, fg.chunks == mpconf.MaxRetries+1, "expected %d attempts, got %d", mpconf.MaxRetries+1, fg.chunks)
+	})
+}
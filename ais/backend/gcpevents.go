@@ -0,0 +1,30 @@
+//go:build gcp
+
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"google.golang.org/api/option"
+	htransport "google.golang.org/api/transport/http"
+)
+
+// Pub/Sub (change notifications) authenticated client - see events.go
+
+const pubsubScope = "https://www.googleapis.com/auth/pubsub"
+
+func init() { pubsubClient = newPubSubClient }
+
+func newPubSubClient(ctx context.Context) (*http.Client, error) {
+	transport, err := htransport.NewTransport(ctx, cmn.NewTransport(cmn.TransportArgs{}), option.WithScopes(pubsubScope))
+	if err != nil {
+		return nil, cmn.NewErrFailedTo(nil, "gcp-backend: create", "pubsub transport", err)
+	}
+	return &http.Client{Transport: transport}, nil
+}
@@ -0,0 +1,181 @@
+//go:build gcp
+
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"io"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	jsoniter "github.com/json-iterator/go"
+	"google.golang.org/api/iterator"
+)
+
+// GCS Storage Insights inventory reports
+// https://cloud.google.com/storage/docs/insights/inventory-reports
+//
+// NOTE assumptions/requirements:
+// - the report's destination is this same bucket, and its destination path is
+//   "<inv-name>/<bucket>[/<inv-id>]" (default: ".inventory/<bucket>") - see aiss3.InvPrefObjname
+// - the report includes at least the `name` metadata field (and, ideally, `size`)
+// - of all the reports under the destination path, the one with the latest manifest is used
+// - (older reports are not removed - use GCS lifecycle rules for that)
+
+const gcpInvManifestSuffix = "_manifest.json"
+
+type gcpInvManifest struct {
+	ReportConfig struct {
+		CsvOptions *struct {
+			Delimiter      string `json:"delimiter"`
+			HeaderRequired *bool  `json:"headerRequired"`
+		} `json:"csvOptions"`
+		ObjectMetadataReportOptions struct {
+			MetadataFields []string `json:"metadataFields"`
+		} `json:"objectMetadataReportOptions"`
+	} `json:"report_config"`
+	SnapshotTime     string   `json:"snapshot_time"`
+	ShardsFileNames  []string `json:"report_shards_file_names"`
+	RecordsProcessed int64    `json:"records_processed"`
+}
+
+// when successful, returns w/ rlock held and inventory's (lom, lmfh) in the context;
+// otherwise, always unlocks and frees (see getBucketInv)
+func (*gsbp) GetBucketInv(bck *meta.Bck, ctx *core.LsoInvCtx) (int, error) {
+	var (
+		cloudBck = bck.RemoteBck()
+		mname    string
+		mtime    time.Time
+		manifest *gcpInvManifest
+	)
+	latest := func(prefix string) (_ time.Time, ecode int, err error) {
+		mname, mtime, ecode, err = gcpLatestInv(cloudBck, prefix)
+		if err != nil {
+			return mtime, ecode, err
+		}
+		manifest, ecode, err = gcpInvManifestGet(cloudBck, mname)
+		if err != nil {
+			return mtime, ecode, err
+		}
+		ctx.Schema = manifest.ReportConfig.ObjectMetadataReportOptions.MetadataFields
+		return mtime, 0, nil
+	}
+	get := func() error {
+		return gcpGetInv(cloudBck, ctx, mname, manifest, mtime)
+	}
+	return getBucketInv(bck, ctx, latest, get)
+}
+
+// using local normalized .csv
+func (gsbp *gsbp) ListObjectsInv(_ *meta.Bck, msg *apc.LsoMsg, lst *cmn.LsoRes, ctx *core.LsoInvCtx) error {
+	return listNormInv(gsbp.t.PageMM(), msg, lst, ctx)
+}
+
+// find the latest manifest under a given prefix
+func gcpLatestInv(cloudBck *cmn.Bck, prefix string) (mname string, mtime time.Time, _ int, _ error) {
+	it := gcpClient.Bucket(cloudBck.Name).Objects(gctx, &storage.Query{Prefix: prefix})
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			ecode, e := gcpErrorToAISError(err, cloudBck)
+			return "", mtime, ecode, e
+		}
+		if !strings.HasSuffix(attrs.Name, gcpInvManifestSuffix) {
+			continue
+		}
+		if mname == "" || attrs.Updated.After(mtime) {
+			mname, mtime = attrs.Name, attrs.Updated
+		}
+	}
+	if mname == "" {
+		return "", mtime, http.StatusNotFound, cos.NewErrNotFound(cloudBck, invTag+":"+prefix)
+	}
+	return mname, mtime, 0, nil
+}
+
+func gcpInvManifestGet(cloudBck *cmn.Bck, mname string) (*gcpInvManifest, int, error) {
+	rc, err := gcpClient.Bucket(cloudBck.Name).Object(mname).NewReader(gctx)
+	if err != nil {
+		ecode, e := gcpErrorToAISError(err, cloudBck)
+		return nil, ecode, e
+	}
+	manifest := &gcpInvManifest{}
+	err = jsoniter.NewDecoder(rc).Decode(manifest)
+	cos.Close(rc)
+	if err != nil {
+		return nil, 0, _errInv("parse-manifest "+cloudBck.Cname(mname), err)
+	}
+	if cmn.Rom.FastV(4, cos.SmoduleBackend) {
+		nlog.Infoln("parsed manifest", cloudBck.Cname(mname), manifest.SnapshotTime, "shards:",
+			len(manifest.ShardsFileNames), "records:", manifest.RecordsProcessed)
+	}
+	return manifest, 0, nil
+}
+
+func gcpGetInv(cloudBck *cmn.Bck, ctx *core.LsoInvCtx, mname string, manifest *gcpInvManifest, mtime time.Time) error {
+	var (
+		dir    = path.Dir(mname)
+		shards = make([]string, 0, len(manifest.ShardsFileNames))
+	)
+	for _, name := range manifest.ShardsFileNames {
+		shards = append(shards, path.Join(dir, name))
+	}
+	conv := gcpInvConv(manifest, shards)
+	open := func(oname string) (io.ReadCloser, error) {
+		rc, err := gcpClient.Bucket(cloudBck.Name).Object(oname).NewReader(gctx)
+		if err != nil {
+			_, err = gcpErrorToAISError(err, cloudBck)
+			return nil, err
+		}
+		return rc, nil
+	}
+	return conv.getInv(ctx, shards, open, mtime)
+}
+
+func gcpInvConv(manifest *gcpInvManifest, shards []string) *invConv {
+	conv := &invConv{delim: ',', fmt: invFormatCSV, fix: gcpInvFix}
+	if len(shards) > 0 && cos.Ext(shards[0]) == "."+invFormatParquet {
+		conv.fmt = invFormatParquet
+	}
+	if opts := manifest.ReportConfig.CsvOptions; opts != nil {
+		if opts.Delimiter != "" {
+			conv.delim = []rune(opts.Delimiter)[0]
+		}
+		if opts.HeaderRequired != nil && !*opts.HeaderRequired {
+			conv.noHdr = manifest.ReportConfig.ObjectMetadataReportOptions.MetadataFields
+		}
+	}
+	conv.cols = [ninvNum]string{
+		ninvName:    "name",
+		ninvSize:    "size",
+		ninvETag:    "etag",
+		ninvMtime:   "updated",
+		ninvVersion: "generation",
+		ninvMD5:     "md5Hash",
+	}
+	return conv
+}
+
+// (compare w/ ListObjects)
+func gcpInvFix(rec []string) {
+	h := cmn.BackendHelpers.Google
+	if v := rec[ninvMD5]; v != "" {
+		rec[ninvMD5], _ = h.EncodeCksum(v)
+	}
+	rec[ninvMtime] = invTime(rec[ninvMtime])
+}
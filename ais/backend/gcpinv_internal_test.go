@@ -0,0 +1,98 @@
+//go:build gcp
+
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/parquet"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// md5("hello"): base64 (GCS) => hex (AIS)
+const (
+	gcpInvMD5 = "XUFAKrxLKna5cZ2REBfFkg=="
+	aisInvMD5 = "5d41402abc4b2a76b9719d911017c592"
+)
+
+func TestGcpInvConv(t *testing.T) {
+	tests := []struct {
+		name     string
+		manifest string
+		shards   []string
+		src      string
+		exp      [][]string
+	}{
+		{
+			name:     "csv-header",
+			manifest: `{"report_config": {"csvOptions": {"delimiter": ",", "headerRequired": true}}}`,
+			shards:   []string{"inv/shard_0.csv"},
+			src: "bucket,generation,name,md5Hash,size,updated,etag,contentType\n" +
+				"b1,1700000000000001,a/b.txt," + gcpInvMD5 + ",123,2025-01-02T03:04:05.678Z,CJ+j,text/plain\n" +
+				"b1,1700000000000002,a/,,0,2025-01-02T03:04:05Z,,\n" + // (directory marker)
+				"b1,1700000000000003,\"c, d.txt\",,0,2025-01-02T03:04:05Z,,\n",
+			exp: [][]string{
+				{"a/b.txt", "123", "CJ+j", "2025-01-02T03:04:05Z", "1700000000000001", aisInvMD5},
+				{"c, d.txt", "0", "", "2025-01-02T03:04:05Z", "1700000000000003", ""},
+			},
+		},
+		{
+			name: "csv-no-header",
+			manifest: `{"report_config": {"csvOptions": {"delimiter": ";", "headerRequired": false},
+				"objectMetadataReportOptions": {"metadataFields": ["size", "name", "updated"]}}}`,
+			shards: []string{"inv/shard_0.csv", "inv/shard_1.csv"},
+			src:    "7;x;2025-01-02T03:04:05Z\n8;y;bad-time\n",
+			exp: [][]string{
+				{"x", "7", "", "2025-01-02T03:04:05Z", "", ""},
+				{"y", "8", "", "bad-time", "", ""},
+			},
+		},
+		{
+			name:     "csv-no-options",
+			manifest: `{}`,
+			shards:   []string{"inv/shard_0.csv"},
+			src:      "name\nonly-name\n",
+			exp: [][]string{
+				{"only-name", "", "", "", "", ""},
+			},
+		},
+	}
+	for _, test := range tests {
+		manifest := &gcpInvManifest{}
+		tassert.CheckFatal(t, jsoniter.Unmarshal([]byte(test.manifest), manifest))
+		c := gcpInvConv(manifest, test.shards)
+		tassert.Fatalf(t, c.fmt == invFormatCSV, "%s: expected CSV, got %q", test.name, c.fmt)
+		recs := invConvert(t, c, []byte(test.src))
+		invCheckRecs(t, test.name, recs, test.exp)
+	}
+}
+
+func TestGcpInvConvParquet(t *testing.T) {
+	c := gcpInvConv(&gcpInvManifest{}, []string{"inv/shard_0.parquet"})
+	tassert.Fatalf(t, c.fmt == invFormatParquet, "expected parquet, got %q", c.fmt)
+
+	cols := []parquet.Column{
+		{Name: "name", Type: parquet.String},
+		{Name: "size", Type: parquet.Int64},
+		{Name: "updated", Type: parquet.TimestampMicros},
+		{Name: "generation", Type: parquet.Int64},
+		{Name: "md5Hash", Type: parquet.String},
+	}
+	ts := int64(1735787045123456) // 2025-01-02T03:04:05.123456Z
+	src := invParquet(t, cols,
+		[]any{"a", int64(10), ts, int64(1700000000000001), gcpInvMD5},
+		[]any{"b/c", int64(1 << 33), ts, int64(1700000000000002), ""},
+		[]any{"d", int64(0), ts, int64(1700000000000003), "not-base64"},
+	)
+	recs := invConvert(t, c, src)
+	invCheckRecs(t, "parquet", recs, [][]string{
+		{"a", "10", "", "2025-01-02T03:04:05Z", "1700000000000001", aisInvMD5},
+		{"b/c", "8589934592", "", "2025-01-02T03:04:05Z", "1700000000000002", ""},
+		{"d", "0", "", "2025-01-02T03:04:05Z", "1700000000000003", ""},
+	})
+}
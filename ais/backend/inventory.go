@@ -0,0 +1,664 @@
+//go:build aws || gcp || azure
+
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2024-2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	aiss3 "github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/cmn/parquet"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// List objects via remote bucket inventory - the part that is common for all providers:
+// - one bucket, one inventory; the latter gets fetched and stored locally as ctx.Lom
+//   (named by aiss3.InvPrefObjname) and is then used to list pages
+// - the local copy is reused for as long as its mtime matches the latest remote inventory
+// - AWS (awsinv.go) stores S3 inventory as is (unzipped)
+// - GCP (gcpinv.go) and Azure (azureinv.go) normalize their (CSV or Parquet, possibly sharded)
+//   inventories into a single CSV with the fixed set of columns (see ninv* below)
+
+const invTag = "bucket-inventory"
+
+const invBusyTimeout = 10 * time.Second
+
+const (
+	invMaxLine = cos.KiB >> 1 // line buf
+	invSwapSGL = invMaxLine
+
+	invMaxPage = 8 * apc.MaxPageSizeAWS
+	invPageSGL = max(invMaxPage*invMaxLine, 2*cos.MiB)
+)
+
+// normalized inventory: column positions
+const (
+	ninvName = iota
+	ninvSize
+	ninvETag
+	ninvMtime
+	ninvVersion
+	ninvMD5
+
+	ninvNum
+)
+
+// normalized inventory: max line (object names are limited to 1KiB by both GCP and Azure)
+const (
+	ninvMaxLine = 4 * cos.KiB
+	ninvSwapSGL = ninvMaxLine
+)
+
+// source inventory formats
+const (
+	invFormatCSV     = "csv"
+	invFormatParquet = "parquet"
+)
+
+type (
+	// normalize source inventory (GCP, Azure)
+	invConv struct {
+		fix    func(rec []string) // provider-specific values (optional)
+		w      *csv.Writer
+		trim   string          // strip this prefix from object names and skip the rest (optional)
+		cols   [ninvNum]string // source column names
+		idx    [ninvNum]int    // source column positions
+		rec    [ninvNum]string // normalized record
+		delim  rune            // CSV delimiter
+		noHdr  []string        // source CSV has no header - use these column names
+		nrec   int64           // total written
+		nskip  int64           // skipped (too long)
+		shards int             // total processed
+		fmt    string          // invFormatCSV | invFormatParquet
+	}
+)
+
+// common list-objects-via-inventory flow:
+// - latest() finds the latest remote inventory and returns its timestamp (called under rlock)
+// - get() fetches and stores it as ctx.Lom (called under wlock)
+// when successful, returns w/ rlock held and inventory's (lom, lmfh) in the context;
+// otherwise, always unlocks and frees
+func getBucketInv(bck *meta.Bck, ctx *core.LsoInvCtx, latest func(prefix string) (time.Time, int, error), get func() error) (int, error) {
+	debug.Assert(ctx != nil && ctx.Lom == nil)
+
+	// one bucket, one inventory, one statically defined name
+	prefix, objName := aiss3.InvPrefObjname(bck.Bucket(), ctx.Name, ctx.ID)
+	lom := core.AllocLOM(objName)
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		core.FreeLOM(lom)
+		return 0, err
+	}
+	if !lom.TryLock(false) {
+		err := cmn.NewErrBusy(invTag, lom.Cname(), "likely getting updated")
+		core.FreeLOM(lom)
+		return 0, err
+	}
+
+	latestMtime, ecode, err := latest(prefix)
+	if err != nil {
+		lom.Unlock(false)
+		core.FreeLOM(lom)
+		return ecode, err
+	}
+	ctx.Lom = lom
+	mtime, usable := checkInvLom(latestMtime, ctx)
+	if usable {
+		if ctx.Lmfh, err = ctx.Lom.Open(); err != nil {
+			lom.Unlock(false)
+			core.FreeLOM(lom)
+			ctx.Lom = nil
+			return 0, _errInv("usable-inv-open", err)
+		}
+
+		return 0, nil // w/ rlock
+	}
+
+	// rlock -> wlock
+
+	lom.Unlock(false)
+	err = cmn.NewErrBusy(invTag, lom.Cname(), "timed out waiting to acquire write access") // prelim
+	sleep, total := time.Second, invBusyTimeout
+	for total >= 0 {
+		if lom.TryLock(true) {
+			err = nil
+			break
+		}
+		time.Sleep(sleep)
+		total -= sleep
+	}
+	if err != nil {
+		core.FreeLOM(lom)
+		ctx.Lom = nil
+		return 0, err // busy
+	}
+
+	// acquired wlock: check for write/write race
+
+	_, _, newMtime, err := ctx.Lom.Fstat(false /*get-atime*/)
+	if err == nil && newMtime.Sub(mtime) > time.Hour {
+		// updated by smbd else
+		// reload the lom and return
+		ctx.Lom.Uncache()
+		_, usable = checkInvLom(newMtime, ctx)
+		debug.Assert(usable)
+
+		// wlock --> rlock must succeed
+		lom.Unlock(true)
+		lom.Lock(false)
+
+		if ctx.Lmfh, err = ctx.Lom.Open(); err != nil {
+			lom.Unlock(false)
+			core.FreeLOM(lom)
+			ctx.Lom = nil
+			return 0, _errInv("reload-inv-open", err)
+		}
+		return 0, nil // ok
+	}
+
+	// still under wlock: read and write as ctx.Lom
+
+	err = get()
+
+	// wlock --> rlock
+
+	lom.Unlock(true)
+
+	if err != nil {
+		core.FreeLOM(lom)
+		ctx.Lom = nil
+		return 0, err
+	}
+
+	lom.Lock(false) // must succeed
+	if ctx.Lmfh, err = ctx.Lom.Open(); err != nil {
+		lom.Unlock(false)
+		core.FreeLOM(lom)
+		ctx.Lom = nil
+		return 0, _errInv("get-inv-open", err)
+	}
+
+	return 0, nil // ok
+}
+
+func checkInvLom(latest time.Time, ctx *core.LsoInvCtx) (time.Time, bool) {
+	size, _, mtime, err := ctx.Lom.Fstat(false /*get-atime*/)
+	if err != nil {
+		debug.Assert(os.IsNotExist(err), err)
+		nlog.Infoln(invTag, "does not exist, getting a new one for the timestamp:", latest)
+		return time.Time{}, false
+	}
+
+	if cmn.Rom.FastV(5, cos.SmoduleBackend) {
+		nlog.Infoln(core.T.String(), "checking", ctx.Lom.String(), ctx.Lom.FQN, ctx.Lom.HrwFQN)
+	}
+	abs := _sinceAbs(mtime, latest)
+	if abs < time.Second {
+		debug.Assert(ctx.Size == 0 || ctx.Size == size)
+		ctx.Size = size
+
+		// start (or rather, keep) using this one
+		errN := ctx.Lom.Load(true, true)
+		debug.AssertNoErr(errN)
+		debug.Assert(ctx.Lom.Lsize() == size, ctx.Lom.Lsize(), size)
+		return time.Time{}, true
+	}
+
+	nlog.Infoln(invTag, ctx.Lom.Cname(), "is likely being updated: [", mtime.String(), latest.String(), abs, "]")
+	return mtime, false
+}
+
+// finalize the workfile as ctx.Lom with its mtime set to the remote inventory's timestamp
+// (NOTE a lighter version of FinalizeObj - no redundancy, no locks)
+func finalizeInv(ctx *core.LsoInvCtx, wfqn string, mtime time.Time) error {
+	lom := ctx.Lom
+	if err := lom.RenameFinalize(wfqn); err != nil {
+		return err
+	}
+	if err := os.Chtimes(lom.FQN, mtime, mtime); err != nil {
+		return err
+	}
+	nlog.Infoln("new", invTag+":", lom.Cname(), ctx.Schema)
+
+	lom.SetSize(ctx.Size)
+	lom.SetAtimeUnix(mtime.UnixNano())
+	if errN := lom.PersistMain(); errN != nil {
+		debug.AssertNoErr(errN) // (unlikely)
+		nlog.Errorln("failed to persist", lom.Cname(), "err:", errN, "- proceeding anyway...")
+	}
+	return nil
+}
+
+// allocate or swap SGLs; returns false when there's nothing to list
+func invSGL(mm *memsys.MMSA, ctx *core.LsoInvCtx, swap int64) bool {
+	if ctx.SGL == nil {
+		if ctx.EOF {
+			debug.Assert(false) // (unlikely)
+			return false
+		}
+		ctx.SGL = mm.NewSGL(invPageSGL, memsys.DefaultBuf2Size)
+	} else if l := ctx.SGL.Len(); l > 0 && l < swap && !ctx.EOF {
+		// swap SGLs
+		sgl := mm.NewSGL(invPageSGL, memsys.DefaultBuf2Size)
+		written, err := io.Copy(sgl, ctx.SGL) // buffering not needed - gets executed via sgl WriteTo()
+		debug.AssertNoErr(err)
+		debug.Assert(written == l && sgl.Len() == l, written, " vs ", l, " vs ", sgl.Len())
+		ctx.SGL.Free()
+		ctx.SGL = sgl
+	}
+	return true
+}
+
+// when little remains: read some more unless eof
+func invFill(ctx *core.LsoInvCtx, swap int64) error {
+	sgl := ctx.SGL
+	if sgl.Len() >= 2*swap || ctx.EOF {
+		return nil
+	}
+	_, err := io.CopyN(sgl, ctx.Lmfh, invPageSGL-sgl.Len()-256)
+	if err == nil {
+		return nil
+	}
+	ctx.EOF = err == io.EOF
+	if !ctx.EOF {
+		nlog.Errorln("Warning: error reading", invTag, err)
+		return err
+	}
+	if sgl.Len() == 0 {
+		return err
+	}
+	return nil
+}
+
+//
+// normalized inventory: list
+//
+
+// using local normalized .csv (GCP, Azure)
+func listNormInv(mm *memsys.MMSA, msg *apc.LsoMsg, lst *cmn.LsoRes, ctx *core.LsoInvCtx) error {
+	debug.Assert(ctx.Lom != nil && ctx.Lmfh != nil, ctx.Lom, " ", ctx.Lmfh)
+
+	if invSGL(mm, ctx, ninvSwapSGL) {
+		err := _listNormInv(msg, lst, ctx)
+		if err == nil || err == io.EOF {
+			return nil
+		}
+		lst.Entries = lst.Entries[:0]
+		return err
+	}
+	lst.Entries = lst.Entries[:0]
+	return nil
+}
+
+func _listNormInv(msg *apc.LsoMsg, lst *cmn.LsoRes, ctx *core.LsoInvCtx) (err error) {
+	var (
+		i    int64
+		rec  = make([]string, 0, ninvNum)
+		sgl  = ctx.SGL
+		lbuf = make([]byte, ninvMaxLine) // reuse for all read lines
+	)
+	msg.PageSize = calcPageSize(msg.PageSize, invMaxPage)
+	for j := len(lst.Entries); j < int(msg.PageSize); j++ {
+		lst.Entries = append(lst.Entries, &cmn.LsoEnt{})
+	}
+	lst.ContinuationToken = ""
+
+	if err = invFill(ctx, ninvSwapSGL); err != nil {
+		return err
+	}
+
+	var (
+		wantCustom = msg.WantProp(apc.GetPropsCustom)
+		nameOnly   = msg.IsFlagSet(apc.LsNameOnly) || msg.IsFlagSet(apc.LsNameSize)
+		custom     = make([]string, 0, 6)
+		skip       = msg.ContinuationToken != "" // (tentatively)
+	)
+
+	// avoid having line split across SGLs
+	for i < msg.PageSize && (sgl.Len() > ninvSwapSGL || ctx.EOF) {
+		lbuf, err = sgl.NextLine(lbuf, true)
+		if err != nil {
+			break
+		}
+		rec = splitCSV(string(lbuf), rec[:0])
+		if len(rec) != ninvNum {
+			nlog.Errorln(ctx.Lom.String(), "invalid record:", cos.BHead(lbuf, 128))
+			continue
+		}
+		objName := rec[ninvName]
+
+		if skip {
+			skip = false
+			if objName != msg.ContinuationToken {
+				nlog.Errorln("Warning: expecting to resume from the previously returned:",
+					msg.ContinuationToken, "vs", objName)
+			}
+		}
+
+		// prefix
+		if msg.IsFlagSet(apc.LsNoRecursion) {
+			if _, errN := cmn.HandleNoRecurs(msg.Prefix, objName); errN != nil {
+				continue
+			}
+		} else if msg.Prefix != "" && !strings.HasPrefix(objName, msg.Prefix) {
+			continue
+		}
+
+		// next entry
+		entry := lst.Entries[i]
+		i++
+		*entry = cmn.LsoEnt{Name: objName}
+		if v := rec[ninvSize]; v != "" {
+			if entry.Size, err = strconv.ParseInt(v, 10, 64); err != nil {
+				nlog.Errorln(ctx.Lom.String(), "failed to parse size", v, err)
+			}
+		}
+		if nameOnly {
+			continue
+		}
+		entry.Checksum = rec[ninvMD5]
+		entry.Version = rec[ninvVersion]
+		if wantCustom {
+			custom = custom[:0]
+			if v := rec[ninvETag]; v != "" {
+				custom = append(custom, cmn.ETag, v)
+			}
+			if v := rec[ninvMtime]; v != "" {
+				custom = append(custom, cmn.LastModified, v)
+			}
+			if len(custom) > 0 {
+				entry.Custom = cmn.CustomProps2S(custom...)
+			}
+		}
+	}
+
+	lst.Entries = lst.Entries[:i]
+
+	// set next continuation token
+	lbuf, err = sgl.NextLine(lbuf, false /*advance roff*/)
+	if err == nil {
+		rec = splitCSV(string(lbuf), rec[:0])
+		lst.ContinuationToken = rec[0]
+	}
+	return err
+}
+
+// split a single-line CSV record (as written by encoding/csv)
+func splitCSV(line string, out []string) []string {
+	for {
+		if line == "" || line[0] != '"' {
+			i := strings.IndexByte(line, ',')
+			if i < 0 {
+				return append(out, line)
+			}
+			out = append(out, line[:i])
+			line = line[i+1:]
+			continue
+		}
+		// quoted
+		var sb strings.Builder
+		line = line[1:]
+		for {
+			i := strings.IndexByte(line, '"')
+			if i < 0 {
+				sb.WriteString(line) // (malformed)
+				return append(out, sb.String())
+			}
+			sb.WriteString(line[:i])
+			line = line[i+1:]
+			if line != "" && line[0] == '"' { // escaped quote
+				sb.WriteByte('"')
+				line = line[1:]
+				continue
+			}
+			break
+		}
+		out = append(out, sb.String())
+		if line == "" {
+			return out
+		}
+		line = line[1:] // (comma)
+	}
+}
+
+//
+// normalized inventory: convert (source => local)
+//
+
+// fetch (and normalize) all inventory shards; then finalize ctx.Lom
+func (c *invConv) getInv(ctx *core.LsoInvCtx, shards []string, open func(oname string) (io.ReadCloser, error), mtime time.Time) error {
+	wfqn := fs.CSM.Gen(ctx.Lom, fs.WorkfileType, "")
+	wfh, err := ctx.Lom.CreateWork(wfqn)
+	if err != nil {
+		return _errInv("create-file", err)
+	}
+	bw := bufio.NewWriterSize(wfh, memsys.DefaultBuf2Size)
+	c.w = csv.NewWriter(bw)
+
+	for _, oname := range shards {
+		if err = c.shard(ctx, oname, open); err != nil {
+			err = fmt.Errorf("%s: %w", oname, err)
+			break
+		}
+	}
+	if err == nil {
+		c.w.Flush()
+		if err = c.w.Error(); err == nil {
+			err = bw.Flush()
+		}
+	}
+	cos.Close(wfh)
+	if err == nil {
+		var finfo os.FileInfo
+		if finfo, err = os.Stat(wfqn); err == nil {
+			ctx.Size = finfo.Size()
+		}
+	}
+	if err == nil {
+		if c.nskip > 0 {
+			nlog.Warningln(invTag, ctx.Lom.Cname(), "skipped", c.nskip, "record(s) with oversized names")
+		}
+		nlog.Infoln(invTag, ctx.Lom.Cname(), "shards:", c.shards, "records:", c.nrec)
+		if err = finalizeInv(ctx, wfqn, mtime); err == nil {
+			return nil
+		}
+	}
+	if nerr := cos.RemoveFile(wfqn); nerr != nil && !os.IsNotExist(nerr) {
+		nlog.Errorf("get-inv (%v), nested fail to remove (%v)", err, nerr)
+	}
+	return _errInv("get-inv", err)
+}
+
+func (c *invConv) shard(ctx *core.LsoInvCtx, oname string, open func(oname string) (io.ReadCloser, error)) error {
+	rc, err := open(oname)
+	if err != nil {
+		return err
+	}
+	c.shards++
+	if c.fmt == invFormatParquet {
+		return c.fromParquet(ctx, rc)
+	}
+	err = c.fromCSV(rc)
+	cos.Close(rc)
+	return err
+}
+
+func (c *invConv) fromCSV(rc io.Reader) error {
+	r := csv.NewReader(bufio.NewReaderSize(rc, memsys.DefaultBuf2Size))
+	r.Comma = c.delim
+	r.LazyQuotes = true
+	r.FieldsPerRecord = -1
+	r.ReuseRecord = true
+
+	if c.noHdr != nil {
+		if err := c.header(c.noHdr); err != nil {
+			return err
+		}
+	} else {
+		hdr, err := r.Read()
+		if err != nil {
+			if err == io.EOF {
+				return nil // empty
+			}
+			return err
+		}
+		if err := c.header(hdr); err != nil {
+			return err
+		}
+	}
+	for {
+		rec, err := r.Read()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if err := c.row(rec); err != nil {
+			return err
+		}
+	}
+}
+
+// (parquet requires random access - download first)
+func (c *invConv) fromParquet(ctx *core.LsoInvCtx, rc io.ReadCloser) error {
+	fqn := fs.CSM.Gen(ctx.Lom, fs.WorkfileType, invFormatParquet)
+	fh, err := cos.CreateFile(fqn)
+	if err != nil {
+		cos.Close(rc)
+		return err
+	}
+	defer func() {
+		cos.Close(fh)
+		cos.RemoveFile(fqn)
+	}()
+
+	buf, slab := core.T.PageMM().AllocSize(memsys.DefaultBuf2Size)
+	size, err := cos.CopyBuffer(fh, rc, buf)
+	slab.Free(buf)
+	cos.Close(rc)
+	if err != nil {
+		return err
+	}
+
+	return c.parquet(fh, size)
+}
+
+func (c *invConv) parquet(r io.ReaderAt, size int64) error {
+	pr, err := parquet.NewReader(r, size)
+	if err != nil {
+		return err
+	}
+	defer pr.Close()
+	if err := c.header(pr.Columns()); err != nil {
+		return err
+	}
+	for {
+		rec, err := pr.Read()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if err := c.row(rec); err != nil {
+			return err
+		}
+	}
+}
+
+func (c *invConv) header(names []string) error {
+	for i := range c.idx {
+		c.idx[i] = -1
+		if c.cols[i] == "" {
+			continue
+		}
+		for j, name := range names {
+			if strings.EqualFold(strings.TrimSpace(name), c.cols[i]) {
+				c.idx[i] = j
+				break
+			}
+		}
+	}
+	if c.idx[ninvName] < 0 {
+		return fmt.Errorf("missing %q column in %v", c.cols[ninvName], names)
+	}
+	return nil
+}
+
+func (c *invConv) row(src []string) error {
+	var size int
+	for i, j := range c.idx {
+		c.rec[i] = ""
+		if j >= 0 && j < len(src) {
+			c.rec[i] = src[j]
+			size += len(src[j])
+		}
+	}
+	name := c.rec[ninvName]
+	if c.trim != "" {
+		if !strings.HasPrefix(name, c.trim) {
+			return nil // not this bucket
+		}
+		name = name[len(c.trim):]
+	}
+	if name == "" || cos.IsLastB(name, '/') {
+		return nil // (directory marker)
+	}
+	// must fit ninvMaxLine even when fully quoted; no multi-line records
+	if 2*size+2*ninvNum >= ninvMaxLine || strings.ContainsAny(name, "\r\n") {
+		c.nskip++
+		return nil
+	}
+	c.rec[ninvName] = name
+	if c.fix != nil {
+		c.fix(c.rec[:])
+	}
+	c.nrec++
+	return c.w.Write(c.rec[:])
+}
+
+// parse inventory timestamp; return the one we use for cmn.LastModified
+func invTime(s string) string {
+	if s == "" {
+		return ""
+	}
+	for _, layout := range []string{time.RFC3339Nano, time.RFC1123, time.RFC1123Z} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return fmtTime(t)
+		}
+	}
+	return s
+}
+
+//
+// internal
+//
+
+func _errInv(tag string, err error) error {
+	return fmt.Errorf("%s: %s: %v", invTag, tag, err)
+}
+
+func _sinceAbs(t1, t2 time.Time) time.Duration {
+	if t1.After(t2) {
+		return t1.Sub(t2)
+	}
+	return t2.Sub(t1)
+}
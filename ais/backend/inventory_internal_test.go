@@ -0,0 +1,263 @@
+//go:build aws || gcp || azure
+
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/parquet"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+// (in-memory local inventory)
+type invReader struct {
+	*bytes.Reader
+}
+
+var _ cos.LomReader = invReader{}
+
+func (invReader) Close() error { return nil }
+
+// normalize CSV source (or Parquet - see invParquet) and return the resulting records
+func invConvert(t *testing.T, c *invConv, src []byte) [][]string {
+	var buf bytes.Buffer
+	c.w = csv.NewWriter(&buf)
+	var err error
+	if c.fmt == invFormatParquet {
+		err = c.parquet(bytes.NewReader(src), int64(len(src)))
+	} else {
+		err = c.fromCSV(bytes.NewReader(src))
+	}
+	tassert.CheckFatal(t, err)
+	c.w.Flush()
+	tassert.CheckFatal(t, c.w.Error())
+
+	recs, err := csv.NewReader(&buf).ReadAll()
+	tassert.CheckFatal(t, err)
+	for _, rec := range recs {
+		tassert.Fatalf(t, len(rec) == ninvNum, "invalid normalized record %v", rec)
+	}
+	return recs
+}
+
+func invParquet(t *testing.T, cols []parquet.Column, rows ...[]any) []byte {
+	var buf bytes.Buffer
+	pw, err := parquet.NewWriter(&buf, cols, 2 /*rows per group*/)
+	tassert.CheckFatal(t, err)
+	for _, row := range rows {
+		tassert.CheckFatal(t, pw.WriteRow(row...))
+	}
+	tassert.CheckFatal(t, pw.Close())
+	return buf.Bytes()
+}
+
+func invCheckRecs(t *testing.T, tag string, recs, exp [][]string) {
+	tassert.Fatalf(t, len(recs) == len(exp), "%s: expected %d records, got %d: %v", tag, len(exp), len(recs), recs)
+	for i := range exp {
+		for j := range exp[i] {
+			tassert.Errorf(t, recs[i][j] == exp[i][j], "%s: record %d, column %d: expected %q, got %q",
+				tag, i, j, exp[i][j], recs[i][j])
+		}
+	}
+}
+
+func TestInvSplitCSV(t *testing.T) {
+	tests := []struct {
+		line string
+		exp  []string
+	}{
+		{"a,1,,", []string{"a", "1", "", ""}},
+		{"", []string{""}},
+		{`"a,b",2`, []string{"a,b", "2"}},
+		{`"say ""hi""",3,x`, []string{`say "hi"`, "3", "x"}},
+		{`x,"",y`, []string{"x", "", "y"}},
+	}
+	for _, test := range tests {
+		out := splitCSV(test.line, nil)
+		tassert.Errorf(t, strings.Join(out, "|") == strings.Join(test.exp, "|"), "%q: expected %q, got %q", test.line, test.exp, out)
+	}
+
+	// round-trip (encoding/csv => splitCSV)
+	var (
+		buf bytes.Buffer
+		rec = []string{`a "quoted", name`, "42", `"etag"`, "", "v1", ""}
+		w   = csv.NewWriter(&buf)
+	)
+	tassert.CheckFatal(t, w.Write(rec))
+	w.Flush()
+	out := splitCSV(strings.TrimSuffix(buf.String(), "\n"), nil)
+	tassert.Errorf(t, strings.Join(out, "|") == strings.Join(rec, "|"), "expected %q, got %q", rec, out)
+}
+
+func TestInvTime(t *testing.T) {
+	tests := []struct {
+		in, exp string
+	}{
+		{"", ""},
+		{"2025-01-02T03:04:05Z", "2025-01-02T03:04:05Z"},
+		{"2025-01-02T03:04:05.678901Z", "2025-01-02T03:04:05Z"},
+		{"2025-01-02T05:04:05+02:00", "2025-01-02T05:04:05+02:00"},
+		{"Thu, 02 Jan 2025 03:04:05 GMT", "2025-01-02T03:04:05Z"},
+		{"Thu, 02 Jan 2025 03:04:05 -0700", "2025-01-02T03:04:05-07:00"},
+		{"not-a-time", "not-a-time"},
+	}
+	for _, test := range tests {
+		out := invTime(test.in)
+		tassert.Errorf(t, out == test.exp, "%q: expected %q, got %q", test.in, test.exp, out)
+	}
+}
+
+func TestInvConvRow(t *testing.T) {
+	c := &invConv{delim: ',', fmt: invFormatCSV, trim: "bck/"}
+	c.cols = [ninvNum]string{ninvName: "name", ninvSize: "size"}
+	long := strings.Repeat("x", ninvMaxLine)
+	src := "Size,NAME,other\n" +
+		"1,bck/a,z\n" +
+		"2,other/b,z\n" + // (another bucket)
+		"3,bck/dir/,z\n" + // (directory marker)
+		"4,bck/" + long + ",z\n" + // (too long)
+		"5,\"bck/c\nd\",z\n" + // (multi-line)
+		"6\n" + // (short record)
+		"7,bck/e,z\n"
+	recs := invConvert(t, c, []byte(src))
+	invCheckRecs(t, "row", recs, [][]string{
+		{"a", "1", "", "", "", ""},
+		{"e", "7", "", "", "", ""},
+	})
+	tassert.Errorf(t, c.nrec == 2, "expected 2 records, got %d", c.nrec)
+	tassert.Errorf(t, c.nskip == 2, "expected 2 skipped, got %d", c.nskip)
+
+	// name column is required
+	c = &invConv{delim: ',', fmt: invFormatCSV}
+	c.cols = [ninvNum]string{ninvName: "name", ninvSize: "size"}
+	c.w = csv.NewWriter(io.Discard)
+	err := c.fromCSV(strings.NewReader("size,etag\n1,x\n"))
+	tassert.Errorf(t, err != nil, "expected missing-name error")
+}
+
+// list normalized inventory page by page
+func TestListNormInvPages(t *testing.T) {
+	const (
+		num    = 30000 // (greater than invPageSGL worth of lines)
+		suffix = "-some-longer-object-name-to-span-multiple-sgl-pages-and-swaps-0123456789abcdef"
+	)
+	var (
+		buf   bytes.Buffer
+		w     = csv.NewWriter(&buf)
+		mm    = memsys.PageMM()
+		names = make([]string, 0, num)
+		index = make(map[string]int, num)
+	)
+	for i := range num {
+		name := fmt.Sprintf("dir-%d/obj-%06d%s", i%3, i, suffix)
+		if i == 7 {
+			name = `quoted, "name"`
+		}
+		names = append(names, name)
+		index[name] = i
+		rec := []string{name, strconv.Itoa(i), "etag-" + strconv.Itoa(i), "2025-01-02T03:04:05Z", "v" + strconv.Itoa(i), ""}
+		tassert.CheckFatal(t, w.Write(rec))
+	}
+	w.Flush()
+	tassert.Fatalf(t, int64(buf.Len()) > invPageSGL, "inventory size %d vs %d", buf.Len(), invPageSGL)
+
+	tests := []struct {
+		prefix   string
+		pageSize int64
+		props    string
+	}{
+		{"", 1000, apc.GetPropsName},
+		{"", 0, apc.GetPropsName + apc.LsPropsSepa + apc.GetPropsCustom},
+		{"dir-1/", 777, apc.GetPropsName + apc.LsPropsSepa + apc.GetPropsSize},
+	}
+	for _, test := range tests {
+		var (
+			exp   = make([]string, 0, num)
+			lst   = &cmn.LsoRes{}
+			ctx   = &core.LsoInvCtx{Lmfh: invReader{bytes.NewReader(buf.Bytes())}}
+			msg   = &apc.LsoMsg{Prefix: test.prefix, Props: test.props}
+			got   = make([]string, 0, num)
+			pages int
+		)
+		for _, name := range names {
+			if strings.HasPrefix(name, test.prefix) {
+				exp = append(exp, name)
+			}
+		}
+		for {
+			msg.PageSize = test.pageSize
+			tassert.CheckFatal(t, listNormInv(mm, msg, lst, ctx))
+			pages++
+			for _, en := range lst.Entries {
+				i := len(got)
+				got = append(got, en.Name)
+				if i >= len(exp) || en.Name != exp[i] {
+					continue
+				}
+				// size, version, custom
+				idx := strconv.Itoa(index[en.Name])
+				tassert.Errorf(t, strconv.FormatInt(en.Size, 10) == idx, "%q: size %d", en.Name, en.Size)
+				tassert.Errorf(t, en.Version == "v"+idx, "%q: version %q", en.Name, en.Version)
+				if msg.WantProp(apc.GetPropsCustom) {
+					md := make(cos.StrKVs, 2)
+					cmn.S2CustomMD(md, en.Custom, "")
+					tassert.Errorf(t, md[cmn.ETag] == "etag-"+idx && md[cmn.LastModified] == "2025-01-02T03:04:05Z",
+						"%q: custom %q", en.Name, en.Custom)
+				}
+			}
+			if lst.ContinuationToken == "" {
+				break
+			}
+			tassert.Fatalf(t, pages <= num, "runaway listing")
+			msg.ContinuationToken = lst.ContinuationToken
+		}
+		if ctx.SGL != nil {
+			ctx.SGL.Free()
+		}
+
+		tassert.Fatalf(t, len(got) == len(exp), "prefix %q: expected %d names, got %d", test.prefix, len(exp), len(got))
+		for i := range exp {
+			if got[i] != exp[i] {
+				t.Fatalf("prefix %q: entry %d: expected %q, got %q", test.prefix, i, exp[i], got[i])
+			}
+		}
+		minPages := len(exp) / int(calcPageSize(test.pageSize, invMaxPage))
+		tassert.Errorf(t, pages >= minPages, "prefix %q: expected at least %d pages, got %d", test.prefix, minPages, pages)
+	}
+}
+
+func TestInvConvParquet(t *testing.T) {
+	c := &invConv{fmt: invFormatParquet}
+	c.cols = [ninvNum]string{ninvName: "name", ninvSize: "size", ninvMtime: "mtime"}
+	cols := []parquet.Column{
+		{Name: "mtime", Type: parquet.TimestampMicros},
+		{Name: "name", Type: parquet.String},
+		{Name: "size", Type: parquet.Int64},
+	}
+	ts := int64(1735787045000000) // 2025-01-02T03:04:05Z
+	src := invParquet(t, cols,
+		[]any{ts, "a", int64(1)},
+		[]any{ts, "dir/", int64(0)},
+		[]any{ts, "b", int64(1 << 40)},
+	)
+	recs := invConvert(t, c, src)
+	invCheckRecs(t, "parquet", recs, [][]string{
+		{"a", "1", "", "2025-01-02T03:04:05Z", "", ""},
+		{"b", "1099511627776", "", "2025-01-02T03:04:05Z", "", ""},
+	})
+}
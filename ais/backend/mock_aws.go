@@ -22,6 +22,10 @@ func NewAWS(core.TargetPut, stats.Tracker, bool) (core.Backend, error) {
 	return nil, &cmn.ErrInitBackend{Provider: apc.AWS}
 }
 
+func ReloadS3Profile(string) error {
+	return &cmn.ErrInitBackend{Provider: apc.AWS}
+}
+
 func StartMpt(*core.LOM, *http.Request, url.Values) (string, int, error) {
 	return "", http.StatusBadRequest, cmn.NewErrUnsupp("start-mpt", mock)
 }
@@ -7,6 +7,9 @@
 package backend
 
 import (
+	"io"
+	"net/http"
+
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/core"
@@ -16,3 +19,11 @@ import (
 func NewAzure(core.TargetPut, stats.Tracker, bool) (core.Backend, error) {
 	return nil, &cmn.ErrInitBackend{Provider: apc.Azure}
 }
+
+func StageMptBlock(*core.LOM, io.ReadSeekCloser, string, int64, int32) (int, error) {
+	return http.StatusBadRequest, cmn.NewErrUnsupp("stage-mpt-block", mock)
+}
+
+func CommitMptBlocks(*core.LOM, string, []int32) (string, string, int, error) {
+	return "", "", http.StatusBadRequest, cmn.NewErrUnsupp("commit-mpt-blocks", mock)
+}
@@ -0,0 +1,62 @@
+//go:build azure || gcp
+
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	cryptorand "crypto/rand"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	coremock "github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+// (chunked upload tests: see azuremp_internal_test.go and gcp_internal_test.go)
+
+const mptTestBucket = "mpt"
+
+var mptTestOnce sync.Once
+
+// mock target and mountpath (once), remote bucket `provider://mpt`, and multipart config
+func mptTestInit(t *testing.T, provider string, mpconf *cmn.MultipartConf) *coremock.TargetMock {
+	mptTestOnce.Do(func() {
+		mpath, err := os.MkdirTemp("", "ais-mpt-")
+		tassert.CheckFatal(t, err)
+		fs.TestNew(nil)
+		_, err = fs.Add(mpath, "daeID")
+		tassert.CheckFatal(t, err)
+		fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}, true)
+		fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{}, true)
+	})
+	config := cmn.GCO.BeginUpdate()
+	config.Backend.Conf = map[string]any{provider: cmn.BackendConfCloud{Multipart: mpconf}}
+	config.Backend.Providers = map[string]cmn.Ns{provider: cmn.NsGlobal}
+	cmn.GCO.CommitUpdate(config)
+
+	bck := meta.NewBck(mptTestBucket, provider, cmn.NsGlobal, &cmn.Bprops{Cksum: cmn.CksumConf{Type: cos.ChecksumNone}})
+	return coremock.NewTarget(coremock.NewBaseBownerMock(bck))
+}
+
+func mptTestLOM(t *testing.T, provider, objName string, size int64) *core.LOM {
+	lom := core.AllocLOM(objName)
+	tassert.CheckFatal(t, lom.InitBck(&cmn.Bck{Name: mptTestBucket, Provider: provider, Ns: cmn.NsGlobal}))
+	lom.SetSize(size)
+	t.Cleanup(func() { core.FreeLOM(lom) })
+	return lom
+}
+
+func mptTestData(size int) []byte {
+	b := make([]byte, size)
+	_, _ = cryptorand.Read(b)
+	return b
+}
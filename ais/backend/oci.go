@@ -110,7 +110,7 @@ func NewOCI(t core.TargetPut, tstats stats.Tracker, startingUp bool) (core.Backe
 
 	bp.base.init(t.Snode(), tstats, startingUp)
 
-	return bp, nil
+	return newThrottled(bp, t, tstats), nil
 }
 
 // fetchCliConfig populates bp with configurationProvider and compartmentOCID fetched
@@ -0,0 +1,259 @@
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"context"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// Client-side rate limiting and adaptive backoff for cloud backends (see cmn/ratelim.go):
+// - one adaptive token bucket (cos.AdaptRateLim) per provider and, when the bucket
+//   configures its own limit, one more per bucket; requests must pass both
+// - configured limits are cluster-wide and get divided by the number of active targets
+// - throttled requests (429, 503 SlowDown) are retried with jittered exponential backoff
+//   while the request rate adapts (see cos.AdaptRateLim)
+// - PUT is retried only when the payload can be reopened (cos.ReadOpenCloser)
+// - GET bytes are charged post-factum, upon successful completion
+// - total time spent waiting is reported as <provider>.throttle.ns.total
+// - S3 multipart upload API (awsmpt.go, azuremp.go) gets throttled as well (see throttleDo)
+
+const (
+	backoffBase = 100 * time.Millisecond
+	backoffMax  = 10 * time.Second
+)
+
+type throttled struct {
+	core.Backend
+	t      core.TargetPut
+	tstats stats.Tracker
+	plim   *cos.AdaptRateLim // provider-level
+	lims   sync.Map          // bucket (cname) => *cos.AdaptRateLim
+}
+
+// throttled backends by provider
+var throttles sync.Map
+
+// interface guard
+var _ core.Backend = (*throttled)(nil)
+
+func newThrottled(bp core.Backend, t core.TargetPut, tstats stats.Tracker) core.Backend {
+	tb := &throttled{Backend: bp, t: t, tstats: tstats, plim: cos.NewAdaptRateLim(0, 0, mono.NanoTime())}
+	throttles.Store(bp.Provider(), tb)
+	return tb
+}
+
+// execute package-level backend call (e.g., upload part) under the provider's rate limit
+func throttleDo(ctx context.Context, bck *meta.Bck, nbytes int64, retry bool, cb func() (int, error)) (int, error) {
+	v, ok := throttles.Load(bck.RemoteBck().Provider)
+	if !ok {
+		return cb()
+	}
+	return v.(*throttled).do(ctx, bck, nbytes, nil, retry, cb)
+}
+
+func isThrottled(ecode int) bool {
+	return ecode == http.StatusTooManyRequests || ecode == http.StatusServiceUnavailable
+}
+
+// provider-level limiter and, if configured, bucket-level one
+func (tb *throttled) limiters(bck *meta.Bck, now int64) (plim, blim *cos.AdaptRateLim, retries int) {
+	var (
+		config   = cmn.GCO.Get()
+		prl, brl = config.Backend.RateLimit(tb.Provider(), bck.Props)
+		nat      = 1
+	)
+	if smap := tb.t.Sowner().Get(); smap != nil {
+		nat = max(smap.CountActiveTs(), 1)
+	}
+	plim = tb.plim
+	plim.Set(float64(prl.OpsPerSec)/float64(nat), float64(prl.BytesPerSec)/float64(nat))
+	if !brl.IsSet() {
+		return plim, nil, prl.MaxRetries
+	}
+	var (
+		ops = float64(brl.OpsPerSec) / float64(nat)
+		bps = float64(brl.BytesPerSec) / float64(nat)
+		key = bck.Cname("")
+	)
+	if v, ok := tb.lims.Load(key); ok {
+		blim = v.(*cos.AdaptRateLim)
+		blim.Set(ops, bps)
+		return plim, blim, prl.MaxRetries
+	}
+	v, _ := tb.lims.LoadOrStore(key, cos.NewAdaptRateLim(ops, bps, now))
+	return plim, v.(*cos.AdaptRateLim), prl.MaxRetries
+}
+
+// execute `cb` under rate limit; upon throttling, retry (at most `MaxRetries` times);
+// when non-nil, `post` is the number of bytes to charge upon success
+func (tb *throttled) do(ctx context.Context, bck *meta.Bck, nbytes int64, post *int64, retry bool,
+	cb func() (int, error)) (ecode int, err error) {
+	var (
+		now                 = mono.NanoTime()
+		plim, blim, retries = tb.limiters(bck, now)
+		waited, tcnt        int64
+		backoff             = backoffBase
+		errCtx, errCb       error
+	)
+	for i := 0; ; i++ {
+		wait := plim.Reserve(now, nbytes)
+		if blim != nil {
+			wait = max(wait, blim.Reserve(now, nbytes))
+		}
+		if wait > 0 {
+			if errCtx = sleepCtx(ctx, wait); errCtx != nil {
+				break
+			}
+			waited += int64(wait)
+		}
+		ecode, errCb = cb()
+		if errCb == nil || !isThrottled(ecode) {
+			break
+		}
+		now = mono.NanoTime()
+		plim.OnThrottle(now)
+		if blim != nil {
+			blim.OnThrottle(now)
+		}
+		tcnt++
+		if !retry || i >= retries {
+			break
+		}
+		// jittered exponential backoff
+		d := backoff/2 + rand.N(backoff/2+1)
+		if errCtx = sleepCtx(ctx, d); errCtx != nil {
+			break
+		}
+		waited += int64(d)
+		backoff = min(backoff*2, backoffMax)
+		now = mono.NanoTime()
+		if cmn.Rom.FastV(4, cos.SmoduleBackend) {
+			nlog.Infoln("retrying throttled", tb.Provider(), bck.Cname(""), "[", ecode, errCb, i+1, "]")
+		}
+	}
+	if errCb == nil && errCtx == nil && post != nil && *post > 0 {
+		now = mono.NanoTime()
+		plim.Charge(now, *post)
+		if blim != nil {
+			blim.Charge(now, *post)
+		}
+	}
+	tb.stats(bck, tcnt, waited)
+	if errCtx != nil && errCb == nil {
+		return 0, errCtx
+	}
+	return ecode, errCb
+}
+
+func (tb *throttled) stats(bck *meta.Bck, tcnt, waited int64) {
+	if tcnt == 0 && waited == 0 {
+		return
+	}
+	vlabs := map[string]string{stats.VarlabBucket: bck.Cname("")}
+	tb.tstats.AddWith(
+		cos.NamedVal64{Name: tb.MetricName(stats.ThrottleCount), Value: tcnt, VarLabs: vlabs},
+		cos.NamedVal64{Name: tb.MetricName(stats.ThrottleLatencyTotal), Value: waited, VarLabs: vlabs},
+	)
+}
+
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	if ctx == nil {
+		time.Sleep(d)
+		return nil
+	}
+	timer := time.NewTimer(d)
+	select {
+	case <-ctx.Done():
+		timer.Stop()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// as core.Backend --------------------------------------------------------------
+
+func (tb *throttled) ListObjects(bck *meta.Bck, msg *apc.LsoMsg, lst *cmn.LsoRes) (ecode int, err error) {
+	return tb.do(context.Background(), bck, 0, nil, true, func() (int, error) {
+		return tb.Backend.ListObjects(bck, msg, lst)
+	})
+}
+
+func (tb *throttled) HeadBucket(ctx context.Context, bck *meta.Bck) (bckProps cos.StrKVs, ecode int, err error) {
+	ecode, err = tb.do(ctx, bck, 0, nil, true, func() (code int, e error) {
+		bckProps, code, e = tb.Backend.HeadBucket(ctx, bck)
+		return code, e
+	})
+	return bckProps, ecode, err
+}
+
+func (tb *throttled) HeadObj(ctx context.Context, lom *core.LOM, oreq *http.Request) (oa *cmn.ObjAttrs, ecode int, err error) {
+	ecode, err = tb.do(ctx, lom.Bck(), 0, nil, true, func() (code int, e error) {
+		oa, code, e = tb.Backend.HeadObj(ctx, lom, oreq)
+		return code, e
+	})
+	return oa, ecode, err
+}
+
+func (tb *throttled) GetObj(ctx context.Context, lom *core.LOM, owt cmn.OWT, oreq *http.Request) (int, error) {
+	var size int64
+	return tb.do(ctx, lom.Bck(), 0, &size, true, func() (ecode int, err error) {
+		ecode, err = tb.Backend.GetObj(ctx, lom, owt, oreq)
+		size = lom.Lsize(true)
+		return ecode, err
+	})
+}
+
+func (tb *throttled) GetObjReader(ctx context.Context, lom *core.LOM, offset, length int64) (res core.GetReaderResult) {
+	var size int64
+	ecode, err := tb.do(ctx, lom.Bck(), 0, &size, true, func() (int, error) {
+		res = tb.Backend.GetObjReader(ctx, lom, offset, length)
+		size = res.Size
+		return res.ErrCode, res.Err
+	})
+	if err != nil {
+		res.Err, res.ErrCode = err, ecode
+	}
+	return res
+}
+
+func (tb *throttled) PutObj(r io.ReadCloser, lom *core.LOM, oreq *http.Request) (int, error) {
+	var (
+		roc, retry = r.(cos.ReadOpenCloser)
+		reader     = r
+		first      = true
+	)
+	return tb.do(context.Background(), lom.Bck(), lom.Lsize(true), nil, retry, func() (int, error) {
+		if !first {
+			rr, err := roc.Open()
+			if err != nil {
+				return 0, err
+			}
+			reader = rr
+		}
+		first = false
+		return tb.Backend.PutObj(reader, lom, oreq)
+	})
+}
+
+func (tb *throttled) DeleteObj(lom *core.LOM) (int, error) {
+	return tb.do(context.Background(), lom.Bck(), 0, nil, true, func() (int, error) {
+		return tb.Backend.DeleteObj(lom)
+	})
+}
@@ -0,0 +1,73 @@
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	coremock "github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+type (
+	thrBackend struct {
+		core.Backend
+		provider string
+	}
+	thrSowner struct{}
+)
+
+func (be *thrBackend) Provider() string { return be.provider }
+
+func (*thrSowner) Get() *meta.Smap               { return &meta.Smap{} }
+func (*thrSowner) Listeners() meta.SmapListeners { return nil }
+
+func TestThrottleLimiters(t *testing.T) {
+	config := cmn.GCO.BeginUpdate()
+	config.Backend.Conf = map[string]any{apc.AWS: cmn.BackendConfCloud{RateLimit: &cmn.RateLimitConf{OpsPerSec: 1}}}
+	cmn.GCO.CommitUpdate(config)
+
+	var (
+		b1 = meta.NewBck("b1", apc.AWS, cmn.NsGlobal, &cmn.Bprops{})
+		b2 = meta.NewBck("b2", apc.AWS, cmn.NsGlobal, &cmn.Bprops{RateLimit: cmn.RateLimitConf{OpsPerSec: 5}})
+		b3 = meta.NewBck("b3", apc.AIS, cmn.NsGlobal, &cmn.Bprops{
+			BackendBck: *b2.Bucket(),
+			RateLimit:  cmn.RateLimitConf{OpsPerSec: 7},
+		})
+		tgt = coremock.NewTarget(coremock.NewBaseBownerMock(b1, b2, b3))
+	)
+	tgt.SO = &thrSowner{}
+	tb := newThrottled(&thrBackend{provider: apc.AWS}, tgt, coremock.NewStatsTracker()).(*throttled)
+
+	// provider-level limiter is shared by all buckets
+	plim, blim, _ := tb.limiters(b1, 0)
+	tassert.Errorf(t, plim == tb.plim && blim == nil, "expected provider-level limiter only")
+	plim2, blim2, _ := tb.limiters(b2, 0)
+	tassert.Errorf(t, plim2 == plim, "expected shared provider-level limiter")
+	tassert.Fatalf(t, blim2 != nil && blim2.Ops() == 5, "expected bucket-level limiter (5 ops/s)")
+
+	// bucket-level limiters are keyed by the bucket that configures them
+	// (not by its remote backend)
+	_, blim3, _ := tb.limiters(b3, 0)
+	tassert.Fatalf(t, blim3 != nil && blim3 != blim2 && blim3.Ops() == 7, "expected separate bucket-level limiter (7 ops/s)")
+
+	// the first request consumes the provider's budget; the second - in another bucket - must wait
+	var calls int
+	cb := func() (int, error) { calls++; return 0, nil }
+	_, err := tb.do(context.Background(), b1, 0, nil, true, cb)
+	tassert.CheckFatal(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = tb.do(ctx, b2, 0, nil, true, cb)
+	tassert.Errorf(t, errors.Is(err, context.DeadlineExceeded), "expected deadline exceeded, got %v", err)
+	tassert.Errorf(t, calls == 1, "expected a single call, got %d", calls)
+}
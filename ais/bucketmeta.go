@@ -202,6 +202,30 @@ func (m *bucketMD) clone() *bucketMD {
 	return dst
 }
 
+// (served to clients) copy of the BMD with webhook tokens hidden - see Bprops.Redacted
+func (m *bucketMD) redacted() *bucketMD {
+	var found bool
+	for _, namespaces := range m.Providers {
+		for _, buckets := range namespaces {
+			for _, p := range buckets {
+				found = found || (p.Events != nil && p.Events.Token != "")
+			}
+		}
+	}
+	if !found {
+		return m
+	}
+	dst := m.clone()
+	for _, namespaces := range dst.Providers {
+		for _, buckets := range namespaces {
+			for name, p := range buckets {
+				buckets[name] = p.Redacted()
+			}
+		}
+	}
+	return dst
+}
+
 func (m *bucketMD) validateUUID(nbmd *bucketMD, si, nsi *meta.Snode, caller string) (err error) {
 	if nbmd == nil || nbmd.Version == 0 || m.Version == 0 {
 		return
@@ -533,6 +557,7 @@ func mergeRemoteBckProps(props *cmn.Bprops, header http.Header) *cmn.Bprops {
 		props.Extra.AWS.CloudRegion = header.Get(apc.HdrS3Region)
 		props.Extra.AWS.Endpoint = header.Get(apc.HdrS3Endpoint)
 		props.Extra.AWS.Profile = header.Get(apc.HdrS3Profile)
+		props.Extra.AWS.BackendProfile = header.Get(apc.HdrS3BackendProfile)
 	case apc.HT:
 		props.Extra.HTTP.OrigURLBck = header.Get(apc.HdrOrigURLBck)
 	}
@@ -60,6 +60,25 @@ var _ = Describe("BMD marshal and unmarshal", func() {
 		}
 	})
 
+	It("should hide webhook tokens from clients", func() {
+		Expect(bmd.redacted()).To(BeIdenticalTo(bmd)) // (nothing to hide)
+
+		bck := meta.NewBck("bucket_1", apc.AWS, cmn.NsGlobal)
+		clone := bmd.clone()
+		props, present := clone.Get(bck)
+		Expect(present).To(BeTrue())
+		props.Events = &cmn.EventsConf{Source: cmn.EventsWebhook, Token: "secret"}
+
+		redacted := clone.redacted()
+		rprops, _ := redacted.Get(bck)
+		Expect(rprops.Events.Token).To(Equal(cmn.EventsTokenRedacted))
+		Expect(string(cos.MustMarshal(redacted))).NotTo(ContainSubstring("secret"))
+
+		// (the original remains intact)
+		props, _ = clone.Get(bck)
+		Expect(props.Events.Token).To(Equal("secret"))
+	})
+
 	for _, node := range []string{apc.Target, apc.Proxy} {
 		makeBMDOwner := func() bmdOwner {
 			var bowner bmdOwner
@@ -38,6 +38,7 @@ type dpq struct {
 	fltPresence string // QparamFltPresence
 	etlName     string // QparamETLName
 	binfo       string // bucket info, with or without requirement to summarize remote obj-s
+	objVer      string // QparamObjVersion (or, same, S3 versionId)
 
 	skipVC        bool // QparamSkipVC (skip loading existing object's metadata)
 	isGFN         bool // QparamIsGFNRequest
@@ -151,6 +152,8 @@ func (dpq *dpq) parse(rawQuery string) (err error) {
 			dpq.silent = cos.IsParseBool(value)
 		case apc.QparamLatestVer:
 			dpq.latestVer = cos.IsParseBool(value)
+		case apc.QparamObjVersion, s3.QparamVersionID:
+			dpq.objVer = value
 
 		default: // the key must be known or `_except`-ed
 			if strings.HasPrefix(key, s3.HeaderPrefix) {
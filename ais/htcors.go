@@ -0,0 +1,116 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// CORS: per-bucket rules (cmn.CORSConf) that both proxies and targets apply
+// to /s3 and /v1/objects requests carrying "Origin" header:
+// - preflight (OPTIONS) requests get fully handled here
+// - all other requests get Access-Control-* response headers if (and only if)
+//   there's a matching rule
+// Requests without "Origin" are not CORS requests - nothing to do.
+
+// returns true when the request has been handled (preflight)
+func (h *htrun) corsHandler(w http.ResponseWriter, r *http.Request, isS3 bool) bool {
+	origin := r.Header.Get(cos.HdrOrigin)
+	if origin == "" {
+		return false
+	}
+	bck := h.corsBck(r, isS3)
+	if r.Method == http.MethodOptions {
+		corsPreflight(w, r, bck, origin)
+		return true
+	}
+	if bck == nil {
+		return false
+	}
+	rule := bck.Props.CORS.Match(origin, r.Method, nil)
+	if rule == nil {
+		return false
+	}
+	hdr := w.Header()
+	corsAllowOrigin(hdr, rule, origin)
+	if len(rule.ExposeHeaders) > 0 {
+		hdr.Set(cos.HdrACExposeHeaders, strings.Join(rule.ExposeHeaders, ", "))
+	}
+	return false
+}
+
+// bucket from the URL path: /s3/<bucket>[/<object>] or /v1/objects/<bucket>/<object>
+func (h *htrun) corsBck(r *http.Request, isS3 bool) (bck *meta.Bck) {
+	var (
+		err   error
+		items []string
+	)
+	if isS3 {
+		items, err = cmn.ParseURL(r.URL.Path, apc.URLPathS3.L, 1, true)
+		if err != nil {
+			return nil
+		}
+		bck, err, _ = meta.InitByNameOnly(items[0], h.owner.bmd)
+	} else {
+		items, err = cmn.ParseURL(r.URL.Path, apc.URLPathObjects.L, 1, true)
+		if err != nil {
+			return nil
+		}
+		bck, err = newBckFromQ(items[0], r.URL.Query(), nil)
+		if err == nil {
+			err = bck.Init(h.owner.bmd)
+		}
+	}
+	if err != nil || bck.Props.CORS.IsEmpty() {
+		return nil
+	}
+	return bck
+}
+
+func corsPreflight(w http.ResponseWriter, r *http.Request, bck *meta.Bck, origin string) {
+	method := r.Header.Get(cos.HdrACRequestMethod)
+	if method == "" || bck == nil {
+		cmn.WriteErrMsg(w, r, "CORS preflight: request not allowed", http.StatusForbidden)
+		return
+	}
+	var headers []string
+	if s := r.Header.Get(cos.HdrACRequestHeaders); s != "" {
+		headers = strings.Split(s, ",")
+		for i := range headers {
+			headers[i] = strings.TrimSpace(headers[i])
+		}
+	}
+	rule := bck.Props.CORS.Match(origin, method, headers)
+	if rule == nil {
+		cmn.WriteErrMsg(w, r, "CORS preflight: "+method+" from origin "+origin+" is not allowed", http.StatusForbidden)
+		return
+	}
+	hdr := w.Header()
+	corsAllowOrigin(hdr, rule, origin)
+	hdr.Set(cos.HdrACAllowMethods, strings.Join(rule.AllowedMethods, ", "))
+	if len(headers) > 0 {
+		hdr.Set(cos.HdrACAllowHeaders, strings.Join(headers, ", "))
+	}
+	if rule.MaxAgeSeconds > 0 {
+		hdr.Set(cos.HdrACMaxAge, strconv.Itoa(rule.MaxAgeSeconds))
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func corsAllowOrigin(hdr http.Header, rule *cmn.CORSRule, origin string) {
+	if rule.AnyOrigin() {
+		hdr.Set(cos.HdrACAllowOrigin, cmn.CORSAnyOrigin)
+		return
+	}
+	hdr.Set(cos.HdrACAllowOrigin, origin)
+	hdr.Add(cos.HdrVary, cos.HdrOrigin)
+}
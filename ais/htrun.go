@@ -1100,13 +1100,19 @@ func (h *htrun) httpdaeget(w http.ResponseWriter, r *http.Request, query url.Val
 	case apc.WhatSmap:
 		body = h.owner.smap.get()
 	case apc.WhatBMD:
-		body = h.owner.bmd.get()
+		bmd := h.owner.bmd.get()
+		if h.checkIntraCall(r.Header, false /*from primary*/) != nil {
+			bmd = bmd.redacted() // hide secrets from clients
+		}
+		body = bmd
 	case apc.WhatSmapVote:
-		var err error
-		body, err = h.cluMeta(cmetaFillOpt{htext: htext, skipPrimeTime: true})
+		cm, err := h.cluMeta(cmetaFillOpt{htext: htext, skipPrimeTime: true})
 		if err != nil {
 			nlog.Errorln("clu-meta failure:", err)
+		} else if cm.BMD != nil && h.checkIntraCall(r.Header, false /*from primary*/) != nil {
+			cm.BMD = cm.BMD.redacted()
 		}
+		body = cm
 	case apc.WhatSnode:
 		body = h.si
 	case apc.WhatLog:
@@ -212,6 +212,7 @@ func (p *proxy) Run() error {
 		{r: apc.Download, h: p.dloadHandler, net: accessNetPublic},
 		{r: apc.ETL, h: p.etlHandler, net: accessNetPublic},
 		{r: apc.Sort, h: p.dsortHandler, net: accessNetPublic},
+		{r: apc.Events, h: p.eventsHandler, net: accessNetPublic},
 
 		{r: apc.IC, h: p.ic.handler, net: accessNetIntraControl},
 		{r: apc.Daemon, h: p.daemonHandler, net: accessNetPublicControl},
@@ -424,6 +425,7 @@ func (p *proxy) _parseReqTry(w http.ResponseWriter, r *http.Request, bckArgs *bc
 		return
 	}
 	bckArgs.bck, bckArgs.query = apireq.bck, apireq.query
+	bckArgs.objName = apireq.items[1]
 	bck, err = bckArgs.initAndTry()
 	objName = apireq.items[1]
 
@@ -467,6 +469,9 @@ func (p *proxy) bucketHandler(w http.ResponseWriter, r *http.Request) {
 
 // verb /v1/objects/
 func (p *proxy) objectHandler(w http.ResponseWriter, r *http.Request) {
+	if p.corsHandler(w, r, false /*s3*/) {
+		return
+	}
 	switch r.Method {
 	case http.MethodGet:
 		p.httpobjget(w, r)
@@ -682,7 +687,7 @@ func (p *proxy) httpbckget(w http.ResponseWriter, r *http.Request, dpq *dpq) {
 		p.writeErr(w, r, err)
 		return
 	}
-	bckArgs := bctx{p: p, w: w, r: r, msg: msg, perms: apc.AceObjLIST, bck: bck, dpq: dpq}
+	bckArgs := bctx{p: p, w: w, r: r, msg: msg, perms: apc.AceObjLIST, bck: bck, dpq: dpq, objName: lsmsg.Prefix}
 	bckArgs.createAIS = false
 
 	if lsmsg.IsFlagSet(apc.LsBckPresent) {
@@ -718,6 +723,7 @@ func (p *proxy) httpobjget(w http.ResponseWriter, r *http.Request, origURLBck ..
 		bckArgs.r = r
 		bckArgs.bck = apireq.bck
 		bckArgs.dpq = apireq.dpq
+		bckArgs.objName = apireq.items[1]
 		bckArgs.perms = apc.AceGET
 		bckArgs.createAIS = false
 	}
@@ -800,13 +806,17 @@ func (p *proxy) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiRe
 		bckArgs.perms = perms
 		bckArgs.createAIS = false
 	}
-	bckArgs.bck, bckArgs.dpq = apireq.bck, apireq.dpq
+	bckArgs.bck, bckArgs.dpq, bckArgs.objName = apireq.bck, apireq.dpq, apireq.items[1]
 	bck, err := bckArgs.initAndTry()
 	freeBctx(bckArgs)
 	if err != nil {
 		return
 	}
 	vlabs[stats.VarlabBucket] = bck.Cname("")
+	if err := p.checkReplStamp(w, r); err != nil {
+		p.statsT.IncWith(errcnt, vlabs)
+		return
+	}
 
 	// 3. redirect
 	var (
@@ -872,6 +882,10 @@ func (p *proxy) httpobjdelete(w http.ResponseWriter, r *http.Request) {
 		p.writeErr(w, r, err)
 		return
 	}
+	if err := p.checkReplStamp(w, r); err != nil {
+		p.statsT.IncBck(stats.ErrDeleteCount, bck.Bucket())
+		return
+	}
 	smap := p.owner.smap.get()
 	tsi, err := smap.HrwName2T(bck.MakeUname(objName))
 	if err != nil {
@@ -971,6 +985,16 @@ func (p *proxy) httpbckdelete(w http.ResponseWriter, r *http.Request, apireq *ap
 				return
 			}
 		}
+		// bucket policy: per listed object or, given range or prefix, per selected objects
+		lrMsg := &apc.ListRange{}
+		if err := cos.MorphMarshal(msg.Value, lrMsg); err != nil {
+			p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+			return
+		}
+		if err := p.accessLR(r.Header, bck, perms, lrMsg); err != nil {
+			p.writeErr(w, r, err, aceErrToCode(err))
+			return
+		}
 		xid, err := p.listrange(r.Method, bck.Name, msg, apireq.query)
 		if err != nil {
 			p.writeErr(w, r, err)
@@ -2044,7 +2068,7 @@ func (p *proxy) httpbckhead(w http.ResponseWriter, r *http.Request, apireq *apiR
 func toHdr(w http.ResponseWriter, bck *meta.Bck, info *cmn.BsummResult, status int, xid string) {
 	hdr := w.Header()
 	if bck.Props != nil {
-		hdr.Set(apc.HdrBucketProps, cos.MustMarshalToString(bck.Props))
+		hdr.Set(apc.HdrBucketProps, cos.MustMarshalToString(bck.Props.Redacted()))
 	}
 	if info != nil {
 		hdr.Set(apc.HdrBucketSumm, cos.MustMarshalToString(info))
@@ -2115,6 +2139,24 @@ func (p *proxy) httpbckpatch(w http.ResponseWriter, r *http.Request, apireq *api
 			return
 		}
 	}
+	if nprops.Tiering.IsActive() {
+		// (error already written)
+		if err = bckArgs.initTiers(nprops.Tiering); err != nil {
+			return
+		}
+	}
+	if nprops.Replication.IsActive() {
+		// (ditto)
+		if err = bckArgs.initRemote(&nprops.Replication.Dst); err != nil {
+			return
+		}
+	}
+	if nprops.ETL.IsActive() {
+		if err = p.checkETLOnPut(nprops.ETL); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+	}
 	if xid, err = p.setBprops(msg, bck, nprops); err != nil {
 		p.writeErr(w, r, err)
 		return
@@ -2347,9 +2389,9 @@ func (p *proxy) lsObjsR(bck *meta.Bck, lsmsg *apc.LsoMsg, hdr http.Header, smap
 		timeout   = config.Client.ListObjTimeout.D()
 	)
 	if cos.IsParseBool(hdr.Get(apc.HdrInventory)) {
-		// TODO: extend to other Clouds or, more precisely, other list-objects supporting backends
-		if !bck.IsRemoteS3() {
-			return nil, cmn.NewErrUnsupp("list (via bucket inventory) non-S3 bucket", bck.Cname(""))
+		// supported: s3, gcp, and azure (see ais/backend/inventory.go)
+		if rbck := bck.RemoteBck(); rbck == nil || (rbck.Provider != apc.AWS && rbck.Provider != apc.GCP && rbck.Provider != apc.Azure) {
+			return nil, cmn.NewErrUnsupp("list (via bucket inventory)", bck.Cname(""))
 		}
 		if lsmsg.ContinuationToken == "" /*first page*/ {
 			// override _lsofc selection (see above)
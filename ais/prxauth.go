@@ -237,6 +237,10 @@ func (p *proxy) validateToken(hdr http.Header) (*tok.Token, error) {
 //	Exceptions:
 //	- read-only access to a bucket is always granted
 //	- PATCH cannot be forbidden
+//
+// In both cases, bucket policy (if defined) is evaluated as well: explicit deny always wins,
+// while allow may substitute for the user's (AuthN) permissions but not for bucket ACL -
+// see cmn/policy.go
 func (p *proxy) checkAccess(w http.ResponseWriter, r *http.Request, bck *meta.Bck, ace apc.AccessAttrs) (err error) {
 	if err = p.access(r.Header, bck, ace); err != nil {
 		p.writeErr(w, r, err, aceErrToCode(err))
@@ -244,6 +248,26 @@ func (p *proxy) checkAccess(w http.ResponseWriter, r *http.Request, bck *meta.Bc
 	return
 }
 
+// replicated PUT and DELETE (apc.HdrReplStamp) take part in conflict resolution
+// (see ais/tgtrepl.go) and are therefore accepted only from cluster members or
+// from peers authenticated as admin
+func (p *proxy) checkReplStamp(w http.ResponseWriter, r *http.Request) error {
+	if r.Header.Get(apc.HdrReplStamp) == "" || p.checkIntraCall(r.Header, false /*from primary*/) == nil {
+		return nil
+	}
+	err := tok.ErrNoToken
+	if cmn.Rom.AuthEnabled() {
+		var tk *tok.Token
+		if tk, err = p.validateToken(r.Header); err == nil && !tk.IsAdmin {
+			err = fmt.Errorf("replicated %s %s: admin token required", r.Method, r.URL.Path)
+		}
+	}
+	if err != nil {
+		p.writeErr(w, r, err, aceErrToCode(err))
+	}
+	return err
+}
+
 func aceErrToCode(err error) (status int) {
 	switch err {
 	case nil:
@@ -255,10 +279,34 @@ func aceErrToCode(err error) (status int) {
 	return status
 }
 
-func (p *proxy) access(hdr http.Header, bck *meta.Bck, ace apc.AccessAttrs) (err error) {
+// optional objName: object name(s) or, for bucket-level operations (e.g. list-objects), prefix
+func (p *proxy) access(hdr http.Header, bck *meta.Bck, ace apc.AccessAttrs, objName ...string) error {
+	return p._access(hdr, bck, ace, func(user string) (bool, error) {
+		return evalPolicy(bck, user, ace, objName)
+	})
+}
+
+// multi-object operation (e.g., delete or evict) on a list, range, or prefix;
+// range and prefix get evaluated against all objects they may select
+func (p *proxy) accessLR(hdr http.Header, bck *meta.Bck, ace apc.AccessAttrs, lrMsg *apc.ListRange) error {
+	if lrMsg.IsList() {
+		return p.access(hdr, bck, ace, lrMsg.ObjNames...)
+	}
+	pt, err := cos.NewParsedTemplate(lrMsg.Template)
+	if err != nil && err != cos.ErrEmptyTemplate {
+		return err
+	}
+	return p._access(hdr, bck, ace, func(user string) (bool, error) {
+		return evalPolicyPrefix(bck, user, ace, pt.Prefix)
+	})
+}
+
+func (p *proxy) _access(hdr http.Header, bck *meta.Bck, ace apc.AccessAttrs, eval func(string) (bool, error)) (err error) {
 	var (
-		tk     *tok.Token
-		bucket *cmn.Bck
+		tk      *tok.Token
+		bucket  *cmn.Bck
+		user    string
+		allowed bool
 	)
 	if p.checkIntraCall(hdr, false /*from primary*/) == nil {
 		return nil
@@ -266,12 +314,27 @@ func (p *proxy) access(hdr http.Header, bck *meta.Bck, ace apc.AccessAttrs) (err
 	if cmn.Rom.AuthEnabled() { // config.Auth.Enabled
 		tk, err = p.validateToken(hdr)
 		if err != nil {
+			if err != tok.ErrNoToken || bck == nil {
+				return err
+			}
 			// NOTE: making exception to allow 3rd party clients read remote ht://bucket
-			if err == tok.ErrNoToken && bck != nil && bck.IsHT() {
-				err = nil
+			if bck.IsHT() {
+				return nil
 			}
+			// otherwise, anonymous access may still be granted by bucket policy (below)
+		} else {
+			user = tk.UserID
+		}
+	}
+	if bck != nil {
+		if allowed, err = eval(user); err != nil {
 			return err
 		}
+	}
+	if cmn.Rom.AuthEnabled() && !allowed {
+		if tk == nil {
+			return tok.ErrNoToken
+		}
 		uid := p.owner.smap.Get().UUID
 		if bck != nil {
 			bucket = bck.Bucket()
@@ -290,7 +353,7 @@ func (p *proxy) access(hdr http.Header, bck *meta.Bck, ace apc.AccessAttrs) (err
 	// - with AuthN:    superuser can PATCH and change ACL
 	if !cmn.Rom.AuthEnabled() {
 		ace &^= (apc.AcePATCH | apc.AceBckSetACL | apc.AccessRO)
-	} else if tk.IsAdmin {
+	} else if tk != nil && tk.IsAdmin {
 		ace &^= (apc.AcePATCH | apc.AceBckSetACL)
 	}
 	if ace == 0 {
@@ -298,3 +361,41 @@ func (p *proxy) access(hdr http.Header, bck *meta.Bck, ace apc.AccessAttrs) (err
 	}
 	return bck.Allow(ace)
 }
+
+// returns allowed = true when bucket policy allows all requested permissions
+// (for all named objects, if any), and error when it explicitly denies any of them
+func evalPolicy(bck *meta.Bck, user string, ace apc.AccessAttrs, objName []string) (allowed bool, err error) {
+	policy := bck.Props.Policy
+	if policy == nil {
+		return false, nil
+	}
+	if len(objName) == 0 {
+		objName = []string{""}
+	}
+	allowed = true
+	for _, name := range objName {
+		allow, deny := policy.Eval(user, name)
+		if denied := ace & deny; denied != 0 {
+			op := apc.AccessOp(denied)
+			if name == "" {
+				return false, cmn.NewBucketAccessDenied(bck.String(), op, allow&^deny)
+			}
+			return false, cmn.NewObjectAccessDenied(bck.Cname(name), op, allow&^deny)
+		}
+		allowed = allowed && allow.Has(ace)
+	}
+	return allowed, nil
+}
+
+// ditto, for all objects that have the given prefix (see BucketPolicy.EvalPrefix)
+func evalPolicyPrefix(bck *meta.Bck, user string, ace apc.AccessAttrs, prefix string) (allowed bool, err error) {
+	policy := bck.Props.Policy
+	if policy == nil {
+		return false, nil
+	}
+	allow, deny := policy.EvalPrefix(user, prefix)
+	if denied := ace & deny; denied != 0 {
+		return false, cmn.NewObjectAccessDenied(bck.Cname(prefix+"*"), apc.AccessOp(denied), allow&^deny)
+	}
+	return allow.Has(ace), nil
+}
@@ -34,6 +34,7 @@ type bctx struct {
 	dpq   *dpq
 
 	origURLBck string
+	objName    string // (object-level access; see bucket policy)
 
 	reqBody []byte          // request body of original request
 	perms   apc.AccessAttrs // apc.AceGET, apc.AcePATCH etc.
@@ -176,7 +177,7 @@ rerr:
 
 // (compare w/ accessSupported)
 func (bctx *bctx) accessAllowed(bck *meta.Bck) (ecode int, err error) {
-	err = bctx.p.access(bctx.r.Header, bck, bctx.perms)
+	err = bctx.p.access(bctx.r.Header, bck, bctx.perms, bctx.objName)
 	ecode = aceErrToCode(err)
 	return ecode, err
 }
@@ -256,6 +257,27 @@ func (bctx *bctx) try() (bck *meta.Bck, err error) {
 	}
 }
 
+// multi-backend tiering: all tiers must exist and get added to BMD on the fly
+// (compare w/ setting `backend_bck` in httpbckpatch)
+func (bctx *bctx) initTiers(tiering *cmn.TieringConf) error {
+	for i := range tiering.Tiers {
+		if err := bctx.initRemote(&tiering.Tiers[i].Bck); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// add remote bucket (e.g., tier or replication destination) to BMD on the fly
+func (bctx *bctx) initRemote(bck *cmn.Bck) error {
+	args := *bctx // (same request)
+	args.bck = meta.CloneBck(bck)
+	args.perms, args.skipBackend, args.createAIS = 0, false, false
+	args.isPresent, args.exists, args.modified = false, false, false
+	_, err := args.initAndTry()
+	return err
+}
+
 //
 // methods that are internal to this source
 //
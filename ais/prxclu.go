@@ -1066,6 +1066,18 @@ func (p *proxy) cluputMsg(w http.ResponseWriter, r *http.Request) {
 			}
 			msg.Name = normp
 		}
+		if msg.Value != nil {
+			profile, ok := msg.Value.(string)
+			if !ok || msg.Name != apc.AWS {
+				p.writeErrf(w, r, "cannot reload %q creds: backend profile requires %q provider (got %q)",
+					msg.Value, apc.AWS, msg.Name)
+				return
+			}
+			if _, err := cmn.GCO.Get().Backend.Profile(apc.AWS, profile); err != nil {
+				p.writeErr(w, r, err)
+				return
+			}
+		}
 		p.reloadCreds(w, r, msg)
 
 	// internal
@@ -1394,6 +1406,9 @@ func (p *proxy) reloadCreds(w http.ResponseWriter, r *http.Request, msg *apc.Act
 	if msg.Name != "" {
 		tag = msg.Name + " " + tag
 	}
+	if msg.Value != nil {
+		tag = fmt.Sprintf("%s (profile %q)", tag, msg.Value)
+	}
 	for _, res := range results {
 		if res.err == nil {
 			continue
@@ -5,6 +5,7 @@
 package ais
 
 import (
+	"io"
 	"net/http"
 	"net/url"
 	"reflect"
@@ -99,12 +100,16 @@ func (p *proxy) httpetlput(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	b, err := cos.ReadAll(r.Body)
+	b, err := io.ReadAll(io.LimitReader(r.Body, etl.MaxInitMsgSize+1))
 	if err != nil {
 		p.writeErr(w, r, err)
 		return
 	}
 	r.Body.Close()
+	if len(b) > etl.MaxInitMsgSize {
+		p.writeErrf(w, r, "init ETL: message size exceeds %s", cos.ToSizeIEC(etl.MaxInitMsgSize, 0))
+		return
+	}
 
 	initMsg, err := etl.UnmarshalInitMsg(b)
 	if err != nil {
@@ -437,3 +442,19 @@ func (p *proxy) stopETL(w http.ResponseWriter, r *http.Request) {
 	}
 	freeBcastRes(results)
 }
+
+// on-PUT ETL (bucket property) must exist and be able to transform streams
+// (see cmn.ETLConf)
+func (p *proxy) checkETLOnPut(conf *cmn.ETLConf) error {
+	etlMD := p.owner.etl.get()
+	for _, name := range conf.Names() {
+		msg := etlMD.get(name)
+		if msg == nil {
+			return cos.NewErrNotFound(p, "etl job "+name)
+		}
+		if err := etl.CheckStream(msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/NVIDIA/aistore/ais/backend"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+)
+
+// remote bucket change notifications pushed to aistore (webhook), e.g.:
+// POST /v1/events/bucket-name?provider=azure (with apc.HdrEventsToken)
+// (see cmn/bckevents.go and ais/tgtevents.go)
+
+func (p *proxy) eventsHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodPost:
+		p.httpeventspost(w, r)
+	case http.MethodOptions:
+		// CloudEvents v1.0 webhook validation (abuse protection) handshake
+		if origin := r.Header.Get("WebHook-Request-Origin"); origin != "" {
+			w.Header().Set("WebHook-Allowed-Origin", origin)
+		}
+	default:
+		cmn.WriteErr405(w, r, http.MethodPost, http.MethodOptions)
+	}
+}
+
+func (p *proxy) httpeventspost(w http.ResponseWriter, r *http.Request) {
+	apireq := apiReqAlloc(1, apc.URLPathEvents.L, false)
+	defer apiReqFree(apireq)
+	if err := p.parseReq(w, r, apireq); err != nil {
+		return
+	}
+	bck := apireq.bck
+	if err := bck.Init(p.owner.bmd); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	conf := bck.Props.Events
+	if !conf.IsActive() || conf.Source != cmn.EventsWebhook {
+		p.writeErr(w, r, cmn.NewErrUnsupp("accept change notifications for", bck.Cname("")+" (webhook not configured)"))
+		return
+	}
+	token := r.Header.Get(apc.HdrEventsToken)
+	if conf.Token == "" || subtle.ConstantTimeCompare(cos.UnsafeB(token), cos.UnsafeB(conf.Token)) != 1 {
+		p.writeErr(w, r, errors.New("change notifications: invalid or missing token"), http.StatusUnauthorized)
+		return
+	}
+	body, err := cos.ReadAll(r.Body)
+	cos.Close(r.Body)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	events, code, err := backend.ParseEventGrid(body)
+	if err != nil {
+		p.writeErr(w, r, fmt.Errorf("%s: invalid change notification: %v", bck.Cname(""), err))
+		return
+	}
+	if code != "" {
+		nlog.Infoln(p.String(), bck.Cname(""), "validating Event Grid subscription")
+		p.writeJSON(w, r, map[string]string{"validationResponse": code}, "events-validation")
+		return
+	}
+	if len(events) == 0 {
+		return
+	}
+
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{
+		Method: http.MethodPut,
+		Path:   apc.URLPathEvents.Join(bck.Name),
+		Query:  bck.NewQuery(),
+		Body:   cos.MustMarshal(events),
+	}
+	args.to = core.Targets
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+	for _, res := range results {
+		if res.err != nil {
+			// (the sender will retry)
+			p.writeErr(w, r, res.errorf("%s: failed to deliver %d change notification(s) to %s", p, len(events), res.si))
+			break
+		}
+	}
+	freeBcastRes(results)
+}
@@ -9,6 +9,7 @@ import (
 	"encoding/xml"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"strings"
@@ -41,6 +42,9 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 
 	// TODO: Fix the hack, https://github.com/tensorflow/tensorflow/issues/41798
 	cos.ReparseQuery(r)
+	if p.corsHandler(w, r, true /*s3*/) {
+		return
+	}
 	apiItems, err := p.parseURL(w, r, apc.URLPathS3.L, 0, true)
 	if err != nil {
 		return
@@ -77,9 +81,42 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 			_, lifecycle = q[s3.QparamLifecycle]
 			_, policy    = q[s3.QparamPolicy]
 			_, cors      = q[s3.QparamCORS]
+			_, objlock   = q[s3.QparamObjectLock]
+			_, encrypt   = q[s3.QparamEncryption]
 			_, acl       = q[s3.QparamACL]
+			_, inventory = q[s3.QparamInventory]
 		)
-		if lifecycle || policy || cors || acl {
+		if lifecycle && len(apiItems) == 1 {
+			// perms: apc.AceBckHEAD
+			p.getBckLifecycleS3(w, r, apiItems[0])
+			return
+		}
+		if policy && len(apiItems) == 1 {
+			// perms: apc.AceBckHEAD
+			p.getBckPolicyS3(w, r, apiItems[0])
+			return
+		}
+		if cors && len(apiItems) == 1 {
+			// perms: apc.AceBckHEAD
+			p.getBckCORSS3(w, r, apiItems[0])
+			return
+		}
+		if objlock && len(apiItems) == 1 {
+			// perms: apc.AceBckHEAD
+			p.getBckObjLockS3(w, r, apiItems[0])
+			return
+		}
+		if encrypt && len(apiItems) == 1 {
+			// perms: apc.AceBckHEAD
+			p.getBckEncryptionS3(w, r, apiItems[0])
+			return
+		}
+		if inventory && len(apiItems) == 1 {
+			// perms: apc.AceBckHEAD
+			p.getBckInventoryS3(w, r, apiItems[0], q.Get(s3.QparamInvID))
+			return
+		}
+		if lifecycle || policy || cors || objlock || encrypt || acl || inventory {
 			p.unsupported(w, r, apiItems[0])
 			return
 		}
@@ -91,9 +128,15 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 				return
 			}
 			// perms: apc.AceObjLIST
+			// (ListObjectsV2 or, with `?versions`, ListObjectVersions)
 			p.listObjectsS3(w, r, apiItems[0], q)
 			return
 		}
+		if len(apiItems) > 1 && (q.Has(s3.QparamTagging) || q.Has(s3.QparamRetention) || q.Has(s3.QparamLegalHold)) {
+			// perms: apc.AceObjHEAD
+			p.objMetaS3(w, r, apiItems, apc.AceObjHEAD)
+			return
+		}
 		// object data otherwise
 		// perms: apc.AceGET
 		p.getObjS3(w, r, apiItems, q, listMultipart)
@@ -109,10 +152,49 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 				p.putBckVersioningS3(w, r, apiItems[0])
 				return
 			}
+			if _, lifecycle := q[s3.QparamLifecycle]; lifecycle {
+				// perms: apc.AcePATCH
+				p.putBckLifecycleS3(w, r, apiItems[0])
+				return
+			}
+			if _, policy := q[s3.QparamPolicy]; policy {
+				// perms: apc.AcePATCH
+				p.putBckPolicyS3(w, r, apiItems[0])
+				return
+			}
+			if _, cors := q[s3.QparamCORS]; cors {
+				// perms: apc.AcePATCH
+				p.putBckCORSS3(w, r, apiItems[0])
+				return
+			}
+			if _, objlock := q[s3.QparamObjectLock]; objlock {
+				// perms: apc.AcePATCH
+				p.putBckObjLockS3(w, r, apiItems[0])
+				return
+			}
+			if _, encrypt := q[s3.QparamEncryption]; encrypt {
+				// perms: apc.AcePATCH
+				p.putBckEncryptionS3(w, r, apiItems[0])
+				return
+			}
+			if _, inventory := q[s3.QparamInventory]; inventory {
+				// perms: apc.AcePATCH
+				p.putBckInventoryS3(w, r, apiItems[0], q.Get(s3.QparamInvID))
+				return
+			}
 			// perms: apc.AceCreateBucket
 			p.putBckS3(w, r, apiItems[0])
 			return
 		}
+		if q := r.URL.Query(); q.Has(s3.QparamTagging) || q.Has(s3.QparamRetention) || q.Has(s3.QparamLegalHold) {
+			// perms: apc.AceObjUpdate (and apc.AcePATCH to bypass governance retention)
+			ace := apc.AceObjUpdate
+			if bypassGovernanceS3(r) {
+				ace |= apc.AcePATCH
+			}
+			p.objMetaS3(w, r, apiItems, ace)
+			return
+		}
 		// perms: apc.AcePUT
 		p.putObjS3(w, r, apiItems)
 	case http.MethodPost:
@@ -121,6 +203,11 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 			p.handleMptUpload(w, r, apiItems)
 			return
 		}
+		if q.Has(s3.QparamSelect) && len(apiItems) > 1 {
+			// perms: apc.AceGET
+			p.getObjS3(w, r, apiItems, q, false /*list multipart*/)
+			return
+		}
 		if len(apiItems) != 1 {
 			s3.WriteErr(w, r, errS3Req, 0)
 			return
@@ -143,11 +230,41 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 				p.delMultipleObjs(w, r, apiItems[0])
 				return
 			}
+			if _, lifecycle := q[s3.QparamLifecycle]; lifecycle {
+				// perms: apc.AcePATCH
+				p.delBckLifecycleS3(w, r, apiItems[0])
+				return
+			}
+			if _, policy := q[s3.QparamPolicy]; policy {
+				// perms: apc.AcePATCH
+				p.delBckPolicyS3(w, r, apiItems[0])
+				return
+			}
+			if _, cors := q[s3.QparamCORS]; cors {
+				// perms: apc.AcePATCH
+				p.delBckCORSS3(w, r, apiItems[0])
+				return
+			}
+			if _, encrypt := q[s3.QparamEncryption]; encrypt {
+				// perms: apc.AcePATCH
+				p.delBckEncryptionS3(w, r, apiItems[0])
+				return
+			}
+			if _, inventory := q[s3.QparamInventory]; inventory {
+				// perms: apc.AcePATCH
+				p.delBckInventoryS3(w, r, apiItems[0], q.Get(s3.QparamInvID))
+				return
+			}
 			// perms: apc.AceDestroyBucket
 			p.delBckS3(w, r, apiItems[0])
 			return
 		}
-		// perms: apc.AceObjDELETE
+		if r.URL.Query().Has(s3.QparamTagging) {
+			// perms: apc.AceObjUpdate
+			p.objMetaS3(w, r, apiItems, apc.AceObjUpdate)
+			return
+		}
+		// perms: apc.AceObjDELETE (and apc.AcePATCH to bypass governance retention)
 		p.delObjS3(w, r, apiItems)
 	default:
 		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodGet, http.MethodHead,
@@ -227,11 +344,11 @@ func (p *proxy) handleMptUpload(w http.ResponseWriter, r *http.Request, parts []
 	if bck == nil {
 		return
 	}
-	if err := p.access(r.Header, bck, apc.AcePUT); err != nil {
+	objName := s3.ObjName(parts)
+	if err := p.access(r.Header, bck, apc.AcePUT, objName); err != nil {
 		s3.WriteErr(w, r, err, http.StatusForbidden)
 		return
 	}
-	objName := s3.ObjName(parts)
 	if err := cmn.ValidOname(objName); err != nil {
 		s3.WriteErr(w, r, err, 0)
 		return
@@ -255,10 +372,6 @@ func (p *proxy) delMultipleObjs(w http.ResponseWriter, r *http.Request, bucket s
 	if bck == nil {
 		return
 	}
-	if err := p.access(r.Header, bck, apc.AceObjDELETE); err != nil {
-		s3.WriteErr(w, r, err, http.StatusForbidden)
-		return
-	}
 	decoder := xml.NewDecoder(r.Body)
 	lst := &s3.Delete{}
 	if err := decoder.Decode(lst); err != nil {
@@ -266,6 +379,9 @@ func (p *proxy) delMultipleObjs(w http.ResponseWriter, r *http.Request, bucket s
 		return
 	}
 	if len(lst.Object) == 0 {
+		if err := p.access(r.Header, bck, apc.AceObjDELETE); err != nil {
+			s3.WriteErr(w, r, err, http.StatusForbidden)
+		}
 		return
 	}
 
@@ -276,6 +392,11 @@ func (p *proxy) delMultipleObjs(w http.ResponseWriter, r *http.Request, bucket s
 	for _, obj := range lst.Object {
 		lrMsg.ObjNames = append(lrMsg.ObjNames, obj.Key)
 	}
+	// (bucket policy: per object)
+	if err := p.access(r.Header, bck, apc.AceObjDELETE, lrMsg.ObjNames...); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
 	msg.Value = lrMsg
 
 	// marshal+unmarshal to convince `p.listrange` to treat `listMsg` as `map[string]interface`
@@ -341,7 +462,7 @@ func (p *proxy) listObjectsS3(w http.ResponseWriter, r *http.Request, bucket str
 	if bck == nil {
 		return
 	}
-	if err := p.access(r.Header, bck, apc.AceObjLIST); err != nil {
+	if err := p.access(r.Header, bck, apc.AceObjLIST, q.Get(s3.QparamPrefix)); err != nil {
 		s3.WriteErr(w, r, err, http.StatusForbidden)
 		return
 	}
@@ -371,6 +492,20 @@ func (p *proxy) listObjectsS3(w http.ResponseWriter, r *http.Request, bucket str
 	// - "fetch-owner"
 	// - "encoding-type"
 	s3.FillLsoMsg(q, lsmsg)
+	if err := cmn.ValidatePrefix("bad list-objects request", lsmsg.Prefix); err != nil {
+		s3.WriteErr(w, r, err, http.StatusBadRequest)
+		return
+	}
+
+	// ListObjectVersions (prior versions are retained only in ais:// buckets - see `versioning.retain`)
+	versions := q.Has(s3.QparamVersions)
+	if versions {
+		lsmsg.AddProps(apc.GetPropsVersion)
+		lsmsg.SetFlag(apc.LsVersions)
+		if marker := q.Get(s3.QparamKeyMarker); marker != "" {
+			lsmsg.StartAfter = marker
+		}
+	}
 
 	lst, err := p.lsAllPagesS3(bck, amsg, lsmsg, r.Header)
 	if cmn.Rom.FastV(5, cos.SmoduleS3) {
@@ -389,11 +524,17 @@ func (p *proxy) listObjectsS3(w http.ResponseWriter, r *http.Request, bucket str
 	// - the implication: if, when working with very large remote datasets, list-objects performance
 	//   becomes an issue - consider using native API.
 
-	resp := s3.NewListObjectResult(bucket)
-	resp.ContinuationToken = lsmsg.ContinuationToken
-	resp.FromLsoResult(lst, lsmsg)
 	sgl := p.gmm.NewSGL(0)
-	resp.MustMarshal(sgl)
+	if versions {
+		resp := s3.NewListVersionsResult(bucket, lsmsg)
+		resp.FromLsoResult(lst, lsmsg)
+		resp.MustMarshal(sgl)
+	} else {
+		resp := s3.NewListObjectResult(bucket)
+		resp.ContinuationToken = lsmsg.ContinuationToken
+		resp.FromLsoResult(lst, lsmsg)
+		resp.MustMarshal(sgl)
+	}
 	w.Header().Set(cos.HdrContentType, cos.ContentXML)
 	sgl.WriteTo2(w)
 	sgl.Free()
@@ -462,7 +603,8 @@ func (p *proxy) copyObjS3(w http.ResponseWriter, r *http.Request, items []string
 	if bckSrc == nil {
 		return
 	}
-	if err := p.access(r.Header, bckSrc, apc.AceGET); err != nil {
+	objName := strings.Trim(parts[1], "/")
+	if err := p.access(r.Header, bckSrc, apc.AceGET, objName); err != nil {
 		s3.WriteErr(w, r, err, http.StatusForbidden)
 		return
 	}
@@ -476,7 +618,6 @@ func (p *proxy) copyObjS3(w http.ResponseWriter, r *http.Request, items []string
 		return
 	}
 
-	objName := strings.Trim(parts[1], "/")
 	smap := p.owner.smap.get()
 	si, err := smap.HrwName2T(bckSrc.MakeUname(objName))
 	if err != nil {
@@ -498,7 +639,8 @@ func (p *proxy) directPutObjS3(w http.ResponseWriter, r *http.Request, items []s
 	if bck == nil {
 		return
 	}
-	if err := p.access(r.Header, bck, apc.AcePUT); err != nil {
+	objName := s3.ObjName(items)
+	if err := p.access(r.Header, bck, apc.AcePUT, objName); err != nil {
 		s3.WriteErr(w, r, err, http.StatusForbidden)
 		return
 	}
@@ -506,7 +648,6 @@ func (p *proxy) directPutObjS3(w http.ResponseWriter, r *http.Request, items []s
 		s3.WriteErr(w, r, errS3Obj, 0)
 		return
 	}
-	objName := s3.ObjName(items)
 	if err := cmn.ValidOname(objName); err != nil {
 		s3.WriteErr(w, r, err, 0)
 		return
@@ -533,7 +674,8 @@ func (p *proxy) getObjS3(w http.ResponseWriter, r *http.Request, items []string,
 	if bck == nil {
 		return
 	}
-	if err := p.access(r.Header, bck, apc.AceGET); err != nil {
+	objName := s3.ObjName(items)
+	if err := p.access(r.Header, bck, apc.AceGET, objName); err != nil {
 		s3.WriteErr(w, r, err, http.StatusForbidden)
 		return
 	}
@@ -545,7 +687,6 @@ func (p *proxy) getObjS3(w http.ResponseWriter, r *http.Request, items []string,
 		s3.WriteErr(w, r, errS3Obj, 0)
 		return
 	}
-	objName := s3.ObjName(items)
 	if err := cmn.ValidOname(objName); err != nil {
 		s3.WriteErr(w, r, err, 0)
 		return
@@ -623,11 +764,11 @@ func (p *proxy) headObjS3(w http.ResponseWriter, r *http.Request, items []string
 	if bck == nil {
 		return
 	}
-	if err := p.access(r.Header, bck, apc.AceObjHEAD); err != nil {
+	objName := s3.ObjName(items)
+	if err := p.access(r.Header, bck, apc.AceObjHEAD, objName); err != nil {
 		s3.WriteErr(w, r, err, http.StatusForbidden)
 		return
 	}
-	objName := s3.ObjName(items)
 	if err := cmn.ValidOname(objName); err != nil {
 		s3.WriteErr(w, r, err, 0)
 		return
@@ -655,11 +796,15 @@ func (p *proxy) delObjS3(w http.ResponseWriter, r *http.Request, items []string)
 	if bck == nil {
 		return
 	}
-	if err := p.access(r.Header, bck, apc.AceObjDELETE); err != nil {
+	objName := s3.ObjName(items)
+	ace := apc.AceObjDELETE
+	if bypassGovernanceS3(r) {
+		ace |= apc.AcePATCH
+	}
+	if err := p.access(r.Header, bck, ace, objName); err != nil {
 		s3.WriteErr(w, r, err, http.StatusForbidden)
 		return
 	}
-	objName := s3.ObjName(items)
 	if err := cmn.ValidOname(objName); err != nil {
 		s3.WriteErr(w, r, err, 0)
 		return
@@ -679,6 +824,36 @@ func (p *proxy) delObjS3(w http.ResponseWriter, r *http.Request, items []string)
 	p.s3Redirect(w, r, si, redirectURL, bck.Name)
 }
 
+// GET|PUT|DELETE /s3/<bucket-name>/<object-name>?tagging
+// GET|PUT /s3/<bucket-name>/<object-name>?retention|legal-hold
+func (p *proxy) objMetaS3(w http.ResponseWriter, r *http.Request, items []string, ace apc.AccessAttrs) {
+	bck := p.initByNameOnly(w, r, items[0] /*bucket*/)
+	if bck == nil {
+		return
+	}
+	objName := s3.ObjName(items)
+	if err := p.access(r.Header, bck, ace, objName); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	if err := cmn.ValidOname(objName); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	smap := p.owner.smap.get()
+	si, err := smap.HrwName2T(bck.MakeUname(objName))
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	if cmn.Rom.FastV(5, cos.SmoduleS3) {
+		nlog.Infoln(r.Method, r.URL.RawQuery, bck.Cname(objName), "=>", si.StringEx())
+	}
+	started := time.Now()
+	redirectURL := p.redirectURL(r, si, started, cmn.NetIntraControl)
+	p.s3Redirect(w, r, si, redirectURL, bck.Name)
+}
+
 // GET /s3/<bucket-name>?versioning
 func (p *proxy) getBckVersioningS3(w http.ResponseWriter, r *http.Request, bucket string) {
 	bck := p.initByNameOnly(w, r, bucket)
@@ -693,7 +868,7 @@ func (p *proxy) getBckVersioningS3(w http.ResponseWriter, r *http.Request, bucke
 	sgl.Free()
 }
 
-// GET /s3/<bucket-name>?lifecycle|cors|policy|acl
+// GET /s3/<bucket-name>?acl
 func (p *proxy) unsupported(w http.ResponseWriter, r *http.Request, bucket string) {
 	if _, err, ecode := meta.InitByNameOnly(bucket, p.owner.bmd); err != nil {
 		s3.WriteErr(w, r, err, ecode)
@@ -733,10 +908,501 @@ func (p *proxy) putBckVersioningS3(w http.ResponseWriter, r *http.Request, bucke
 	}
 }
 
+// GET /s3/<bucket-name>?lifecycle
+func (p *proxy) getBckLifecycleS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Header, bck, apc.AceBckHEAD); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	if bck.Props.Lifecycle.IsEmpty() {
+		err := s3.NewErrCode("NoSuchLifecycleConfiguration", "the lifecycle configuration does not exist: "+bck.Cname(""))
+		s3.WriteErr(w, r, err, http.StatusNotFound)
+		return
+	}
+	resp := s3.NewLifecycleConfiguration(bck.Props.Lifecycle)
+	sgl := p.gmm.NewSGL(0)
+	resp.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo2(w)
+	sgl.Free()
+}
+
+// PUT /s3/<bucket-name>?lifecycle
+func (p *proxy) putBckLifecycleS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Header, bck, apc.AcePATCH); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	decoder := xml.NewDecoder(r.Body)
+	lconf := &s3.LifecycleConfiguration{}
+	if err := decoder.Decode(lconf); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	conf, err := lconf.ToConf()
+	if err != nil {
+		s3.WriteErr(w, r, s3.NewErrCode("MalformedXML", err.Error()), 0)
+		return
+	}
+	nprops := bck.Props.Clone()
+	nprops.Lifecycle = conf
+	p.setBpropsS3(w, r, msg, bck, nprops)
+}
+
+// DELETE /s3/<bucket-name>?lifecycle
+func (p *proxy) delBckLifecycleS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Header, bck, apc.AcePATCH); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	if bck.Props.Lifecycle == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	nprops := bck.Props.Clone()
+	nprops.Lifecycle = nil
+	if p.setBpropsS3(w, r, msg, bck, nprops) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// GET /s3/<bucket-name>?policy
+func (p *proxy) getBckPolicyS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Header, bck, apc.AceBckHEAD); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	if bck.Props.Policy == nil {
+		err := s3.NewErrCode("NoSuchBucketPolicy", "the bucket policy does not exist: "+bck.Cname(""))
+		s3.WriteErr(w, r, err, http.StatusNotFound)
+		return
+	}
+	w.Header().Set(cos.HdrContentType, cos.ContentJSON)
+	w.Write(cos.UnsafeB(bck.Props.Policy.Doc))
+}
+
+// PUT /s3/<bucket-name>?policy
+func (p *proxy) putBckPolicyS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Header, bck, apc.AcePATCH); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	doc, err := io.ReadAll(io.LimitReader(r.Body, cmn.MaxPolicyDocSize+1))
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	policy, err := s3.ParsePolicy(bck.Name, doc)
+	if err != nil {
+		s3.WriteErr(w, r, s3.NewErrCode("MalformedPolicy", err.Error()), 0)
+		return
+	}
+	nprops := bck.Props.Clone()
+	nprops.Policy = policy
+	if p.setBpropsS3(w, r, msg, bck, nprops) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// DELETE /s3/<bucket-name>?policy
+func (p *proxy) delBckPolicyS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Header, bck, apc.AcePATCH); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	if bck.Props.Policy == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	nprops := bck.Props.Clone()
+	nprops.Policy = nil
+	if p.setBpropsS3(w, r, msg, bck, nprops) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// GET /s3/<bucket-name>?cors
+func (p *proxy) getBckCORSS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Header, bck, apc.AceBckHEAD); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	if bck.Props.CORS.IsEmpty() {
+		err := s3.NewErrCode("NoSuchCORSConfiguration", "the CORS configuration does not exist: "+bck.Cname(""))
+		s3.WriteErr(w, r, err, http.StatusNotFound)
+		return
+	}
+	resp := s3.NewCORSConfiguration(bck.Props.CORS)
+	sgl := p.gmm.NewSGL(0)
+	resp.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo2(w)
+	sgl.Free()
+}
+
+// PUT /s3/<bucket-name>?cors
+func (p *proxy) putBckCORSS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Header, bck, apc.AcePATCH); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	decoder := xml.NewDecoder(r.Body)
+	cconf := &s3.CORSConfiguration{}
+	if err := decoder.Decode(cconf); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	conf, err := cconf.ToConf()
+	if err != nil {
+		s3.WriteErr(w, r, s3.NewErrCode("MalformedXML", err.Error()), 0)
+		return
+	}
+	nprops := bck.Props.Clone()
+	nprops.CORS = conf
+	p.setBpropsS3(w, r, msg, bck, nprops)
+}
+
+// DELETE /s3/<bucket-name>?cors
+func (p *proxy) delBckCORSS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Header, bck, apc.AcePATCH); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	if bck.Props.CORS == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	nprops := bck.Props.Clone()
+	nprops.CORS = nil
+	if p.setBpropsS3(w, r, msg, bck, nprops) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// GET /s3/<bucket-name>?object-lock
+func (p *proxy) getBckObjLockS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Header, bck, apc.AceBckHEAD); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	if bck.Props.ObjLock == nil {
+		err := s3.NewErrCode("ObjectLockConfigurationNotFoundError", "object lock configuration does not exist: "+bck.Cname(""))
+		s3.WriteErr(w, r, err, http.StatusNotFound)
+		return
+	}
+	resp := s3.NewObjectLockConfiguration(bck.Props.ObjLock)
+	sgl := p.gmm.NewSGL(0)
+	resp.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo2(w)
+	sgl.Free()
+}
+
+// PUT /s3/<bucket-name>?object-lock
+// (once enabled, object lock cannot be disabled - see p.setBprops)
+func (p *proxy) putBckObjLockS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Header, bck, apc.AcePATCH); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	decoder := xml.NewDecoder(r.Body)
+	lconf := &s3.ObjectLockConfiguration{}
+	if err := decoder.Decode(lconf); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	conf, err := lconf.ToConf()
+	if err != nil {
+		s3.WriteErr(w, r, s3.NewErrCode("MalformedXML", err.Error()), 0)
+		return
+	}
+	nprops := bck.Props.Clone()
+	nprops.ObjLock = conf
+	p.setBpropsS3(w, r, msg, bck, nprops)
+}
+
+// GET /s3/<bucket-name>?encryption
+func (p *proxy) getBckEncryptionS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Header, bck, apc.AceBckHEAD); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	if bck.Props.SSE == nil {
+		err := s3.NewErrCode("ServerSideEncryptionConfigurationNotFoundError",
+			"the server side encryption configuration was not found: "+bck.Cname(""))
+		s3.WriteErr(w, r, err, http.StatusNotFound)
+		return
+	}
+	resp := s3.NewSSEConfiguration(bck.Props.SSE)
+	sgl := p.gmm.NewSGL(0)
+	resp.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo2(w)
+	sgl.Free()
+}
+
+// PUT /s3/<bucket-name>?encryption
+func (p *proxy) putBckEncryptionS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Header, bck, apc.AcePATCH); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	decoder := xml.NewDecoder(r.Body)
+	econf := &s3.ServerSideEncryptionConfiguration{}
+	if err := decoder.Decode(econf); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	conf, err := econf.ToConf()
+	if err != nil {
+		s3.WriteErr(w, r, s3.NewErrCode("MalformedXML", err.Error()), 0)
+		return
+	}
+	nprops := bck.Props.Clone()
+	nprops.SSE = conf
+	p.setBpropsS3(w, r, msg, bck, nprops)
+}
+
+// DELETE /s3/<bucket-name>?encryption
+// (existing objects remain encrypted)
+func (p *proxy) delBckEncryptionS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Header, bck, apc.AcePATCH); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	if bck.Props.SSE == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	nprops := bck.Props.Clone()
+	nprops.SSE = nil
+	if p.setBpropsS3(w, r, msg, bck, nprops) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// GET /s3/<bucket-name>?inventory[&id=<id>]
+// (without ID: list inventory configurations)
+func (p *proxy) getBckInventoryS3(w http.ResponseWriter, r *http.Request, bucket, id string) {
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Header, bck, apc.AceBckHEAD); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	var (
+		conf = bck.Props.Inventory
+		sgl  = p.gmm.NewSGL(0)
+	)
+	if id == "" {
+		resp := &s3.ListInventoryConfigurationsResult{}
+		if conf != nil {
+			resp.Configs = append(resp.Configs, s3.NewInventoryConfiguration(conf))
+		}
+		sgl.Write([]byte(xml.Header))
+		err := xml.NewEncoder(sgl).Encode(resp)
+		debug.AssertNoErr(err)
+	} else {
+		if conf == nil || conf.ID != id {
+			sgl.Free()
+			err := s3.NewErrCode("NoSuchConfiguration", "the inventory configuration "+id+" does not exist: "+bck.Cname(""))
+			s3.WriteErr(w, r, err, http.StatusNotFound)
+			return
+		}
+		s3.NewInventoryConfiguration(conf).MustMarshal(sgl)
+	}
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo2(w)
+	sgl.Free()
+}
+
+// PUT /s3/<bucket-name>?inventory&id=<id>
+func (p *proxy) putBckInventoryS3(w http.ResponseWriter, r *http.Request, bucket, id string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Header, bck, apc.AcePATCH); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	decoder := xml.NewDecoder(r.Body)
+	iconf := &s3.InventoryConfiguration{}
+	if err := decoder.Decode(iconf); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	if id == "" || iconf.ID != id {
+		err := s3.NewErrCode("InvalidArgument", "inventory ID '"+id+"' does not match the configuration ID '"+iconf.ID+"'")
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	conf, err := iconf.ToConf()
+	if err != nil {
+		if _, ok := err.(*s3.ErrCode); ok {
+			s3.WriteErr(w, r, err, http.StatusNotImplemented)
+		} else {
+			s3.WriteErr(w, r, s3.NewErrCode("MalformedXML", err.Error()), 0)
+		}
+		return
+	}
+	// destination must exist
+	dst := meta.CloneBck(&conf.Dest)
+	if err := dst.Init(p.owner.bmd); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	nprops := bck.Props.Clone()
+	nprops.Inventory = conf
+	p.setBpropsS3(w, r, msg, bck, nprops)
+}
+
+// DELETE /s3/<bucket-name>?inventory&id=<id>
+// (previously generated inventories remain)
+func (p *proxy) delBckInventoryS3(w http.ResponseWriter, r *http.Request, bucket, id string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Header, bck, apc.AcePATCH); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	if conf := bck.Props.Inventory; conf == nil || conf.ID != id {
+		err := s3.NewErrCode("NoSuchConfiguration", "the inventory configuration "+id+" does not exist: "+bck.Cname(""))
+		s3.WriteErr(w, r, err, http.StatusNotFound)
+		return
+	}
+	nprops := bck.Props.Clone()
+	nprops.Inventory = nil
+	if p.setBpropsS3(w, r, msg, bck, nprops) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// validate and commit updated bucket props (compare w/ p.makeNewBckProps)
+func (p *proxy) setBpropsS3(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg, bck *meta.Bck, nprops *cmn.Bprops) bool {
+	if err := nprops.Validate(p.owner.smap.get().CountActiveTs()); err != nil && !cmn.IsErrWarning(err) {
+		s3.WriteErr(w, r, err, 0)
+		return false
+	}
+	if _, err := p.setBprops(msg, bck, nprops); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return false
+	}
+	return true
+}
+
 //
 // misc. utils
 //
 
+func bypassGovernanceS3(r *http.Request) bool {
+	return cos.IsParseBool(r.Header.Get(s3.HeaderBypassGovernance))
+}
+
 func (p *proxy) initByNameOnly(w http.ResponseWriter, r *http.Request, bucket string) *meta.Bck {
 	bck, err, ecode := meta.InitByNameOnly(bucket, p.owner.bmd)
 	if err != nil {
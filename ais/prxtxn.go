@@ -402,9 +402,14 @@ func (p *proxy) setBprops(msg *apc.ActMsg, bck *meta.Bck, nprops *cmn.Bprops) (s
 			bargs.hdr = remoteBckProps
 		}
 		nprops = defaultBckProps(bargs)
+		nprops.ObjLock = bprops.ObjLock // (can't be disabled - see below)
+		nprops.SSE = bprops.SSE         // (can be disabled but only explicitly)
 	default:
 		return "", fmt.Errorf(fmtErrInvaldAction, msg.Action, []string{apc.ActSetBprops, apc.ActResetBprops})
 	}
+	if bprops.ObjLock != nil && nprops.ObjLock == nil {
+		return "", fmt.Errorf("%s: once enabled, object lock cannot be disabled", bck.Cname(""))
+	}
 	// msg{propsToUpdate} => nmsg{nprops} and prep context(nmsg)
 	nmsg := *msg
 	nmsg.Value = nprops
@@ -1132,6 +1137,16 @@ func (p *proxy) makeNewBckProps(bck *meta.Bck, propsToUpdate *cmn.BpropsToSet, c
 			return
 		}
 	}
+	// named backend profile must exist (and only S3 buckets can have one)
+	if name := nprops.Extra.AWS.BackendProfile; name != "" && name != bprops.Extra.AWS.BackendProfile {
+		if bck.Provider != apc.AWS {
+			err = fmt.Errorf("%s: backend profile %q applies only to s3:// buckets", bck, name)
+			return
+		}
+		if _, err = cfg.Backend.Profile(apc.AWS, name); err != nil {
+			return
+		}
+	}
 	// cannot have re-mirroring and erasure coding on the same bucket at the same time
 	remirror := _reMirror(bprops, nprops)
 	targetCnt, reec := _reEC(bprops, nprops, bck, p.owner.smap.get())
@@ -13,6 +13,7 @@ const (
 	QparamCORS              = "cors"
 	QparamPolicy            = "policy"
 	QparamACL               = "acl"
+	QparamTagging           = "tagging"
 	QparamMultiDelete       = "delete"
 	QparamMaxKeys           = "max-keys"
 	QparamPrefix            = "prefix"
@@ -20,6 +21,28 @@ const (
 	QparamStartAfter        = "start-after"
 	QparamDelimiter         = "delimiter"
 
+	// object versions
+	QparamVersions        = "versions"
+	QparamVersionID       = "versionId"
+	QparamKeyMarker       = "key-marker"
+	QparamVersionIDMarker = "version-id-marker"
+
+	// object lock
+	QparamObjectLock = "object-lock"
+	QparamRetention  = "retention"
+	QparamLegalHold  = "legal-hold"
+
+	// server-side encryption
+	QparamEncryption = "encryption"
+
+	// bucket inventory configuration
+	QparamInventory = "inventory"
+	QparamInvID     = "id"
+
+	// select object content
+	QparamSelect     = "select"
+	QparamSelectType = "select-type"
+
 	// multipart
 	QparamMptUploads        = "uploads"
 	QparamMptUploadID       = "uploadId"
@@ -39,6 +62,15 @@ const (
 
 	HeaderCredentials = "X-Amz-Credential" //nolint:gosec // This is just a header name definition...
 
+	HeaderBypassGovernance = "X-Amz-Bypass-Governance-Retention"
+
+	// server-side encryption: SSE-S3 and SSE-C
+	// https://docs.aws.amazon.com/AmazonS3/latest/userguide/ServerSideEncryptionCustomerKeys.html
+	HeaderSSE           = "X-Amz-Server-Side-Encryption"
+	HeaderSSECAlgorithm = "X-Amz-Server-Side-Encryption-Customer-Algorithm"
+	HeaderSSECKey       = "X-Amz-Server-Side-Encryption-Customer-Key"
+	HeaderSSECKeyMD5    = "X-Amz-Server-Side-Encryption-Customer-Key-Md5"
+
 	versioningEnabled  = "Enabled"
 	versioningDisabled = "Suspended"
 
@@ -0,0 +1,55 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutBucketCors.html
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_CORSRule.html
+
+type (
+	CORSConfiguration struct {
+		XMLName xml.Name   `xml:"CORSConfiguration"`
+		Rules   []CORSRule `xml:"CORSRule"`
+	}
+	CORSRule struct {
+		ID             string   `xml:"ID,omitempty"`
+		AllowedOrigins []string `xml:"AllowedOrigin"`
+		AllowedMethods []string `xml:"AllowedMethod"`
+		AllowedHeaders []string `xml:"AllowedHeader,omitempty"`
+		ExposeHeaders  []string `xml:"ExposeHeader,omitempty"`
+		MaxAgeSeconds  int      `xml:"MaxAgeSeconds,omitempty"`
+	}
+)
+
+func NewCORSConfiguration(conf *cmn.CORSConf) *CORSConfiguration {
+	debug.Assert(conf != nil)
+	out := &CORSConfiguration{Rules: make([]CORSRule, 0, len(conf.Rules))}
+	for i := range conf.Rules {
+		out.Rules = append(out.Rules, CORSRule(conf.Rules[i]))
+	}
+	return out
+}
+
+func (r *CORSConfiguration) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
+// convert and validate
+func (r *CORSConfiguration) ToConf() (*cmn.CORSConf, error) {
+	conf := &cmn.CORSConf{Rules: make([]cmn.CORSRule, 0, len(r.Rules))}
+	for i := range r.Rules {
+		conf.Rules = append(conf.Rules, cmn.CORSRule(r.Rules[i]))
+	}
+	return conf, conf.Validate()
+}
@@ -0,0 +1,73 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3_test
+
+import (
+	"encoding/xml"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CORS", func() {
+	const body = `<CORSConfiguration>
+  <CORSRule>
+    <AllowedOrigin>https://*.example.com</AllowedOrigin>
+    <AllowedMethod>GET</AllowedMethod>
+    <AllowedMethod>HEAD</AllowedMethod>
+    <AllowedHeader>x-amz-*</AllowedHeader>
+    <AllowedHeader>Range</AllowedHeader>
+    <ExposeHeader>ETag</ExposeHeader>
+    <MaxAgeSeconds>600</MaxAgeSeconds>
+  </CORSRule>
+  <CORSRule>
+    <ID>public-read</ID>
+    <AllowedOrigin>*</AllowedOrigin>
+    <AllowedMethod>GET</AllowedMethod>
+  </CORSRule>
+</CORSConfiguration>`
+
+	It("should convert and match CORS rules", func() {
+		cconf := &s3.CORSConfiguration{}
+		Expect(xml.Unmarshal([]byte(body), cconf)).NotTo(HaveOccurred())
+		conf, err := cconf.ToConf()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(conf.Rules).To(HaveLen(2))
+
+		rule := conf.Match("https://viewer.example.com", "HEAD", []string{"Range", "X-Amz-Date"})
+		Expect(rule).NotTo(BeNil())
+		Expect(rule.MaxAgeSeconds).To(Equal(600))
+		Expect(rule.AnyOrigin()).To(BeFalse())
+
+		rule = conf.Match("https://viewer.other.org", "GET", nil)
+		Expect(rule).NotTo(BeNil())
+		Expect(rule.ID).To(Equal("public-read"))
+		Expect(rule.AnyOrigin()).To(BeTrue())
+
+		Expect(conf.Match("https://viewer.other.org", "GET", []string{"Range"})).To(BeNil())
+		Expect(conf.Match("https://viewer.example.com", "PUT", nil)).To(BeNil())
+
+		// and back
+		conf2, err := s3.NewCORSConfiguration(conf).ToConf()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(conf2).To(Equal(conf))
+	})
+
+	DescribeTable("should reject invalid rules",
+		func(rule string) {
+			cconf := &s3.CORSConfiguration{}
+			Expect(xml.Unmarshal([]byte("<CORSConfiguration>"+rule+"</CORSConfiguration>"), cconf)).NotTo(HaveOccurred())
+			_, err := cconf.ToConf()
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("no rules", ""),
+		Entry("no origin", "<CORSRule><AllowedMethod>GET</AllowedMethod></CORSRule>"),
+		Entry("no method", "<CORSRule><AllowedOrigin>*</AllowedOrigin></CORSRule>"),
+		Entry("bad method", "<CORSRule><AllowedOrigin>*</AllowedOrigin><AllowedMethod>PATCH</AllowedMethod></CORSRule>"),
+		Entry("two wildcards", "<CORSRule><AllowedOrigin>https://*.*.com</AllowedOrigin><AllowedMethod>GET</AllowedMethod></CORSRule>"),
+	)
+})
@@ -20,12 +20,23 @@ import (
 
 const ErrPrefix = "aws-error"
 
-type Error struct {
-	Code      string
-	Message   string
-	Resource  string
-	RequestID string `xml:"RequestId"`
-}
+type (
+	Error struct {
+		Code      string
+		Message   string
+		Resource  string
+		RequestID string `xml:"RequestId"`
+	}
+	// error that carries its own S3 error code, e.g. "NoSuchLifecycleConfiguration"
+	ErrCode struct {
+		code string
+		msg  string
+	}
+)
+
+func NewErrCode(code, msg string) *ErrCode { return &ErrCode{code: code, msg: msg} }
+
+func (e *ErrCode) Error() string { return e.msg }
 
 func (e *Error) mustMarshal(sgl *memsys.SGL) {
 	sgl.Write([]byte(xml.Header))
@@ -60,11 +71,27 @@ func WriteErr(w http.ResponseWriter, r *http.Request, err error, ecode int) {
 		allocated = true
 	}
 	out.Message = in.Message
-	switch {
+	switch ec, isCode := err.(*ErrCode); {
+	case isCode:
+		out.Code = ec.code
 	case cmn.IsErrBucketAlreadyExists(err):
 		out.Code = "BucketAlreadyExists"
 	case cmn.IsErrBckNotFound(err):
 		out.Code = "NoSuchBucket"
+	case cmn.IsErrObjLocked(err):
+		out.Code = "AccessDenied"
+	case cmn.IsErrSSEKey(err):
+		out.Code = "InvalidRequest"
+	case cmn.IsErrPrecondFailed(err):
+		out.Code = "PreconditionFailed"
+		in.Status = http.StatusPreconditionFailed
+	case cmn.IsErrNotModified(err):
+		// (conditional GET or HEAD; no body)
+		w.WriteHeader(http.StatusNotModified)
+		if allocated {
+			cmn.FreeHterr(in)
+		}
+		return
 	case in.TypeCode != "":
 		out.Code = in.TypeCode
 	default:
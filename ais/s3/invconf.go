@@ -0,0 +1,176 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// inventory configuration of ais:// buckets (see cmn.InventoryConf)
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutBucketInventoryConfiguration.html
+//
+// NOTE (limitations):
+// - one inventory configuration per bucket (putting another one replaces it)
+// - destination must be an ais:// bucket in this same cluster (account ID is ignored)
+// - formats: CSV and Parquet (no ORC); no encryption of the inventory results
+// - optional fields: Size, ETag (object checksum), and, in addition to S3:
+//   VersionId, LastAccessTime, and CustomMetadata (JSON)
+
+const (
+	invFreqDaily  = "Daily"
+	invFreqWeekly = "Weekly"
+
+	invFormatCSV     = "CSV"
+	invFormatParquet = "Parquet"
+	invFormatORC     = "ORC"
+
+	invARNPrefix = "arn:aws:s3:::"
+
+	invWeek = 7 * 24 * time.Hour
+)
+
+// S3 optional field => cmn.InvField*
+var invFields = map[string]string{
+	"Size":           cmn.InvFieldSize,
+	"ETag":           cmn.InvFieldChecksum,
+	"VersionId":      cmn.InvFieldVersion,
+	"LastAccessTime": cmn.InvFieldAtime,
+	"CustomMetadata": cmn.InvFieldCustom,
+}
+
+type (
+	InventoryConfiguration struct {
+		XMLName        xml.Name           `xml:"InventoryConfiguration"`
+		Destination    InvDestination     `xml:"Destination"`
+		Filter         *InvFilter         `xml:"Filter,omitempty"`
+		OptionalFields *InvOptionalFields `xml:"OptionalFields,omitempty"`
+		Schedule       InvSchedule        `xml:"Schedule"`
+		ID             string             `xml:"Id"`
+		IncludedVers   string             `xml:"IncludedObjectVersions,omitempty"`
+		IsEnabled      bool               `xml:"IsEnabled"`
+	}
+	InvDestination struct {
+		S3BucketDestination InvBucketDestination `xml:"S3BucketDestination"`
+	}
+	InvBucketDestination struct {
+		AccountID string `xml:"AccountId,omitempty"`
+		Bucket    string `xml:"Bucket"`
+		Format    string `xml:"Format"`
+		Prefix    string `xml:"Prefix,omitempty"`
+	}
+	InvFilter struct {
+		Prefix string `xml:"Prefix"`
+	}
+	InvOptionalFields struct {
+		Fields []string `xml:"Field"`
+	}
+	InvSchedule struct {
+		Frequency string `xml:"Frequency"`
+	}
+
+	ListInventoryConfigurationsResult struct {
+		XMLName     xml.Name                  `xml:"ListInventoryConfigurationsResult"`
+		Configs     []*InventoryConfiguration `xml:"InventoryConfiguration"`
+		IsTruncated bool                      `xml:"IsTruncated"`
+	}
+)
+
+func NewInventoryConfiguration(conf *cmn.InventoryConf) *InventoryConfiguration {
+	debug.Assert(conf != nil)
+	out := &InventoryConfiguration{
+		ID:           conf.ID,
+		IsEnabled:    !conf.Disabled,
+		IncludedVers: "Current",
+		Schedule:     InvSchedule{Frequency: invFreqDaily},
+	}
+	dst := &out.Destination.S3BucketDestination
+	dst.Bucket = invARNPrefix + conf.Dest.Name
+	dst.Prefix = conf.Prefix
+	dst.Format = invFormatCSV
+	if conf.Format == cmn.InvFormatParquet {
+		dst.Format = invFormatParquet
+	}
+	if conf.ObjPrefix != "" {
+		out.Filter = &InvFilter{Prefix: conf.ObjPrefix}
+	}
+	if conf.Interval.D() >= invWeek {
+		out.Schedule.Frequency = invFreqWeekly
+	}
+	fields := conf.AllFields()
+	if len(fields) > 1 {
+		out.OptionalFields = &InvOptionalFields{}
+		for _, f := range fields[1:] {
+			for name, v := range invFields {
+				if v == f {
+					out.OptionalFields.Fields = append(out.OptionalFields.Fields, name)
+					break
+				}
+			}
+		}
+	}
+	return out
+}
+
+func (r *InventoryConfiguration) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
+// convert and validate
+func (r *InventoryConfiguration) ToConf() (*cmn.InventoryConf, error) {
+	dst := &r.Destination.S3BucketDestination
+	if dst.Bucket == "" {
+		return nil, errors.New("inventory destination bucket is empty")
+	}
+	conf := &cmn.InventoryConf{
+		ID:       r.ID,
+		Dest:     cmn.Bck{Name: strings.TrimPrefix(dst.Bucket, invARNPrefix), Provider: apc.AIS},
+		Prefix:   dst.Prefix,
+		Disabled: !r.IsEnabled,
+	}
+	if r.Filter != nil {
+		conf.ObjPrefix = r.Filter.Prefix
+	}
+	switch dst.Format {
+	case invFormatCSV:
+		conf.Format = cmn.InvFormatCSV
+	case invFormatParquet:
+		conf.Format = cmn.InvFormatParquet
+	case invFormatORC:
+		return nil, NewErrCode("NotImplemented", "ORC inventory format is not supported")
+	default:
+		return nil, errors.New("invalid inventory format '" + dst.Format + "'")
+	}
+	switch r.Schedule.Frequency {
+	case invFreqDaily:
+		conf.Interval = cos.Duration(24 * time.Hour)
+	case invFreqWeekly:
+		conf.Interval = cos.Duration(invWeek)
+	default:
+		return nil, errors.New("invalid inventory schedule frequency '" + r.Schedule.Frequency + "'")
+	}
+	// S3 semantics: no optional fields - object names only
+	conf.Fields = []string{cmn.InvFieldName}
+	if r.OptionalFields != nil {
+		for _, name := range r.OptionalFields.Fields {
+			f, ok := invFields[name]
+			if !ok {
+				return nil, errors.New("unsupported inventory optional field '" + name + "'")
+			}
+			conf.Fields = append(conf.Fields, f)
+		}
+	}
+	return conf, conf.Validate(apc.AIS)
+}
@@ -0,0 +1,89 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3_test
+
+import (
+	"encoding/xml"
+	"time"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Inventory", func() {
+	const body = `<InventoryConfiguration>
+  <Destination>
+    <S3BucketDestination>
+      <AccountId>123456789012</AccountId>
+      <Bucket>arn:aws:s3:::inv-dst</Bucket>
+      <Format>Parquet</Format>
+      <Prefix>reports/</Prefix>
+    </S3BucketDestination>
+  </Destination>
+  <IsEnabled>true</IsEnabled>
+  <Filter><Prefix>data/</Prefix></Filter>
+  <Id>daily-1</Id>
+  <IncludedObjectVersions>Current</IncludedObjectVersions>
+  <OptionalFields><Field>ETag</Field><Field>Size</Field></OptionalFields>
+  <Schedule><Frequency>Weekly</Frequency></Schedule>
+</InventoryConfiguration>`
+
+	It("should convert S3 inventory configuration", func() {
+		iconf := &s3.InventoryConfiguration{}
+		Expect(xml.Unmarshal([]byte(body), iconf)).NotTo(HaveOccurred())
+		conf, err := iconf.ToConf()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(conf.ID).To(Equal("daily-1"))
+		Expect(conf.Dest).To(Equal(cmn.Bck{Name: "inv-dst", Provider: apc.AIS}))
+		Expect(conf.Prefix).To(Equal("reports/"))
+		Expect(conf.ObjPrefix).To(Equal("data/"))
+		Expect(conf.Format).To(Equal(cmn.InvFormatParquet))
+		Expect(conf.Interval.D()).To(Equal(7 * 24 * time.Hour))
+		Expect(conf.IsActive()).To(BeTrue())
+		Expect(conf.AllFields()).To(Equal([]string{cmn.InvFieldName, cmn.InvFieldSize, cmn.InvFieldChecksum}))
+
+		// and back
+		back := s3.NewInventoryConfiguration(conf)
+		conf2, err := back.ToConf()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(conf2.AllFields()).To(Equal(conf.AllFields()))
+		conf2.Fields = conf.Fields
+		Expect(conf2).To(Equal(conf))
+	})
+
+	It("should default to object names only and reject unsupported settings", func() {
+		iconf := &s3.InventoryConfiguration{
+			ID:        "names",
+			IsEnabled: false,
+			Schedule:  s3.InvSchedule{Frequency: "Daily"},
+		}
+		iconf.Destination.S3BucketDestination = s3.InvBucketDestination{Bucket: "dst", Format: "CSV"}
+		conf, err := iconf.ToConf()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(conf.AllFields()).To(Equal([]string{cmn.InvFieldName}))
+		Expect(conf.Prefix).To(Equal(cmn.InvDefaultPrefix))
+		Expect(conf.IsActive()).To(BeFalse())
+		Expect(s3.NewInventoryConfiguration(conf).OptionalFields).To(BeNil())
+
+		iconf.Destination.S3BucketDestination.Format = "ORC"
+		_, err = iconf.ToConf()
+		Expect(err).To(HaveOccurred())
+
+		iconf.Destination.S3BucketDestination.Format = "CSV"
+		iconf.Schedule.Frequency = "Hourly"
+		_, err = iconf.ToConf()
+		Expect(err).To(HaveOccurred())
+
+		iconf.Schedule.Frequency = "Daily"
+		iconf.OptionalFields = &s3.InvOptionalFields{Fields: []string{"StorageClass"}}
+		_, err = iconf.ToConf()
+		Expect(err).To(HaveOccurred())
+	})
+})
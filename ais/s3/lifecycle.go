@@ -0,0 +1,136 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutBucketLifecycleConfiguration.html
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_LifecycleRule.html
+//
+// NOTE (limitations):
+// - filtering by prefix only (no tags, no object size ranges, no <And>)
+// - at most one transition per rule
+// - dates (as opposed to days) are not supported
+
+const (
+	lcyStatusEnabled  = "Enabled"
+	lcyStatusDisabled = "Disabled"
+)
+
+type (
+	LifecycleConfiguration struct {
+		XMLName xml.Name        `xml:"LifecycleConfiguration"`
+		Rules   []LifecycleRule `xml:"Rule"`
+	}
+	LifecycleRule struct {
+		Filter                         *LifecycleFilter                `xml:"Filter,omitempty"`
+		Expiration                     *LifecycleExpiration            `xml:"Expiration,omitempty"`
+		Transition                     *LifecycleTransition            `xml:"Transition,omitempty"`
+		NoncurrentVersionExpiration    *NoncurrentVersionExpiration    `xml:"NoncurrentVersionExpiration,omitempty"`
+		AbortIncompleteMultipartUpload *AbortIncompleteMultipartUpload `xml:"AbortIncompleteMultipartUpload,omitempty"`
+		ID                             string                          `xml:"ID"`
+		Prefix                         string                          `xml:"Prefix,omitempty"` // deprecated (use Filter)
+		Status                         string                          `xml:"Status"`
+	}
+	LifecycleFilter struct {
+		Prefix string `xml:"Prefix"`
+	}
+	LifecycleExpiration struct {
+		Days int `xml:"Days"`
+	}
+	LifecycleTransition struct {
+		StorageClass string `xml:"StorageClass,omitempty"`
+		Days         int    `xml:"Days"`
+	}
+	NoncurrentVersionExpiration struct {
+		NoncurrentDays int `xml:"NoncurrentDays"`
+	}
+	AbortIncompleteMultipartUpload struct {
+		DaysAfterInitiation int `xml:"DaysAfterInitiation"`
+	}
+)
+
+func NewLifecycleConfiguration(conf *cmn.LifecycleConf) *LifecycleConfiguration {
+	debug.Assert(conf != nil)
+	out := &LifecycleConfiguration{Rules: make([]LifecycleRule, 0, len(conf.Rules))}
+	for i := range conf.Rules {
+		var (
+			in   = &conf.Rules[i]
+			rule = LifecycleRule{ID: in.ID, Status: lcyStatusEnabled, Filter: &LifecycleFilter{Prefix: in.Prefix}}
+		)
+		if in.Disabled {
+			rule.Status = lcyStatusDisabled
+		}
+		if in.ExpirationDays > 0 {
+			rule.Expiration = &LifecycleExpiration{Days: in.ExpirationDays}
+		}
+		if in.Transition != nil {
+			rule.Transition = &LifecycleTransition{StorageClass: in.Transition.StorageClass, Days: in.Transition.Days}
+		}
+		if in.NoncurrentDays > 0 {
+			rule.NoncurrentVersionExpiration = &NoncurrentVersionExpiration{NoncurrentDays: in.NoncurrentDays}
+		}
+		if in.AbortMptDays > 0 {
+			rule.AbortIncompleteMultipartUpload = &AbortIncompleteMultipartUpload{DaysAfterInitiation: in.AbortMptDays}
+		}
+		out.Rules = append(out.Rules, rule)
+	}
+	return out
+}
+
+func (r *LifecycleConfiguration) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
+// convert and validate
+func (r *LifecycleConfiguration) ToConf() (*cmn.LifecycleConf, error) {
+	if len(r.Rules) == 0 {
+		return nil, errors.New("lifecycle configuration must contain at least one rule")
+	}
+	conf := &cmn.LifecycleConf{Rules: make([]cmn.LifecycleRule, 0, len(r.Rules))}
+	for i := range r.Rules {
+		var (
+			in   = &r.Rules[i]
+			rule = cmn.LifecycleRule{ID: in.ID, Prefix: in.Prefix}
+		)
+		switch in.Status {
+		case lcyStatusEnabled:
+		case lcyStatusDisabled:
+			rule.Disabled = true
+		default:
+			return nil, fmt.Errorf("lifecycle rule %q: invalid status %q", in.ID, in.Status)
+		}
+		if in.Filter != nil {
+			if in.Prefix != "" && in.Prefix != in.Filter.Prefix {
+				return nil, fmt.Errorf("lifecycle rule %q: cannot specify both prefix and filter", in.ID)
+			}
+			rule.Prefix = in.Filter.Prefix
+		}
+		if in.Expiration != nil {
+			rule.ExpirationDays = in.Expiration.Days
+		}
+		if in.Transition != nil {
+			rule.Transition = &cmn.LifecycleTransition{StorageClass: in.Transition.StorageClass, Days: in.Transition.Days}
+		}
+		if in.NoncurrentVersionExpiration != nil {
+			rule.NoncurrentDays = in.NoncurrentVersionExpiration.NoncurrentDays
+		}
+		if in.AbortIncompleteMultipartUpload != nil {
+			rule.AbortMptDays = in.AbortIncompleteMultipartUpload.DaysAfterInitiation
+		}
+		conf.Rules = append(conf.Rules, rule)
+	}
+	return conf, conf.Validate()
+}
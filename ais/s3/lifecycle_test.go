@@ -0,0 +1,79 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3_test
+
+import (
+	"encoding/xml"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Lifecycle", func() {
+	const body = `<LifecycleConfiguration>
+  <Rule>
+    <ID>expire-tmp</ID>
+    <Filter><Prefix>tmp/</Prefix></Filter>
+    <Status>Enabled</Status>
+    <Expiration><Days>7</Days></Expiration>
+    <AbortIncompleteMultipartUpload><DaysAfterInitiation>2</DaysAfterInitiation></AbortIncompleteMultipartUpload>
+  </Rule>
+  <Rule>
+    <ID>archive</ID>
+    <Prefix>logs/</Prefix>
+    <Status>Disabled</Status>
+    <Transition><Days>30</Days><StorageClass>GLACIER</StorageClass></Transition>
+    <NoncurrentVersionExpiration><NoncurrentDays>10</NoncurrentDays></NoncurrentVersionExpiration>
+  </Rule>
+</LifecycleConfiguration>`
+
+	It("should convert S3 lifecycle configuration", func() {
+		lconf := &s3.LifecycleConfiguration{}
+		Expect(xml.Unmarshal([]byte(body), lconf)).NotTo(HaveOccurred())
+		conf, err := lconf.ToConf()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(conf.Rules).To(HaveLen(2))
+
+		r0, r1 := conf.Rules[0], conf.Rules[1]
+		Expect(r0.ID).To(Equal("expire-tmp"))
+		Expect(r0.Prefix).To(Equal("tmp/"))
+		Expect(r0.Disabled).To(BeFalse())
+		Expect(r0.ExpirationDays).To(Equal(7))
+		Expect(r0.AbortMptDays).To(Equal(2))
+		Expect(r0.Transition).To(BeNil())
+
+		Expect(r1.Prefix).To(Equal("logs/"))
+		Expect(r1.Disabled).To(BeTrue())
+		Expect(r1.Transition.Days).To(Equal(30))
+		Expect(r1.Transition.StorageClass).To(Equal("GLACIER"))
+		Expect(r1.NoncurrentDays).To(Equal(10))
+		Expect(conf.IsActive()).To(BeTrue())
+
+		// and back
+		back := s3.NewLifecycleConfiguration(conf)
+		conf2, err := back.ToConf()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(conf2).To(Equal(conf))
+	})
+
+	DescribeTable("should reject invalid rules",
+		func(rule string) {
+			lconf := &s3.LifecycleConfiguration{}
+			Expect(xml.Unmarshal([]byte("<LifecycleConfiguration>"+rule+"</LifecycleConfiguration>"), lconf)).NotTo(HaveOccurred())
+			_, err := lconf.ToConf()
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("no rules", ""),
+		Entry("no ID", "<Rule><Status>Enabled</Status><Expiration><Days>1</Days></Expiration></Rule>"),
+		Entry("invalid status", "<Rule><ID>a</ID><Status>On</Status><Expiration><Days>1</Days></Expiration></Rule>"),
+		Entry("no action", "<Rule><ID>a</ID><Status>Enabled</Status></Rule>"),
+		Entry("duplicate ID", "<Rule><ID>a</ID><Status>Enabled</Status><Expiration><Days>1</Days></Expiration></Rule>"+
+			"<Rule><ID>a</ID><Status>Enabled</Status><Expiration><Days>2</Days></Expiration></Rule>"),
+		Entry("expiration before transition", "<Rule><ID>a</ID><Status>Enabled</Status>"+
+			"<Expiration><Days>5</Days></Expiration><Transition><Days>10</Days></Transition></Rule>"),
+	)
+})
@@ -9,10 +9,12 @@ import (
 	"net/http"
 	"sort"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/nlog"
@@ -160,6 +162,31 @@ func CleanupUpload(id, fqn string, aborted bool) (exists bool) {
 	return true
 }
 
+// abort (and cleanup) stale uploads - as per bucket lifecycle rule
+func AbortStale(bckName string, rule *cmn.LifecycleRule, now time.Time) (n int) {
+	var stale []*mpt
+	mu.Lock()
+	for id, mpt := range ups {
+		if mpt.bckName != bckName || !strings.HasPrefix(mpt.objName, rule.Prefix) {
+			continue
+		}
+		if rule.MptStale(mpt.ctime, now) {
+			stale = append(stale, mpt)
+			delete(ups, id)
+		}
+	}
+	mu.Unlock()
+
+	for _, mpt := range stale {
+		for _, part := range mpt.parts {
+			if err := cos.RemoveFile(part.FQN); err != nil {
+				nlog.Errorln("failed to remove part [", bckName, mpt.objName, err, "]")
+			}
+		}
+	}
+	return len(stale)
+}
+
 func ListUploads(bckName, idMarker string, maxUploads int) (result *ListMptUploadsResult) {
 	mu.RLock()
 	results := make([]UploadInfoResult, 0, len(ups))
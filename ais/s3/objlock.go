@@ -0,0 +1,151 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// Object Lock (see cmn/objlock.go):
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutObjectLockConfiguration.html
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutObjectRetention.html
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutObjectLegalHold.html
+
+const (
+	objLockEnabled = "Enabled"
+	legalHoldOn    = "ON"
+	legalHoldOff   = "OFF"
+)
+
+type (
+	ObjectLockConfiguration struct {
+		XMLName xml.Name        `xml:"ObjectLockConfiguration"`
+		Enabled string          `xml:"ObjectLockEnabled"`
+		Rule    *ObjectLockRule `xml:"Rule,omitempty"`
+	}
+	ObjectLockRule struct {
+		DefaultRetention DefaultRetention `xml:"DefaultRetention"`
+	}
+	DefaultRetention struct {
+		Mode  string `xml:"Mode"`
+		Days  int    `xml:"Days,omitempty"`
+		Years int    `xml:"Years,omitempty"`
+	}
+
+	Retention struct {
+		XMLName         xml.Name `xml:"Retention"`
+		Mode            string   `xml:"Mode,omitempty"`
+		RetainUntilDate string   `xml:"RetainUntilDate,omitempty"`
+	}
+	LegalHold struct {
+		XMLName xml.Name `xml:"LegalHold"`
+		Status  string   `xml:"Status"`
+	}
+)
+
+/////////////////////////////
+// ObjectLockConfiguration //
+/////////////////////////////
+
+func NewObjectLockConfiguration(conf *cmn.ObjLockConf) *ObjectLockConfiguration {
+	debug.Assert(conf != nil)
+	out := &ObjectLockConfiguration{Enabled: objLockEnabled}
+	if conf.Days > 0 {
+		out.Rule = &ObjectLockRule{DefaultRetention{Mode: strings.ToUpper(conf.Mode), Days: conf.Days}}
+	}
+	return out
+}
+
+func (r *ObjectLockConfiguration) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
+// convert and validate
+func (r *ObjectLockConfiguration) ToConf() (*cmn.ObjLockConf, error) {
+	if r.Enabled != objLockEnabled {
+		return nil, fmt.Errorf("invalid ObjectLockEnabled %q (expecting %q)", r.Enabled, objLockEnabled)
+	}
+	conf := &cmn.ObjLockConf{}
+	if r.Rule != nil {
+		dr := &r.Rule.DefaultRetention
+		if (dr.Days == 0) == (dr.Years == 0) {
+			return nil, errors.New("default retention: expecting either Days or Years")
+		}
+		conf.Mode = strings.ToLower(dr.Mode)
+		conf.Days = dr.Days + dr.Years*365
+	}
+	return conf, conf.Validate()
+}
+
+///////////////
+// Retention //
+///////////////
+
+func NewRetention(r *cmn.ObjRetention) *Retention {
+	if r.Mode == "" {
+		return &Retention{}
+	}
+	return &Retention{Mode: strings.ToUpper(r.Mode), RetainUntilDate: r.Until.UTC().Format(time.RFC3339)}
+}
+
+func (r *Retention) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
+// empty mode (and zero time) to remove retention
+func (r *Retention) Parse() (mode string, until time.Time, err error) {
+	if r.Mode == "" {
+		if r.RetainUntilDate != "" {
+			err = errors.New("retention: RetainUntilDate requires Mode")
+		}
+		return
+	}
+	mode = strings.ToLower(r.Mode)
+	if err = cmn.ValidateObjLockMode(mode); err != nil {
+		return
+	}
+	until, err = time.Parse(time.RFC3339, r.RetainUntilDate)
+	return
+}
+
+///////////////
+// LegalHold //
+///////////////
+
+func NewLegalHold(on bool) *LegalHold {
+	if on {
+		return &LegalHold{Status: legalHoldOn}
+	}
+	return &LegalHold{Status: legalHoldOff}
+}
+
+func (r *LegalHold) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
+func (r *LegalHold) Parse() (bool, error) {
+	switch r.Status {
+	case legalHoldOn:
+		return true, nil
+	case legalHoldOff:
+		return false, nil
+	default:
+		return false, fmt.Errorf("legal hold: invalid status %q (expecting %q or %q)", r.Status, legalHoldOn, legalHoldOff)
+	}
+}
@@ -0,0 +1,82 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3_test
+
+import (
+	"encoding/xml"
+	"time"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/cmn"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ObjectLock", func() {
+	It("should convert bucket configuration", func() {
+		const body = `<ObjectLockConfiguration>
+  <ObjectLockEnabled>Enabled</ObjectLockEnabled>
+  <Rule><DefaultRetention><Mode>COMPLIANCE</Mode><Years>1</Years></DefaultRetention></Rule>
+</ObjectLockConfiguration>`
+		lconf := &s3.ObjectLockConfiguration{}
+		Expect(xml.Unmarshal([]byte(body), lconf)).NotTo(HaveOccurred())
+		conf, err := lconf.ToConf()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(conf.Mode).To(Equal(cmn.ObjLockCompliance))
+		Expect(conf.Days).To(Equal(365))
+
+		back := s3.NewObjectLockConfiguration(conf)
+		Expect(back.Enabled).To(Equal("Enabled"))
+		Expect(back.Rule.DefaultRetention.Mode).To(Equal("COMPLIANCE"))
+		Expect(back.Rule.DefaultRetention.Days).To(Equal(365))
+	})
+
+	It("should reject invalid configurations", func() {
+		for _, body := range []string{
+			`<ObjectLockConfiguration><ObjectLockEnabled>Disabled</ObjectLockEnabled></ObjectLockConfiguration>`,
+			`<ObjectLockConfiguration><ObjectLockEnabled>Enabled</ObjectLockEnabled>
+  <Rule><DefaultRetention><Mode>WORM</Mode><Days>1</Days></DefaultRetention></Rule></ObjectLockConfiguration>`,
+			`<ObjectLockConfiguration><ObjectLockEnabled>Enabled</ObjectLockEnabled>
+  <Rule><DefaultRetention><Mode>GOVERNANCE</Mode><Days>1</Days><Years>1</Years></DefaultRetention></Rule></ObjectLockConfiguration>`,
+		} {
+			lconf := &s3.ObjectLockConfiguration{}
+			Expect(xml.Unmarshal([]byte(body), lconf)).NotTo(HaveOccurred())
+			_, err := lconf.ToConf()
+			Expect(err).To(HaveOccurred(), body)
+		}
+	})
+
+	It("should enforce retention and legal hold", func() {
+		var (
+			now       = time.Now()
+			retention = &s3.Retention{Mode: "GOVERNANCE", RetainUntilDate: now.Add(time.Hour).UTC().Format(time.RFC3339)}
+		)
+		mode, until, err := retention.Parse()
+		Expect(err).NotTo(HaveOccurred())
+
+		r := cmn.ObjRetention{Mode: mode, Until: until}
+		Expect(cmn.IsErrObjLocked(r.Check("o", now, false))).To(BeTrue())
+		Expect(r.Check("o", now, true /*bypass*/)).NotTo(HaveOccurred())
+		Expect(r.Check("o", now.Add(2*time.Hour), false)).NotTo(HaveOccurred())
+
+		// can extend, cannot shorten (unless bypassing governance)
+		Expect(r.CheckUpdate("o", mode, until.Add(time.Hour), now, false)).NotTo(HaveOccurred())
+		Expect(r.CheckUpdate("o", mode, until.Add(-time.Minute), now, false)).To(HaveOccurred())
+		Expect(r.CheckUpdate("o", "", time.Time{}, now, false)).To(HaveOccurred())
+		Expect(r.CheckUpdate("o", "", time.Time{}, now, true)).NotTo(HaveOccurred())
+
+		r.Mode = cmn.ObjLockCompliance
+		Expect(r.Check("o", now, true)).To(HaveOccurred())
+		Expect(r.CheckUpdate("o", "", time.Time{}, now, true)).To(HaveOccurred())
+
+		hold := &s3.LegalHold{}
+		Expect(xml.Unmarshal([]byte(`<LegalHold><Status>ON</Status></LegalHold>`), hold)).NotTo(HaveOccurred())
+		on, err := hold.Parse()
+		Expect(err).NotTo(HaveOccurred())
+		r = cmn.ObjRetention{LegalHold: on}
+		Expect(cmn.IsErrObjLocked(r.Check("o", now, true))).To(BeTrue())
+	})
+})
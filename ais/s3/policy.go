@@ -0,0 +1,330 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// https://docs.aws.amazon.com/AmazonS3/latest/userguide/bucket-policies.html
+//
+// S3 bucket policy => cmn.BucketPolicy translation:
+// - Principal: "*" or {"AWS": [...]}, whereby IAM ARNs (e.g. "arn:aws:iam::123456789012:user/alice")
+//   resolve to the user name ("alice") that must match AuthN user
+// - Action: S3 action names (wildcards supported: "s3:*", "s3:Get*") => apc.AccessAttrs
+// - Resource: "arn:aws:s3:::bucket" (bucket-level actions), and "arn:aws:s3:::bucket/*",
+//   "arn:aws:s3:::bucket/prefix*", or "arn:aws:s3:::bucket/exact-object-name" (object-level);
+//   actions that do not apply to a given resource type are ignored
+// - Condition: only "s3:prefix" (StringEquals and StringLike) that restricts list-objects prefix;
+//   applies to bucket resources only
+
+const (
+	policyVersion  = "2012-10-17"
+	effectAllow    = "Allow"
+	effectDeny     = "Deny"
+	arnS3Prefix    = "arn:aws:s3:::"
+	arnUserSepa    = ":user/"
+	condKeyPrefix  = "s3:prefix"
+	condStrEquals  = "StringEquals"
+	condStrLike    = "StringLike"
+	principalKeyAW = "AWS"
+)
+
+// S3 action => AIS permissions
+var policyActions = map[string]apc.AccessAttrs{
+	"s3:GetObject":                        apc.AceGET | apc.AceObjHEAD,
+	"s3:GetObjectVersion":                 apc.AceGET | apc.AceObjHEAD,
+	"s3:PutObject":                        apc.AcePUT | apc.AceAPPEND,
+	"s3:DeleteObject":                     apc.AceObjDELETE,
+	"s3:DeleteObjectVersion":              apc.AceObjDELETE,
+	"s3:AbortMultipartUpload":             apc.AcePUT,
+	"s3:ListMultipartUploadParts":         apc.AcePUT,
+	"s3:ListBucketMultipartUploads":       apc.AceObjLIST,
+	"s3:ListBucket":                       apc.AceObjLIST | apc.AceBckHEAD,
+	"s3:ListBucketVersions":               apc.AceObjLIST | apc.AceBckHEAD,
+	"s3:GetBucketLocation":                apc.AceBckHEAD,
+	"s3:GetBucketVersioning":              apc.AceBckHEAD,
+	"s3:GetLifecycleConfiguration":        apc.AceBckHEAD,
+	"s3:GetBucketPolicy":                  apc.AceBckHEAD,
+	"s3:PutBucketVersioning":              apc.AcePATCH,
+	"s3:PutLifecycleConfiguration":        apc.AcePATCH,
+	"s3:PutBucketPolicy":                  apc.AcePATCH,
+	"s3:DeleteBucketPolicy":               apc.AcePATCH,
+	"s3:PutBucketAcl":                     apc.AceBckSetACL,
+	"s3:DeleteBucket":                     apc.AceDestroyBucket,
+	"s3:GetObjectAcl":                     apc.AceObjHEAD,
+	"s3:GetBucketAcl":                     apc.AceBckHEAD,
+	"s3:GetBucketCORS":                    apc.AceBckHEAD,
+	"s3:PutBucketCORS":                    apc.AcePATCH,
+	"s3:GetObjectAttributes":              apc.AceObjHEAD,
+	"s3:GetBucketObjectLockConfiguration": apc.AceBckHEAD,
+	"s3:PutBucketObjectLockConfiguration": apc.AcePATCH,
+	"s3:GetObjectRetention":               apc.AceObjHEAD,
+	"s3:PutObjectRetention":               apc.AceObjUpdate,
+	"s3:GetObjectLegalHold":               apc.AceObjHEAD,
+	"s3:PutObjectLegalHold":               apc.AceObjUpdate,
+	"s3:BypassGovernanceRetention":        apc.AcePATCH,
+	"s3:GetObjectTagging":                 apc.AceObjHEAD,
+	"s3:PutObjectTagging":                 apc.AceObjUpdate,
+	"s3:DeleteObjectTagging":              apc.AceObjUpdate,
+	"s3:RestoreObject":                    apc.AceGET,
+	"s3:ReplicateObject":                  apc.AcePUT,
+	"s3:GetBucketPolicyStatus":            apc.AceBckHEAD,
+	"s3:GetBucketOwnershipControls":       apc.AceBckHEAD,
+	"s3:PutBucketOwnershipControls":       apc.AcePATCH,
+	"s3:GetBucketPublicAccessBlock":       apc.AceBckHEAD,
+	"s3:PutBucketPublicAccessBlock":       apc.AcePATCH,
+	"s3:GetEncryptionConfiguration":       apc.AceBckHEAD,
+	"s3:PutEncryptionConfiguration":       apc.AcePATCH,
+	"s3:GetBucketRequestPayment":          apc.AceBckHEAD,
+	"s3:GetBucketNotification":            apc.AceBckHEAD,
+	"s3:PutBucketNotification":            apc.AcePATCH,
+	"s3:GetReplicationConfiguration":      apc.AceBckHEAD,
+}
+
+type (
+	// https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_elements.html
+	PolicyDoc struct {
+		Version   string            `json:"Version"`
+		ID        string            `json:"Id,omitempty"`
+		Statement []PolicyStatement `json:"Statement"`
+	}
+	PolicyStatement struct {
+		Condition map[string]map[string]strList `json:"Condition,omitempty"`
+		Principal policyPrincipal               `json:"Principal"`
+		Sid       string                        `json:"Sid,omitempty"`
+		Effect    string                        `json:"Effect"`
+		Action    strList                       `json:"Action"`
+		Resource  strList                       `json:"Resource"`
+	}
+
+	// either a single string or a list of strings
+	strList []string
+
+	// either "*" or {"AWS": strList}
+	policyPrincipal struct {
+		Names []string
+	}
+)
+
+func (l *strList) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := cos.JSON.Unmarshal(b, &s); err == nil {
+		*l = []string{s}
+		return nil
+	}
+	var ss []string
+	if err := cos.JSON.Unmarshal(b, &ss); err != nil {
+		return fmt.Errorf("expecting string or list of strings, got %s", cos.BHead(b))
+	}
+	*l = ss
+	return nil
+}
+
+func (pp *policyPrincipal) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := cos.JSON.Unmarshal(b, &s); err == nil {
+		if s != cmn.PolicyAnyone {
+			return fmt.Errorf("invalid principal %q", s)
+		}
+		pp.Names = []string{cmn.PolicyAnyone}
+		return nil
+	}
+	var m map[string]strList
+	if err := cos.JSON.Unmarshal(b, &m); err != nil {
+		return fmt.Errorf("invalid principal %s", cos.BHead(b))
+	}
+	for k, v := range m {
+		if k != principalKeyAW {
+			return fmt.Errorf("principal type %q is not supported", k)
+		}
+		for _, name := range v {
+			if i := strings.LastIndex(name, arnUserSepa); i >= 0 {
+				name = name[i+len(arnUserSepa):]
+			}
+			if name == "" {
+				return errors.New("empty principal")
+			}
+			pp.Names = append(pp.Names, name)
+		}
+	}
+	return nil
+}
+
+// parse S3 bucket policy document and translate it into AIS bucket policy
+func ParsePolicy(bucket string, doc []byte) (*cmn.BucketPolicy, error) {
+	if len(doc) > cmn.MaxPolicyDocSize {
+		return nil, fmt.Errorf("policy document is too large (%d > %d)", len(doc), cmn.MaxPolicyDocSize)
+	}
+	var pdoc PolicyDoc
+	if err := cos.JSON.Unmarshal(doc, &pdoc); err != nil {
+		return nil, fmt.Errorf("invalid policy document: %v", err)
+	}
+	if pdoc.Version != "" && pdoc.Version != policyVersion {
+		return nil, fmt.Errorf("unsupported policy version %q (expecting %q)", pdoc.Version, policyVersion)
+	}
+	if len(pdoc.Statement) == 0 {
+		return nil, errors.New("policy document contains no statements")
+	}
+	bp := &cmn.BucketPolicy{Doc: string(doc), Entries: make([]cmn.PolicyACE, 0, len(pdoc.Statement))}
+	for i := range pdoc.Statement {
+		entries, err := pdoc.Statement[i].toACEs(bucket)
+		if err != nil {
+			return nil, fmt.Errorf("statement %d: %v", i, err)
+		}
+		bp.Entries = append(bp.Entries, entries...)
+	}
+	return bp, bp.Validate()
+}
+
+func (st *PolicyStatement) toACEs(bucket string) ([]cmn.PolicyACE, error) {
+	var deny bool
+	switch st.Effect {
+	case effectAllow:
+	case effectDeny:
+		deny = true
+	default:
+		return nil, fmt.Errorf("invalid effect %q", st.Effect)
+	}
+	if len(st.Principal.Names) == 0 {
+		return nil, errors.New("missing principal")
+	}
+	access, err := actionsToAccess(st.Action)
+	if err != nil {
+		return nil, err
+	}
+	if len(st.Resource) == 0 {
+		return nil, errors.New("missing resource")
+	}
+
+	// condition prefixes, if any
+	type condPrefix struct {
+		prefix string
+		exact  bool
+	}
+	var conds []condPrefix
+	for op, kvs := range st.Condition {
+		if op != condStrEquals && op != condStrLike {
+			return nil, fmt.Errorf("condition operator %q is not supported", op)
+		}
+		for k, v := range kvs {
+			if k != condKeyPrefix {
+				return nil, fmt.Errorf("condition key %q is not supported", k)
+			}
+			for _, prefix := range v {
+				if op == condStrLike && strings.HasSuffix(prefix, "*") {
+					conds = append(conds, condPrefix{prefix: strings.TrimSuffix(prefix, "*")})
+				} else {
+					conds = append(conds, condPrefix{prefix: prefix, exact: true})
+				}
+			}
+		}
+	}
+
+	entries := make([]cmn.PolicyACE, 0, len(st.Resource))
+	for _, res := range st.Resource {
+		prefix, exact, isBck, err := parseResource(bucket, res)
+		if err != nil {
+			return nil, err
+		}
+		if !isBck {
+			if len(conds) > 0 {
+				return nil, fmt.Errorf("condition key %q applies to bucket resources only (have %q)", condKeyPrefix, res)
+			}
+			if a := access & cmn.PolicyObjAccess; a != 0 {
+				entries = append(entries, cmn.PolicyACE{
+					Principals: st.Principal.Names, Prefix: prefix, Exact: exact, Access: a, Deny: deny,
+				})
+			}
+			continue
+		}
+		a := access & cmn.PolicyBckAccess
+		if a == 0 {
+			continue
+		}
+		if len(conds) == 0 {
+			entries = append(entries, cmn.PolicyACE{
+				Principals: st.Principal.Names, Access: a, Bucket: true, Deny: deny,
+			})
+			continue
+		}
+		for _, c := range conds {
+			entries = append(entries, cmn.PolicyACE{
+				Principals: st.Principal.Names, Prefix: c.prefix, Exact: c.exact, Access: a, Bucket: true, Deny: deny,
+			})
+		}
+	}
+	if len(entries) == 0 {
+		return nil, errors.New("none of the actions apply to the specified resources")
+	}
+	return entries, nil
+}
+
+func actionsToAccess(actions []string) (access apc.AccessAttrs, _ error) {
+	if len(actions) == 0 {
+		return 0, errors.New("missing action")
+	}
+	for _, action := range actions {
+		if action == "*" || action == "s3:*" {
+			access |= apc.AccessAll
+			continue
+		}
+		if !strings.HasSuffix(action, "*") {
+			a, ok := policyActions[action]
+			if !ok {
+				return 0, fmt.Errorf("action %q is not supported", action)
+			}
+			access |= a
+			continue
+		}
+		// wildcard
+		var (
+			found  bool
+			prefix = strings.TrimSuffix(action, "*")
+		)
+		for name, a := range policyActions {
+			if strings.HasPrefix(name, prefix) {
+				access |= a
+				found = true
+			}
+		}
+		if !found {
+			return 0, fmt.Errorf("action %q does not match any supported action", action)
+		}
+	}
+	return access, nil
+}
+
+// returns object name prefix and whether the resource names a single object or the bucket itself
+func parseResource(bucket, res string) (prefix string, exact, isBck bool, _ error) {
+	s, ok := strings.CutPrefix(res, arnS3Prefix)
+	if !ok {
+		return "", false, false, fmt.Errorf("invalid resource %q (expecting %q prefix)", res, arnS3Prefix)
+	}
+	name, oname, hasObj := strings.Cut(s, "/")
+	if name != bucket {
+		return "", false, false, fmt.Errorf("resource %q does not belong to bucket %q", res, bucket)
+	}
+	if !hasObj {
+		return "", false, true, nil
+	}
+	if strings.HasSuffix(oname, "*") {
+		prefix = strings.TrimSuffix(oname, "*")
+		if strings.Contains(prefix, "*") {
+			return "", false, false, fmt.Errorf("resource %q: only trailing wildcards are supported", res)
+		}
+		return prefix, false, false, nil
+	}
+	if strings.Contains(oname, "*") {
+		return "", false, false, fmt.Errorf("resource %q: only trailing wildcards are supported", res)
+	}
+	return oname, true, false, nil
+}
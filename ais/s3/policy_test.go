@@ -0,0 +1,132 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3_test
+
+import (
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Policy", func() {
+	const doc = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "PublicRead",
+      "Effect": "Allow",
+      "Principal": "*",
+      "Action": ["s3:GetObject"],
+      "Resource": "arn:aws:s3:::bck/public/*"
+    },
+    {
+      "Effect": "Allow",
+      "Principal": {"AWS": ["arn:aws:iam::123456789012:user/alice", "bob"]},
+      "Action": "s3:*",
+      "Resource": ["arn:aws:s3:::bck", "arn:aws:s3:::bck/*"]
+    },
+    {
+      "Effect": "Deny",
+      "Principal": {"AWS": "bob"},
+      "Action": "s3:Delete*",
+      "Resource": "arn:aws:s3:::bck/keep.txt"
+    }
+  ]
+}`
+
+	It("should parse and evaluate S3 bucket policy", func() {
+		policy, err := s3.ParsePolicy("bck", []byte(doc))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policy.Doc).To(Equal(doc))
+		Expect(policy.Entries).To(HaveLen(4))
+
+		// anonymous
+		allow, deny := policy.Eval("", "public/a.jpg")
+		Expect(allow.Has(apc.AceGET)).To(BeTrue())
+		Expect(deny).To(BeZero())
+		allow, _ = policy.Eval("", "private/a.jpg")
+		Expect(allow).To(BeZero())
+
+		// named users
+		allow, _ = policy.Eval("alice", "private/a.jpg")
+		Expect(allow).To(Equal(cmn.PolicyObjAccess | cmn.PolicyBckAccess))
+		allow, _ = policy.Eval("alice", "")
+		Expect(allow).To(Equal(cmn.PolicyObjAccess | cmn.PolicyBckAccess))
+		Expect(allow.Has(apc.AceAdmin)).To(BeFalse())
+		_, deny = policy.Eval("bob", "keep.txt")
+		Expect(deny.Has(apc.AceObjDELETE)).To(BeTrue())
+		_, deny = policy.Eval("bob", "keep.txt.bak")
+		Expect(deny).To(BeZero())
+	})
+
+	It("should keep bucket and object resources separate", func() {
+		policy, err := s3.ParsePolicy("bck", []byte(`{"Statement": [
+		  {"Effect": "Allow", "Principal": "*", "Action": "s3:*", "Resource": "arn:aws:s3:::bck/*"},
+		  {"Effect": "Deny", "Principal": "*", "Action": "s3:*", "Resource": "arn:aws:s3:::bck/tmp/*"}
+		]}`))
+		Expect(err).NotTo(HaveOccurred())
+
+		allow, deny := policy.Eval("", "")
+		Expect(allow).To(Equal(cmn.PolicyObjAccess))
+		Expect(deny).To(BeZero())
+		for _, ace := range []apc.AccessAttrs{apc.AceDestroyBucket, apc.AcePATCH, apc.AceBckSetACL, apc.AceObjLIST} {
+			Expect(allow.Has(ace)).To(BeFalse())
+		}
+		_, deny = policy.Eval("", "tmp/a")
+		Expect(deny.Has(apc.AceObjLIST)).To(BeFalse())
+		Expect(deny.Has(apc.AceObjDELETE)).To(BeTrue())
+
+		// conversely, bucket resource does not grant object-level access
+		policy, err = s3.ParsePolicy("bck", []byte(`{"Statement": [
+		  {"Effect": "Allow", "Principal": "*", "Action": "s3:*", "Resource": "arn:aws:s3:::bck"}
+		]}`))
+		Expect(err).NotTo(HaveOccurred())
+		allow, _ = policy.Eval("", "a.jpg")
+		Expect(allow).To(Equal(cmn.PolicyBckAccess))
+	})
+
+	It("should restrict list-objects prefix via s3:prefix condition", func() {
+		policy, err := s3.ParsePolicy("bck", []byte(`{"Statement": [
+		  {"Effect": "Allow", "Principal": "*", "Action": "s3:ListBucket", "Resource": "arn:aws:s3:::bck",
+		   "Condition": {"StringLike": {"s3:prefix": "home/*"}, "StringEquals": {"s3:prefix": "public"}}}
+		]}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policy.Entries).To(HaveLen(2))
+
+		for prefix, expected := range map[string]bool{
+			"home/": true, "home/alice/": true, "public": true, "public/": false, "": false, "other/": false,
+		} {
+			allow, _ := policy.Eval("", prefix)
+			Expect(allow.Has(apc.AceObjLIST)).To(Equal(expected), "prefix %q", prefix)
+		}
+	})
+
+	DescribeTable("should reject invalid policies",
+		func(stmt string) {
+			_, err := s3.ParsePolicy("bck", []byte(`{"Version": "2012-10-17", "Statement": [`+stmt+`]}`))
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("no statements", ""),
+		Entry("invalid effect",
+			`{"Effect": "Maybe", "Principal": "*", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::bck/*"}`),
+		Entry("unknown action",
+			`{"Effect": "Allow", "Principal": "*", "Action": "s3:Fly", "Resource": "arn:aws:s3:::bck/*"}`),
+		Entry("other bucket",
+			`{"Effect": "Allow", "Principal": "*", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::other/*"}`),
+		Entry("inner wildcard",
+			`{"Effect": "Allow", "Principal": "*", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::bck/*/a"}`),
+		Entry("unsupported condition",
+			`{"Effect": "Allow", "Principal": "*", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::bck/*",
+			  "Condition": {"IpAddress": {"aws:SourceIp": "10.0.0.0/8"}}}`),
+		Entry("prefix condition on object resource",
+			`{"Effect": "Allow", "Principal": "*", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::bck/*",
+			  "Condition": {"StringLike": {"s3:prefix": "home/*"}}}`),
+		Entry("object actions on bucket resource",
+			`{"Effect": "Allow", "Principal": "*", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::bck"}`),
+	)
+})
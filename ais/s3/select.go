@@ -0,0 +1,267 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/binary"
+	"encoding/xml"
+	"errors"
+	"hash/crc32"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/sqlsel"
+)
+
+// SelectObjectContent
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_SelectObjectContent.html
+// https://docs.aws.amazon.com/AmazonS3/latest/API/RESTSelectObjectAppendix.html
+
+const (
+	CompressionNone  = "NONE"
+	CompressionGzip  = "GZIP"
+	CompressionBzip2 = "BZIP2"
+)
+
+type (
+	SelectRequest struct {
+		XMLName             xml.Name       `xml:"SelectObjectContentRequest"`
+		ScanRange           *ScanRange     `xml:"ScanRange"`
+		Expression          string         `xml:"Expression"`
+		ExpressionType      string         `xml:"ExpressionType"`
+		InputSerialization  SelectInput    `xml:"InputSerialization"`
+		OutputSerialization SelectOutput   `xml:"OutputSerialization"`
+		RequestProgress     SelectProgress `xml:"RequestProgress"`
+	}
+	SelectInput struct {
+		CSV             *sqlsel.CSVInput  `xml:"CSV"`
+		JSON            *sqlsel.JSONInput `xml:"JSON"`
+		Parquet         *struct{}         `xml:"Parquet"`
+		CompressionType string            `xml:"CompressionType"`
+	}
+	SelectOutput struct {
+		CSV  *sqlsel.CSVOutput  `xml:"CSV"`
+		JSON *sqlsel.JSONOutput `xml:"JSON"`
+	}
+	SelectProgress struct {
+		Enabled bool `xml:"Enabled"`
+	}
+	ScanRange struct {
+		Start *int64 `xml:"Start"`
+		End   *int64 `xml:"End"`
+	}
+	// (see EventWriter.Progress and EventWriter.End)
+	SelectStats struct {
+		BytesScanned   int64 `xml:"BytesScanned"`
+		BytesProcessed int64 `xml:"BytesProcessed"`
+		BytesReturned  int64 `xml:"BytesReturned"`
+	}
+)
+
+func (req *SelectRequest) Validate() error {
+	if !strings.EqualFold(req.ExpressionType, "SQL") {
+		return NewErrCode("InvalidExpressionType", "expression type must be SQL, got '"+req.ExpressionType+"'")
+	}
+	in := &req.InputSerialization
+	switch {
+	case in.Parquet != nil:
+		return NewErrCode("NotImplemented", "Parquet input is not supported")
+	case (in.CSV == nil) == (in.JSON == nil):
+		return NewErrCode("InvalidRequest", "input serialization must specify either CSV or JSON")
+	}
+	switch strings.ToUpper(in.CompressionType) {
+	case "":
+		in.CompressionType = CompressionNone
+	case CompressionNone, CompressionGzip, CompressionBzip2:
+		in.CompressionType = strings.ToUpper(in.CompressionType)
+	default:
+		return NewErrCode("InvalidCompressionFormat", "invalid compression type '"+in.CompressionType+"'")
+	}
+	out := &req.OutputSerialization
+	if (out.CSV == nil) == (out.JSON == nil) {
+		return NewErrCode("InvalidRequest", "output serialization must specify either CSV or JSON")
+	}
+	if req.ScanRange != nil {
+		switch {
+		case in.CompressionType != CompressionNone:
+			return NewErrCode("UnsupportedScanRangeInput", "scan range is not supported for compressed objects")
+		case in.JSON != nil && !strings.EqualFold(in.JSON.Type, sqlsel.JSONLines):
+			return NewErrCode("UnsupportedScanRangeInput", "scan range requires JSON lines (or CSV) input")
+		case req.ScanRange.Start == nil && req.ScanRange.End == nil:
+			return NewErrCode("InvalidRequestParameter", "scan range must specify Start and/or End")
+		}
+	}
+	return nil
+}
+
+// [start, end] given object size
+func (sr *ScanRange) Range(size int64) (start, end int64, _ error) {
+	switch {
+	case sr.Start == nil:
+		// the last End bytes
+		start, end = max(size-*sr.End, 0), size-1
+	case sr.End == nil:
+		start, end = *sr.Start, size-1
+	default:
+		start, end = *sr.Start, *sr.End
+	}
+	if start < 0 || end < start-1 {
+		return 0, 0, NewErrCode("InvalidRequestParameter", "invalid scan range")
+	}
+	return start, end, nil
+}
+
+/////////////////
+// EventWriter //
+/////////////////
+
+// EventWriter encodes the SelectObjectContent response: a sequence of binary
+// messages ("event stream") carrying Records, Cont (keep-alive), Progress, Stats,
+// and End events - or an error.
+//
+//	[total length (4)] [headers length (4)] [prelude CRC (4)] [headers] [payload] [message CRC (4)]
+//
+// Buffers Records payload to send it in chunks; not thread-safe.
+type EventWriter struct {
+	w        io.Writer
+	last     time.Time
+	buf      []byte
+	msg      []byte
+	returned int64
+}
+
+const (
+	recordsChunk = 128 * 1024
+	contInterval = 10 * time.Second // must be less than (typical) client's read timeout
+
+	hdrTypeString = 7
+)
+
+// interface guard
+var _ io.Writer = (*EventWriter)(nil)
+
+func NewEventWriter(w io.Writer) *EventWriter {
+	return &EventWriter{w: w, last: time.Now(), buf: make([]byte, 0, recordsChunk)}
+}
+
+func (ew *EventWriter) Returned() int64 { return ew.returned }
+
+// records
+func (ew *EventWriter) Write(p []byte) (int, error) {
+	ew.buf = append(ew.buf, p...)
+	ew.returned += int64(len(p))
+	if len(ew.buf) >= recordsChunk {
+		if err := ew.Flush(); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+// send buffered records, if any
+func (ew *EventWriter) Flush() error {
+	if len(ew.buf) == 0 {
+		return nil
+	}
+	err := ew.event("Records", "application/octet-stream", ew.buf)
+	ew.buf = ew.buf[:0]
+	return err
+}
+
+// keep the connection alive while scanning (with no records to send)
+func (ew *EventWriter) KeepAlive() error {
+	if time.Since(ew.last) < contInterval {
+		return nil
+	}
+	if len(ew.buf) > 0 {
+		return ew.Flush()
+	}
+	return ew.event("Cont", "", nil)
+}
+
+func (ew *EventWriter) Progress(stats *SelectStats) error {
+	return ew.stats("Progress", stats)
+}
+
+// final Stats, followed by End
+func (ew *EventWriter) End(stats *SelectStats) error {
+	if err := ew.Flush(); err != nil {
+		return err
+	}
+	if err := ew.stats("Stats", stats); err != nil {
+		return err
+	}
+	return ew.event("End", "", nil)
+}
+
+// terminates the stream; buffered records are discarded
+func (ew *EventWriter) Error(err error) error {
+	code, msg := "InternalError", err.Error()
+	var (
+		ecode *ErrCode
+		serr  *sqlsel.Error
+	)
+	switch {
+	case errors.As(err, &serr):
+		code, msg = serr.Code, serr.Msg
+	case errors.As(err, &ecode):
+		code = ecode.code
+	}
+	ew.buf = ew.buf[:0]
+	return ew.send([][2]string{{":error-code", code}, {":error-message", msg}, {":message-type", "error"}}, nil)
+}
+
+func (ew *EventWriter) stats(event string, stats *SelectStats) error {
+	var sb strings.Builder
+	if err := xml.NewEncoder(&sb).EncodeElement(stats, xml.StartElement{Name: xml.Name{Local: event}}); err != nil {
+		return err
+	}
+	return ew.event(event, "text/xml", []byte(sb.String()))
+}
+
+func (ew *EventWriter) event(event, ctype string, payload []byte) error {
+	hdrs := make([][2]string, 0, 3)
+	hdrs = append(hdrs, [2]string{":event-type", event})
+	if ctype != "" {
+		hdrs = append(hdrs, [2]string{":content-type", ctype})
+	}
+	hdrs = append(hdrs, [2]string{":message-type", "event"})
+	return ew.send(hdrs, payload)
+}
+
+func (ew *EventWriter) send(hdrs [][2]string, payload []byte) error {
+	ew.msg = AppendEventMsg(ew.msg[:0], hdrs, payload)
+	if _, err := ew.w.Write(ew.msg); err != nil {
+		return err
+	}
+	if f, ok := ew.w.(http.Flusher); ok {
+		f.Flush()
+	}
+	ew.last = time.Now()
+	return nil
+}
+
+func AppendEventMsg(b []byte, hdrs [][2]string, payload []byte) []byte {
+	var hlen int
+	for _, h := range hdrs {
+		hlen += 1 + len(h[0]) + 1 + 2 + len(h[1])
+	}
+	total := 12 + hlen + len(payload) + 4
+	off := len(b)
+	b = binary.BigEndian.AppendUint32(b, uint32(total))
+	b = binary.BigEndian.AppendUint32(b, uint32(hlen))
+	b = binary.BigEndian.AppendUint32(b, crc32.ChecksumIEEE(b[off:off+8]))
+	for _, h := range hdrs {
+		b = append(b, byte(len(h[0])))
+		b = append(b, h[0]...)
+		b = append(b, hdrTypeString)
+		b = binary.BigEndian.AppendUint16(b, uint16(len(h[1])))
+		b = append(b, h[1]...)
+	}
+	b = append(b, payload...)
+	return binary.BigEndian.AppendUint32(b, crc32.ChecksumIEEE(b[off:]))
+}
@@ -0,0 +1,132 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/xml"
+	"errors"
+	"hash/crc32"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/cmn/sqlsel"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type eventMsg struct {
+	hdrs    map[string]string
+	payload []byte
+}
+
+// decode and validate event stream
+func decodeEvents(b []byte) (msgs []eventMsg) {
+	for len(b) > 0 {
+		Expect(len(b)).To(BeNumerically(">=", 16))
+		total := binary.BigEndian.Uint32(b)
+		hlen := binary.BigEndian.Uint32(b[4:])
+		Expect(binary.BigEndian.Uint32(b[8:])).To(Equal(crc32.ChecksumIEEE(b[:8])))
+		Expect(int(total)).To(BeNumerically("<=", len(b)))
+		Expect(binary.BigEndian.Uint32(b[total-4:])).To(Equal(crc32.ChecksumIEEE(b[:total-4])))
+
+		msg := eventMsg{hdrs: make(map[string]string)}
+		for h := b[12 : 12+hlen]; len(h) > 0; {
+			nlen := int(h[0])
+			name := string(h[1 : 1+nlen])
+			Expect(h[1+nlen]).To(BeEquivalentTo(7))
+			vlen := int(binary.BigEndian.Uint16(h[2+nlen:]))
+			msg.hdrs[name] = string(h[4+nlen : 4+nlen+vlen])
+			h = h[4+nlen+vlen:]
+		}
+		msg.payload = b[12+hlen : total-4]
+		msgs = append(msgs, msg)
+		b = b[total:]
+	}
+	return msgs
+}
+
+var _ = Describe("SelectObjectContent", func() {
+	const body = `<SelectObjectContentRequest xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+  <Expression>SELECT s._1 FROM S3Object s</Expression>
+  <ExpressionType>SQL</ExpressionType>
+  <InputSerialization>
+    <CompressionType>gzip</CompressionType>
+    <CSV><FileHeaderInfo>USE</FileHeaderInfo><FieldDelimiter>|</FieldDelimiter></CSV>
+  </InputSerialization>
+  <OutputSerialization><JSON><RecordDelimiter>,</RecordDelimiter></JSON></OutputSerialization>
+  <RequestProgress><Enabled>true</Enabled></RequestProgress>
+</SelectObjectContentRequest>`
+
+	It("should parse and validate request", func() {
+		req := &s3.SelectRequest{}
+		Expect(xml.Unmarshal([]byte(body), req)).NotTo(HaveOccurred())
+		Expect(req.Validate()).NotTo(HaveOccurred())
+		Expect(req.Expression).To(Equal("SELECT s._1 FROM S3Object s"))
+		Expect(req.InputSerialization.CompressionType).To(Equal(s3.CompressionGzip))
+		Expect(req.InputSerialization.CSV.FileHeaderInfo).To(Equal(sqlsel.HeaderUse))
+		Expect(req.InputSerialization.CSV.FieldDelimiter).To(Equal("|"))
+		Expect(req.OutputSerialization.JSON.RecordDelimiter).To(Equal(","))
+		Expect(req.RequestProgress.Enabled).To(BeTrue())
+
+		// scan range of a compressed object
+		start := int64(10)
+		req.ScanRange = &s3.ScanRange{Start: &start}
+		Expect(req.Validate()).To(HaveOccurred())
+
+		req = &s3.SelectRequest{ExpressionType: "SQL"}
+		Expect(req.Validate()).To(HaveOccurred()) // neither CSV nor JSON
+	})
+
+	It("should resolve scan range", func() {
+		start, end := int64(10), int64(20)
+		for _, test := range []struct {
+			sr         s3.ScanRange
+			start, end int64
+		}{
+			{s3.ScanRange{Start: &start, End: &end}, 10, 20},
+			{s3.ScanRange{Start: &start}, 10, 99},
+			{s3.ScanRange{End: &end}, 80, 99},
+		} {
+			s, e, err := test.sr.Range(100)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(s).To(Equal(test.start))
+			Expect(e).To(Equal(test.end))
+		}
+	})
+
+	It("should encode event stream", func() {
+		var (
+			out   bytes.Buffer
+			ew    = s3.NewEventWriter(&out)
+			large = bytes.Repeat([]byte("x"), 200*1024)
+		)
+		_, err := ew.Write([]byte("a,b\n"))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = ew.Write(large)
+		Expect(err).NotTo(HaveOccurred())
+		stats := &s3.SelectStats{BytesScanned: 1, BytesProcessed: 2, BytesReturned: ew.Returned()}
+		Expect(ew.End(stats)).NotTo(HaveOccurred())
+
+		msgs := decodeEvents(out.Bytes())
+		Expect(msgs).To(HaveLen(3))
+		Expect(msgs[0].hdrs).To(HaveKeyWithValue(":event-type", "Records"))
+		Expect(msgs[0].hdrs).To(HaveKeyWithValue(":message-type", "event"))
+		Expect(msgs[0].payload).To(Equal(append([]byte("a,b\n"), large...)))
+		Expect(msgs[1].hdrs).To(HaveKeyWithValue(":event-type", "Stats"))
+		Expect(string(msgs[1].payload)).To(ContainSubstring("<BytesReturned>204804</BytesReturned>"))
+		Expect(msgs[2].hdrs).To(HaveKeyWithValue(":event-type", "End"))
+		Expect(msgs[2].payload).To(BeEmpty())
+
+		out.Reset()
+		Expect(ew.Error(errors.New("oops"))).NotTo(HaveOccurred())
+		msgs = decodeEvents(out.Bytes())
+		Expect(msgs).To(HaveLen(1))
+		Expect(msgs[0].hdrs).To(HaveKeyWithValue(":message-type", "error"))
+		Expect(msgs[0].hdrs).To(HaveKeyWithValue(":error-code", "InternalError"))
+		Expect(msgs[0].hdrs).To(HaveKeyWithValue(":error-message", "oops"))
+	})
+})
@@ -0,0 +1,118 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/sse"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// Server-side encryption (see cmn/encryption.go):
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutBucketEncryption.html
+// https://docs.aws.amazon.com/AmazonS3/latest/userguide/ServerSideEncryptionCustomerKeys.html
+//
+// "aws:kms" with KMSMasterKeyID selects a specific key of the (cluster-managed)
+// key provider; "AES256" - the provider's current key.
+
+const sseAlgKMS = "aws:kms"
+
+type (
+	ServerSideEncryptionConfiguration struct {
+		XMLName xml.Name  `xml:"ServerSideEncryptionConfiguration"`
+		Rules   []SSERule `xml:"Rule"`
+	}
+	SSERule struct {
+		Default          SSEDefault `xml:"ApplyServerSideEncryptionByDefault"`
+		BucketKeyEnabled bool       `xml:"BucketKeyEnabled,omitempty"`
+	}
+	SSEDefault struct {
+		SSEAlgorithm   string `xml:"SSEAlgorithm"`
+		KMSMasterKeyID string `xml:"KMSMasterKeyID,omitempty"`
+	}
+)
+
+func NewSSEConfiguration(conf *cmn.SSEConf) *ServerSideEncryptionConfiguration {
+	debug.Assert(conf != nil)
+	rule := SSERule{Default: SSEDefault{SSEAlgorithm: cmn.SSEAlgAES256}}
+	if conf.KeyID != "" {
+		rule.Default = SSEDefault{SSEAlgorithm: sseAlgKMS, KMSMasterKeyID: conf.KeyID}
+	}
+	return &ServerSideEncryptionConfiguration{Rules: []SSERule{rule}}
+}
+
+func (r *ServerSideEncryptionConfiguration) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
+func (r *ServerSideEncryptionConfiguration) ToConf() (*cmn.SSEConf, error) {
+	if len(r.Rules) != 1 {
+		return nil, fmt.Errorf("expecting exactly one encryption rule, got %d", len(r.Rules))
+	}
+	dflt := &r.Rules[0].Default
+	switch dflt.SSEAlgorithm {
+	case cmn.SSEAlgAES256:
+		if dflt.KMSMasterKeyID != "" {
+			return nil, errors.New("KMSMasterKeyID requires " + sseAlgKMS)
+		}
+		return &cmn.SSEConf{}, nil
+	case sseAlgKMS:
+		return &cmn.SSEConf{KeyID: dflt.KMSMasterKeyID}, nil
+	default:
+		return nil, fmt.Errorf("unsupported SSEAlgorithm %q", dflt.SSEAlgorithm)
+	}
+}
+
+// PUT: whether SSE-S3 (cluster-managed keys) is requested
+func SSEFromHeader(hdr http.Header) (bool, error) {
+	switch alg := hdr.Get(HeaderSSE); alg {
+	case "":
+		return false, nil
+	case cmn.SSEAlgAES256, sseAlgKMS:
+		return true, nil
+	default:
+		return false, NewErrCode("InvalidArgument", fmt.Sprintf("unsupported %s: %q", HeaderSSE, alg))
+	}
+}
+
+// SSE-C: customer-provided key, if any
+func SSECFromHeader(hdr http.Header) (*sse.CustKey, error) {
+	alg := hdr.Get(HeaderSSECAlgorithm)
+	if alg == "" {
+		if hdr.Get(HeaderSSECKey) != "" {
+			return nil, NewErrCode("InvalidArgument", HeaderSSECKey+" requires "+HeaderSSECAlgorithm)
+		}
+		return nil, nil
+	}
+	if alg != cmn.SSEAlgAES256 {
+		return nil, NewErrCode("InvalidEncryptionAlgorithmError", fmt.Sprintf("unsupported %s: %q", HeaderSSECAlgorithm, alg))
+	}
+	ck, err := sse.NewCustKey(hdr.Get(HeaderSSECKey), hdr.Get(HeaderSSECKeyMD5))
+	if err != nil {
+		return nil, NewErrCode("InvalidArgument", err.Error())
+	}
+	return ck, nil
+}
+
+func setSSEHeaders(hdr http.Header, lom *core.LOM) {
+	if !lom.IsEncrypted() {
+		return
+	}
+	if md5 := lom.SSECustMD5(); md5 != "" {
+		hdr.Set(HeaderSSECAlgorithm, cmn.SSEAlgAES256)
+		hdr.Set(HeaderSSECKeyMD5, md5)
+	} else {
+		hdr.Set(HeaderSSE, cmn.SSEAlgAES256)
+	}
+}
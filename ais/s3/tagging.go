@@ -0,0 +1,63 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"net/url"
+	"sort"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutObjectTagging.html
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetObjectTagging.html
+
+type (
+	Tagging struct {
+		XMLName xml.Name `xml:"Tagging"`
+		TagSet  []Tag    `xml:"TagSet>Tag"`
+	}
+	Tag struct {
+		Key   string `xml:"Key"`
+		Value string `xml:"Value"`
+	}
+)
+
+// from url-encoded object tags (see cmn.TagsObjMD)
+func NewTagging(encoded string) (*Tagging, error) {
+	r := &Tagging{TagSet: []Tag{}}
+	if encoded == "" {
+		return r, nil
+	}
+	tags, err := url.ParseQuery(encoded)
+	if err != nil {
+		return nil, err
+	}
+	for k, vs := range tags {
+		for _, v := range vs {
+			r.TagSet = append(r.TagSet, Tag{Key: k, Value: v})
+		}
+	}
+	sort.Slice(r.TagSet, func(i, j int) bool { return r.TagSet[i].Key < r.TagSet[j].Key })
+	return r, nil
+}
+
+func (r *Tagging) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
+// convert and validate
+func (r *Tagging) ToTags() (url.Values, error) {
+	tags := make(url.Values, len(r.TagSet))
+	for _, tag := range r.TagSet {
+		tags.Add(tag.Key, tag.Value)
+	}
+	return tags, cmn.ValidateObjTags(tags)
+}
@@ -0,0 +1,64 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3_test
+
+import (
+	"encoding/xml"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/cmn"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Tagging", func() {
+	const body = `<Tagging><TagSet>
+  <Tag><Key>split</Key><Value>train</Value></Tag>
+  <Tag><Key>license</Key><Value>cc by-4.0</Value></Tag>
+  <Tag><Key>pii</Key><Value></Value></Tag>
+</TagSet></Tagging>`
+
+	It("should convert, filter, and convert back", func() {
+		tagging := &s3.Tagging{}
+		Expect(xml.Unmarshal([]byte(body), tagging)).NotTo(HaveOccurred())
+		tags, err := tagging.ToTags()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tags).To(HaveLen(3))
+
+		encoded := tags.Encode()
+		Expect(cmn.MatchObjTags(encoded, "split=train")).To(BeTrue())
+		Expect(cmn.MatchObjTags(encoded, "split=test")).To(BeFalse())
+		Expect(cmn.MatchObjTags(encoded, "license=cc by-4.0")).To(BeTrue())
+		Expect(cmn.MatchObjTags(encoded, "pii")).To(BeTrue())
+		Expect(cmn.MatchObjTags(encoded, "pii=")).To(BeTrue())
+		Expect(cmn.MatchObjTags(encoded, "owner")).To(BeFalse())
+		Expect(cmn.MatchObjTags("", "split")).To(BeFalse())
+
+		back, err := s3.NewTagging(encoded)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(back.TagSet).To(HaveLen(3))
+		Expect(back.TagSet[0]).To(Equal(s3.Tag{Key: "license", Value: "cc by-4.0"}))
+	})
+
+	It("should enforce limits", func() {
+		tagging := &s3.Tagging{}
+		for i := range cmn.MaxObjTags + 1 {
+			tagging.TagSet = append(tagging.TagSet, s3.Tag{Key: "k" + strconv.Itoa(i)})
+		}
+		_, err := tagging.ToTags()
+		Expect(err).To(HaveOccurred())
+
+		tagging.TagSet = []s3.Tag{{Key: "a"}, {Key: "a"}}
+		_, err = tagging.ToTags()
+		Expect(err).To(HaveOccurred())
+
+		tagging.TagSet = []s3.Tag{{Key: strings.Repeat("k", cmn.MaxObjTagKeyLen+1)}}
+		_, err = tagging.ToTags()
+		Expect(err).To(HaveOccurred())
+	})
+})
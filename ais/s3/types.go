@@ -218,6 +218,11 @@ func SetS3Headers(hdr http.Header, lom *core.LOM) {
 		if v, exists := lom.GetCustomKey(cmn.VersionObjMD); exists {
 			// NOTE: could this `cmn.VersionObjMD` value be the result of original GET from gs:// bucket, for instance?
 			hdr.Set(cos.S3VersionHeader, v)
+		} else if lom.Bck().IsAIS() && lom.VersionConf().Retain > 0 {
+			// ais:// object versions that can be accessed by versionId
+			if v := lom.Version(true); v != "" {
+				hdr.Set(cos.S3VersionHeader, v)
+			}
 		}
 	}
 	for k, v := range lom.GetCustomMD() {
@@ -225,6 +230,7 @@ func SetS3Headers(hdr http.Header, lom *core.LOM) {
 			hdr.Set(k, v)
 		}
 	}
+	setSSEHeaders(hdr, lom)
 }
 
 func (r *CopyObjectResult) MustMarshal(sgl *memsys.SGL) {
@@ -0,0 +1,94 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_ListObjectVersions.html
+// - current versions come from list-objects with apc.LsVersions flag,
+//   prior versions from the respective `cmn.LsoEnt.Versions`
+// - no delete markers: deleting an object without versionId removes all its versions
+
+const versionNull = "null" // (unversioned)
+
+type (
+	ListVersionsResult struct {
+		Name            string          `xml:"Name"`
+		Ns              string          `xml:"xmlns,attr"`
+		Prefix          string          `xml:"Prefix"`
+		KeyMarker       string          `xml:"KeyMarker"`
+		VersionIDMarker string          `xml:"VersionIdMarker"`
+		NextKeyMarker   string          `xml:"NextKeyMarker,omitempty"`
+		Versions        []*ObjVerInfo   `xml:"Version"`
+		CommonPrefixes  []*CommonPrefix `xml:"CommonPrefixes,omitempty"`
+		MaxKeys         int             `xml:"MaxKeys"`
+		IsTruncated     bool            `xml:"IsTruncated"`
+	}
+	ObjVerInfo struct {
+		Key          string `xml:"Key"`
+		VersionID    string `xml:"VersionId"`
+		LastModified string `xml:"LastModified"`
+		ETag         string `xml:"ETag,omitempty"`
+		Class        string `xml:"StorageClass"`
+		Size         int64  `xml:"Size"`
+		IsLatest     bool   `xml:"IsLatest"`
+	}
+)
+
+func NewListVersionsResult(bucket string, lsmsg *apc.LsoMsg) *ListVersionsResult {
+	return &ListVersionsResult{
+		Name:      bucket,
+		Ns:        s3Namespace,
+		Prefix:    lsmsg.Prefix,
+		KeyMarker: lsmsg.StartAfter,
+		MaxKeys:   apc.MaxPageSizeAWS,
+		Versions:  make([]*ObjVerInfo, 0, apc.MaxPageSizeAWS),
+	}
+}
+
+func (r *ListVersionsResult) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
+func (r *ListVersionsResult) FromLsoResult(lst *cmn.LsoRes, lsmsg *apc.LsoMsg) {
+	r.IsTruncated = lst.ContinuationToken != ""
+	r.NextKeyMarker = lst.ContinuationToken
+	for _, e := range lst.Entries {
+		if e.Flags&apc.EntryIsDir != 0 {
+			prefix := e.Name
+			if prefix[len(prefix)-1] != '/' {
+				prefix += "/"
+			}
+			r.CommonPrefixes = append(r.CommonPrefixes, &CommonPrefix{Prefix: prefix})
+			continue
+		}
+		oi := entryToS3(e, lsmsg)
+		curr := &ObjVerInfo{
+			Key:          oi.Key,
+			VersionID:    e.Version,
+			LastModified: oi.LastModified,
+			ETag:         oi.ETag,
+			Size:         oi.Size,
+			IsLatest:     true,
+		}
+		if curr.VersionID == "" {
+			curr.VersionID = versionNull
+		}
+		r.Versions = append(r.Versions, curr)
+		for i := range e.Versions {
+			v := &e.Versions[i]
+			r.Versions = append(r.Versions, &ObjVerInfo{Key: e.Name, VersionID: v.Version, LastModified: v.Mtime, Size: v.Size})
+		}
+	}
+}
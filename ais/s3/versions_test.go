@@ -0,0 +1,62 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3_test
+
+import (
+	"encoding/xml"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/memsys"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ListObjectVersions", func() {
+	It("should list current and prior versions", func() {
+		lst := &cmn.LsoRes{
+			Entries: cmn.LsoEntries{
+				{Name: "dir", Flags: apc.EntryIsDir},
+				{
+					Name: "a", Version: "3", Size: 30, Atime: "t3",
+					Versions: []cmn.LsoVer{{Version: "2", Size: 20, Mtime: "t2"}, {Version: "1", Size: 10, Mtime: "t1"}},
+				},
+				{Name: "b", Size: 5, Atime: "t0"},
+			},
+			ContinuationToken: "b",
+		}
+		lsmsg := &apc.LsoMsg{Prefix: ""}
+		res := s3.NewListVersionsResult("bck", lsmsg)
+		res.FromLsoResult(lst, lsmsg)
+
+		Expect(res.IsTruncated).To(BeTrue())
+		Expect(res.NextKeyMarker).To(Equal("b"))
+		Expect(res.CommonPrefixes).To(HaveLen(1))
+		Expect(res.CommonPrefixes[0].Prefix).To(Equal("dir/"))
+
+		Expect(res.Versions).To(HaveLen(4))
+		vers := make([]string, 0, 4)
+		for _, v := range res.Versions {
+			vers = append(vers, v.Key+"@"+v.VersionID)
+		}
+		Expect(vers).To(Equal([]string{"a@3", "a@2", "a@1", "b@null"}))
+		Expect(res.Versions[0].IsLatest).To(BeTrue())
+		Expect(res.Versions[1].IsLatest).To(BeFalse())
+		Expect(res.Versions[1].Size).To(BeEquivalentTo(20))
+		Expect(res.Versions[2].LastModified).To(Equal("t1"))
+		Expect(res.Versions[3].IsLatest).To(BeTrue())
+
+		mm := memsys.PageMM()
+		sgl := mm.NewSGL(0)
+		defer sgl.Free()
+		res.MustMarshal(sgl)
+		out := &s3.ListVersionsResult{}
+		Expect(xml.Unmarshal(sgl.Bytes(), out)).NotTo(HaveOccurred())
+		Expect(out.Versions).To(HaveLen(4))
+		Expect(out.Versions[3].VersionID).To(Equal("null"))
+	})
+})
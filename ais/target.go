@@ -20,6 +20,7 @@ import (
 	"github.com/NVIDIA/aistore/ais/backend"
 	"github.com/NVIDIA/aistore/ais/s3"
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/api/env"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/archive"
 	"github.com/NVIDIA/aistore/cmn/atomic"
@@ -30,6 +31,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/kvdb"
 	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/cmn/sse"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/ec"
@@ -70,6 +72,8 @@ type (
 		res          *res.Res
 		transactions transactions
 		regstate     regstate
+		wbq          wbQueue   // write-back (see tgtwb.go)
+		replq        replQueue // cross-cluster replication (see tgtrepl.go)
 	}
 )
 
@@ -140,6 +144,8 @@ func (t *target) initBuiltTagged(tstats *stats.Trunner, config *cmn.Config, star
 			add, err = backend.NewOCI(t, tstats, startingUp)
 		case apc.HT:
 			add, err = backend.NewHT(t, config, tstats, startingUp)
+		case apc.File:
+			add, err = backend.NewFile(t, tstats, startingUp)
 		case apc.AIS:
 			continue
 		default:
@@ -250,6 +256,22 @@ func (t *target) init(config *cmn.Config) {
 	if err := ts.InitCDF(config); err != nil {
 		cos.ExitLog(err)
 	}
+
+	initSSE()
+}
+
+// server-side encryption: cluster-managed keys (see cmn/encryption.go)
+func initSSE() {
+	fqn := os.Getenv(env.AisSSEKeyring)
+	if fqn == "" {
+		return
+	}
+	kr, err := sse.LoadKeyring(fqn)
+	if err != nil {
+		cos.ExitLog(err) // FATAL
+	}
+	sse.Init(kr)
+	nlog.Infoln("SSE:", kr.Name())
 }
 
 func (t *target) initHostIP(config *cmn.Config) {
@@ -337,6 +359,7 @@ func (t *target) Run() error {
 	// register object type and workfile type
 	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{})
 	fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{})
+	fs.CSM.Reg(fs.ObjVersionType, &fs.ObjVersionContentResolver{})
 
 	// Init meta-owners and load local instances
 	if prev := t.owner.bmd.init(); prev {
@@ -392,6 +415,8 @@ func (t *target) Run() error {
 	}
 
 	t.transactions.init(t)
+	t.wbq.init(t, db)
+	t.replq.init(t, db)
 
 	t.reb = reb.New(config)
 	t.res = res.New()
@@ -403,6 +428,10 @@ func (t *target) Run() error {
 	mirror.Init()
 
 	xreg.RegWithHK()
+	t.regLifecycle()
+	t.regInventory()
+	t.regTierMigrate()
+	t.regEvents()
 
 	marked := xreg.GetResilverMarked()
 	if marked.Interrupted || daemon.resilver.required {
@@ -415,6 +444,8 @@ func (t *target) Run() error {
 	err = t.htrun.run(config)
 
 	etl.StopAll() // stop all running ETLs if any
+	t.wbq.stop()
+	t.replq.stop()
 	cos.Close(db) // close kv db
 
 	// gracefully
@@ -509,6 +540,7 @@ func (t *target) initRecvHandlers() {
 		{r: apc.Download, h: t.downloadHandler, net: accessNetIntraControl},
 		{r: apc.Sort, h: dsort.TargetHandler, net: accessControlData},
 		{r: apc.ETL, h: t.etlHandler, net: accessNetAll},
+		{r: apc.Events, h: t.eventsHandler, net: accessNetIntraControl},
 
 		{r: "/" + apc.S3, h: t.s3Handler, net: accessNetPublicData},
 		{r: "/", h: t.errURL, net: accessNetAll},
@@ -620,6 +652,9 @@ func (t *target) bucketHandler(w http.ResponseWriter, r *http.Request) {
 
 // verb /v1/objects
 func (t *target) objectHandler(w http.ResponseWriter, r *http.Request) {
+	if t.corsHandler(w, r, false /*s3*/) {
+		return
+	}
 	switch r.Method {
 	case http.MethodGet:
 		apireq := apiReqAlloc(2, apc.URLPathObjects.L, true /*dpq*/)
@@ -705,6 +740,13 @@ func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck
 		}
 	}
 
+	// specific version
+	if dpq.objVer != "" {
+		if done, err := t.getObjVer(w, r, bck, lom, dpq.objVer, dpq.isS3); done {
+			return lom, err
+		}
+	}
+
 	// two special flows
 	if dpq.etlName != "" {
 		t.getETL(w, r, dpq.etlName, lom)
@@ -748,6 +790,7 @@ func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck
 		goi.w = w
 		goi.ctx = context.Background()
 		goi.ranges = byteRanges{Range: r.Header.Get(cos.HdrRange), Size: 0}
+		goi.pc = cmn.PrecondsFromHeader(r.Header)
 		goi.latestVer = _validateWarmGet(goi.lom, dpq.latestVer) // apc.QparamLatestVer || versioning.*_warm_get
 	}
 	if dpq.isArch() {
@@ -773,7 +816,10 @@ func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck
 	// do
 	if ecode, err := goi.getObject(); err != nil {
 		vlabs := map[string]string{stats.VarlabBucket: bck.Cname("")}
-		if goi.isIOErr {
+		switch {
+		case ecode == http.StatusNotModified:
+			// not counting
+		case goi.isIOErr:
 			t.statsT.AddWith(
 				cos.NamedVal64{Name: stats.ErrGetCount, Value: 1, VarLabs: vlabs},
 				cos.NamedVal64{Name: stats.IOErrGetCount, Value: 1, VarLabs: vlabs},
@@ -781,7 +827,7 @@ func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck
 			if cmn.Rom.FastV(4, cos.SmoduleAIS) {
 				nlog.Warningln("io-error [", err, "]", goi.lom.String())
 			}
-		} else {
+		default:
 			t.statsT.AddWith(
 				cos.NamedVal64{Name: stats.ErrGetCount, Value: 1, VarLabs: vlabs},
 			)
@@ -812,6 +858,18 @@ func _validateWarmGet(lom *core.LOM, latestVer bool /*apc.QparamLatestVer*/) boo
 	}
 }
 
+// conditional requests: 304 (Not Modified) or 412 (Precondition Failed)
+func precondStatus(err error) int {
+	switch {
+	case cmn.IsErrNotModified(err):
+		return http.StatusNotModified
+	case cmn.IsErrPrecondFailed(err):
+		return http.StatusPreconditionFailed
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
 func (t *target) _erris(w http.ResponseWriter, r *http.Request, err error, code int, silent bool) {
 	if silent { // e.g,. apc.QparamSilent, StatusNotFound
 		t.writeErr(w, r, err, code, Silent)
@@ -946,7 +1004,23 @@ func (t *target) httpobjdelete(w http.ResponseWriter, r *http.Request, apireq *a
 		return
 	}
 
-	ecode, err := t.DeleteObject(lom, evict)
+	var (
+		ecode int
+		err   error
+	)
+	if ver := apireq.query.Get(apc.QparamObjVersion); ver != "" && !evict {
+		ecode, err = t.delObjVer(apireq.bck, lom, ver, false /*bypass governance*/)
+		if err != nil {
+			t.writeErr(w, r, err, ecode)
+		}
+		core.FreeLOM(lom)
+		return
+	}
+	if stamp := r.Header.Get(apc.HdrReplStamp); stamp != "" && !evict {
+		ecode, err = t.delReplicated(lom, stamp)
+	} else {
+		ecode, err = t.deleteObject(lom, evict, false /*bypass governance*/, cmn.PrecondsFromHeader(r.Header))
+	}
 	if err == nil && ecode == 0 {
 		// EC cleanup if EC is enabled
 		ec.ECM.CleanupObject(lom)
@@ -1043,6 +1117,21 @@ func (t *target) httpobjhead(w http.ResponseWriter, r *http.Request, apireq *api
 		}
 	}
 	lom := core.AllocLOM(objName)
+	if ver := query.Get(apc.QparamObjVersion); ver != "" {
+		if err := lom.InitBck(bck.Bucket()); err != nil {
+			t.writeErr(w, r, err)
+			core.FreeLOM(lom)
+			return
+		}
+		done, err := t.getObjVer(w, r, bck, lom, ver, false /*s3*/)
+		if done {
+			if err != nil {
+				t._erris(w, r, err, 0, cos.IsParseBool(query.Get(apc.QparamSilent)))
+			}
+			core.FreeLOM(lom)
+			return
+		}
+	}
 	ecode, err := t.objHead(r, w.Header(), query, bck, lom)
 	core.FreeLOM(lom)
 	if err != nil {
@@ -1155,6 +1244,18 @@ func (t *target) objHead(r *http.Request, whdr http.Header, q url.Values, bck *m
 		}
 	}
 
+	// conditional HEAD (remote object that is not present: compare entity tags only)
+	if pc := cmn.PrecondsFromHeader(r.Header); pc != nil {
+		if exists {
+			err = lom.EvalPreconds(pc, true /*exists*/, true /*read*/)
+		} else {
+			err = pc.Eval(lom.Cname(), &op.ObjAttrs, time.Time{}, true /*read*/)
+		}
+		if err != nil {
+			return precondStatus(err), err
+		}
+	}
+
 	// to header
 	cmn.ToHeader(&op.ObjAttrs, whdr, op.ObjAttrs.Size)
 	if op.ObjAttrs.Cksum == nil {
@@ -1220,8 +1321,21 @@ func (t *target) httpobjpatch(w http.ResponseWriter, r *http.Request, apireq *ap
 		}
 		return
 	}
+	// object lock attributes can only be modified via (S3) object lock API;
+	// encryption and other reserved (system) attributes - never
+	for key := range custom {
+		if cmn.IsObjLockMD(key) || cmn.IsSSEMD(key) || cmn.IsReservedObjMD(key) {
+			t.writeErrf(w, r, "%s: cannot set %q via custom props", lom.Cname(), key)
+			return
+		}
+	}
 	delOldSetNew := cos.IsParseBool(apireq.query.Get(apc.QparamNewCustom))
 	if delOldSetNew {
+		for key, val := range lom.GetCustomMD() {
+			if cmn.IsObjLockMD(key) || cmn.IsSSEMD(key) || cmn.IsReservedObjMD(key) {
+				custom[key] = val
+			}
+		}
 		lom.SetCustomMD(custom)
 	} else {
 		for key, val := range custom {
@@ -1282,10 +1396,18 @@ func (t *target) putApndArch(r *http.Request, lom *core.LOM, started int64, dpq
 	return a.do()
 }
 
-func (t *target) DeleteObject(lom *core.LOM, evict bool) (code int, err error) {
+func (t *target) DeleteObject(lom *core.LOM, evict bool) (int, error) {
+	return t.deleteObject(lom, evict, false /*bypass governance*/, nil)
+}
+
+// (pc: conditional DELETE, or nil)
+func (t *target) deleteObject(lom *core.LOM, evict, bypassGovernance bool, pc *cmn.Preconds) (code int, err error) {
 	var isback bool
 	lom.Lock(true)
-	code, err, isback = t.delobj(lom, evict)
+	code, err, isback = t.delobj(lom, evict, bypassGovernance, pc)
+	if err == nil && !evict && lom.Bck().IsAIS() {
+		t.replDel(lom)
+	}
 	lom.Unlock(true)
 
 	// special corner-case retry (quote):
@@ -1312,6 +1434,10 @@ func (t *target) DeleteObject(lom *core.LOM, evict bool) (code int, err error) {
 				cos.NamedVal64{Name: stats.ErrDeleteCount, Value: 1, VarLabs: vlabs},
 			)
 		}
+	case cmn.IsErrPrecondFailed(err):
+		t.statsT.AddWith(
+			cos.NamedVal64{Name: stats.ErrDeleteCount, Value: 1, VarLabs: vlabs},
+		)
 	default:
 		// not to confuse with `stats.RemoteDeletedDelCount` that counts against
 		// QparamLatestVer, 'versioning.validate_warm_get' and friends
@@ -1330,11 +1456,12 @@ func (t *target) DeleteObject(lom *core.LOM, evict bool) (code int, err error) {
 }
 
 // NOTE: s3 will return err=nil with OK status to indicate (not deleting) non-existing object (see also aws.go)
-func (t *target) delobj(lom *core.LOM, evict bool) (int, error, bool) {
+func (t *target) delobj(lom *core.LOM, evict, bypassGovernance bool, pc *cmn.Preconds) (int, error, bool) {
 	var (
 		aisErr, backendErr         error
 		aisErrCode, backendErrCode int
 		delFromAIS, delFromBackend bool
+		wbPending                  bool // (write-back)
 	)
 	delFromBackend = lom.Bck().IsRemote() && !evict
 	err := lom.Load(false /*cache it*/, true /*locked*/)
@@ -1352,16 +1479,51 @@ func (t *target) delobj(lom *core.LOM, evict bool) (int, error, bool) {
 		if !delFromBackend {
 			return http.StatusNotFound, err, false
 		}
+		if pc != nil {
+			if err := lom.EvalPreconds(pc, false /*exists*/, false /*read*/); err != nil {
+				return precondStatus(err), err, false
+			}
+		}
 	} else {
+		if err := lom.CheckObjLock(bypassGovernance); err != nil {
+			return http.StatusForbidden, err, false
+		}
+		if !evict && lom.Bck().IsAIS() {
+			if err := lom.CheckPriorVersionsLock(bypassGovernance); err != nil {
+				if cmn.IsErrObjLocked(err) {
+					return http.StatusForbidden, err, false
+				}
+				return 0, err, false
+			}
+		}
+		if wbPending = lom.WriteBackPending(); wbPending && evict {
+			return http.StatusForbidden, cmn.NewErrFailedTo(t, "evict", lom.Cname(), errWbPending), false
+		}
+		if pc != nil {
+			if err := lom.EvalPreconds(pc, true /*exists*/, false /*read*/); err != nil {
+				return precondStatus(err), err, false
+			}
+		}
 		delFromAIS = true
 	}
 
 	// do
 	if delFromBackend {
-		backendErrCode, backendErr = t.Backend(lom.Bck()).DeleteObj(lom)
+		if lom.Bprops().Tiering.IsActive() {
+			backendErrCode, backendErr = t.delTiered(lom)
+		} else {
+			backendErrCode, backendErr = t.Backend(lom.Bck()).DeleteObj(lom)
+		}
+		core.HcacheDel(lom)
+		if wbPending && cos.IsNotExist(backendErr, backendErrCode) {
+			backendErrCode, backendErr = 0, nil // (never written back)
+		}
 	}
 	if delFromAIS {
 		size := lom.Lsize()
+		if lom.Bck().IsAIS() {
+			lom.RemovePriorVersions()
+		}
 		aisErr = lom.RemoveObj()
 		if aisErr != nil {
 			if !os.IsNotExist(aisErr) {
@@ -1377,6 +1539,8 @@ func (t *target) delobj(lom *core.LOM, evict bool) (int, error, bool) {
 			debug.Assert(lom.Bck().IsRemote())
 			t.statsT.Inc(stats.LruEvictCount)
 			t.statsT.Add(stats.LruEvictSize, size)
+		} else if wbPending {
+			t.wbq.del(lom)
 		}
 	}
 	if backendErr != nil {
@@ -1396,6 +1560,13 @@ func (t *target) objMv(lom *core.LOM, msg *apc.ActMsg) (err error) {
 	if msg.Name == lom.ObjName {
 		return fmt.Errorf("%s: cannot rename/move object %s onto itself", t.si, lom)
 	}
+	if lom.Bprops().ObjLock != nil {
+		if err := lom.Load(false /*cache it*/, false /*locked*/); err == nil {
+			if err := lom.CheckObjLock(false /*bypass governance*/); err != nil {
+				return err
+			}
+		}
+	}
 
 	buf, slab := t.gmm.Alloc()
 	coiParams := xs.AllocCOI()
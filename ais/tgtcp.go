@@ -194,7 +194,16 @@ func (t *target) daeputMsg(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
-		// one
+		// one profile
+		if profile, ok := msg.Value.(string); ok && profile != "" {
+			debug.Assert(provider == apc.AWS, provider)
+			if err := backend.ReloadS3Profile(profile); err != nil {
+				t.writeErr(w, r, err)
+			}
+			return
+		}
+
+		// one provider
 		var add core.Backend
 		switch provider {
 		case apc.AWS:
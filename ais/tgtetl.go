@@ -6,6 +6,7 @@ package ais
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 
@@ -23,10 +24,6 @@ import (
 
 // [METHOD] /v1/etl
 func (t *target) etlHandler(w http.ResponseWriter, r *http.Request) {
-	if !k8s.IsK8s() {
-		t.writeErr(w, r, k8s.ErrK8sRequired, 0, Silent)
-		return
-	}
 	switch {
 	case r.Method == http.MethodPut:
 		t.handleETLPut(w, r)
@@ -54,12 +51,16 @@ func (t *target) handleETLPut(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	b, err := cos.ReadAll(r.Body)
+	b, err := io.ReadAll(io.LimitReader(r.Body, etl.MaxInitMsgSize+1))
 	if err != nil {
 		t.writeErr(w, r, err)
 		return
 	}
 	r.Body.Close()
+	if len(b) > etl.MaxInitMsgSize {
+		t.writeErrf(w, r, "init ETL: message size exceeds %s", cos.ToSizeIEC(etl.MaxInitMsgSize, 0))
+		return
+	}
 
 	initMsg, err := etl.UnmarshalInitMsg(b)
 	if err != nil {
@@ -68,11 +69,20 @@ func (t *target) handleETLPut(w http.ResponseWriter, r *http.Request) {
 	}
 	xid := r.URL.Query().Get(apc.QparamUUID)
 
+	// (in-process WASM requires neither K8s nor Kubernetes-free runtime)
+	if _, ok := initMsg.(*etl.InitWasmMsg); !ok {
+		if err := etl.CheckRuntime(); err != nil {
+			t.writeErr(w, r, err, 0, Silent)
+			return
+		}
+	}
 	switch msg := initMsg.(type) {
 	case *etl.InitSpecMsg:
 		err = etl.InitSpec(msg, xid, etl.StartOpts{})
 	case *etl.InitCodeMsg:
 		err = etl.InitCode(msg, xid)
+	case *etl.InitWasmMsg:
+		err = etl.InitWasm(msg, xid)
 	default:
 		debug.Assert(false, initMsg.String())
 	}
@@ -116,7 +126,9 @@ func (t *target) handleETLGet(w http.ResponseWriter, r *http.Request) {
 	case apc.ETLHealth:
 		t.healthETL(w, r, apiItems[0])
 	case apc.ETLMetrics:
-		k8s.InitMetricsClient()
+		if etl.Runtime() == etl.RtK8s {
+			k8s.InitMetricsClient()
+		}
 		t.metricsETL(w, r, apiItems[0])
 	default:
 		t.writeErrURL(w, r)
@@ -150,8 +162,9 @@ func (t *target) stopETL(w http.ResponseWriter, r *http.Request, etlName string)
 	}
 }
 
+// etlName: single ETL or comma-separated ETL pipeline (see apc.ETLPipelineSep)
 func (t *target) getETL(w http.ResponseWriter, r *http.Request, etlName string, lom *core.LOM) {
-	comm, err := etl.GetCommunicator(etlName)
+	pipeline, err := etl.GetPipeline(apc.ParseETLPipeline(etlName))
 	if err != nil {
 		if cos.IsErrNotFound(err) {
 			smap := t.owner.smap.Get()
@@ -163,7 +176,15 @@ func (t *target) getETL(w http.ResponseWriter, r *http.Request, etlName string,
 		t.writeErr(w, r, err)
 		return
 	}
+	if len(pipeline) > 1 {
+		// (per-stage stats and errors - see etl.Pipeline)
+		if err := pipeline.InlineTransform(w, lom); err != nil {
+			t.writeErr(w, r, err)
+		}
+		return
+	}
 
+	comm := pipeline[0]
 	if err := comm.InlineTransform(w, r, lom); err != nil {
 		errV := cmn.NewErrETL(&cmn.ETLErrCtx{ETLName: etlName, PodName: comm.PodName(), SvcName: comm.SvcName()},
 			err.Error())
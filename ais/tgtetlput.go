@@ -0,0 +1,170 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/ext/etl"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// Write-path (on-PUT) ETL, target side (see cmn/etlput.go):
+// - PUT path transforming new content as it streams in
+// - transforming work file that contains new content written by other means
+//   (promote, APPEND)
+
+type etlPut struct {
+	p     etl.Pipeline
+	r     cos.ReadCloseSizer // transformed content
+	orig  *cos.CksumHash     // checksum of the original content
+	expct *cos.Cksum         // (end-to-end) checksum that has arrived with the original content
+	conf  *cmn.ETLConf
+}
+
+// new content written by user PUT (native and S3 API) and promote (including APPEND) -
+// but not copies, migrations, cold GETs, etc.
+func (poi *putOI) etlOnPut() bool {
+	if !poi.lom.Bprops().ETL.IsActive() {
+		return false
+	}
+	switch poi.owt {
+	case cmn.OwtPut:
+		return poi.restful && !poi.t2t
+	case cmn.OwtPromote:
+		return true
+	default:
+		return false
+	}
+}
+
+func (poi *putOI) etlInit() (int, error) {
+	conf := poi.lom.Bprops().ETL
+	p, err := etl.GetPipeline(conf.Names())
+	if err != nil {
+		return http.StatusServiceUnavailable, fmt.Errorf("%s: on-PUT etl %q is not running: %v",
+			poi.lom.Bck().Cname(""), conf.OnPut, err)
+	}
+	poi.etl = &etlPut{p: p, conf: conf}
+	return 0, nil
+}
+
+// PUT fails with 422 when transformer rejects the input
+func isErrETL(err error) bool {
+	var objErr *etl.ObjErr
+	return errors.As(err, &objErr)
+}
+
+// transform PUT stream; the checksum that may have arrived with the object is the
+// original content's - compute and validate it separately (see fini)
+func (ep *etlPut) open(poi *putOI, ckconf *cmn.CksumConf) (io.Reader, error) {
+	ty := ckconf.Type
+	if ep.expct = poi.cksumToUse; !ep.expct.IsEmpty() {
+		ty = ep.expct.Ty()
+	}
+	poi.cksumToUse = nil
+	ep.orig = cos.NewCksumHash(ty)
+
+	size := poi.size
+	if size <= 0 {
+		size = -1 // unknown
+	}
+	in := cos.NewReaderWithArgs(cos.ReaderArgs{R: io.TeeReader(poi.r, ep.orig.H), Size: size})
+	r, err := ep.p.TransformReader(in, poi.lom, ep.conf.Timeout.D())
+	if err != nil {
+		return nil, err
+	}
+	ep.r = r
+	return r, nil
+}
+
+func (ep *etlPut) fini(poi *putOI) error {
+	ep.orig.Finalize()
+	if !ep.expct.IsEmpty() && !ep.orig.Equal(ep.expct) {
+		poi.t.statsT.AddWith(
+			cos.NamedVal64{Name: stats.ErrPutCksumCount, Value: 1, VarLabs: poi._vlabs()},
+		)
+		return cos.NewErrDataCksum(ep.expct, &ep.orig.Cksum, poi.lom.Cname())
+	}
+	poi.lom.SetOrigCksum(ep.conf.OnPut, &ep.orig.Cksum)
+	return nil
+}
+
+func (ep *etlPut) close() {
+	if ep.r != nil {
+		cos.Close(ep.r)
+		ep.r = nil
+	}
+}
+
+// transform work file in place (compare w/ encryptWork) - for new content written
+// outside the regular PUT path; expecting the original content's checksum in place
+func (poi *putOI) etlWork() (int, error) {
+	lom := poi.lom
+	if !poi.etlOnPut() {
+		lom.SetOrigCksum("", nil) // (drop stale, if any)
+		return 0, nil
+	}
+	if ecode, err := poi.etlInit(); err != nil {
+		return ecode, err
+	}
+	src, err := os.Open(poi.workFQN)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	var (
+		ep    = poi.etl
+		orig  = lom.Checksum().Clone()
+		in    = cos.NewReaderWithArgs(cos.ReaderArgs{R: src, Size: lom.Lsize()})
+		cksum = cos.NewCksumHash(lom.CksumType())
+	)
+	r, err := ep.p.TransformReader(in, lom, ep.conf.Timeout.D())
+	if err != nil {
+		return http.StatusUnprocessableEntity, err
+	}
+	etlFQN := fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfileETL)
+	dst, err := lom.CreateWork(etlFQN)
+	if err != nil {
+		r.Close()
+		return http.StatusInternalServerError, err
+	}
+	buf, slab := poi.t.gmm.Alloc()
+	written, err := cos.CopyBuffer(cos.NewWriterMulti(dst, cksum.H), r, buf)
+	slab.Free(buf)
+	r.Close()
+	if errC := dst.Close(); err == nil {
+		err = errC
+	}
+	if err == nil {
+		err = cos.Rename(etlFQN, poi.workFQN)
+	}
+	if err != nil {
+		if errRm := cos.RemoveFile(etlFQN); errRm != nil && !os.IsNotExist(errRm) {
+			nlog.Errorf(fmtNested, poi.t, err, "remove", etlFQN, errRm)
+		}
+		if isErrETL(err) {
+			return http.StatusUnprocessableEntity, err
+		}
+		return http.StatusInternalServerError, err
+	}
+
+	lom.SetSize(written)
+	if cksum.Type() == cos.ChecksumNone {
+		lom.SetCksum(cos.NoneCksum)
+	} else {
+		cksum.Finalize()
+		lom.SetCksum(&cksum.Cksum)
+	}
+	lom.SetOrigCksum(ep.conf.OnPut, orig)
+	return 0, nil
+}
@@ -0,0 +1,257 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/NVIDIA/aistore/ais/backend"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/hk"
+)
+
+// remote bucket change notifications (see cmn/bckevents.go):
+// - housekeeper starts (and stops) pollers: one per bucket with polled source (SQS, Pub/Sub),
+//   run by the target that owns the bucket (HRW)
+// - the poller delivers received events to all targets (intra-cluster PUT /v1/events/bucket-name)
+//   and acknowledges (deletes) the messages only when all targets have the events;
+//   undelivered messages become visible again and get redelivered by the queue
+// - webhook (Azure Event Grid) is handled by the gateway that delivers the events in the same way
+// - upon receiving events, each target evicts its stale copies and, optionally, cold-GETs
+//   created (or overwritten) objects that it owns
+
+const (
+	evIval       = 30 * time.Second
+	evBackoffMax = time.Minute
+)
+
+type evPoller struct {
+	cancel context.CancelFunc
+	conf   cmn.EventsConf
+}
+
+// bucket uname => running poller (accessed only by housekeeper)
+var evPollers = make(map[string]*evPoller, 4)
+
+func (t *target) regEvents() {
+	hk.Reg(apc.Events+hk.NameSuffix, t.evHK, evIval)
+}
+
+func (t *target) evHK(int64) time.Duration {
+	if nlog.Stopping() {
+		for uname, p := range evPollers {
+			p.cancel()
+			delete(evPollers, uname)
+		}
+		return evIval
+	}
+	if !t.ClusterStarted() {
+		return evIval
+	}
+	var (
+		smap = t.owner.smap.get()
+		bmd  = t.owner.bmd.get()
+		keep = make(map[string]struct{}, len(evPollers))
+	)
+	bmd.Range(nil /*any provider*/, nil /*any namespace*/, func(bck *meta.Bck) bool {
+		conf := bck.Props.Events
+		if !conf.Polled() {
+			return false
+		}
+		uname := bck.MakeUname("")
+		tsi, err := smap.HrwName2T(uname)
+		if err != nil || tsi.ID() != t.SID() {
+			return false
+		}
+		key := string(uname)
+		keep[key] = struct{}{}
+		p, ok := evPollers[key]
+		if ok {
+			if p.conf == *conf {
+				return false
+			}
+			p.cancel() // reconfigured
+			delete(evPollers, key)
+		}
+		src, err := backend.NewEventSource(conf)
+		if err != nil {
+			nlog.Errorln(t.String(), bck.Cname(""), "failed to start polling change notifications:", err)
+			return false
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		evPollers[key] = &evPoller{cancel: cancel, conf: *conf}
+		go t.pollEvents(ctx, meta.CloneBck(bck.Bucket()), src)
+		return false
+	})
+	// no longer configured, disabled, or no longer owned by this target
+	for key, p := range evPollers {
+		if _, ok := keep[key]; !ok {
+			p.cancel()
+			delete(evPollers, key)
+		}
+	}
+	return evIval
+}
+
+func (t *target) pollEvents(ctx context.Context, bck *meta.Bck, src backend.EventSource) {
+	nlog.Infoln(t.String(), "start polling", src.String(), "=>", bck.Cname(""))
+	backoff := time.Second
+	for ctx.Err() == nil {
+		msgs, err := src.Receive(ctx)
+		if err == nil && len(msgs) > 0 {
+			var events []cmn.BckEvent
+			for i := range msgs {
+				events = append(events, msgs[i].Events...)
+			}
+			if err = t.deliverEvents(bck, events); err == nil {
+				err = src.Ack(ctx, msgs)
+			}
+		}
+		if err == nil {
+			backoff = time.Second
+			continue
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		nlog.Warningln(t.String(), src.String(), "=>", bck.Cname("")+":", err, "- retrying in", backoff)
+		select {
+		case <-ctx.Done():
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, evBackoffMax)
+	}
+	nlog.Infoln(t.String(), "stop polling", src.String(), "=>", bck.Cname(""))
+}
+
+// deliver to all targets, including this one
+func (t *target) deliverEvents(bck *meta.Bck, events []cmn.BckEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	if err := bck.Init(t.owner.bmd); err != nil {
+		return err
+	}
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{
+		Method: http.MethodPut,
+		Path:   apc.URLPathEvents.Join(bck.Name),
+		Query:  bck.NewQuery(),
+		Body:   cos.MustMarshal(events),
+	}
+	args.to = core.Targets
+	results := t.bcastGroup(args)
+	freeBcArgs(args)
+
+	var err error
+	for _, res := range results {
+		if res.err != nil {
+			err = res.errorf("%s: failed to deliver %d change notification(s) to %s", t, len(events), res.si)
+			break
+		}
+	}
+	freeBcastRes(results)
+
+	t.applyEvents(bck, events)
+	return err
+}
+
+// PUT /v1/events/bucket-name (intra-cluster)
+func (t *target) eventsHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		cmn.WriteErr405(w, r, http.MethodPut)
+		return
+	}
+	if !t.ensureIntraControl(w, r, false /* from primary */) {
+		return
+	}
+	apireq := apiReqAlloc(1, apc.URLPathEvents.L, false)
+	defer apiReqFree(apireq)
+	if err := t.parseReq(w, r, apireq); err != nil {
+		return
+	}
+	bck := apireq.bck
+	if err := bck.Init(t.owner.bmd); err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	var events []cmn.BckEvent
+	if err := cmn.ReadJSON(w, r, &events); err != nil {
+		return
+	}
+	t.applyEvents(bck, events)
+}
+
+func (t *target) applyEvents(bck *meta.Bck, events []cmn.BckEvent) {
+	var (
+		smap     = t.owner.smap.get()
+		conf     = bck.Props.Events
+		cloudBck = bck.RemoteBck()
+		prefetch []string
+		evicted  int
+	)
+	for i := range events {
+		ev := &events[i]
+		if ev.Bucket != "" && ev.Bucket != cloudBck.Name {
+			continue // (queue shared by multiple buckets)
+		}
+		if err := cmn.ValidateOname(ev.ObjName); err != nil {
+			nlog.Warningln(t.String(), bck.Cname(""), "invalid change notification:", err)
+			continue
+		}
+		lom := core.AllocLOM(ev.ObjName)
+		if err := lom.InitBck(bck.Bucket()); err != nil {
+			core.FreeLOM(lom)
+			continue
+		}
+		core.HcacheDel(lom)
+		if err := lom.Load(false /*cache it*/, false /*locked*/); err == nil {
+			etag, _ := lom.GetCustomKey(cmn.ETag)
+			if !ev.Stale(etag, lom.Version()) {
+				core.FreeLOM(lom)
+				continue
+			}
+			if _, err := t.DeleteObject(lom, true /*evict*/); err != nil {
+				nlog.Warningln(t.String(), "failed to evict", lom.Cname(), "err:", err)
+			} else {
+				evicted++
+			}
+		}
+		if conf.IsActive() && conf.Prefetch && !ev.Deleted {
+			if _, local, err := lom.HrwTarget(&smap.Smap); err == nil && local {
+				prefetch = append(prefetch, ev.ObjName)
+			}
+		}
+		core.FreeLOM(lom)
+	}
+	if cmn.Rom.FastV(4, cos.SmoduleAIS) {
+		nlog.Infoln(t.String(), bck.Cname(""), "change notifications:", len(events), "evicted:", evicted,
+			"prefetch:", len(prefetch))
+	}
+	if len(prefetch) > 0 {
+		go t.prefetchChanged(bck, prefetch)
+	}
+}
+
+func (t *target) prefetchChanged(bck *meta.Bck, objNames []string) {
+	for _, objName := range objNames {
+		lom := core.AllocLOM(objName)
+		if err := lom.InitBck(bck.Bucket()); err == nil {
+			if ecode, err := t.GetCold(context.Background(), lom, cmn.OwtGetPrefetchLock); err != nil {
+				if !cos.IsNotExist(err, ecode) {
+					nlog.Warningln(t.String(), "failed to prefetch", lom.Cname(), "err:", err)
+				}
+			}
+		}
+		core.FreeLOM(lom)
+	}
+}
@@ -99,6 +99,13 @@ func (t *target) FinalizeObj(lom *core.LOM, workFQN string, xctn core.Xact, owt
 		poi.owt = owt
 		poi.xctn = xctn
 	}
+	if err = poi.encryptWork(); err != nil {
+		freePOI(poi)
+		if nerr := cos.RemoveFile(workFQN); nerr != nil && !os.IsNotExist(nerr) {
+			nlog.Errorf(fmtNested, t, err, "remove", workFQN, nerr)
+		}
+		return http.StatusInternalServerError, err
+	}
 	ecode, err = poi.finalize()
 	freePOI(poi)
 	return
@@ -164,7 +171,12 @@ func (t *target) GetCold(ctx context.Context, lom *core.LOM, owt cmn.OWT) (ecode
 		now     = mono.NanoTime()
 		backend = t.Backend(lom.Bck())
 	)
-	if ecode, err = backend.GetObj(ctx, lom, owt, nil /*origReq*/); err != nil {
+	if lom.Bprops().Tiering.IsActive() {
+		ecode, err = t.getColdTiered(ctx, lom, owt)
+	} else {
+		ecode, err = backend.GetObj(ctx, lom, owt, nil /*origReq*/)
+	}
+	if err != nil {
 		if owt != cmn.OwtGetPrefetchLock {
 			lom.Unlock(true)
 		}
@@ -208,17 +220,36 @@ func (t *target) GetColdBlob(params *core.BlobParams, oa *cmn.ObjAttrs) (xctn co
 func (t *target) HeadCold(lom *core.LOM, origReq *http.Request) (oa *cmn.ObjAttrs, ecode int, err error) {
 	var (
 		backend = t.Backend(lom.Bck())
-		now     = mono.NanoTime()
+		hconf   = lom.Bprops().HeadCache
 		vlabs   = map[string]string{stats.VarlabBucket: lom.Bck().Cname("")}
 	)
-	oa, ecode, err = backend.HeadObj(context.Background(), lom, origReq)
+	if hconf.IsActive() {
+		var ok bool
+		if oa, ecode, ok = core.HcacheGet(lom); ok {
+			t.statsT.IncWith(stats.HeadCacheHitCount, vlabs)
+			if ecode == http.StatusNotFound {
+				err = cos.NewErrNotFound(t, lom.Cname())
+			}
+			return oa, ecode, err
+		}
+		t.statsT.IncWith(stats.HeadCacheMissCount, vlabs)
+	}
+
+	now := mono.NanoTime()
+	oa, ecode, err = core.HeadObjTiered(context.Background(), lom, origReq)
 	if err != nil {
 		t.statsT.IncWith(stats.ErrHeadCount, vlabs)
+		if hconf.IsActive() && cos.IsNotExist(err, ecode) {
+			core.HcachePut(lom, nil, hconf.NegativeTTL())
+		}
 	} else {
 		t.statsT.AddWith(
 			cos.NamedVal64{Name: backend.MetricName(stats.HeadCount), Value: 1, VarLabs: vlabs},
 			cos.NamedVal64{Name: backend.MetricName(stats.HeadLatencyTotal), Value: mono.SinceNano(now), VarLabs: vlabs},
 		)
+		if hconf.IsActive() {
+			core.HcachePut(lom, oa, hconf.TTL.D())
+		}
 	}
 	return oa, ecode, err
 }
@@ -333,7 +364,16 @@ func (t *target) _promLocal(params *core.PromoteParams, lom *core.LOM) (fileSize
 		poi.xctn = params.Xact
 	}
 	lom.SetSize(fileSize)
-	ecode, err = poi.finalize()
+	if ecode, err = poi.etlWork(); err == nil {
+		err = poi.encryptWork()
+	}
+	if err == nil {
+		ecode, err = poi.finalize()
+	} else if extraCopy {
+		if nerr := cos.RemoveFile(workFQN); nerr != nil && !os.IsNotExist(nerr) {
+			nlog.Errorf(fmtNested, t, err, "remove", workFQN, nerr)
+		}
+	}
 	freePOI(poi)
 	return
 }
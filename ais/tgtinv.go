@@ -0,0 +1,125 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// bucket inventory: for each bucket with configured (and enabled) inventory,
+// run x-inventory once per scheduled time slot, where the slot is the current
+// time truncated to the configured interval (UTC); see also cmn/inventory.go
+//
+// NOTE: the last-run state is in-memory; after restart, a given target
+// checks whether it has already written its manifest for the current slot
+
+const invIval = 10 * time.Minute
+
+// bucket cname + inventory ID => last scheduled run (accessed only by housekeeper)
+var invRuns = make(map[string]string, 4)
+
+func (t *target) regInventory() {
+	hk.Reg(apc.ActInventory+hk.NameSuffix, t.invHK, invIval)
+}
+
+func (t *target) invHK(int64) time.Duration {
+	if !t.ClusterStarted() || nlog.Stopping() {
+		return invIval
+	}
+	now := time.Now().UTC()
+	bmd := t.owner.bmd.get()
+	bmd.Range(nil /*any provider*/, nil /*any namespace*/, func(bck *meta.Bck) bool {
+		conf := bck.Props.Inventory
+		if !conf.IsActive() {
+			return false
+		}
+		var (
+			key = bck.Cname(conf.ID)
+			run = now.Truncate(conf.Interval.D()).Format(cmn.InvRunTimeFmt)
+		)
+		if invRuns[key] == run {
+			return false
+		}
+		if _, ok := invRuns[key]; !ok && t.invDone(bck, run) {
+			invRuns[key] = run
+			return false
+		}
+		if _, err := t.runInventory("" /*xid*/, bck, run); err != nil {
+			nlog.Errorln(t.String(), "failed to run", apc.ActInventory, bck.Cname(""), "err:", err)
+			return false
+		}
+		invRuns[key] = run
+		return false
+	})
+	return invIval
+}
+
+// whether this target has already completed a given (scheduled) run
+func (t *target) invDone(bck *meta.Bck, run string) bool {
+	conf := bck.Props.Inventory
+	dst, err := t.invDst(conf)
+	if err != nil {
+		return false
+	}
+	lom := core.AllocLOM(conf.RunPrefix(bck.Bucket(), run) + t.SID() + "-" + cmn.InvManifest)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(dst.Bucket()); err != nil {
+		return false
+	}
+	smap := t.owner.smap.get()
+	tsi, local, err := lom.HrwTarget(&smap.Smap)
+	if err != nil {
+		return false
+	}
+	if local {
+		return lom.Load(false /*cache it*/, false /*locked*/) == nil
+	}
+	return t.headt2t(lom, tsi, smap)
+}
+
+func (t *target) invDst(conf *cmn.InventoryConf) (*meta.Bck, error) {
+	dst := meta.CloneBck(&conf.Dest)
+	if err := dst.Init(t.owner.bmd); err != nil {
+		return nil, err
+	}
+	return dst, nil
+}
+
+func (t *target) runInventory(xid string, bck *meta.Bck, run string) (string, error) {
+	conf := bck.Props.Inventory
+	if conf == nil {
+		return "", cmn.NewErrUnsupp("generate inventory of", bck.Cname("")+" (inventory is not configured)")
+	}
+	dst, err := t.invDst(conf)
+	if err != nil {
+		return "", err
+	}
+	if xid == "" {
+		xid = cos.GenUUID()
+	}
+	rns := xreg.RenewInventory(xid, bck, &xreg.InvArgs{Dst: dst, Run: run})
+	if rns.Err != nil {
+		if cmn.IsErrXactUsePrev(rns.Err) {
+			return "", nil
+		}
+		return "", rns.Err
+	}
+	if rns.IsRunning() {
+		return "", nil
+	}
+	xctn := rns.Entry.Get()
+	xact.GoRunW(xctn)
+	return xctn.ID(), nil
+}
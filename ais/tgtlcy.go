@@ -0,0 +1,67 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"time"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// bucket lifecycle: periodically run x-lifecycle for each bucket that has
+// (at least one enabled) lifecycle rule; see also cmn/lifecycle.go
+
+const lcyIval = time.Hour
+
+func (t *target) regLifecycle() {
+	hk.Reg(apc.ActLifecycle+hk.NameSuffix, t.lcyHK, lcyIval)
+}
+
+func (t *target) lcyHK(int64) time.Duration {
+	if !t.ClusterStarted() || nlog.Stopping() {
+		return lcyIval
+	}
+	bmd := t.owner.bmd.get()
+	bmd.Range(nil /*any provider*/, nil /*any namespace*/, func(bck *meta.Bck) bool {
+		if bck.Props.Lifecycle.IsActive() {
+			if _, err := t.runLifecycle("" /*xid*/, bck); err != nil {
+				nlog.Errorln(t.String(), "failed to run", apc.ActLifecycle, bck.Cname(""), "err:", err)
+			}
+		}
+		return false
+	})
+	return lcyIval
+}
+
+func (t *target) runLifecycle(xid string, bck *meta.Bck) (string, error) {
+	if xid == "" {
+		xid = cos.GenUUID()
+	}
+	rns := xreg.RenewLifecycle(xid, bck, &xreg.LcyArgs{AbortMpt: abortStaleMpt})
+	if rns.Err != nil {
+		if cmn.IsErrXactUsePrev(rns.Err) {
+			return "", nil
+		}
+		return "", rns.Err
+	}
+	if rns.IsRunning() {
+		return "", nil
+	}
+	xctn := rns.Entry.Get()
+	xact.GoRunW(xctn)
+	return xctn.ID(), nil
+}
+
+func abortStaleMpt(bck *meta.Bck, rule *cmn.LifecycleRule, now time.Time) int {
+	return s3.AbortStale(bck.Name, rule, now)
+}
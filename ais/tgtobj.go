@@ -29,6 +29,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/feat"
 	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/cmn/sse"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/ec"
@@ -55,6 +56,9 @@ type (
 		t          *target       // this
 		lom        *core.LOM     // obj
 		cksumToUse *cos.Cksum    // if available (not `none`), can be validated and will be stored
+		etl        *etlPut       // on-PUT ETL (see tgtetlput.go)
+		ssec       *sse.CustKey  // customer-provided encryption key (SSE-C)
+		pc         *cmn.Preconds // conditional PUT (If-Match, If-None-Match, etc.)
 		config     *cmn.Config   // (during this request)
 		resphdr    http.Header   // as implied
 		workFQN    string        // temp fqn to be renamed
@@ -69,6 +73,7 @@ type (
 		skipVC     bool          // skip loading existing Version and skip comparing Checksums (skip VC)
 		coldGET    bool          // (one implication: proceed to write)
 		remoteErr  bool          // to exclude `putRemote` errors when counting soft IO errors
+		sse        bool          // encrypt with cluster-managed key (SSE-S3)
 	}
 
 	getOI struct {
@@ -77,6 +82,7 @@ type (
 		ctx        context.Context // context used when getting object from remote backend (access creds)
 		t          *target         // this
 		lom        *core.LOM       // obj
+		pc         *cmn.Preconds   // conditional GET and HEAD
 		dpq        *dpq
 		ranges     byteRanges // range read (see https://www.rfc-editor.org/rfc/rfc7233#section-2.1)
 		atime      int64      // access time.Now()
@@ -174,6 +180,16 @@ func (poi *putOI) do(resphdr http.Header, r *http.Request, dpq *dpq) (int, error
 			poi.size = size
 		}
 	}
+	if err := poi.sseFromHeader(r.Header); err != nil {
+		return http.StatusBadRequest, err
+	}
+	if poi.pc = cmn.PrecondsFromHeader(r.Header); poi.pc != nil {
+		// fail fast (and evaluate again under write lock - see poi.fini)
+		if err := poi.lom.CheckPreconds(poi.pc, false /*locked*/); err != nil {
+			cos.DrainReader(poi.r)
+			return precondStatus(err), err
+		}
+	}
 	return poi.putObject()
 }
 
@@ -188,10 +204,17 @@ func (poi *putOI) putObject() (ecode int, err error) {
 		poi.workFQN = fs.CSM.Gen(lom, fs.WorkfileType, "fntl-0x24")
 	}
 
+	if poi.etlOnPut() {
+		if ecode, err = poi.etlInit(); err != nil {
+			cos.Close(poi.r)
+			return ecode, err
+		}
+	}
+
 	poi.ltime = mono.NanoTime()
 
-	// if checksums match PUT is a no-op
-	if !poi.skipVC && !poi.coldGET {
+	// if checksums match PUT is a no-op (unless requested to encrypt or conditional, or to transform)
+	if !poi.skipVC && !poi.coldGET && poi.ssec == nil && !poi.sse && poi.pc == nil && poi.etl == nil {
 		if poi.lom.EqCksum(poi.cksumToUse) {
 			if cmn.Rom.FastV(4, cos.SmoduleAIS) {
 				nlog.Infoln(poi.lom.String(), "has identical", poi.cksumToUse.String(), "- PUT is a no-op")
@@ -205,6 +228,9 @@ func (poi *putOI) putObject() (ecode int, err error) {
 	poi._cleanup(buf, slab, lmfh, erw)
 	if erw != nil {
 		err, ecode = erw, http.StatusInternalServerError
+		if isErrETL(err) {
+			ecode = http.StatusUnprocessableEntity
+		}
 		goto rerr
 	}
 
@@ -235,8 +261,8 @@ func (poi *putOI) putObject() (ecode int, err error) {
 rerr:
 	if poi.owt == cmn.OwtPut && poi.restful && !poi.t2t {
 		vlabs := poi._vlabs()
-		if err != cmn.ErrSkip && !poi.remoteErr && err != io.ErrUnexpectedEOF &&
-			!cos.IsRetriableConnErr(err) && !cos.IsErrMv(err) {
+		if err != cmn.ErrSkip && !poi.remoteErr && err != io.ErrUnexpectedEOF && !isErrETL(err) &&
+			!cos.IsRetriableConnErr(err) && !cos.IsErrMv(err) && !cmn.IsErrPrecondFailed(err) {
 			poi.t.statsT.AddWith(
 				cos.NamedVal64{Name: stats.ErrPutCount, Value: 1, VarLabs: vlabs},
 				cos.NamedVal64{Name: stats.IOErrPutCount, Value: 1, VarLabs: vlabs},
@@ -351,7 +377,35 @@ func (poi *putOI) fini() (ecode int, err error) {
 		bck = lom.Bck()
 	)
 	// put remote
+	var (
+		wb     string
+		locked bool
+	)
 	if bck.IsRemote() && poi.owt < cmn.OwtRebalance {
+		// object lock (WORM) and conditional PUT: evaluate under write lock
+		// and keep holding it while PUT-ing remote (compare-and-swap)
+		if poi.pc != nil || lom.Bprops().ObjLock != nil {
+			lom.Lock(true)
+			defer lom.Unlock(true)
+			locked = true
+			if err = lom.CheckOverwrite(true /*locked*/); err != nil {
+				return http.StatusForbidden, err
+			}
+			if poi.pc != nil {
+				if ecode, err = poi.remotePreconds(); err != nil {
+					return ecode, err
+				}
+			}
+		}
+		if lom.IsWriteBack() {
+			// write-back: PUT remote later (see tgtwb.go)
+			wb = wbMarker()
+			lom.ObjAttrs().DelStdCustom()
+			lom.SetVersion("")
+			wbMeta(lom, poi.oreq)
+			lom.SetCustomKey(cmn.WriteBackObjMD, wb)
+			goto lock
+		}
 		ecode, err = poi.putRemote()
 		if err != nil {
 			loghdr := poi.loghdr()
@@ -368,8 +422,10 @@ func (poi *putOI) fini() (ecode int, err error) {
 			}
 			nlog.Infof("PUT (%s): retried OK", loghdr)
 		}
+		lom.ClearWriteBack() // (in case the bucket's write policy has changed)
 	}
 
+lock:
 	// locking strategies: optimistic and otherwise
 	// (see GetCold() implementation and cmn.OWT enum)
 	switch poi.owt {
@@ -383,14 +439,51 @@ func (poi *putOI) fini() (ecode int, err error) {
 		defer lom.Unlock(true)
 	default:
 		debug.Assert(cos.IsValidAtime(poi.atime), poi.atime) // expecting valid atime
-		lom.Lock(true)
-		defer lom.Unlock(true)
+		if !locked {
+			lom.Lock(true)
+			defer lom.Unlock(true)
+		}
 		lom.SetAtimeUnix(poi.atime)
 	}
 
+	// object lock (WORM)
+	if poi.owt < cmn.OwtRebalance {
+		if !bck.IsRemote() {
+			if err = lom.CheckOverwrite(true /*locked*/); err != nil {
+				return http.StatusForbidden, err
+			}
+			// conditional PUT: evaluate under write lock (compare-and-swap)
+			if poi.pc != nil {
+				if err = lom.CheckPreconds(poi.pc, true /*locked*/); err != nil {
+					return precondStatus(err), err
+				}
+			}
+		}
+		lom.InitRetention()
+	}
+
+	// cross-cluster replication: check the incoming stamp, if any, while the current
+	// object (that may be about to become a prior version) is still in place
+	var replIn string
+	if bck.IsAIS() && poi.owt < cmn.OwtRebalance {
+		if replIn, ecode, err = poi.replCheck(); err != nil {
+			return ecode, err
+		}
+	}
+
 	// ais versioning
+	var retained string
 	if bck.IsAIS() && lom.VersionConf().Enabled {
 		if poi.owt < cmn.OwtRebalance {
+			if lom.VersionConf().Retain > 0 {
+				// keep the current (soon to be prior) version
+				if retained, err = lom.RetainVersion(); err != nil {
+					return 0, err
+				}
+				if retained != "" {
+					defer poi.unretain(&retained, &err)
+				}
+			}
 			if poi.skipVC {
 				err = lom.IncVersion()
 				debug.AssertNoErr(err)
@@ -402,10 +495,28 @@ func (poi *putOI) fini() (ecode int, err error) {
 		}
 	}
 
+	// cross-cluster replication: stamp (or, when replicated, accept the incoming stamp)
+	var repl string
+	if bck.IsAIS() && poi.owt < cmn.OwtRebalance {
+		repl = poi.replicate(replIn)
+	}
+
+	// write-back: persist (or, when migrating, re-queue) prior to finalizing
+	if wb == "" && poi.owt >= cmn.OwtRebalance && lom.WriteBackPending() {
+		wb, _ = lom.GetCustomKey(cmn.WriteBackObjMD)
+	}
+	if wb != "" {
+		poi.t.wbq.add(lom, wb)
+	}
+
 	// done
 	if err = lom.RenameFinalize(poi.workFQN); err != nil {
 		return 0, err
 	}
+	retained = "" // (the new version is now in place - nothing to undo)
+	if repl != "" {
+		poi.t.replq.add(lom, replPut, repl)
+	}
 	if lom.HasCopies() {
 		if errdc := lom.DelAllCopies(); errdc != nil {
 			nlog.Errorf("PUT (%s): failed to delete old copies [%v], proceeding anyway...", poi.loghdr(), errdc)
@@ -417,6 +528,54 @@ func (poi *putOI) fini() (ecode int, err error) {
 	return 0, lom.PersistMain()
 }
 
+// conditional PUT to a remote bucket (under write lock): evaluate preconditions
+// against the backend's object - or, if the latter is yet to be written back
+// (see tgtwb.go), against the in-cluster one
+func (poi *putOI) remotePreconds() (int, error) {
+	var (
+		lom  = poi.lom
+		prev = core.AllocLOM("")
+	)
+	defer core.FreeLOM(prev)
+	if err := prev.InitFQN(lom.FQN, lom.Bucket()); err != nil {
+		return 0, err
+	}
+	if prev.Load(false /*cache it*/, true /*locked*/) == nil && prev.WriteBackPending() {
+		if err := prev.EvalPreconds(poi.pc, true /*exists*/, false /*read*/); err != nil {
+			return precondStatus(err), err
+		}
+		return 0, nil
+	}
+	// (not using HEAD cache)
+	oa, ecode, err := core.HeadObjTiered(context.Background(), lom, nil /*origReq*/)
+	switch {
+	case err == nil:
+		var mtime time.Time
+		if s, ok := oa.GetCustomKey(cmn.LastModified); ok {
+			mtime, _ = time.Parse(time.RFC3339, s)
+		}
+		err = poi.pc.Eval(lom.Cname(), oa, mtime, false /*read*/)
+	case cos.IsNotExist(err, ecode):
+		err = poi.pc.Eval(lom.Cname(), nil, time.Time{}, false /*read*/)
+	default:
+		return ecode, err
+	}
+	if err != nil {
+		return precondStatus(err), err
+	}
+	return 0, nil
+}
+
+// failed to finalize: restore the retained (prior) version as the current one
+func (poi *putOI) unretain(retained *string, err *error) {
+	if *err == nil || *retained == "" {
+		return
+	}
+	if errU := poi.lom.UnretainVersion(*retained); errU != nil {
+		nlog.Errorln(poi.loghdr(), "failed to restore prior version", *retained, "[", errU, "]")
+	}
+}
+
 // via backend.PutObj()
 func (poi *putOI) putRemote() (int, error) {
 	var (
@@ -435,6 +594,7 @@ func (poi *putOI) putRemote() (int, error) {
 		backend = poi.t.Backend(lom.Bck())
 	)
 	ecode, err = backend.PutObj(lmfh, lom, poi.oreq)
+	core.HcacheDel(lom)
 	if err == nil {
 		if !lom.Bck().IsRemoteAIS() {
 			lom.SetCustomKey(cmn.SourceObjMD, backend.Provider())
@@ -458,10 +618,29 @@ func (poi *putOI) write() (buf []byte, slab *memsys.Slab, lmfh cos.LomWriter, er
 			finalized bool           // to avoid computing the same checksum type twice
 		}{}
 		ckconf = poi.lom.CksumConf()
+		src    = io.Reader(poi.r)
+		w      io.Writer
+		encw   *sse.Writer
 	)
 	if lmfh, err = poi.lom.CreateWork(poi.workFQN); err != nil {
 		return
 	}
+	// transform new content (see tgtetlput.go)
+	if poi.etl != nil {
+		if src, err = poi.etl.open(poi, ckconf); err != nil {
+			return
+		}
+		defer poi.etl.close()
+	}
+	// encrypt at rest (checksums are computed on plaintext)
+	raw := poi.isRaw()
+	w = lmfh
+	if encw, err = poi.encryptTo(lmfh); err != nil {
+		return
+	}
+	if encw != nil {
+		w = encw
+	}
 	if poi.size <= 0 {
 		buf, slab = poi.t.gmm.Alloc()
 	} else {
@@ -469,17 +648,25 @@ func (poi *putOI) write() (buf []byte, slab *memsys.Slab, lmfh cos.LomWriter, er
 	}
 
 	switch {
+	case raw:
+		// ciphertext: keep plaintext checksum that has arrived with the object
+		cksum := poi.cksumToUse
+		if cksum.IsEmpty() {
+			cksum = cos.NoneCksum
+		}
+		poi.lom.SetCksum(cksum)
+		written, err = cos.CopyBuffer(w, src, buf)
 	case ckconf.Type == cos.ChecksumNone:
 		poi.lom.SetCksum(cos.NoneCksum)
 		// not using `ReadFrom` of the `*os.File` -
 		// ultimately, https://github.com/golang/go/blob/master/src/internal/poll/copy_file_range_linux.go#L100
-		written, err = cos.CopyBuffer(lmfh, poi.r, buf)
+		written, err = cos.CopyBuffer(w, src, buf)
 	case !poi.cksumToUse.IsEmpty() && !poi.validateCksum(ckconf):
 		// if the corresponding validation is not configured/enabled we just go ahead
 		// and use the checksum that has arrived with the object
 		poi.lom.SetCksum(poi.cksumToUse)
 		// (ditto)
-		written, err = cos.CopyBuffer(lmfh, poi.r, buf)
+		written, err = cos.CopyBuffer(w, src, buf)
 	default:
 		writers := make([]io.Writer, 0, 3)
 		cksums.store = cos.NewCksumHash(ckconf.Type) // always according to the bucket
@@ -494,14 +681,19 @@ func (poi *putOI) write() (buf []byte, slab *memsys.Slab, lmfh cos.LomWriter, er
 				writers = append(writers, cksums.compt.H)
 			}
 		}
-		writers = append(writers, lmfh)
-		written, err = cos.CopyBuffer(cos.NewWriterMulti(writers...), poi.r, buf) // (ditto)
+		writers = append(writers, w)
+		written, err = cos.CopyBuffer(cos.NewWriterMulti(writers...), src, buf) // (ditto)
 	}
 	if err != nil {
 		return
 	}
 
 	// validate
+	if poi.etl != nil {
+		if err = poi.etl.fini(poi); err != nil {
+			return
+		}
+	}
 	if cksums.compt != nil {
 		cksums.finalized = cksums.compt == cksums.store
 		cksums.compt.Finalize()
@@ -515,6 +707,11 @@ func (poi *putOI) write() (buf []byte, slab *memsys.Slab, lmfh cos.LomWriter, er
 	}
 
 	// ok
+	if encw != nil {
+		if err = encw.Close(); err != nil { // (the last frame)
+			return
+		}
+	}
 	if poi.lom.IsFeatureSet(feat.FsyncPUT) {
 		err = lmfh.Sync() // compare w/ cos.FlushClose
 		debug.AssertNoErr(err)
@@ -523,6 +720,9 @@ func (poi *putOI) write() (buf []byte, slab *memsys.Slab, lmfh cos.LomWriter, er
 	cos.Close(lmfh)
 	lmfh = nil
 
+	if raw {
+		written = sse.PlainSize(written)
+	}
 	poi.lom.SetSize(written) // TODO: compare with non-zero lom.Lsize() that may have been set via oa.FromHeader()
 	if cksums.store != nil {
 		if !cksums.finalized {
@@ -667,9 +867,8 @@ do:
 	// cold-GET: upgrade rlock => wlock and call t.Backend.GetObjReader
 	if cold {
 		var (
-			res     core.GetReaderResult
-			ckconf  = goi.lom.CksumConf()
-			backend = goi.t.Backend(goi.lom.Bck())
+			res    core.GetReaderResult
+			ckconf = goi.lom.CksumConf()
 		)
 		if cs.IsNil() {
 			cs = fs.Cap()
@@ -698,8 +897,8 @@ do:
 		goi.lom.SetCustomMD(nil)
 
 		goi.rstarttime = mono.NanoTime()
-		// get remote reader (compare w/ t.GetCold)
-		res = backend.GetObjReader(goi.ctx, goi.lom, 0, 0)
+		// get remote reader (compare w/ t.GetCold); with multi-backend tiering, try tiers in order
+		res = core.GetObjReaderTiered(goi.ctx, goi.lom, 0, 0)
 		if res.Err != nil {
 			goi.lom.Unlock(true)
 			goi.unlocked = true
@@ -711,7 +910,8 @@ do:
 		goi.cold = true
 
 		// 3 alternative ways to perform cold GET
-		if goi.dpq.arch.path == "" && goi.dpq.arch.regx == "" &&
+		// (conditional GET always takes the regular path - to evaluate preconditions prior to transmitting)
+		if goi.dpq.arch.path == "" && goi.dpq.arch.regx == "" && goi.pc == nil &&
 			(ckconf.Type == cos.ChecksumNone || (!ckconf.ValidateColdGet && !ckconf.EnableReadRange)) {
 			if goi.ranges.Range == "" && goi.lom.IsFeatureSet(feat.StreamingColdGET) {
 				err = goi.coldStream(&res)
@@ -732,6 +932,11 @@ do:
 
 	// read locally and stream back
 fin:
+	if goi.pc != nil {
+		if err = goi.lom.EvalPreconds(goi.pc, true /*exists*/, true /*read*/); err != nil {
+			return precondStatus(err), err
+		}
+	}
 	ecode, err = goi.txfini()
 	if err == nil {
 		return 0, nil
@@ -1012,7 +1217,8 @@ func (goi *getOI) getFromNeighbor(lom *core.LOM, tsi *meta.Snode) bool {
 
 func (goi *getOI) txfini() (ecode int, err error) {
 	var (
-		lmfh *os.File
+		lmfh cos.LomReader
+		ssec *sse.CustKey
 		hrng *htrange
 		fqn  = goi.lom.FQN
 		dpq  = goi.dpq
@@ -1020,14 +1226,24 @@ func (goi *getOI) txfini() (ecode int, err error) {
 	if !goi.cold && !dpq.isGFN && !goi.lom.IsChunked() {
 		fqn = goi.lom.LBGet() // best-effort GET load balancing (see also mirror.findLeastUtilized())
 	}
-	// open
-	lmfh, err = goi.lom.OpenFile()
+	// open (and decrypt, if need be)
+	if goi.lom.SSECustMD5() != "" && goi.req != nil {
+		if ssec, err = s3.SSECFromHeader(goi.req.Header); err != nil {
+			return http.StatusBadRequest, err
+		}
+	}
+	lmfh, err = goi.lom.OpenSSE(ssec)
 	if err != nil {
-		if os.IsNotExist(err) {
+		switch {
+		case cmn.IsErrSSEKey(err):
+			ecode = http.StatusBadRequest
+		case os.IsNotExist(err):
 			// NOTE: retry only once and only when ec-enabled - see goi.restoreFromAny()
 			ecode = http.StatusNotFound
 			goi.retry = goi.lom.ECEnabled()
-		} else {
+		case goi.lom.IsEncrypted() && !cos.IsPathErr(err):
+			ecode = http.StatusInternalServerError // (key provider, etc.)
+		default:
 			goi.t.FSHC(err, goi.lom.Mountpath(), fqn)
 			ecode = http.StatusInternalServerError
 			err = cmn.NewErrFailedTo(goi.t, "goi-finalize", goi.lom.Cname(), err, ecode)
@@ -1059,7 +1275,7 @@ func (goi *getOI) txfini() (ecode int, err error) {
 	return ecode, err
 }
 
-func (goi *getOI) _txrng(fqn string, lmfh *os.File, whdr http.Header, hrng *htrange) (err error) {
+func (goi *getOI) _txrng(fqn string, lmfh cos.LomReader, whdr http.Header, hrng *htrange) (err error) {
 	var (
 		r     io.Reader
 		lom   = goi.lom
@@ -1102,7 +1318,7 @@ func (goi *getOI) _txrng(fqn string, lmfh *os.File, whdr http.Header, hrng *htra
 }
 
 // in particular, setup reader and writer and set headers
-func (goi *getOI) _txreg(fqn string, lmfh *os.File, whdr http.Header) (err error) {
+func (goi *getOI) _txreg(fqn string, lmfh cos.LomReader, whdr http.Header) (err error) {
 	var (
 		dpq   = goi.dpq
 		lom   = goi.lom
@@ -1124,7 +1340,7 @@ func (goi *getOI) _txreg(fqn string, lmfh *os.File, whdr http.Header) (err error
 }
 
 // TODO: checksum
-func (goi *getOI) _txarch(fqn string, lmfh *os.File, whdr http.Header) error {
+func (goi *getOI) _txarch(fqn string, lmfh cos.LomReader, whdr http.Header) error {
 	var (
 		ar  archive.Reader
 		dpq = goi.dpq
@@ -1326,13 +1542,21 @@ func (a *apndOI) apnd(buf []byte) (packedHdl string, ecode int, err error) {
 		workFQN = fs.CSM.Gen(a.lom, fs.WorkfileType, fs.WorkfileAppend)
 		a.lom.Lock(false)
 		if a.lom.Load(false /*cache it*/, false /*locked*/) == nil {
-			_, a.hdl.partialCksum, err = cos.CopyFile(a.lom.FQN, workFQN, buf, a.lom.CksumType())
-			a.lom.Unlock(false)
-			if err != nil {
-				ecode = http.StatusInternalServerError
-				return
+			if a.lom.IsEncrypted() {
+				fh, ecode, err = a.decrypted(workFQN, buf)
+				a.lom.Unlock(false)
+				if err != nil {
+					return
+				}
+			} else {
+				_, a.hdl.partialCksum, err = cos.CopyFile(a.lom.FQN, workFQN, buf, a.lom.CksumType())
+				a.lom.Unlock(false)
+				if err != nil {
+					ecode = http.StatusInternalServerError
+					return
+				}
+				fh, err = a.lom.AppendWork(workFQN)
 			}
-			fh, err = a.lom.AppendWork(workFQN)
 		} else {
 			a.lom.Unlock(false)
 			a.hdl.partialCksum = cos.NewCksumHash(a.lom.CksumType())
@@ -1370,6 +1594,34 @@ func (a *apndOI) apnd(buf []byte) (packedHdl string, ecode int, err error) {
 	return
 }
 
+// encrypted at rest: start with the decrypted content (that, upon flush, gets
+// encrypted again along with the appended data)
+func (a *apndOI) decrypted(workFQN string, buf []byte) (cos.LomWriter, int, error) {
+	if a.lom.SSECustMD5() != "" {
+		return nil, http.StatusNotImplemented, cmn.NewErrNotImpl("append to", "SSE-C encrypted object "+a.lom.Cname())
+	}
+	lmfh, err := a.lom.Open()
+	if err != nil {
+		return nil, 0, err
+	}
+	fh, err := a.lom.CreateWork(workFQN)
+	if err != nil {
+		cos.Close(lmfh)
+		return nil, http.StatusInternalServerError, err
+	}
+	a.hdl.partialCksum = cos.NewCksumHash(a.lom.CksumType())
+	_, err = cos.CopyBuffer(cos.NewWriterMulti(fh, a.hdl.partialCksum.H), lmfh, buf)
+	cos.Close(lmfh)
+	if err != nil {
+		cos.Close(fh)
+		if errRm := cos.RemoveFile(workFQN); errRm != nil {
+			nlog.Errorln(a.t.String(), "nested error: failed to remove", workFQN, "[", errRm, "]")
+		}
+		return nil, http.StatusInternalServerError, err
+	}
+	return fh, 0, nil
+}
+
 func (a *apndOI) flush() (int, error) {
 	if a.hdl.workFQN == "" {
 		return 0, fmt.Errorf("failed to finalize append-file operation: empty source in the %+v handle", a.hdl)
@@ -1438,7 +1690,8 @@ func (coi *coi) do(t *target, dm *bundle.DataMover, lom *core.LOM) (size int64,
 	}
 
 	// DP == nil: use default (no-op transform) if source bucket is remote
-	if coi.DP == nil && (lom.Bck().IsRemote() || coi.BckTo.IsRemote()) {
+	// (or destination requires encryption)
+	if coi.DP == nil && (lom.Bck().IsRemote() || coi.BckTo.IsRemote() || (coi.BckTo.Props != nil && coi.BckTo.Props.SSE != nil)) {
 		coi.DP = &core.LDP{}
 	}
 
@@ -1771,6 +2024,9 @@ func (a *putA2I) do() (int, error) {
 	if a.filename == "" {
 		return 0, errors.New("archive path is not defined")
 	}
+	if a.lom.Bprops().SSE != nil || a.lom.IsEncrypted() {
+		return http.StatusNotImplemented, cmn.NewErrNotImpl("append to", "encrypted archive "+a.lom.Cname())
+	}
 	// standard library does not support appending to tgz, zip, and such;
 	// for TAR there is an optimizing workaround not requiring a full copy
 	if a.mime == archive.ExtTar && !a.put /*append*/ && !a.lom.IsChunked() {
@@ -21,6 +21,7 @@ import (
 	"github.com/NVIDIA/aistore/core/mock"
 	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/tools/readers"
+	"github.com/NVIDIA/aistore/xact/xs"
 )
 
 const (
@@ -60,6 +61,7 @@ func TestMain(m *testing.M) {
 	fs.TestNew(nil)
 	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}, true)
 	fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{}, true)
+	fs.CSM.Reg(fs.ObjVersionType, &fs.ObjVersionContentResolver{}, true)
 
 	// target
 	config := cmn.GCO.Get()
@@ -67,6 +69,7 @@ func TestMain(m *testing.M) {
 	co := newConfigOwner(config)
 	t = newTarget(co)
 	t.initSnode(config)
+	xs.Treg(t)
 	tid, _ := initTID(config)
 	t.si.Init(tid, apc.Target)
 
@@ -0,0 +1,204 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// Object lock (WORM), target side (see cmn/objlock.go):
+// - S3 GET/PUT object retention and legal hold
+// - bucket-wide check that precedes destroying (evicting) or renaming a bucket
+//   with object lock enabled
+
+const maxObjLockBody = 4 * cos.KiB
+
+var errNoObjLock = errors.New("object lock is not enabled for the bucket")
+
+// GET /s3/<bucket-name>/<object-name>?retention
+func (t *target) getObjRetention(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if ecode, err := t._lockLoad(lom, bck, false /*exclusive*/); err != nil {
+		s3.WriteErr(w, r, err, ecode)
+		return
+	}
+	retention := lom.Retention()
+	lom.Unlock(false)
+
+	if retention.Mode == "" {
+		err := s3.NewErrCode("NoSuchObjectLockConfiguration", "object retention does not exist: "+lom.Cname())
+		s3.WriteErr(w, r, err, http.StatusNotFound)
+		return
+	}
+	resp := s3.NewRetention(&retention)
+	sgl := t.gmm.NewSGL(0)
+	resp.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo2(w)
+	sgl.Free()
+}
+
+// PUT /s3/<bucket-name>/<object-name>?retention
+func (t *target) putObjRetention(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) {
+	if bck.Props.ObjLock == nil {
+		s3.WriteErr(w, r, s3.NewErrCode("InvalidRequest", errNoObjLock.Error()), 0)
+		return
+	}
+	retention := &s3.Retention{}
+	if err := _readXML(r, retention); err != nil {
+		s3.WriteErr(w, r, s3.NewErrCode("MalformedXML", err.Error()), 0)
+		return
+	}
+	mode, until, err := retention.Parse()
+	if err != nil {
+		s3.WriteErr(w, r, s3.NewErrCode("InvalidArgument", err.Error()), 0)
+		return
+	}
+
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if ecode, err := t._lockLoad(lom, bck, true /*exclusive*/); err != nil {
+		s3.WriteErr(w, r, err, ecode)
+		return
+	}
+	curr := lom.Retention()
+	err = curr.CheckUpdate(lom.Cname(), mode, until, time.Now(), cos.IsParseBool(r.Header.Get(s3.HeaderBypassGovernance)))
+	if err == nil {
+		err = lom.PersistRetention(mode, until)
+	}
+	lom.Unlock(true)
+	if err != nil {
+		ecode := 0
+		if cmn.IsErrObjLocked(err) {
+			ecode = http.StatusForbidden
+		}
+		s3.WriteErr(w, r, err, ecode)
+	}
+}
+
+// GET /s3/<bucket-name>/<object-name>?legal-hold
+func (t *target) getObjLegalHold(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if ecode, err := t._lockLoad(lom, bck, false /*exclusive*/); err != nil {
+		s3.WriteErr(w, r, err, ecode)
+		return
+	}
+	retention := lom.Retention()
+	lom.Unlock(false)
+
+	resp := s3.NewLegalHold(retention.LegalHold)
+	sgl := t.gmm.NewSGL(0)
+	resp.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo2(w)
+	sgl.Free()
+}
+
+// PUT /s3/<bucket-name>/<object-name>?legal-hold
+func (t *target) putObjLegalHold(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) {
+	if bck.Props.ObjLock == nil {
+		s3.WriteErr(w, r, s3.NewErrCode("InvalidRequest", errNoObjLock.Error()), 0)
+		return
+	}
+	hold := &s3.LegalHold{}
+	if err := _readXML(r, hold); err != nil {
+		s3.WriteErr(w, r, s3.NewErrCode("MalformedXML", err.Error()), 0)
+		return
+	}
+	on, err := hold.Parse()
+	if err != nil {
+		s3.WriteErr(w, r, s3.NewErrCode("InvalidArgument", err.Error()), 0)
+		return
+	}
+
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if ecode, err := t._lockLoad(lom, bck, true /*exclusive*/); err != nil {
+		s3.WriteErr(w, r, err, ecode)
+		return
+	}
+	err = lom.PersistLegalHold(on)
+	lom.Unlock(true)
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+	}
+}
+
+func _readXML(r *http.Request, v any) error {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxObjLockBody))
+	if err != nil {
+		return err
+	}
+	return xml.Unmarshal(body, v)
+}
+
+// Walk the bucket and fail upon the first locked object or prior version. Is called when
+// beginning to destroy (evict) or rename the bucket - the operations that'd otherwise
+// delete locked objects. Skips the walk when the bucket is known to contain no locked
+// objects (see core.BckObjLocksNone).
+func (t *target) checkBckObjLocks(bck *meta.Bck) error {
+	bprops, present := t.owner.bmd.get().Get(bck)
+	if !present || bprops.ObjLock == nil {
+		return nil
+	}
+	none, gen := core.BckObjLocksNone(bck)
+	if none {
+		return nil
+	}
+	var (
+		now = time.Now()
+		cb  = func(fqn string, de fs.DirEntry) error {
+			if de.IsDir() {
+				return nil
+			}
+			lom := core.AllocLOM("")
+			defer core.FreeLOM(lom)
+			if err := lom.InitFQN(fqn, bck.Bucket()); err != nil {
+				return nil
+			}
+			if err := lom.Load(false /*cache it*/, false /*locked*/); err != nil {
+				return nil
+			}
+			retention := lom.Retention()
+			if err := retention.Check(lom.Cname(), now, false /*bypass governance*/); err != nil {
+				return err
+			}
+			for _, ver := range lom.PriorVersions() {
+				vlom, err := lom.LoadVersion(ver)
+				if err != nil {
+					continue
+				}
+				retention = vlom.Retention()
+				core.FreeLOM(vlom)
+				if err := retention.Check(lom.Cname()+" version "+ver, now, false); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	)
+	avail := fs.GetAvail()
+	for _, mi := range avail {
+		opts := &fs.WalkOpts{Mi: mi, Bck: *bck.Bucket(), CTs: []string{fs.ObjectType}, Callback: cb}
+		if err := fs.Walk(opts); err != nil {
+			return err
+		}
+	}
+	core.BckObjLocksClean(bck, gen)
+	return nil
+}
@@ -0,0 +1,121 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/ec"
+)
+
+// GET, HEAD, and DELETE specific version of an ais:// object
+// (apc.QparamObjVersion or S3 versionId):
+// - the current version is handled by the regular datapath, except DELETE
+//   that permanently removes it and promotes the most recent prior version
+// - prior versions are retained when bucket's `versioning.retain` > 0
+//   (see core/lom_ver)
+
+// GET or HEAD; returns false when `ver` is the current version (to be handled by the caller)
+func (t *target) getObjVer(w http.ResponseWriter, r *http.Request, bck *meta.Bck, lom *core.LOM, ver string, isS3 bool) (bool, error) {
+	if _, err := t._lockLoad(lom, bck, false /*exclusive*/); err != nil {
+		return true, err
+	}
+	if lom.Version() == ver {
+		lom.Unlock(false)
+		return false, nil
+	}
+	vlom, err := lom.LoadVersion(ver)
+	if err != nil {
+		lom.Unlock(false)
+		return true, err
+	}
+	defer func() {
+		core.FreeLOM(vlom)
+		lom.Unlock(false)
+	}()
+
+	hdr := w.Header()
+	_verToHeader(hdr, vlom, ver, isS3)
+	if r.Method == http.MethodHead {
+		return true, nil
+	}
+	fh, err := vlom.Open()
+	if err != nil {
+		return true, err
+	}
+	buf, slab := t.gmm.AllocSize(vlom.Lsize())
+	_, err = io.CopyBuffer(w, fh, buf)
+	slab.Free(buf)
+	cos.Close(fh)
+	if err != nil {
+		// (too late to respond with error)
+		nlog.Warningln("GET", lom.Cname(), "version", ver, "[", err, "]")
+	}
+	return true, nil
+}
+
+func _verToHeader(hdr http.Header, vlom *core.LOM, ver string, isS3 bool) {
+	cmn.ToHeader(vlom.ObjAttrs(), hdr, vlom.Lsize())
+	if !isS3 {
+		return
+	}
+	s3.SetS3Headers(hdr, vlom)
+	hdr.Set(cos.S3VersionHeader, ver)
+	hdr.Set(cos.S3LastModified, cos.FormatNanoTime(vlom.AtimeUnix(), cos.RFC1123GMT))
+}
+
+// permanently delete the specified version unless it is locked (retention or legal hold)
+func (t *target) delObjVer(bck *meta.Bck, lom *core.LOM, ver string, bypassGovernance bool) (int, error) {
+	if ecode, err := t._lockLoad(lom, bck, true /*exclusive*/); err != nil {
+		return ecode, err
+	}
+	var (
+		err     error
+		current = lom.Version() == ver
+	)
+	if current {
+		err = lom.CheckObjLock(bypassGovernance)
+	} else {
+		var vlom *core.LOM
+		if vlom, err = lom.LoadVersion(ver); err == nil {
+			err = vlom.CheckObjLock(bypassGovernance)
+			core.FreeLOM(vlom)
+		}
+	}
+	if err != nil {
+		lom.Unlock(true)
+		switch {
+		case cmn.IsErrObjLocked(err):
+			return http.StatusForbidden, err
+		case cos.IsNotExist(err, 0):
+			return http.StatusNotFound, err
+		default:
+			return 0, err
+		}
+	}
+	if current {
+		err = lom.RemoveCurrentVersion()
+	} else {
+		err = lom.RemoveVersion(ver)
+	}
+	lom.Unlock(true)
+	if err != nil {
+		if cos.IsNotExist(err, 0) {
+			return http.StatusNotFound, err
+		}
+		return 0, err
+	}
+	if current {
+		ec.ECM.CleanupObject(lom)
+	}
+	return 0, nil
+}
@@ -0,0 +1,96 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Conditional PUT", func() {
+	var (
+		be  *memBackend
+		bck = meta.NewBck("cond-put", apc.AWS, cmn.NsGlobal)
+	)
+
+	BeforeEach(func() {
+		config := cmn.GCO.BeginUpdate()
+		config.Backend.Providers = map[string]cmn.Ns{apc.AWS: cmn.NsGlobal}
+		cmn.GCO.CommitUpdate(config)
+		be = newMemBackend(apc.AWS)
+		t.backend = backends{apc.AWS: be}
+
+		bmd := t.owner.bmd.get().clone()
+		bmd.Version++ // (unique BID)
+		bmd.add(bck, &cmn.Bprops{Cksum: cmn.CksumConf{Type: cos.ChecksumNone}})
+		t.owner.bmd.putPersist(bmd, nil)
+		Expect(fs.CreateBucket(bck.Bucket(), false /*nilbmd*/)).To(BeEmpty())
+	})
+
+	AfterEach(func() {
+		bmd := t.owner.bmd.get().clone()
+		bmd.del(bck)
+		t.owner.bmd.putPersist(bmd, nil)
+		for _, mi := range fs.GetAvail() {
+			os.RemoveAll(mi.MakePathBck(bck.Bucket()))
+		}
+	})
+
+	put := func(name string, b []byte, pc *cmn.Preconds) (int, error) {
+		lom := core.AllocLOM(name)
+		defer core.FreeLOM(lom)
+		Expect(lom.InitBck(bck.Bucket())).NotTo(HaveOccurred())
+		poi := &putOI{
+			atime:   time.Now().UnixNano(),
+			t:       t,
+			lom:     lom,
+			r:       io.NopCloser(bytes.NewReader(b)),
+			workFQN: path.Join(testMountpath, name+".work"),
+			config:  cmn.GCO.Get(),
+			owt:     cmn.OwtPut,
+			pc:      pc,
+		}
+		return poi.putObject()
+	}
+
+	It("should evaluate preconditions against remote object", func() {
+		createOnly := &cmn.Preconds{IfNoneMatch: cmn.AnyETag}
+
+		// exists remotely but not in cluster
+		be.set(bck.Bucket(), "remote-only", []byte("remote"))
+		ecode, err := put("remote-only", []byte("new"), createOnly)
+		Expect(cmn.IsErrPrecondFailed(err)).To(BeTrue())
+		Expect(ecode).To(Equal(http.StatusPreconditionFailed))
+		lom := core.AllocLOM("remote-only")
+		defer core.FreeLOM(lom)
+		Expect(lom.InitBck(bck.Bucket())).NotTo(HaveOccurred())
+		b, _ := be.get(lom)
+		Expect(b).To(Equal([]byte("remote")))
+
+		ecode, err = put("remote-only", []byte("new"), &cmn.Preconds{IfMatch: cmn.AnyETag})
+		Expect(err).NotTo(HaveOccurred(), "ecode %d", ecode)
+		b, _ = be.get(lom)
+		Expect(b).To(Equal([]byte("new")))
+
+		// create-only
+		_, err = put("absent", []byte("first"), createOnly)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = put("absent", []byte("second"), createOnly)
+		Expect(cmn.IsErrPrecondFailed(err)).To(BeTrue())
+	})
+})
@@ -0,0 +1,417 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/kvdb"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/stats"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Cross-cluster replication (see cmn/replication.go):
+// - each target journals PUTs and DELETEs of its (replicated) objects in the local kvdb
+//   (see tgtrq.go), one entry per object: a newer update replaces the older one, and
+//   at most one update per object is in flight at any time (per-object ordering)
+// - entries get shipped to the remote cluster via remote AIS backend (backend.AISbp);
+//   failed attempts are retried indefinitely with exponential backoff
+// - on the receiving side, replicated PUT and DELETE (apc.HdrReplStamp) are not journaled,
+//   and are rejected (409) when the local copy has a newer (higher) version
+// - an entry gets dropped if its object is no longer present locally with the same stamp
+//   (e.g., migrated away by rebalance); x-repl-resync catches up
+
+const replCollection = "replication"
+
+// journaled ops
+const (
+	replPut = "put"
+	replDel = "del"
+)
+
+type (
+	// persistent (kvdb) entry
+	replEntry struct {
+		Bck   cmn.Bck `json:"bck"`
+		Name  string  `json:"name"`
+		Op    string  `json:"op"`
+		Stamp string  `json:"stamp"`
+		Time  int64   `json:"time"` // when journaled (unix nano)
+	}
+	replQueue struct {
+		lags map[string]int64 // last reported lag, by bucket (cname)
+		rqueue
+	}
+)
+
+// interface guards
+var (
+	_ rqEntry = (*replEntry)(nil)
+	_ rqOps   = (*replQueue)(nil)
+)
+
+var errReplGone = errors.New("replication: update no longer pending") // (drop the entry)
+
+func (e *replEntry) bucket() *cmn.Bck { return &e.Bck }
+func (e *replEntry) objName() string  { return e.Name }
+func (e *replEntry) tag() string      { return e.Op + e.Stamp }
+
+func (q *replQueue) init(t *target, db kvdb.Driver) {
+	q.lags = make(map[string]int64, 4)
+	q.rqueue.init(t, db, replCollection, stats.ErrReplCount, q)
+}
+
+// journal PUT or DELETE (called under the object's write lock)
+func (q *replQueue) add(lom *core.LOM, op, stamp string) {
+	entry := &replEntry{Bck: *lom.Bucket(), Name: lom.ObjName, Op: op, Stamp: stamp, Time: time.Now().UnixNano()}
+	if _, prev := q.rqueue.add(lom.Uname(), entry, 0); prev == nil {
+		q.stats(&entry.Bck, 1)
+	}
+}
+
+func (q *replQueue) pending(uname string) bool { return q.get(uname) != nil }
+
+// the version of the pending (not yet shipped) DELETE, if any - for the re-created
+// object to supersede it
+func (q *replQueue) tombstone(uname string) (ver int64) {
+	if item := q.get(uname); item != nil {
+		if entry := item.entry.(*replEntry); entry.Op == replDel {
+			ver, _, _ = cmn.ParseReplStamp(entry.Stamp)
+		}
+	}
+	return ver
+}
+
+// as rqOps ---------------------------------------------------------------------
+
+func (*replQueue) newEntry() rqEntry { return &replEntry{} }
+
+func (q *replQueue) process(item *rqItem) (int, error) { return q.ship(item) }
+
+func (q *replQueue) finish(item *rqItem, ecode int, err error) bool {
+	entry := item.entry.(*replEntry)
+	vlabs := map[string]string{stats.VarlabBucket: entry.Bck.Cname("")}
+	switch {
+	case ecode == http.StatusConflict:
+		q.t.statsT.IncWith(stats.ReplConflictCount, vlabs)
+		if cmn.Rom.FastV(4, cos.SmoduleAIS) {
+			nlog.Infoln(q.t.String(), "replication", entry.Bck.Cname(entry.Name), "[", err, "]")
+		}
+	case err == errReplGone:
+	case err != nil:
+		return false
+	case entry.Op == replDel:
+		q.t.statsT.IncWith(stats.ReplDelCount, vlabs)
+	default:
+		q.t.statsT.AddWith(
+			cos.NamedVal64{Name: stats.ReplPutCount, Value: 1, VarLabs: vlabs},
+			cos.NamedVal64{Name: stats.ReplPutSize, Value: item.size, VarLabs: vlabs},
+		)
+	}
+	return true
+}
+
+func (q *replQueue) queued(item *rqItem, n int64) { q.stats(item.entry.bucket(), n) }
+
+// paused
+func (q *replQueue) hold(item *rqItem) bool {
+	bprops, present := q.t.owner.bmd.get().Get(meta.CloneBck(item.entry.bucket()))
+	return present && bprops.Replication.IsActive() && bprops.Replication.Disabled
+}
+
+func (q *replQueue) tick() { q.lag() }
+
+func (q *replQueue) stats(bck *cmn.Bck, n int64) {
+	q.t.statsT.AddWith(
+		cos.NamedVal64{Name: stats.ReplBacklogCount, Value: n, VarLabs: map[string]string{stats.VarlabBucket: bck.Cname("")}},
+	)
+}
+
+// update lag gauges: age of the oldest pending update, by bucket
+func (q *replQueue) lag() {
+	var (
+		now    = time.Now().UnixNano()
+		oldest = make(map[string]int64, len(q.lags))
+	)
+	q.mu.Lock()
+	for _, item := range q.items {
+		entry := item.entry.(*replEntry)
+		cname := entry.Bck.Cname("")
+		if t, ok := oldest[cname]; !ok || entry.Time < t {
+			oldest[cname] = entry.Time
+		}
+	}
+	q.mu.Unlock()
+
+	for cname, t := range oldest {
+		lag := max(now-t, 0)
+		q.setLag(cname, lag)
+	}
+	for cname := range q.lags {
+		if _, ok := oldest[cname]; !ok {
+			q.setLag(cname, 0)
+		}
+	}
+}
+
+// (gauge via delta; is called by a single goroutine)
+func (q *replQueue) setLag(cname string, lag int64) {
+	prev := q.lags[cname]
+	if lag == 0 {
+		delete(q.lags, cname)
+	} else {
+		q.lags[cname] = lag
+	}
+	if lag != prev {
+		q.t.statsT.AddWith(
+			cos.NamedVal64{Name: stats.ReplLag, Value: lag - prev, VarLabs: map[string]string{stats.VarlabBucket: cname}},
+		)
+	}
+}
+
+// ship the update to the remote cluster
+func (q *replQueue) ship(item *rqItem) (int, error) {
+	entry := item.entry.(*replEntry)
+	lom := core.AllocLOM(entry.Name)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(&entry.Bck); err != nil {
+		if cmn.IsErrBckNotFound(err) {
+			return 0, errReplGone
+		}
+		return 0, err
+	}
+	conf := lom.Bprops().Replication
+	if !conf.IsActive() {
+		return 0, errReplGone
+	}
+	dlom := core.AllocLOM(entry.Name)
+	defer core.FreeLOM(dlom)
+	if err := dlom.InitBck(&conf.Dst); err != nil {
+		return 0, err
+	}
+
+	lom.Lock(false)
+	err := lom.Load(false /*cache it*/, true /*locked*/)
+	exists := err == nil
+	if err != nil && !cos.IsNotExist(err, 0) && !cmn.IsErrObjNought(err) {
+		lom.Unlock(false)
+		return 0, err
+	}
+	var stamp string
+	if exists {
+		stamp, _ = lom.GetCustomKey(cmn.ReplObjMD)
+	}
+
+	if entry.Op == replDel {
+		lom.Unlock(false)
+		if exists && cmn.CmpReplStamp(stamp, entry.Stamp) > 0 {
+			return 0, errReplGone // (re-created)
+		}
+		ecode, err := q.t.aisbp().DeleteObjRepl(dlom, entry.Stamp)
+		if cos.IsNotExist(err, ecode) {
+			ecode, err = 0, nil
+		}
+		return ecode, err
+	}
+
+	if !exists || stamp != entry.Stamp {
+		lom.Unlock(false)
+		return 0, errReplGone
+	}
+	fh, err := lom.NewHandle()
+	if err != nil {
+		lom.Unlock(false)
+		return 0, err
+	}
+	dlom.CopyAttrs(lom, false /*skip cksum*/)
+	item.size = lom.Lsize()
+	oreq := &http.Request{Method: http.MethodPut, Header: http.Header{apc.HdrReplStamp: []string{entry.Stamp}}}
+	ecode, err := q.t.aisbp().PutObj(fh, dlom, oreq)
+	lom.Unlock(false)
+	return ecode, err
+}
+
+// x-repl-resync: journal the object unless the remote copy is up to date
+func (q *replQueue) resync(lom *core.LOM) (bool, error) {
+	conf := lom.Bprops().Replication
+	if !conf.IsActive() || !conf.Match(lom.ObjName) || q.pending(lom.Uname()) {
+		return false, nil
+	}
+	uuid := q.t.owner.smap.get().UUID
+	stamp, ok := lom.GetCustomKey(cmn.ReplObjMD)
+	if !ok {
+		// written prior to enabling replication: stamp it now
+		if !lom.TryLock(true) {
+			return false, nil
+		}
+		stamp = replStamp(lom.Version(true), "", uuid)
+		lom.SetCustomKey(cmn.ReplObjMD, stamp)
+		err := lom.Persist()
+		lom.Unlock(true)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	dlom := core.AllocLOM(lom.ObjName)
+	defer core.FreeLOM(dlom)
+	if err := dlom.InitBck(&conf.Dst); err != nil {
+		return false, err
+	}
+	oa, ecode, err := q.t.aisbp().HeadObj(context.Background(), dlom, nil /*origReq*/)
+	switch {
+	case err == nil:
+		rstamp, _ := oa.GetCustomKey(cmn.ReplObjMD)
+		if cmn.CmpReplStamp(replStamp(oa.Version(true), rstamp, conf.Dst.Ns.UUID), stamp) >= 0 {
+			return false, nil
+		}
+	case !cos.IsNotExist(err, ecode):
+		return false, err
+	}
+
+	lom.Lock(true)
+	if cur, _ := lom.GetCustomKey(cmn.ReplObjMD); cur == stamp {
+		q.add(lom, replPut, stamp)
+	}
+	lom.Unlock(true)
+	return true, nil
+}
+
+func (t *target) runReplResync(xid string, bck *meta.Bck) (string, error) {
+	if !bck.Props.Replication.IsActive() {
+		return "", cmn.NewErrUnsupp("resync", bck.Cname("")+" (replication is not configured)")
+	}
+	rns := xreg.RenewReplResync(xid, bck, &xreg.ReplArgs{Resync: t.replq.resync})
+	if rns.Err != nil {
+		if cmn.IsErrXactUsePrev(rns.Err) {
+			return rns.Entry.Get().ID(), nil
+		}
+		return "", rns.Err
+	}
+	xctn := rns.Entry.Get()
+	if rns.IsRunning() {
+		return xctn.ID(), nil
+	}
+	xact.GoRunW(xctn)
+	return xctn.ID(), nil
+}
+
+//
+// replication stamp: object version and the cluster that wrote it
+//
+
+func replVersion(ver string) int64 {
+	v, _ := strconv.ParseInt(ver, 10, 64)
+	return v
+}
+
+// given object's version and its stamp (if any): the stamp must carry the same version;
+// otherwise (e.g., written locally without replication), the object is attributed to
+// the specified cluster
+func replStamp(ver, stamp, clusterID string) string {
+	v := replVersion(ver)
+	if sv, _, err := cmn.ParseReplStamp(stamp); err == nil && sv == v {
+		return stamp
+	}
+	return cmn.NewReplStamp(v, clusterID)
+}
+
+// the stamp of the existing object, if any (under write lock)
+func (t *target) replStampOf(lom *core.LOM) (stamp string) {
+	elom := core.AllocLOM(lom.ObjName)
+	if elom.InitBck(lom.Bucket()) == nil && elom.Load(false /*cache it*/, true /*locked*/) == nil {
+		cur, _ := elom.GetCustomKey(cmn.ReplObjMD)
+		stamp = replStamp(elom.Version(), cur, t.owner.smap.get().UUID)
+	}
+	core.FreeLOM(elom)
+	return stamp
+}
+
+//
+// local PUT and DELETE; receiving side
+//
+
+// PUT (under write lock): given replicated PUT, check its incoming stamp against the
+// existing object's (to be done before the latter, if any, becomes a prior version)
+func (poi *putOI) replCheck() (stamp string, ecode int, err error) {
+	if poi.oreq == nil {
+		return "", 0, nil
+	}
+	if stamp = poi.oreq.Header.Get(apc.HdrReplStamp); stamp == "" {
+		return "", 0, nil
+	}
+	if _, _, err = cmn.ParseReplStamp(stamp); err != nil {
+		return "", http.StatusBadRequest, err
+	}
+	lom := poi.lom
+	if cur := poi.t.replStampOf(lom); cmn.CmpReplStamp(cur, stamp) >= 0 {
+		return "", http.StatusConflict, fmt.Errorf("%w: %s (%s vs %s)", cmn.ErrReplConflict, lom.Cname(), cur, stamp)
+	}
+	return stamp, 0, nil
+}
+
+// PUT: set (or accept the already checked incoming) replication stamp;
+// returns the stamp to journal, if any
+func (poi *putOI) replicate(stamp string) (journal string) {
+	lom := poi.lom
+	if stamp != "" {
+		// same version on both sides
+		ver, _, _ := cmn.ParseReplStamp(stamp)
+		lom.SetVersion(strconv.FormatInt(ver, 10))
+		lom.SetCustomKey(cmn.ReplObjMD, stamp)
+		return ""
+	}
+	conf := lom.Bprops().Replication
+	if !conf.IsActive() || !conf.Match(lom.ObjName) {
+		delete(lom.GetCustomMD(), cmn.ReplObjMD) // (e.g., copied from a replicated bucket)
+		return ""
+	}
+	// re-created while its deletion is yet to be shipped
+	ver := replVersion(lom.Version(true))
+	if del := poi.t.replq.tombstone(lom.Uname()); del >= ver {
+		ver = del + 1
+		lom.SetVersion(strconv.FormatInt(ver, 10))
+	}
+	journal = cmn.NewReplStamp(ver, poi.t.owner.smap.get().UUID)
+	lom.SetCustomKey(cmn.ReplObjMD, journal)
+	return journal
+}
+
+// local DELETE (under write lock): supersedes the deleted version
+func (t *target) replDel(lom *core.LOM) {
+	if conf := lom.Bprops().Replication; conf.IsActive() && conf.Match(lom.ObjName) {
+		ver := replVersion(lom.Version(true)) + 1
+		t.replq.add(lom, replDel, cmn.NewReplStamp(ver, t.owner.smap.get().UUID))
+	}
+}
+
+// replicated DELETE (not to be journaled)
+func (t *target) delReplicated(lom *core.LOM, stamp string) (int, error) {
+	if _, _, err := cmn.ParseReplStamp(stamp); err != nil {
+		return http.StatusBadRequest, err
+	}
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err == nil {
+		cur, _ := lom.GetCustomKey(cmn.ReplObjMD)
+		if cur = replStamp(lom.Version(), cur, t.owner.smap.get().UUID); cmn.CmpReplStamp(cur, stamp) > 0 {
+			return http.StatusConflict, fmt.Errorf("%w: %s (%s vs %s)", cmn.ErrReplConflict, lom.Cname(), cur, stamp)
+		}
+	}
+	ecode, err, _ := t.delobj(lom, false /*evict*/, false /*bypass governance*/, nil)
+	return ecode, err
+}
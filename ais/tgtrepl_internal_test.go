@@ -0,0 +1,322 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/kvdb"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Replication", func() {
+	const (
+		uuid = "clu-a"
+		peer = "clu-b"
+	)
+	var (
+		db       *kvdb.BuntDriver
+		prevSmap *smapX
+
+		// source (replicated to ais://@dc2/repl) and destination
+		src = meta.NewBck("repl", apc.AIS, cmn.NsGlobal)
+		dst = meta.NewBck("repl-dst", apc.AIS, cmn.NsGlobal)
+		ret = meta.NewBck("repl-ret", apc.AIS, cmn.NsGlobal) // (destination that retains prior versions)
+	)
+
+	BeforeEach(func() {
+		prevSmap = t.owner.smap.get()
+		smap := newSmap()
+		smap.addTarget(t.si)
+		smap.UUID = uuid
+		t.owner.smap.put(smap)
+
+		bmd := t.owner.bmd.get().clone()
+		for _, b := range []*meta.Bck{src, dst, ret} {
+			props := &cmn.Bprops{
+				Cksum:      cmn.CksumConf{Type: cos.ChecksumNone},
+				Versioning: cmn.VersionConf{Enabled: true},
+			}
+			if b == ret {
+				props.Versioning.Retain = 2
+			}
+			if b == src {
+				props.Replication = &cmn.ReplicationConf{Dst: cmn.Bck{Name: "repl", Provider: apc.AIS, Ns: cmn.Ns{UUID: "dc2"}}}
+			}
+			bmd.Version++ // (unique BID)
+			bmd.add(b, props)
+			Expect(fs.CreateBucket(b.Bucket(), false /*nilbmd*/)).To(BeEmpty())
+		}
+		t.owner.bmd.putPersist(bmd, nil)
+
+		var err error
+		db, err = kvdb.NewBuntDB(filepath.Join(testMountpath, "repl.db"))
+		Expect(err).NotTo(HaveOccurred())
+		t.replq = replQueue{}
+		t.replq.init(t, db) // (not shipping in the background: cluster not started)
+	})
+
+	AfterEach(func() {
+		t.replq.stop()
+		db.Close()
+		bmd := t.owner.bmd.get().clone()
+		for _, b := range []*meta.Bck{src, dst, ret} {
+			bmd.del(b)
+			for _, mi := range fs.GetAvail() {
+				os.RemoveAll(mi.MakePathBck(b.Bucket()))
+			}
+		}
+		t.owner.bmd.putPersist(bmd, nil)
+		if prevSmap != nil {
+			t.owner.smap.put(prevSmap)
+		}
+		os.Remove(filepath.Join(testMountpath, "repl.db"))
+	})
+
+	newLOM := func(b *meta.Bck, name string) *core.LOM {
+		lom := core.AllocLOM(name)
+		Expect(lom.InitBck(b.Bucket())).NotTo(HaveOccurred())
+		return lom
+	}
+	// (stamp: replicated PUT)
+	put := func(b *meta.Bck, name, stamp string) (int, error) {
+		lom := newLOM(b, name)
+		defer core.FreeLOM(lom)
+		_ = lom.Load(true, false)
+		poi := &putOI{
+			atime:   time.Now().UnixNano(),
+			t:       t,
+			lom:     lom,
+			r:       io.NopCloser(bytes.NewReader([]byte("replicated"))),
+			workFQN: path.Join(testMountpath, name+".work"),
+			config:  cmn.GCO.Get(),
+			owt:     cmn.OwtPut,
+		}
+		if stamp != "" {
+			poi.oreq = &http.Request{Method: http.MethodPut, Header: http.Header{apc.HdrReplStamp: []string{stamp}}}
+		}
+		return poi.putObject()
+	}
+	load := func(b *meta.Bck, name string) (ver, stamp string) {
+		lom := newLOM(b, name)
+		defer core.FreeLOM(lom)
+		Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+		stamp, _ = lom.GetCustomKey(cmn.ReplObjMD)
+		return lom.Version(), stamp
+	}
+	journaled := func(b *meta.Bck, name string) *replEntry {
+		lom := newLOM(b, name)
+		defer core.FreeLOM(lom)
+		item := t.replq.get(lom.Uname())
+		if item == nil {
+			return nil
+		}
+		return item.entry.(*replEntry)
+	}
+
+	It("should journal PUT and DELETE by object version", func() {
+		for range 2 {
+			_, err := put(src, "obj-journal", "")
+			Expect(err).NotTo(HaveOccurred())
+		}
+		ver, stamp := load(src, "obj-journal")
+		Expect(ver).To(Equal("2"))
+		Expect(stamp).To(Equal(cmn.NewReplStamp(2, uuid)))
+		Expect(journaled(src, "obj-journal").Stamp).To(Equal(stamp))
+
+		// DELETE supersedes the deleted version
+		lom := newLOM(src, "obj-journal")
+		Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+		_, err := t.deleteObject(lom, false /*evict*/, false, nil)
+		core.FreeLOM(lom)
+		Expect(err).NotTo(HaveOccurred())
+		entry := journaled(src, "obj-journal")
+		Expect(entry.Op).To(Equal(replDel))
+		Expect(entry.Stamp).To(Equal(cmn.NewReplStamp(3, uuid)))
+
+		// re-created (prior to shipping the DELETE) supersedes the latter
+		_, err = put(src, "obj-journal", "")
+		Expect(err).NotTo(HaveOccurred())
+		ver, stamp = load(src, "obj-journal")
+		Expect(ver).To(Equal("4"))
+		Expect(journaled(src, "obj-journal").Stamp).To(Equal(stamp))
+	})
+
+	It("should reject replicated PUT and DELETE of an older version", func() {
+		for range 3 {
+			_, err := put(dst, "obj-conflict", "")
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		ecode, err := put(dst, "obj-conflict", cmn.NewReplStamp(2, peer))
+		Expect(errors.Is(err, cmn.ErrReplConflict)).To(BeTrue())
+		Expect(ecode).To(Equal(http.StatusConflict))
+		ver, _ := load(dst, "obj-conflict")
+		Expect(ver).To(Equal("3"))
+
+		// newer: accepted with its version, and not journaled
+		stamp := cmn.NewReplStamp(5, peer)
+		_, err = put(dst, "obj-conflict", stamp)
+		Expect(err).NotTo(HaveOccurred())
+		ver, cur := load(dst, "obj-conflict")
+		Expect(ver).To(Equal("5"))
+		Expect(cur).To(Equal(stamp))
+		Expect(journaled(dst, "obj-conflict")).To(BeNil())
+
+		lom := newLOM(dst, "obj-conflict")
+		defer core.FreeLOM(lom)
+		ecode, err = t.delReplicated(lom, cmn.NewReplStamp(4, peer))
+		Expect(errors.Is(err, cmn.ErrReplConflict)).To(BeTrue())
+		Expect(ecode).To(Equal(http.StatusConflict))
+		_, err = t.delReplicated(lom, cmn.NewReplStamp(6, peer))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cos.IsNotExist(lom.Load(false, false), 0)).To(BeTrue())
+
+		_, err = put(dst, "obj-conflict", "bad-stamp")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should reject older replicated PUT when retaining prior versions", func() {
+		for range 3 {
+			_, err := put(ret, "obj-retain", "")
+			Expect(err).NotTo(HaveOccurred())
+		}
+		lom := newLOM(ret, "obj-retain")
+		Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+		Expect(lom.PriorVersions()).To(Equal([]string{"2", "1"}))
+		core.FreeLOM(lom)
+
+		ecode, err := put(ret, "obj-retain", cmn.NewReplStamp(2, peer))
+		Expect(errors.Is(err, cmn.ErrReplConflict)).To(BeTrue())
+		Expect(ecode).To(Equal(http.StatusConflict))
+
+		// current version and its prior versions remain intact
+		ver, _ := load(ret, "obj-retain")
+		Expect(ver).To(Equal("3"))
+		lom = newLOM(ret, "obj-retain")
+		defer core.FreeLOM(lom)
+		Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+		Expect(lom.PriorVersions()).To(Equal([]string{"2", "1"}))
+		for _, v := range lom.PriorVersions() {
+			vlom, err := lom.LoadVersion(v)
+			Expect(err).NotTo(HaveOccurred())
+			core.FreeLOM(vlom)
+		}
+
+		_, err = put(ret, "obj-retain", "bad-stamp")
+		Expect(err).To(HaveOccurred())
+		ver, _ = load(ret, "obj-retain")
+		Expect(ver).To(Equal("3"))
+	})
+
+	It("should retry with backoff and drop the entry upon conflict", func() {
+		_, err := put(src, "obj-retry", "")
+		Expect(err).NotTo(HaveOccurred())
+
+		for tries := 1; tries <= 2; tries++ {
+			items := t.replq.due()
+			Expect(items).To(HaveLen(1))
+			item := items[0]
+			t.replq.done(item, http.StatusServiceUnavailable, errors.New("remote unavailable"))
+			Expect(item.tries).To(Equal(tries))
+			Expect(time.Duration(item.next - item.failed)).To(Equal(rqMinBackoff << (tries - 1)))
+			Expect(t.replq.due()).To(BeEmpty()) // backing off
+			item.next = mono.NanoTime()         // (fast-forward)
+		}
+
+		items := t.replq.due()
+		Expect(items).To(HaveLen(1))
+		t.replq.done(items[0], http.StatusConflict, cmn.ErrReplConflict)
+		Expect(journaled(src, "obj-retry")).To(BeNil())
+		var entry replEntry
+		_, err = db.Get(replCollection, items[0].uname, &entry)
+		Expect(cos.IsNotExist(err, 0)).To(BeTrue())
+	})
+
+	It("should keep per-object order", func() {
+		_, err := put(src, "obj-order", "")
+		Expect(err).NotTo(HaveOccurred())
+		items := t.replq.due()
+		Expect(items).To(HaveLen(1))
+
+		// newer update waits while the older one is in flight
+		_, err = put(src, "obj-order", "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(t.replq.due()).To(BeEmpty())
+
+		t.replq.done(items[0], 0, nil)
+		newer := t.replq.due()
+		Expect(newer).To(HaveLen(1))
+		Expect(newer[0].entry.(*replEntry).Stamp).To(Equal(cmn.NewReplStamp(2, uuid)))
+		var entry replEntry
+		_, err = db.Get(replCollection, newer[0].uname, &entry)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entry.Stamp).To(Equal(cmn.NewReplStamp(2, uuid)))
+	})
+
+	It("should not lose newer entry while deleting older one", func() {
+		lom := newLOM(src, "obj-race")
+		defer core.FreeLOM(lom)
+		uname := lom.Uname()
+		for i := 1; i <= 500; i++ {
+			t.replq.add(lom, replPut, cmn.NewReplStamp(int64(2*i), uuid))
+			var wg sync.WaitGroup
+			wg.Add(2)
+			go func() {
+				t.replq.del(uname)
+				wg.Done()
+			}()
+			go func() {
+				t.replq.add(lom, replPut, cmn.NewReplStamp(int64(2*i+1), uuid))
+				wg.Done()
+			}()
+			wg.Wait()
+
+			// queued <=> persisted, and the same
+			var entry replEntry
+			_, err := db.Get(replCollection, uname, &entry)
+			if item := t.replq.get(uname); item != nil {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(entry.Stamp).To(Equal(item.entry.(*replEntry).Stamp))
+			} else {
+				Expect(cos.IsNotExist(err, 0)).To(BeTrue())
+			}
+		}
+	})
+
+	It("should recover the journal upon restart", func() {
+		for _, name := range []string{"obj-restart1", "obj-restart2"} {
+			_, err := put(src, name, "")
+			Expect(err).NotTo(HaveOccurred())
+		}
+		t.replq.stop()
+
+		t.replq = replQueue{}
+		t.replq.init(t, db)
+		for _, name := range []string{"obj-restart1", "obj-restart2"} {
+			entry := journaled(src, name)
+			Expect(entry).NotTo(BeNil())
+			Expect(entry.Op).To(Equal(replPut))
+			Expect(entry.Stamp).To(Equal(cmn.NewReplStamp(1, uuid)))
+		}
+		Expect(t.replq.due()).To(HaveLen(2))
+	})
+})
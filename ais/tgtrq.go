@@ -0,0 +1,249 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/kvdb"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/stats"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Persistent retry queue - common part of write-back (tgtwb.go) and cross-cluster
+// replication (tgtrepl.go):
+// - one entry per object (by uname) persisted in the local kvdb, to survive restarts;
+//   a newer entry replaces the older one
+// - at most one entry per object is in flight at any time
+// - entries get processed by a pool of workers once the cluster has started;
+//   failures are retried indefinitely with exponential backoff
+// - processing, stats, and whether the entry is done with - all queue-specific (see rqOps)
+
+const (
+	rqWorkers    = 8
+	rqIval       = time.Second
+	rqMinBackoff = time.Second
+	rqMaxBackoff = 5 * time.Minute
+)
+
+type (
+	// persistent (kvdb) entry
+	rqEntry interface {
+		bucket() *cmn.Bck
+		objName() string
+		tag() string // identifies the update (to tell it from a newer one)
+	}
+	rqOps interface {
+		newEntry() rqEntry
+		process(item *rqItem) (int, error)
+		// done with the entry (processed, dropped, or rejected) - or else retry
+		finish(item *rqItem, ecode int, err error) bool
+		// queued (+1) or done with (-1)
+		queued(item *rqItem, n int64)
+		// not to be processed at this time (e.g., paused)
+		hold(item *rqItem) bool
+		// periodic
+		tick()
+	}
+	rqItem struct {
+		entry  rqEntry
+		uname  string
+		next   int64 // (mono) do not retry before
+		failed int64 // (mono) last failure
+		size   int64 // processed size
+		tries  int
+	}
+	rqueue struct {
+		ops    rqOps
+		t      *target
+		db     kvdb.Driver
+		coll   string              // kvdb collection (and log tag)
+		errMet string              // error counter
+		items  map[string]*rqItem  // by uname
+		busy   map[string]struct{} // unames in flight
+		workCh chan *rqItem
+		kickCh chan struct{}
+		stopCh cos.StopCh
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+	}
+)
+
+func (q *rqueue) init(t *target, db kvdb.Driver, coll, errMet string, ops rqOps) {
+	q.t, q.db, q.coll, q.errMet, q.ops = t, db, coll, errMet, ops
+	q.items = make(map[string]*rqItem, 64)
+	q.busy = make(map[string]struct{}, rqWorkers)
+	q.workCh = make(chan *rqItem, rqWorkers)
+	q.kickCh = make(chan struct{}, 1)
+	q.stopCh.Init()
+
+	all, _, err := db.GetAll(coll, "")
+	if err != nil && !cos.IsNotExist(err, 0) {
+		nlog.Errorln(t.String(), "failed to load", coll, "queue:", err)
+	}
+	for uname, v := range all {
+		item := &rqItem{uname: uname, entry: ops.newEntry()}
+		if err := jsoniter.UnmarshalFromString(v, item.entry); err != nil {
+			nlog.Errorln(t.String(), "invalid", coll, "entry", uname, "err:", err)
+			continue
+		}
+		q.items[uname] = item
+		ops.queued(item, 1)
+	}
+	if n := len(q.items); n > 0 {
+		nlog.Infoln(t.String(), coll, "backlog:", n)
+	}
+
+	for range rqWorkers {
+		q.wg.Add(1)
+		go q.work()
+	}
+	q.wg.Add(1)
+	go q.run()
+}
+
+func (q *rqueue) stop() {
+	q.stopCh.Close()
+	q.wg.Wait()
+}
+
+// persist and queue (replacing the previous entry, if any)
+// NOTE: persisting and un-persisting (see unpersist) are serialized by q.mu
+func (q *rqueue) add(uname string, entry rqEntry, size int64) (item, prev *rqItem) {
+	item = &rqItem{uname: uname, entry: entry, size: size}
+	q.mu.Lock()
+	if _, err := q.db.Set(q.coll, uname, entry); err != nil {
+		nlog.Errorln(q.t.String(), "failed to persist", q.coll, "entry", entry.bucket().Cname(entry.objName()), "err:", err)
+	}
+	prev = q.items[uname]
+	q.items[uname] = item
+	q.mu.Unlock()
+	q.kick()
+	return item, prev
+}
+
+func (q *rqueue) del(uname string) {
+	q.mu.Lock()
+	item, ok := q.items[uname]
+	if ok {
+		delete(q.items, uname)
+		q.unpersist(item)
+	}
+	q.mu.Unlock()
+	if ok {
+		q.ops.queued(item, -1)
+	}
+}
+
+func (q *rqueue) get(uname string) (item *rqItem) {
+	q.mu.Lock()
+	item = q.items[uname]
+	q.mu.Unlock()
+	return item
+}
+
+func (q *rqueue) kick() {
+	select {
+	case q.kickCh <- struct{}{}:
+	default:
+	}
+}
+
+func (q *rqueue) run() {
+	ticker := time.NewTicker(rqIval)
+	defer func() {
+		ticker.Stop()
+		close(q.workCh)
+		q.wg.Done()
+	}()
+	for {
+		select {
+		case <-ticker.C:
+			q.ops.tick()
+		case <-q.kickCh:
+		case <-q.stopCh.Listen():
+			return
+		}
+		if !q.t.ClusterStarted() || nlog.Stopping() {
+			continue
+		}
+		for _, item := range q.due() {
+			select {
+			case q.workCh <- item:
+			case <-q.stopCh.Listen():
+				return
+			}
+		}
+	}
+}
+
+func (q *rqueue) due() (out []*rqItem) {
+	now := mono.NanoTime()
+	q.mu.Lock()
+	for uname, item := range q.items {
+		// (in flight: a newer entry waits for the older one to finish)
+		if _, ok := q.busy[uname]; ok || item.next > now || q.ops.hold(item) {
+			continue
+		}
+		q.busy[uname] = struct{}{}
+		out = append(out, item)
+	}
+	q.mu.Unlock()
+	return out
+}
+
+func (q *rqueue) work() {
+	defer q.wg.Done()
+	for item := range q.workCh {
+		ecode, err := q.ops.process(item)
+		q.done(item, ecode, err)
+	}
+}
+
+func (q *rqueue) done(item *rqItem, ecode int, err error) {
+	fin := q.ops.finish(item, ecode, err)
+	q.mu.Lock()
+	delete(q.busy, item.uname)
+	cur := q.items[item.uname]
+	if fin {
+		if cur == item {
+			delete(q.items, item.uname)
+			q.unpersist(item)
+		}
+		q.mu.Unlock()
+		if cur == item {
+			q.ops.queued(item, -1)
+		} else {
+			q.kick() // (newer update waiting)
+		}
+		return
+	}
+	item.tries++
+	item.failed = mono.NanoTime()
+	backoff := min(rqMinBackoff<<min(item.tries-1, 16), rqMaxBackoff)
+	item.next = item.failed + backoff.Nanoseconds()
+	q.mu.Unlock()
+
+	bck := item.entry.bucket()
+	q.t.statsT.IncWith(q.errMet, map[string]string{stats.VarlabBucket: bck.Cname("")})
+	nlog.Warningln(q.t.String(), q.coll, bck.Cname(item.entry.objName()), "failed [", err, ecode, "], tries:", item.tries)
+}
+
+// entry done with: delete it from kvdb unless already replaced by a newer one
+// (compare-and-delete under q.mu)
+func (q *rqueue) unpersist(item *rqItem) {
+	cur := q.ops.newEntry()
+	if _, err := q.db.Get(q.coll, item.uname, cur); err == nil && cur.tag() == item.entry.tag() {
+		if _, err := q.db.Delete(q.coll, item.uname); err != nil {
+			nlog.Errorln(q.t.String(), "failed to delete", q.coll, "entry", item.entry.bucket().Cname(item.entry.objName()), "err:", err)
+		}
+	}
+}
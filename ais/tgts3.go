@@ -32,6 +32,9 @@ func (t *target) s3Handler(w http.ResponseWriter, r *http.Request) {
 	if cmn.Rom.FastV(5, cos.SmoduleS3) {
 		nlog.Infoln("s3Handler", t.String(), r.Method, r.URL)
 	}
+	if t.corsHandler(w, r, true /*s3*/) {
+		return
+	}
 	apiItems, err := t.parseURL(w, r, apc.URLPathS3.L, 0, true)
 	if err != nil {
 		return
@@ -52,9 +55,12 @@ func (t *target) s3Handler(w http.ResponseWriter, r *http.Request) {
 		t.putCopyMpt(w, r, config, apiItems)
 	case http.MethodDelete:
 		q := r.URL.Query()
-		if q.Has(s3.QparamMptUploadID) {
+		switch {
+		case q.Has(s3.QparamMptUploadID):
 			t.abortMpt(w, r, apiItems, q)
-		} else {
+		case q.Has(s3.QparamTagging):
+			t.delObjTagging(w, r, apiItems)
+		default:
 			t.delObjS3(w, r, apiItems)
 		}
 	case http.MethodPost:
@@ -90,6 +96,12 @@ func (t *target) putCopyMpt(w http.ResponseWriter, r *http.Request, config *cmn.
 			nlog.Infoln("putMptPart", bck.String(), items, q)
 		}
 		t.putMptPart(w, r, items, q, bck)
+	case q.Has(s3.QparamTagging):
+		t.putObjTagging(w, r, bck, s3.ObjName(items))
+	case q.Has(s3.QparamRetention):
+		t.putObjRetention(w, r, bck, s3.ObjName(items))
+	case q.Has(s3.QparamLegalHold):
+		t.putObjLegalHold(w, r, bck, s3.ObjName(items))
 	case r.Header.Get(cos.S3HdrObjSrc) == "":
 		objName := s3.ObjName(items)
 		lom := core.AllocLOM(objName)
@@ -244,6 +256,17 @@ func (t *target) getObjS3(w http.ResponseWriter, r *http.Request, items []string
 		return
 	}
 	objName := s3.ObjName(items)
+	switch {
+	case q.Has(s3.QparamTagging):
+		t.getObjTagging(w, r, bck, objName)
+		return
+	case q.Has(s3.QparamRetention):
+		t.getObjRetention(w, r, bck, objName)
+		return
+	case q.Has(s3.QparamLegalHold):
+		t.getObjLegalHold(w, r, bck, objName)
+		return
+	}
 	if q.Has(s3.QparamMptPartNo) {
 		if cmn.Rom.FastV(5, cos.SmoduleS3) {
 			nlog.Infoln("getMptPart", bck.String(), objName, q)
@@ -294,6 +317,14 @@ func (t *target) headObjS3(w http.ResponseWriter, r *http.Request, items []strin
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
+	if ver := r.URL.Query().Get(s3.QparamVersionID); ver != "" {
+		if done, err := t.getObjVer(w, r, bck, lom, ver, true /*s3*/); done {
+			if err != nil {
+				s3.WriteErr(w, r, err, 0)
+			}
+			return
+		}
+	}
 	exists := true
 	err = lom.Load(true /*cache it*/, false /*locked*/)
 	if err != nil {
@@ -324,6 +355,19 @@ func (t *target) headObjS3(w http.ResponseWriter, r *http.Request, items []strin
 		op.ObjAttrs = *objAttrs
 	}
 
+	// conditional HEAD (see also objHead)
+	if pc := cmn.PrecondsFromHeader(r.Header); pc != nil {
+		if exists {
+			err = lom.EvalPreconds(pc, true /*exists*/, true /*read*/)
+		} else {
+			err = pc.Eval(lom.Cname(), &op.ObjAttrs, time.Time{}, true /*read*/)
+		}
+		if err != nil {
+			s3.WriteErr(w, r, err, precondStatus(err))
+			return
+		}
+	}
+
 	custom := op.GetCustomMD()
 	lom.SetCustomMD(custom)
 
@@ -370,12 +414,26 @@ func (t *target) delObjS3(w http.ResponseWriter, r *http.Request, items []string
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
-	ecode, err = t.DeleteObject(lom, false)
+	if ver := r.URL.Query().Get(s3.QparamVersionID); ver != "" {
+		bypass := cos.IsParseBool(r.Header.Get(s3.HeaderBypassGovernance))
+		if ecode, err := t.delObjVer(bck, lom, ver, bypass); err != nil {
+			s3.WriteErr(w, r, err, ecode)
+		}
+		return
+	}
+	var (
+		bypass = cos.IsParseBool(r.Header.Get(s3.HeaderBypassGovernance))
+		pc     = cmn.PrecondsFromHeader(r.Header)
+	)
+	ecode, err = t.deleteObject(lom, false /*evict*/, bypass, pc)
 	if err != nil {
 		name := lom.Cname()
-		if ecode == http.StatusNotFound {
+		switch {
+		case ecode == http.StatusNotFound:
 			s3.WriteErr(w, r, cos.NewErrNotFound(t, name), http.StatusNotFound)
-		} else {
+		case cmn.IsErrObjLocked(err), cmn.IsErrPrecondFailed(err):
+			s3.WriteErr(w, r, err, ecode)
+		default:
 			s3.WriteErr(w, r, fmt.Errorf("error deleting %s: %v", name, err), ecode)
 		}
 		return
@@ -392,6 +450,10 @@ func (t *target) postObjS3(w http.ResponseWriter, r *http.Request, items []strin
 		return
 	}
 	q := r.URL.Query()
+	if q.Has(s3.QparamSelect) {
+		t.selectObjS3(w, r, bck, s3.ObjName(items))
+		return
+	}
 	if q.Has(s3.QparamMptUploads) {
 		if cmn.Rom.FastV(5, cos.SmoduleS3) {
 			nlog.Infoln("startMpt", bck.String(), items, q)
@@ -406,7 +468,7 @@ func (t *target) postObjS3(w http.ResponseWriter, r *http.Request, items []strin
 		t.completeMpt(w, r, items, q, bck)
 		return
 	}
-	err = fmt.Errorf("set query parameter %q to start multipart upload or %q to complete the upload",
-		s3.QparamMptUploads, s3.QparamMptUploadID)
+	err = fmt.Errorf("set query parameter %q to start multipart upload, %q to complete the upload, or %q to select object content",
+		s3.QparamMptUploads, s3.QparamMptUploadID, s3.QparamSelect)
 	s3.WriteErr(w, r, err, 0)
 }
@@ -12,6 +12,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"slices"
 	"sort"
 	"strconv"
 	"time"
@@ -48,6 +49,15 @@ func multiWriter(writers ...io.Writer) io.Writer {
 	return io.MultiWriter(a...)
 }
 
+// remote S3 bucket that supports multipart upload (see cmn.BackendCaps)
+func s3mptNative(bck *meta.Bck) bool {
+	if !bck.IsRemoteS3() {
+		return false
+	}
+	prof := cmn.GCO.Get().Backend.ProfileOf(bck.RemoteBck())
+	return prof == nil || prof.Capabilities().MPT
+}
+
 // Initialize multipart upload.
 // - Generate UUID for the upload
 // - Return the UUID to a caller
@@ -65,7 +75,7 @@ func (t *target) startMpt(w http.ResponseWriter, r *http.Request, items []string
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
-	if bck.IsRemoteS3() {
+	if s3mptNative(bck) {
 		metadata = cmn.BackendHelpers.Amazon.DecodeMetadata(r.Header)
 		uploadID, ecode, err = backend.StartMpt(lom, r, q)
 		if err != nil {
@@ -145,7 +155,7 @@ func (t *target) putMptPart(w http.ResponseWriter, r *http.Request, items []stri
 		checkPartSHA = partSHA != "" && partSHA != cos.S3UnsignedPayload
 		cksumSHA     = &cos.CksumHash{}
 		cksumMD5     = &cos.CksumHash{}
-		remote       = bck.IsRemoteS3()
+		remote       = s3mptNative(bck)
 	)
 	if checkPartSHA {
 		cksumSHA = cos.NewCksumHash(cos.ChecksumSHA256)
@@ -173,6 +183,13 @@ func (t *target) putMptPart(w http.ResponseWriter, r *http.Request, items []stri
 	}
 
 	cos.Close(partFh)
+
+	// Azure: stage the part (as a block) from the local part file (see backend/azuremp.go)
+	if err == nil && bck.IsRemoteAzure() {
+		remoteStart := mono.NanoTime()
+		ecode, err = stageMptBlock(lom, wfqn, uploadID, size, partNum)
+		remotePutLatency = mono.SinceNano(remoteStart)
+	}
 	if err != nil {
 		if nerr := cos.RemoveFile(wfqn); nerr != nil && !os.IsNotExist(nerr) {
 			nlog.Errorf(fmtNested, t, err, "remove", wfqn, nerr)
@@ -267,15 +284,32 @@ func (t *target) completeMpt(w http.ResponseWriter, r *http.Request, items []str
 		return
 	}
 
-	// call s3
+	// call s3 (or azure)
 	var (
 		version string
 		etag    string
 		started = time.Now()
-		remote  = bck.IsRemoteS3()
+		remote  = s3mptNative(bck)
+		azure   = bck.IsRemoteAzure()
 	)
-	if remote {
+	switch {
+	case remote:
 		v, e, ecode, err := backend.CompleteMpt(lom, r, q, uploadID, body, partList)
+		core.HcacheDel(lom)
+		if err != nil {
+			s3.WriteMptErr(w, r, err, ecode, lom, uploadID)
+			return
+		}
+		version = v
+		etag = e
+	case azure:
+		nums := make([]int32, 0, len(partList.Parts))
+		for _, part := range partList.Parts {
+			nums = append(nums, *part.PartNumber)
+		}
+		slices.Sort(nums)
+		v, e, ecode, err := backend.CommitMptBlocks(lom, uploadID, nums)
+		core.HcacheDel(lom)
 		if err != nil {
 			s3.WriteMptErr(w, r, err, ecode, lom, uploadID)
 			return
@@ -342,7 +376,7 @@ func (t *target) completeMpt(w http.ResponseWriter, r *http.Request, items []str
 		lom.SetCksum(actualCksum.Cksum.Clone())
 	}
 	if etag == "" {
-		debug.Assert(!remote)
+		debug.Assert(!remote && !azure)
 		debug.Assert(concatMD5 != "")
 
 		resMD5Val := cos.ChecksumB2S(cos.UnsafeB(concatMD5), cos.ChecksumMD5)
@@ -360,9 +394,19 @@ func (t *target) completeMpt(w http.ResponseWriter, r *http.Request, items []str
 		for k, v := range cmn.BackendHelpers.Amazon.EncodeMetadata(metadata) {
 			lom.SetCustomKey(k, v)
 		}
+	} else if azure {
+		lom.SetCustomKey(cmn.SourceObjMD, apc.Azure)
+		if version != "" {
+			lom.SetCustomKey(cmn.VersionObjMD, version)
+		}
 	}
 	lom.SetCustomKey(cmn.ETag, etag)
 
+	// other remote backends (e.g., GCS) and S3-compatible endpoints configured without
+	// multipart capability do not support S3 multipart API natively:
+	// PUT the resulting object (see backend.PutObj, and in particular, GCS resumable upload)
+	putRemote := bck.IsRemote() && !remote && !azure
+
 	poi := allocPOI()
 	{
 		poi.t = t
@@ -370,8 +414,16 @@ func (t *target) completeMpt(w http.ResponseWriter, r *http.Request, items []str
 		poi.lom = lom
 		poi.workFQN = wfqn
 		poi.owt = cmn.OwtNone
+		if putRemote {
+			poi.owt = cmn.OwtPut
+		}
+	}
+	ecode, errF := 0, poi.encryptWork()
+	if errF == nil {
+		ecode, errF = poi.finalize()
+	} else if nerr := cos.RemoveFile(wfqn); nerr != nil && !os.IsNotExist(nerr) {
+		nlog.Errorf(fmtNested, t, errF, "remove", wfqn, nerr)
 	}
-	ecode, errF := poi.finalize()
 	freePOI(poi)
 
 	// .6 cleanup parts - unconditionally
@@ -380,7 +432,7 @@ func (t *target) completeMpt(w http.ResponseWriter, r *http.Request, items []str
 
 	if errF != nil {
 		// NOTE: not failing if remote op. succeeded
-		if !remote {
+		if !remote && !azure {
 			s3.WriteMptErr(w, r, errF, ecode, lom, uploadID)
 			return
 		}
@@ -388,6 +440,11 @@ func (t *target) completeMpt(w http.ResponseWriter, r *http.Request, items []str
 	}
 
 	// .7 respond
+	if putRemote {
+		if v, ok := lom.GetCustomKey(cmn.ETag); ok && v != "" {
+			etag = v
+		}
+	}
 	result := &s3.CompleteMptUploadResult{Bucket: bck.Name, Key: objName, ETag: etag}
 	sgl := t.gmm.NewSGL(0)
 	result.MustMarshal(sgl)
@@ -399,11 +456,21 @@ func (t *target) completeMpt(w http.ResponseWriter, r *http.Request, items []str
 	// stats
 	vlabs := map[string]string{stats.VarlabBucket: bck.Cname(""), stats.VarlabXactKind: "", stats.VarlabXactID: ""}
 	t.statsT.IncWith(stats.PutCount, vlabs)
-	if remote {
+	if remote || azure {
 		t.statsT.IncWith(t.Backend(bck).MetricName(stats.PutCount), vlabs)
 	}
 }
 
+func stageMptBlock(lom *core.LOM, wfqn, uploadID string, size int64, partNum int32) (int, error) {
+	fh, err := os.Open(wfqn)
+	if err != nil {
+		return 0, err
+	}
+	ecode, err := backend.StageMptBlock(lom, fh, uploadID, size, partNum)
+	cos.Close(fh)
+	return ecode, err
+}
+
 func _appendMpt(nparts []*s3.MptPart, buf []byte, mw io.Writer) (concatMD5 string, written int64, err error) {
 	for _, partInfo := range nparts {
 		var (
@@ -445,7 +512,7 @@ func (t *target) abortMpt(w http.ResponseWriter, r *http.Request, items []string
 
 	uploadID := q.Get(s3.QparamMptUploadID)
 
-	if bck.IsRemoteS3() {
+	if s3mptNative(bck) {
 		ecode, err := backend.AbortMpt(lom, r, q, uploadID)
 		if err != nil {
 			s3.WriteErr(w, r, err, ecode)
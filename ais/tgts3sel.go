@@ -0,0 +1,216 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"compress/bzip2"
+	"compress/gzip"
+	"context"
+	"encoding/xml"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/archive"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/cmn/sqlsel"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+const maxSelectBody = 256 * cos.KiB
+
+// counts bytes (S3 Select stats) and keeps the response stream alive
+type selReader struct {
+	r  io.Reader
+	ew *s3.EventWriter // nil when not keeping alive
+	n  int64
+}
+
+func (sr *selReader) Read(p []byte) (n int, err error) {
+	n, err = sr.r.Read(p)
+	sr.n += int64(n)
+	if sr.ew != nil {
+		if errK := sr.ew.KeepAlive(); errK != nil {
+			return n, errK
+		}
+	}
+	return n, err
+}
+
+// POST /s3/<bucket-name>/<object-name>?select&select-type=2
+// - archived file (shard member): use `apc.QparamArchpath` (and, optionally, `apc.QparamArchmime`)
+func (t *target) selectObjS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxSelectBody))
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	req := &s3.SelectRequest{}
+	if err := xml.Unmarshal(body, req); err != nil {
+		s3.WriteErr(w, r, s3.NewErrCode("MalformedXML", err.Error()), 0)
+		return
+	}
+	if err := req.Validate(); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	query, err := sqlsel.Parse(req.Expression)
+	if err != nil {
+		s3.WriteErr(w, r, selErr(err), 0)
+		return
+	}
+	ssec, err := s3.SSECFromHeader(r.Header)
+	if err != nil {
+		s3.WriteErr(w, r, err, http.StatusBadRequest)
+		return
+	}
+
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	ecode, err := t._lockLoad(lom, bck, false /*exclusive*/)
+	if ecode == http.StatusNotFound && bck.IsRemote() {
+		ecode, err = t.GetCold(context.Background(), lom, cmn.OwtGetLock)
+		if err == nil {
+			ecode, err = t._lockLoad(lom, bck, false)
+		}
+	}
+	if err != nil {
+		s3.WriteErr(w, r, err, ecode)
+		return
+	}
+	defer lom.Unlock(false)
+
+	lmfh, err := lom.OpenSSE(ssec)
+	if err != nil {
+		if cmn.IsErrSSEKey(err) {
+			ecode = http.StatusBadRequest
+		}
+		s3.WriteErr(w, r, err, ecode)
+		return
+	}
+	defer cos.Close(lmfh)
+
+	// input: object, its range, or archived file
+	var (
+		in io.Reader = lmfh
+		q            = r.URL.Query()
+	)
+	if archpath := q.Get(apc.QparamArchpath); archpath != "" {
+		if req.ScanRange != nil {
+			s3.WriteErr(w, r, s3.NewErrCode("UnsupportedScanRangeInput", "scan range is not supported for archived files"), 0)
+			return
+		}
+		csl, ecode, err := t.selArch(lom, lmfh, archpath, q.Get(apc.QparamArchmime))
+		if err != nil {
+			s3.WriteErr(w, r, err, ecode)
+			return
+		}
+		defer csl.Close()
+		in = csl
+	} else if req.ScanRange != nil {
+		start, end, err := req.ScanRange.Range(lom.Lsize())
+		if err != nil {
+			s3.WriteErr(w, r, err, 0)
+			return
+		}
+		in = sqlsel.NewRangeReader(lmfh, lom.Lsize(), start, end)
+	}
+
+	var (
+		ew        = s3.NewEventWriter(w)
+		scanned   = &selReader{r: in}
+		processed = &selReader{ew: ew}
+	)
+	switch req.InputSerialization.CompressionType {
+	case s3.CompressionGzip:
+		gzr, err := gzip.NewReader(scanned)
+		if err != nil {
+			s3.WriteErr(w, r, s3.NewErrCode("InvalidCompressionFormat", err.Error()), 0)
+			return
+		}
+		defer gzr.Close()
+		processed.r = gzr
+	case s3.CompressionBzip2:
+		processed.r = bzip2.NewReader(scanned)
+	default:
+		processed.r = scanned
+	}
+
+	// records: reader and writer
+	var (
+		rr sqlsel.Reader
+		rw sqlsel.Writer
+	)
+	if csvin := req.InputSerialization.CSV; csvin != nil {
+		rr, err = sqlsel.NewCSVReader(processed, csvin)
+	} else {
+		rr, err = sqlsel.NewJSONReader(processed, req.InputSerialization.JSON)
+	}
+	if err == nil {
+		if csvout := req.OutputSerialization.CSV; csvout != nil {
+			rw, err = sqlsel.NewCSVWriter(ew, csvout)
+		} else {
+			rw = sqlsel.NewJSONWriter(ew, req.OutputSerialization.JSON)
+		}
+	}
+	if err != nil {
+		s3.WriteErr(w, r, selErr(err), 0)
+		return
+	}
+
+	// stream
+	w.Header().Set(cos.HdrContentType, cos.ContentBinary)
+	w.WriteHeader(http.StatusOK)
+
+	err = query.Run(rr, rw)
+	if err == nil {
+		stats := &s3.SelectStats{BytesScanned: scanned.n, BytesProcessed: processed.n, BytesReturned: ew.Returned()}
+		if req.RequestProgress.Enabled {
+			err = ew.Progress(stats)
+		}
+		if err == nil {
+			err = ew.End(stats)
+		}
+	}
+	if err != nil {
+		if cmn.Rom.FastV(4, cos.SmoduleS3) {
+			nlog.Warningln("select", lom.Cname(), "failed:", err)
+		}
+		ew.Error(err)
+	}
+}
+
+func (t *target) selArch(lom *core.LOM, lmfh cos.LomReader, archpath, mime string) (cos.ReadCloseSizer, int, error) {
+	mime, err := archive.MimeFile(lmfh, t.smm, mime, lom.ObjName)
+	if err != nil {
+		return nil, 0, err
+	}
+	ar, err := archive.NewReader(mime, lmfh, lom.Lsize())
+	if err != nil {
+		return nil, 0, err
+	}
+	csl, err := ar.ReadOne(archpath)
+	if err != nil {
+		return nil, 0, cmn.NewErrFailedTo(t, "extract "+archpath+" from", lom.Cname(), err)
+	}
+	if csl == nil {
+		return nil, http.StatusNotFound, cos.NewErrNotFound(t, archpath+" in "+lom.Cname())
+	}
+	return csl, 0, nil
+}
+
+// S3 error code
+func selErr(err error) error {
+	var serr *sqlsel.Error
+	if errors.As(err, &serr) {
+		return s3.NewErrCode(serr.Code, serr.Msg)
+	}
+	return err
+}
@@ -0,0 +1,115 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"encoding/xml"
+	"io"
+	"net/http"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// S3 object tagging: tags are stored in the object's metadata (see lom.PersistTags)
+// and are therefore supported only for objects that are present in the cluster
+
+const maxTaggingBody = 16 * cos.KiB
+
+// GET /s3/<bucket-name>/<object-name>?tagging
+func (t *target) getObjTagging(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if ecode, err := t._lockLoad(lom, bck, false /*exclusive*/); err != nil {
+		s3.WriteErr(w, r, err, ecode)
+		return
+	}
+	tags := lom.Tags()
+	lom.Unlock(false)
+
+	resp, err := s3.NewTagging(tags)
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	sgl := t.gmm.NewSGL(0)
+	resp.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo2(w)
+	sgl.Free()
+}
+
+// PUT /s3/<bucket-name>/<object-name>?tagging
+func (t *target) putObjTagging(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxTaggingBody))
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	tagging := &s3.Tagging{}
+	if err := xml.Unmarshal(body, tagging); err != nil {
+		s3.WriteErr(w, r, s3.NewErrCode("MalformedXML", err.Error()), 0)
+		return
+	}
+	tags, err := tagging.ToTags()
+	if err != nil {
+		s3.WriteErr(w, r, s3.NewErrCode("InvalidTag", err.Error()), 0)
+		return
+	}
+
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if ecode, err := t._lockLoad(lom, bck, true /*exclusive*/); err != nil {
+		s3.WriteErr(w, r, err, ecode)
+		return
+	}
+	err = lom.PersistTags(tags)
+	lom.Unlock(true)
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+	}
+}
+
+// DELETE /s3/<bucket-name>/<object-name>?tagging
+func (t *target) delObjTagging(w http.ResponseWriter, r *http.Request, items []string) {
+	bck, err, ecode := meta.InitByNameOnly(items[0], t.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, ecode)
+		return
+	}
+	lom := core.AllocLOM(s3.ObjName(items))
+	defer core.FreeLOM(lom)
+	if ecode, err := t._lockLoad(lom, bck, true /*exclusive*/); err != nil {
+		s3.WriteErr(w, r, err, ecode)
+		return
+	}
+	if lom.Tags() != "" {
+		err = lom.PersistTags(nil)
+	}
+	lom.Unlock(true)
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// init, lock, and load; returns locked lom upon success
+func (t *target) _lockLoad(lom *core.LOM, bck *meta.Bck, exclusive bool) (int, error) {
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		return 0, err
+	}
+	lom.Lock(exclusive)
+	if err := lom.Load(true /*cache it*/, true /*locked*/); err != nil {
+		lom.Unlock(exclusive)
+		if cos.IsNotExist(err, 0) {
+			return http.StatusNotFound, cos.NewErrNotFound(t, lom.Cname())
+		}
+		return 0, err
+	}
+	return 0, nil
+}
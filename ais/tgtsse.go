@@ -0,0 +1,113 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/cmn/sse"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// Server-side encryption at rest, target side (see cmn/encryption.go):
+// - per-object encryption requested via (S3) SSE headers that native API
+//   accepts as well
+// - PUT path encrypting new content
+// - encrypting new content written by other means
+
+// parse SSE request headers
+func (poi *putOI) sseFromHeader(hdr http.Header) (err error) {
+	if poi.ssec, err = s3.SSECFromHeader(hdr); err != nil {
+		return err
+	}
+	if poi.sse, err = s3.SSEFromHeader(hdr); err != nil {
+		return err
+	}
+	if poi.ssec == nil && !poi.sse {
+		return nil
+	}
+	return sseSupported(poi.lom.Bck())
+}
+
+func sseSupported(bck *meta.Bck) error {
+	if !bck.IsAIS() {
+		return cmn.NewErrUnsupp("encrypt objects in", bck.Cname("")+" (server-side encryption is supported only for ais:// buckets)")
+	}
+	if bck.Props.EC.Enabled {
+		return cmn.NewErrUnsupp("encrypt objects in", bck.Cname("")+" (erasure coded)")
+	}
+	return nil
+}
+
+// rebalance migrates encrypted objects as is: ciphertext that arrives together with
+// its encryption attributes, including wrapped data key and SSE-C key MD5
+func (poi *putOI) isRaw() bool {
+	return poi.owt == cmn.OwtRebalance && poi.lom.IsEncrypted()
+}
+
+// returns encrypting writer or nil when the new content is to be stored as is
+func (poi *putOI) encryptTo(w io.Writer) (*sse.Writer, error) {
+	lom := poi.lom
+	if !lom.Bck().IsAIS() || poi.isRaw() {
+		return nil, nil
+	}
+	dek, err := lom.InitSSE(poi.ssec, poi.sse)
+	if err != nil || dek == nil {
+		return nil, err
+	}
+	return sse.NewWriter(w, dek)
+}
+
+// encrypt plaintext work file in place - for new content that is written
+// outside the regular PUT path (e.g., multipart upload, promote, archive)
+func (poi *putOI) encryptWork() error {
+	lom := poi.lom
+	if !lom.Bck().IsAIS() {
+		return nil
+	}
+	dek, err := lom.InitSSE(nil, false) // (also drops stale encryption attributes, if any)
+	if err != nil || dek == nil {
+		return err
+	}
+	src, err := os.Open(poi.workFQN)
+	if err != nil {
+		return err
+	}
+	encFQN := fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfileSSE)
+	dst, err := lom.CreateWork(encFQN)
+	if err != nil {
+		src.Close()
+		return err
+	}
+	encw, err := sse.NewWriter(dst, dek)
+	if err == nil {
+		buf, slab := poi.t.gmm.Alloc()
+		_, err = cos.CopyBuffer(encw, src, buf)
+		slab.Free(buf)
+		if err == nil {
+			err = encw.Close()
+		}
+	}
+	src.Close()
+	if errC := dst.Close(); err == nil {
+		err = errC
+	}
+	if err == nil {
+		err = cos.Rename(encFQN, poi.workFQN)
+	}
+	if err != nil {
+		if errRm := cos.RemoveFile(encFQN); errRm != nil && !os.IsNotExist(errRm) {
+			nlog.Errorf(fmtNested, poi.t, err, "remove", encFQN, errRm)
+		}
+	}
+	return err
+}
@@ -0,0 +1,143 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"bytes"
+	cryptorand "crypto/rand"
+	"encoding/base64"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/sse"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SSE", func() {
+	put := func(lom *core.LOM, r io.Reader, owt cmn.OWT, ck *sse.CustKey, cksum *cos.Cksum) {
+		poi := &putOI{
+			atime:      time.Now().UnixNano(),
+			t:          t,
+			lom:        lom,
+			r:          io.NopCloser(r),
+			workFQN:    path.Join(testMountpath, lom.ObjName+".work"),
+			config:     cmn.GCO.Get(),
+			owt:        owt,
+			ssec:       ck,
+			cksumToUse: cksum,
+		}
+		_, err := poi.putObject()
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	It("should migrate SSE-C object as is", func() {
+		ck, err := sse.NewCustKey(base64.StdEncoding.EncodeToString(sse.GenKey()), "")
+		Expect(err).NotTo(HaveOccurred())
+		plain := make([]byte, sse.FrameSize+100)
+		_, _ = cryptorand.Read(plain)
+
+		src := core.AllocLOM("sse-src")
+		defer core.FreeLOM(src)
+		Expect(src.InitBck(&cmn.Bck{Name: testBucket, Provider: apc.AIS, Ns: cmn.NsGlobal})).NotTo(HaveOccurred())
+		put(src, bytes.NewReader(plain), cmn.OwtPut, ck, nil)
+		defer src.RemoveMain()
+		Expect(src.SSECustMD5()).To(Equal(ck.MD5))
+
+		// rebalance: ciphertext on the wire, along with all attributes
+		raw, err := os.ReadFile(src.FQN)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(int64(len(raw))).To(Equal(sse.EncSize(int64(len(plain)))))
+
+		dst := core.AllocLOM("sse-dst")
+		defer core.FreeLOM(dst)
+		Expect(dst.InitBck(src.Bucket())).NotTo(HaveOccurred())
+		dst.CopyAttrs(src.ObjAttrs(), false /*skip cksum*/)
+		put(dst, bytes.NewReader(raw), cmn.OwtRebalance, nil, src.Checksum())
+		defer dst.RemoveMain()
+
+		Expect(dst.Load(false, false)).NotTo(HaveOccurred())
+		Expect(dst.Lsize()).To(BeEquivalentTo(len(plain)))
+		Expect(dst.SSECustMD5()).To(Equal(ck.MD5))
+		wrapped, _ := src.GetCustomKey(cmn.SSEKeyObjMD)
+		moved, _ := dst.GetCustomKey(cmn.SSEKeyObjMD)
+		Expect(moved).To(Equal(wrapped))
+
+		_, err = dst.Open()
+		Expect(cmn.IsErrSSEKey(err)).To(BeTrue())
+		r, err := dst.OpenSSE(ck)
+		Expect(err).NotTo(HaveOccurred())
+		b, err := io.ReadAll(r)
+		r.Close()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(b).To(Equal(plain))
+	})
+
+	It("should append to encrypted object", func() {
+		// cluster-managed key
+		kfqn := filepath.Join(testMountpath, "sse-keyring.json")
+		kf := `{"current": "k1", "keys": {"k1": "` + base64.StdEncoding.EncodeToString(sse.GenKey()) + `"}}`
+		Expect(os.WriteFile(kfqn, []byte(kf), 0o600)).NotTo(HaveOccurred())
+		defer os.Remove(kfqn)
+		kr, err := sse.LoadKeyring(kfqn)
+		Expect(err).NotTo(HaveOccurred())
+		sse.Init(kr)
+		defer sse.Init(nil)
+		if t.owner.smap.get() == nil {
+			smap := newSmap()
+			smap.addTarget(t.si)
+			t.owner.smap.put(smap)
+		}
+
+		bck := meta.NewBck("sse-apnd", apc.AIS, cmn.NsGlobal)
+		bmd := t.owner.bmd.get().clone()
+		bmd.Version++ // (unique BID)
+		bmd.add(bck, &cmn.Bprops{Cksum: cmn.CksumConf{Type: cos.ChecksumXXHash}, SSE: &cmn.SSEConf{}})
+		Expect(fs.CreateBucket(bck.Bucket(), false /*nilbmd*/)).To(BeEmpty())
+		t.owner.bmd.putPersist(bmd, nil)
+		defer func() {
+			bmd := t.owner.bmd.get().clone()
+			bmd.del(bck)
+			t.owner.bmd.putPersist(bmd, nil)
+			for _, mi := range fs.GetAvail() {
+				os.RemoveAll(mi.MakePathBck(bck.Bucket()))
+			}
+		}()
+
+		head, tail := []byte("encrypted at rest;"), []byte(" appended")
+		lom := core.AllocLOM("sse-apnd-obj")
+		defer core.FreeLOM(lom)
+		Expect(lom.InitBck(bck.Bucket())).NotTo(HaveOccurred())
+		put(lom, bytes.NewReader(head), cmn.OwtPut, nil, nil)
+		Expect(lom.IsEncrypted()).To(BeTrue())
+
+		a := &apndOI{started: time.Now().UnixNano(), t: t, config: cmn.GCO.Get(), lom: lom, r: io.NopCloser(bytes.NewReader(tail))}
+		hdl, _, err := a.apnd(make([]byte, cos.KiB))
+		Expect(err).NotTo(HaveOccurred())
+		a = &apndOI{started: time.Now().UnixNano(), t: t, config: cmn.GCO.Get(), lom: lom}
+		Expect(a.parse(hdl)).NotTo(HaveOccurred())
+		_, err = a.flush()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+		Expect(lom.IsEncrypted()).To(BeTrue())
+		Expect(lom.Lsize()).To(BeEquivalentTo(len(head) + len(tail)))
+		r, err := lom.Open()
+		Expect(err).NotTo(HaveOccurred())
+		b, err := io.ReadAll(r)
+		r.Close()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(b).To(Equal(append(head, tail...)))
+	})
+})
@@ -0,0 +1,117 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"context"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// multi-backend tiering (see cmn/tiering.go):
+// - cold GET, HEAD, and DELETE: see core/tier.go and below
+// - for each bucket with configured (and enabled) migration, run x-tier-migrate
+//   once every cmn.TierMigrateIval
+
+func (t *target) regTierMigrate() {
+	hk.Reg(apc.ActTierMigrate+hk.NameSuffix, t.tierHK, cmn.TierMigrateIval)
+}
+
+func (t *target) tierHK(int64) time.Duration {
+	if !t.ClusterStarted() || nlog.Stopping() {
+		return cmn.TierMigrateIval
+	}
+	bmd := t.owner.bmd.get()
+	bmd.Range(nil /*any provider*/, nil /*any namespace*/, func(bck *meta.Bck) bool {
+		if !bck.Props.Tiering.HasMigration() {
+			return false
+		}
+		if _, err := t.runTierMigrate("" /*xid*/, bck); err != nil {
+			nlog.Errorln(t.String(), "failed to run", apc.ActTierMigrate, bck.Cname(""), "err:", err)
+		}
+		return false
+	})
+	return cmn.TierMigrateIval
+}
+
+func (*target) runTierMigrate(xid string, bck *meta.Bck) (string, error) {
+	if !bck.Props.Tiering.HasMigration() {
+		return "", cmn.NewErrUnsupp("migrate", bck.Cname("")+" (tiering is not configured or disabled)")
+	}
+	if xid == "" {
+		xid = cos.GenUUID()
+	}
+	rns := xreg.RenewTierMigrate(xid, bck)
+	if rns.Err != nil {
+		if cmn.IsErrXactUsePrev(rns.Err) {
+			return "", nil
+		}
+		return "", rns.Err
+	}
+	if rns.IsRunning() {
+		return "", nil
+	}
+	xctn := rns.Entry.Get()
+	xact.GoRunW(xctn)
+	return xctn.ID(), nil
+}
+
+// cold GET via reader (compare w/ backend.GetObj)
+func (t *target) getColdTiered(ctx context.Context, lom *core.LOM, owt cmn.OWT) (int, error) {
+	res := core.GetObjReaderTiered(ctx, lom, 0, 0)
+	if res.Err != nil {
+		return res.ErrCode, res.Err
+	}
+	params := core.AllocPutParams()
+	{
+		params.WorkTag = fs.WorkfileColdget
+		params.Reader = res.R
+		params.OWT = owt
+		params.Cksum = res.ExpCksum
+		params.Size = res.Size
+		params.Atime = time.Now()
+		params.ColdGET = true
+	}
+	err := t.PutObject(lom, params)
+	core.FreePutParams(params)
+	return 0, err
+}
+
+// delete from all tiers (so that older replicas, if any, do not resurface via cold GET);
+// not-found everywhere is reported as such
+func (t *target) delTiered(lom *core.LOM) (int, error) {
+	ecode, err := t.Backend(lom.Bck()).DeleteObj(lom)
+	if err != nil && !cos.IsNotExist(err, ecode) {
+		return ecode, err
+	}
+	conf := lom.Bprops().Tiering
+	for i := range conf.Tiers {
+		tier := &conf.Tiers[i].Bck
+		tlom, errV := lom.TierLOM(tier)
+		if errV != nil {
+			nlog.Warningln(t.String(), "tier", tier.Cname(""), "[", lom.Cname(), errV, "]")
+			continue
+		}
+		tcode, terr := t.Backend(tlom.Bck()).DeleteObj(tlom)
+		core.FreeLOM(tlom)
+		switch {
+		case terr == nil:
+			ecode, err = 0, nil
+		case !cos.IsNotExist(terr, tcode):
+			return tcode, terr
+		}
+	}
+	return ecode, err
+}
@@ -0,0 +1,264 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/xact/xreg"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// in-memory remote backend
+type memBackend struct {
+	core.Backend
+	provider string
+	objs     map[string][]byte // by remote bucket's cname(object)
+	mu       sync.Mutex
+}
+
+func newMemBackend(provider string) *memBackend {
+	return &memBackend{provider: provider, objs: make(map[string][]byte, 4)}
+}
+
+func (be *memBackend) Provider() string              { return be.provider }
+func (be *memBackend) MetricName(name string) string { return be.provider + "." + name }
+
+func (*memBackend) key(lom *core.LOM) string { return lom.Bck().RemoteBck().Cname(lom.ObjName) }
+
+func (be *memBackend) get(lom *core.LOM) ([]byte, bool) {
+	be.mu.Lock()
+	b, ok := be.objs[be.key(lom)]
+	be.mu.Unlock()
+	return b, ok
+}
+
+func (be *memBackend) has(bck *cmn.Bck, objName string) bool {
+	be.mu.Lock()
+	_, ok := be.objs[bck.Cname(objName)]
+	be.mu.Unlock()
+	return ok
+}
+
+func (be *memBackend) set(bck *cmn.Bck, objName string, b []byte) {
+	be.mu.Lock()
+	be.objs[bck.Cname(objName)] = b
+	be.mu.Unlock()
+}
+
+func (be *memBackend) GetObjReader(_ context.Context, lom *core.LOM, _, _ int64) (res core.GetReaderResult) {
+	b, ok := be.get(lom)
+	if !ok {
+		res.ErrCode, res.Err = http.StatusNotFound, cos.NewErrNotFound(nil, be.key(lom))
+		return res
+	}
+	lom.SetCustomKey(cmn.SourceObjMD, be.provider)
+	res.R, res.Size = io.NopCloser(bytes.NewReader(b)), int64(len(b))
+	return res
+}
+
+func (be *memBackend) HeadObj(_ context.Context, lom *core.LOM, _ *http.Request) (*cmn.ObjAttrs, int, error) {
+	b, ok := be.get(lom)
+	if !ok {
+		return nil, http.StatusNotFound, cos.NewErrNotFound(nil, be.key(lom))
+	}
+	oa := &cmn.ObjAttrs{Size: int64(len(b)), CustomMD: cos.StrKVs{cmn.SourceObjMD: be.provider}}
+	return oa, 0, nil
+}
+
+func (be *memBackend) PutObj(r io.ReadCloser, lom *core.LOM, _ *http.Request) (int, error) {
+	b, err := io.ReadAll(r)
+	r.Close()
+	if err != nil {
+		return 0, err
+	}
+	be.set(lom.Bck().RemoteBck(), lom.ObjName, b)
+	return 0, nil
+}
+
+func (be *memBackend) DeleteObj(lom *core.LOM) (int, error) {
+	be.mu.Lock()
+	defer be.mu.Unlock()
+	key := be.key(lom)
+	if _, ok := be.objs[key]; !ok {
+		return http.StatusNotFound, cos.NewErrNotFound(nil, key)
+	}
+	delete(be.objs, key)
+	return 0, nil
+}
+
+var _ = Describe("Tiering", func() {
+	var (
+		hot, cold *memBackend
+
+		bck     = meta.NewBck("tiered", apc.AIS, cmn.NsGlobal)
+		hotBck  = meta.NewBck("onprem", apc.AWS, cmn.NsGlobal)
+		coldBck = meta.NewBck("archive", apc.GCP, cmn.NsGlobal)
+		content = []byte("tiered object")
+	)
+
+	addBck := func(b *meta.Bck, props *cmn.Bprops) {
+		bmd := t.owner.bmd.get().clone()
+		bmd.Version++ // (unique BID)
+		bmd.add(b, props)
+		t.owner.bmd.putPersist(bmd, nil)
+	}
+
+	BeforeEach(func() {
+		config := cmn.GCO.BeginUpdate()
+		config.Backend.Providers = map[string]cmn.Ns{apc.AWS: cmn.NsGlobal, apc.GCP: cmn.NsGlobal}
+		cmn.GCO.CommitUpdate(config)
+		hot, cold = newMemBackend(apc.AWS), newMemBackend(apc.GCP)
+		t.backend = backends{apc.AWS: hot, apc.GCP: cold}
+
+		addBck(hotBck, &cmn.Bprops{Cksum: cmn.CksumConf{Type: cos.ChecksumNone}})
+		addBck(coldBck, &cmn.Bprops{Cksum: cmn.CksumConf{Type: cos.ChecksumNone}})
+		addBck(bck, &cmn.Bprops{
+			Cksum:      cmn.CksumConf{Type: cos.ChecksumNone},
+			BackendBck: *hotBck.Bucket(),
+			Tiering: &cmn.TieringConf{
+				Tiers: []cmn.TierConf{{Bck: *coldBck.Bucket(), Age: cos.Duration(time.Hour)}},
+			},
+		})
+		Expect(fs.CreateBucket(bck.Bucket(), false /*nilbmd*/)).To(BeEmpty())
+	})
+
+	AfterEach(func() {
+		bmd := t.owner.bmd.get().clone()
+		for _, b := range []*meta.Bck{bck, hotBck, coldBck} {
+			bmd.del(b)
+		}
+		t.owner.bmd.putPersist(bmd, nil)
+		for _, mi := range fs.GetAvail() {
+			os.RemoveAll(mi.MakePathBck(bck.Bucket()))
+		}
+	})
+
+	newLOM := func(objName string) *core.LOM {
+		lom := core.AllocLOM(objName)
+		Expect(lom.InitBck(bck.Bucket())).NotTo(HaveOccurred())
+		return lom
+	}
+	coldGet := func(objName string) *core.LOM {
+		lom := newLOM(objName)
+		ecode, err := t.GetCold(context.Background(), lom, cmn.OwtGetLock)
+		Expect(err).NotTo(HaveOccurred(), "ecode %d", ecode)
+		Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+		return lom
+	}
+	tierOf := func(lom *core.LOM) string {
+		if tier := lom.Tier(); tier != nil {
+			return tier.Cname("")
+		}
+		return ""
+	}
+
+	It("should cold-GET from the first tier that has the object", func() {
+		hot.set(hotBck.Bucket(), "in-hot", content)
+		cold.set(coldBck.Bucket(), "in-cold", content)
+
+		lom := coldGet("in-hot")
+		defer core.FreeLOM(lom)
+		Expect(lom.Lsize()).To(BeEquivalentTo(len(content)))
+		Expect(tierOf(lom)).To(BeEmpty())
+
+		lom2 := coldGet("in-cold")
+		defer core.FreeLOM(lom2)
+		Expect(lom2.Lsize()).To(BeEquivalentTo(len(content)))
+		Expect(tierOf(lom2)).To(Equal(coldBck.Cname("")))
+		r, err := lom2.Open()
+		Expect(err).NotTo(HaveOccurred())
+		b, err := io.ReadAll(r)
+		r.Close()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(b).To(Equal(content))
+
+		// missing everywhere
+		lom3 := newLOM("none")
+		defer core.FreeLOM(lom3)
+		ecode, err := t.GetCold(context.Background(), lom3, cmn.OwtGetLock)
+		Expect(cos.IsNotExist(err, ecode)).To(BeTrue())
+	})
+
+	It("should HEAD the tier that has the object", func() {
+		cold.set(coldBck.Bucket(), "in-cold", content)
+		lom := newLOM("in-cold")
+		defer core.FreeLOM(lom)
+		oa, _, err := core.HeadObjTiered(context.Background(), lom, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(oa.Size).To(BeEquivalentTo(len(content)))
+		tier, _ := oa.GetCustomKey(cmn.TierObjMD)
+		Expect(tier).To(Equal(coldBck.Cname("")))
+
+		lom2 := newLOM("none")
+		defer core.FreeLOM(lom2)
+		_, ecode, err := core.HeadObjTiered(context.Background(), lom2, nil)
+		Expect(cos.IsNotExist(err, ecode)).To(BeTrue())
+	})
+
+	It("should delete object from all tiers", func() {
+		hot.set(hotBck.Bucket(), "both", content)
+		cold.set(coldBck.Bucket(), "both", content)
+		lom := coldGet("both")
+		defer core.FreeLOM(lom)
+
+		_, err := t.delTiered(lom)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hot.has(hotBck.Bucket(), "both")).To(BeFalse())
+		Expect(cold.has(coldBck.Bucket(), "both")).To(BeFalse())
+
+		ecode, err := t.delTiered(lom)
+		Expect(cos.IsNotExist(err, ecode)).To(BeTrue())
+	})
+
+	It("should migrate cold objects down the chain", func() {
+		for _, name := range []string{"cold", "warm"} {
+			hot.set(hotBck.Bucket(), name, content)
+			lom := coldGet(name)
+			if name == "cold" {
+				// (not accessed for 2h)
+				lom.Uncache()
+				atime := time.Now().Add(-2 * time.Hour)
+				Expect(os.Chtimes(lom.FQN, atime, atime)).NotTo(HaveOccurred())
+			}
+			core.FreeLOM(lom)
+		}
+
+		b := meta.CloneBck(bck.Bucket())
+		Expect(b.Init(t.owner.bmd)).NotTo(HaveOccurred())
+		xid, err := t.runTierMigrate("", b)
+		Expect(err).NotTo(HaveOccurred())
+		xctn, err := xreg.GetXact(xid)
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(xctn.Finished).WithTimeout(10 * time.Second).Should(BeTrue())
+		Expect(xctn.Snap().Err).To(BeEmpty())
+		Expect(xctn.Objs()).To(BeEquivalentTo(1))
+
+		Expect(hot.has(hotBck.Bucket(), "cold")).To(BeFalse())
+		Expect(cold.has(coldBck.Bucket(), "cold")).To(BeTrue())
+		Expect(hot.has(hotBck.Bucket(), "warm")).To(BeTrue())
+		Expect(cold.has(coldBck.Bucket(), "warm")).To(BeFalse())
+
+		lom := newLOM("cold")
+		defer core.FreeLOM(lom)
+		Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+		Expect(tierOf(lom)).To(Equal(coldBck.Cname("")))
+		src, _ := lom.GetCustomKey(cmn.SourceObjMD)
+		Expect(src).To(Equal(apc.GCP))
+	})
+})
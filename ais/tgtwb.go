@@ -0,0 +1,301 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/kvdb"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/stats"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Write-back (data write policy "delayed") for remote buckets:
+// - PUT completes once the object is stored in-cluster; the object carries
+//   cmn.WriteBackObjMD custom key (the "pending" marker) until it gets written
+//   to remote backend
+// - each target maintains its own queue of pending objects persisted in the
+//   local kvdb (see tgtrq.go), to survive restarts; objects that migrate to other targets
+//   (rebalance) bring the marker with them and get (re)queued upon arrival
+// - uploads are retried indefinitely with exponential backoff
+// - x-write-back flushes (and waits for) a given bucket's backlog
+//
+// NOTE: the marker is the source of truth: an entry whose object no longer exists,
+// carries a different marker (overwritten), or is no longer HRW-local (migrated)
+// is simply dropped.
+
+const wbCollection = "write-back"
+
+type (
+	// persistent (kvdb) entry
+	wbEntry struct {
+		Bck   cmn.Bck `json:"bck"`
+		Name  string  `json:"name"`
+		Since string  `json:"since"` // cmn.WriteBackObjMD value
+		Size  int64   `json:"size"`
+	}
+	wbQueue struct {
+		rqueue
+	}
+)
+
+// interface guards
+var (
+	_ rqEntry = (*wbEntry)(nil)
+	_ rqOps   = (*wbQueue)(nil)
+)
+
+var (
+	errWbGone    = errors.New("write-back: object no longer pending") // (drop the entry)
+	errWbPending = errors.New("not yet written to remote backend (write-back pending)")
+)
+
+func (e *wbEntry) bucket() *cmn.Bck { return &e.Bck }
+func (e *wbEntry) objName() string  { return e.Name }
+func (e *wbEntry) tag() string      { return e.Since }
+
+func (q *wbQueue) init(t *target, db kvdb.Driver) {
+	q.rqueue.init(t, db, wbCollection, stats.ErrWriteBackCount, q)
+}
+
+// called by PUT (and rebalance) under the object's write lock, prior to finalizing
+// (if not persisted, the object remains stored in-cluster with the marker that will not survive restart)
+func (q *wbQueue) add(lom *core.LOM, since string) {
+	entry := &wbEntry{Bck: *lom.Bucket(), Name: lom.ObjName, Since: since, Size: lom.Lsize()}
+	item, prev := q.rqueue.add(lom.Uname(), entry, entry.Size)
+	if prev != nil {
+		q.stats(&entry.Bck, 0, item.size-prev.size)
+	} else {
+		q.stats(&entry.Bck, 1, item.size)
+	}
+}
+
+// object deleted
+func (q *wbQueue) del(lom *core.LOM) { q.rqueue.del(lom.Uname()) }
+
+// as rqOps ---------------------------------------------------------------------
+
+func (*wbQueue) newEntry() rqEntry { return &wbEntry{} }
+
+func (q *wbQueue) process(item *rqItem) (int, error) { return q.upload(item) }
+
+func (q *wbQueue) finish(item *rqItem, _ int, err error) bool {
+	switch {
+	case err == nil:
+		vlabs := map[string]string{stats.VarlabBucket: item.entry.bucket().Cname("")}
+		q.t.statsT.AddWith(
+			cos.NamedVal64{Name: stats.WriteBackCount, Value: 1, VarLabs: vlabs},
+			cos.NamedVal64{Name: stats.WriteBackSize, Value: item.size, VarLabs: vlabs},
+		)
+		return true
+	case err == errWbGone:
+		return true
+	default:
+		return false
+	}
+}
+
+func (q *wbQueue) queued(item *rqItem, n int64) {
+	if n > 0 && item.size == 0 {
+		item.size = item.entry.(*wbEntry).Size // (loaded)
+	}
+	q.stats(item.entry.bucket(), n, n*item.size)
+}
+
+func (*wbQueue) hold(*rqItem) bool { return false }
+func (*wbQueue) tick()             {}
+
+func (q *wbQueue) stats(bck *cmn.Bck, n, size int64) {
+	vlabs := map[string]string{stats.VarlabBucket: bck.Cname("")}
+	q.t.statsT.AddWith(
+		cos.NamedVal64{Name: stats.WriteBackBacklogCount, Value: n, VarLabs: vlabs},
+		cos.NamedVal64{Name: stats.WriteBackBacklogSize, Value: size, VarLabs: vlabs},
+	)
+}
+
+// write the object to remote backend and, upon success, remove the marker
+func (q *wbQueue) upload(item *rqItem) (int, error) {
+	entry := item.entry.(*wbEntry)
+	lom := core.AllocLOM(entry.Name)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(&entry.Bck); err != nil {
+		if cmn.IsErrBckNotFound(err) {
+			return 0, errWbGone
+		}
+		return 0, err
+	}
+
+	lom.Lock(false)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		lom.Unlock(false)
+		if cos.IsNotExist(err, 0) || cmn.IsErrObjNought(err) {
+			return 0, errWbGone
+		}
+		return 0, err
+	}
+	if v, _ := lom.GetCustomKey(cmn.WriteBackObjMD); v != entry.Since {
+		lom.Unlock(false)
+		return 0, errWbGone
+	}
+	// migrated: the new owner is responsible (and must be the only writer)
+	smap := q.t.owner.smap.get()
+	if _, local, err := lom.HrwTarget(&smap.Smap); err == nil && !local {
+		lom.Unlock(false)
+		return 0, errWbGone
+	}
+	fh, err := lom.NewHandle()
+	if err != nil {
+		lom.Unlock(false)
+		return 0, err
+	}
+	var (
+		backend = q.t.Backend(lom.Bck())
+		oreq    = wbReq(lom)
+	)
+	ecode, err := backend.PutObj(fh, lom, oreq)
+	core.HcacheDel(lom)
+	lom.Unlock(false)
+	if err != nil {
+		return ecode, err
+	}
+	return 0, q.finalize(lom, entry, backend.Provider())
+}
+
+// update in-cluster metadata with the one returned by the backend (compare w/ putRemote)
+func (*wbQueue) finalize(uploaded *core.LOM, entry *wbEntry, provider string) error {
+	lom := core.AllocLOM(entry.Name)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(&entry.Bck); err != nil {
+		return err
+	}
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		if cmn.IsErrObjNought(err) {
+			return nil // deleted in the meantime
+		}
+		return err
+	}
+	if v, _ := lom.GetCustomKey(cmn.WriteBackObjMD); v != entry.Since {
+		return nil // overwritten in the meantime (and queued again)
+	}
+	lom.SetVersion(uploaded.Version())
+	for _, k := range [...]string{cmn.VersionObjMD, cmn.ETag, cmn.MD5ObjMD, cmn.CRC32CObjMD, cmn.LastModified} {
+		if v, ok := uploaded.GetCustomKey(k); ok {
+			lom.SetCustomKey(k, v)
+		}
+	}
+	if !lom.Bck().IsRemoteAIS() {
+		lom.SetCustomKey(cmn.SourceObjMD, provider)
+	}
+	lom.ClearWriteBack()
+	return lom.Persist()
+}
+
+// synthetic request to carry user metadata (see also: delayed PUT in poi.fini)
+func wbReq(lom *core.LOM) *http.Request {
+	var hdr http.Header
+	for k, v := range lom.GetCustomMD() {
+		if strings.HasPrefix(k, cmn.AwsHeaderMetaPrefix) {
+			if hdr == nil {
+				hdr = make(http.Header, 2)
+			}
+			hdr.Set(k, v)
+		}
+	}
+	if hdr == nil {
+		return nil
+	}
+	return &http.Request{Method: http.MethodPut, Header: hdr}
+}
+
+//
+// x-write-back
+//
+
+func (q *wbQueue) flush(bck *meta.Bck) int64 {
+	now := mono.NanoTime()
+	q.mu.Lock()
+	for _, item := range q.items {
+		if bck.Equal((*meta.Bck)(item.entry.bucket()), false /*same ID*/, true /*same backend*/) {
+			item.next = 0
+		}
+	}
+	q.mu.Unlock()
+	q.kick()
+	return now
+}
+
+func (q *wbQueue) backlog(bck *meta.Bck, since int64) (num, size, failed int64) {
+	q.mu.Lock()
+	for _, item := range q.items {
+		if !bck.Equal((*meta.Bck)(item.entry.bucket()), false, true) {
+			continue
+		}
+		num++
+		size += item.size
+		if item.failed > since {
+			failed++
+		}
+	}
+	q.mu.Unlock()
+	return num, size, failed
+}
+
+func (t *target) runWriteBack(xid string, bck *meta.Bck) (string, error) {
+	if !bck.IsRemote() {
+		return "", fmt.Errorf("%s: write-back is supported only for remote buckets", bck.Cname(""))
+	}
+	args := &xreg.WbArgs{Flush: t.wbq.flush, Backlog: t.wbq.backlog}
+	rns := xreg.RenewWriteBack(xid, bck, args)
+	if rns.Err != nil {
+		if cmn.IsErrXactUsePrev(rns.Err) {
+			return rns.Entry.Get().ID(), nil
+		}
+		return "", rns.Err
+	}
+	xctn := rns.Entry.Get()
+	if rns.IsRunning() {
+		return xctn.ID(), nil
+	}
+	xact.GoRunW(xctn)
+	return xctn.ID(), nil
+}
+
+// is called when beginning to destroy (evict) the bucket - the operation that'd
+// otherwise delete objects that are yet to be written back
+func (t *target) checkBckWriteBack(bck *meta.Bck) error {
+	num, size, _ := t.wbq.backlog(bck, 0)
+	if num == 0 {
+		return nil
+	}
+	err := fmt.Errorf("%w: %d object%s (%s)", errWbPending, num, cos.Plural(int(num)), cos.ToSizeIEC(size, 2))
+	return cmn.NewErrFailedTo(t, "evict", bck.Cname(""), err, http.StatusConflict)
+}
+
+// delayed PUT: see poi.fini
+func wbMarker() string { return strconv.FormatInt(time.Now().UnixNano(), 10) }
+
+func wbMeta(lom *core.LOM, oreq *http.Request) {
+	if oreq == nil {
+		return
+	}
+	for k := range oreq.Header {
+		if strings.HasPrefix(k, cmn.AwsHeaderMetaPrefix) {
+			lom.SetCustomKey(k, oreq.Header.Get(k))
+		}
+	}
+}
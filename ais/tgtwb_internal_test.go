@@ -0,0 +1,207 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/kvdb"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// remote backend that only supports PUT (any other call panics)
+type wbBackend struct {
+	core.Backend
+	fail atomic.Int32 // number of PUTs to fail
+	puts atomic.Int32
+}
+
+func (*wbBackend) Provider() string { return apc.AWS }
+
+func (be *wbBackend) PutObj(r io.ReadCloser, lom *core.LOM, _ *http.Request) (int, error) {
+	cos.DrainReader(r)
+	r.Close()
+	if be.fail.Dec() >= 0 {
+		return http.StatusServiceUnavailable, errors.New("remote unavailable")
+	}
+	be.puts.Inc()
+	lom.SetVersion("v1")
+	return 0, nil
+}
+
+var _ = Describe("WriteBack", func() {
+	const wbBucket = "wb"
+	var (
+		be  *wbBackend
+		db  *kvdb.BuntDriver
+		bck = meta.NewBck(wbBucket, apc.AWS, cmn.NsGlobal)
+	)
+
+	BeforeEach(func() {
+		config := cmn.GCO.BeginUpdate()
+		config.Backend.Providers = map[string]cmn.Ns{apc.AWS: cmn.NsGlobal}
+		cmn.GCO.CommitUpdate(config)
+		be = &wbBackend{}
+		t.backend = backends{apc.AWS: be}
+		if t.owner.smap.get() == nil {
+			smap := newSmap()
+			smap.addTarget(t.si)
+			t.owner.smap.put(smap)
+		}
+
+		bmd := t.owner.bmd.get().clone()
+		bmd.add(bck, &cmn.Bprops{
+			Cksum:       cmn.CksumConf{Type: cos.ChecksumNone},
+			WritePolicy: cmn.WritePolicyConf{Data: apc.WriteDelayed, MD: apc.WriteImmediate},
+			Versioning:  cmn.VersionConf{ValidateWarmGet: true},
+		})
+		t.owner.bmd.putPersist(bmd, nil)
+		Expect(fs.CreateBucket(bck.Bucket(), false /*nilbmd*/)).To(BeEmpty())
+
+		var err error
+		db, err = kvdb.NewBuntDB(filepath.Join(testMountpath, "wb.db"))
+		Expect(err).NotTo(HaveOccurred())
+		t.wbq = wbQueue{}
+		t.wbq.init(t, db) // (not uploading in the background: cluster not started)
+	})
+
+	AfterEach(func() {
+		t.wbq.stop()
+		db.Close()
+		bmd := t.owner.bmd.get().clone()
+		bmd.del(bck)
+		t.owner.bmd.putPersist(bmd, nil)
+		for _, mi := range fs.GetAvail() {
+			os.RemoveAll(mi.MakePathBck(bck.Bucket()))
+		}
+		os.Remove(filepath.Join(testMountpath, "wb.db"))
+	})
+
+	put := func(name string, owt cmn.OWT, oa *cmn.ObjAttrs) *core.LOM {
+		lom := core.AllocLOM(name)
+		Expect(lom.InitBck(bck.Bucket())).NotTo(HaveOccurred())
+		if oa != nil {
+			lom.CopyAttrs(oa, false /*skip cksum*/)
+		}
+		poi := &putOI{
+			atime:   time.Now().UnixNano(),
+			t:       t,
+			lom:     lom,
+			r:       io.NopCloser(bytes.NewReader([]byte("write-back"))),
+			workFQN: path.Join(testMountpath, name+".work"),
+			config:  cmn.GCO.Get(),
+			owt:     owt,
+		}
+		_, err := poi.putObject()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+		return lom
+	}
+	pending := func(lom *core.LOM) *rqItem {
+		t.wbq.mu.Lock()
+		defer t.wbq.mu.Unlock()
+		return t.wbq.items[lom.Uname()]
+	}
+
+	It("should queue pending object and skip remote metadata check", func() {
+		lom := put("obj-pending", cmn.OwtPut, nil)
+		defer core.FreeLOM(lom)
+		Expect(lom.WriteBackPending()).To(BeTrue())
+		Expect(be.puts.Load()).To(BeZero())
+		Expect(pending(lom)).NotTo(BeNil())
+
+		// (HEAD remote would panic)
+		res := lom.CheckRemoteMD(false /*locked*/, false /*sync*/, nil)
+		Expect(res.Err).NotTo(HaveOccurred())
+		Expect(res.Eq).To(BeTrue())
+	})
+
+	It("should refuse to evict pending object and bucket", func() {
+		lom := put("obj-evict", cmn.OwtPut, nil)
+		defer core.FreeLOM(lom)
+
+		lom.Lock(true)
+		ecode, err, _ := t.delobj(lom, true /*evict*/, false, nil)
+		lom.Unlock(true)
+		Expect(errors.Is(err, errWbPending)).To(BeTrue())
+		Expect(ecode).To(Equal(http.StatusForbidden))
+		Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+
+		b := meta.CloneBck(bck.Bucket())
+		Expect(b.Init(t.owner.bmd)).NotTo(HaveOccurred())
+		Expect(errors.Is(t.checkBckWriteBack(b), errWbPending)).To(BeTrue())
+	})
+
+	It("should re-queue migrated object", func() {
+		src := put("obj-migrate", cmn.OwtPut, nil)
+		since, _ := src.GetCustomKey(cmn.WriteBackObjMD)
+		oa := &cmn.ObjAttrs{}
+		oa.CopyFrom(src, false /*skip cksum*/)
+		t.wbq.del(src)
+		Expect(pending(src)).To(BeNil())
+		core.FreeLOM(src)
+
+		// arriving via rebalance with the marker
+		lom := put("obj-migrate", cmn.OwtRebalance, oa)
+		defer core.FreeLOM(lom)
+		item := pending(lom)
+		Expect(item).NotTo(BeNil())
+		Expect(item.entry.(*wbEntry).Since).To(Equal(since))
+	})
+
+	It("should retry with backoff and clear the marker upon success", func() {
+		lom := put("obj-retry", cmn.OwtPut, nil)
+		defer core.FreeLOM(lom)
+		be.fail.Store(2)
+
+		for tries := 1; tries <= 2; tries++ {
+			items := t.wbq.due()
+			Expect(items).To(HaveLen(1))
+			item := items[0]
+			ecode, err := t.wbq.upload(item)
+			Expect(err).To(HaveOccurred())
+			t.wbq.done(item, ecode, err)
+			Expect(item.tries).To(Equal(tries))
+			Expect(time.Duration(item.next - item.failed)).To(Equal(rqMinBackoff << (tries - 1)))
+			Expect(t.wbq.due()).To(BeEmpty()) // backing off
+			item.next = mono.NanoTime()       // (fast-forward)
+		}
+
+		items := t.wbq.due()
+		Expect(items).To(HaveLen(1))
+		ecode, err := t.wbq.upload(items[0])
+		Expect(err).NotTo(HaveOccurred())
+		t.wbq.done(items[0], ecode, err)
+		Expect(be.puts.Load()).To(BeEquivalentTo(1))
+		Expect(pending(lom)).To(BeNil())
+
+		var entry wbEntry
+		_, err = db.Get(wbCollection, lom.Uname(), &entry)
+		Expect(cos.IsNotExist(err, 0)).To(BeTrue())
+
+		uploaded := core.AllocLOM(lom.ObjName)
+		defer core.FreeLOM(uploaded)
+		Expect(uploaded.InitBck(bck.Bucket())).NotTo(HaveOccurred())
+		Expect(uploaded.Load(false, false)).NotTo(HaveOccurred())
+		Expect(uploaded.WriteBackPending()).To(BeFalse())
+		Expect(uploaded.Version()).To(Equal("v1"))
+	})
+})
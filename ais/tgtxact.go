@@ -258,6 +258,16 @@ func (t *target) xstart(args *xact.ArgsMsg, bck *meta.Bck, msg *apc.ActMsg) (xid
 	case apc.ActLoadLomCache:
 		rns := xreg.RenewBckLoadLomCache(args.ID, bck)
 		return xid, rns.Err
+	case apc.ActLifecycle:
+		return t.runLifecycle(args.ID, bck)
+	case apc.ActInventory:
+		return t.runInventory(args.ID, bck, "" /*run*/)
+	case apc.ActWriteBack:
+		return t.runWriteBack(args.ID, bck)
+	case apc.ActTierMigrate:
+		return t.runTierMigrate(args.ID, bck)
+	case apc.ActReplResync:
+		return t.runReplResync(args.ID, bck)
 	case apc.ActBlobDl:
 		debug.Assert(msg.Name != "")
 		lom := core.AllocLOM(msg.Name)
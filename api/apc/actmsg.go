@@ -48,6 +48,11 @@ const (
 
 	ActLRU          = "lru"
 	ActStoreCleanup = "cleanup-store"
+	ActLifecycle    = "lifecycle"    // enforce bucket lifecycle rules (see cmn.LifecycleConf)
+	ActInventory    = "inventory"    // generate bucket inventory (see cmn.InventoryConf)
+	ActWriteBack    = "write-back"   // flush write-back backlog (see WriteDelayed)
+	ActTierMigrate  = "tier-migrate" // migrate cold objects down the chain of remote tiers (see cmn.TieringConf)
+	ActReplResync   = "repl-resync"  // catch up cross-cluster replication (see cmn.ReplicationConf)
 
 	ActEvictRemoteBck = "evict-remote-bck" // evict remote bucket's data
 	ActList           = "list"
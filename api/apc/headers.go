@@ -40,9 +40,10 @@ const (
 	HdrBackendProvider  = aisPrefix + "Provider"           // ProviderAmazon et al. - see cmn/bck.go.
 
 	// including BucketProps.Extra.AWS
-	HdrS3Region   = aisPrefix + "Cloud_region"
-	HdrS3Endpoint = aisPrefix + "Endpoint"
-	HdrS3Profile  = aisPrefix + "Profile"
+	HdrS3Region         = aisPrefix + "Cloud_region"
+	HdrS3Endpoint       = aisPrefix + "Endpoint"
+	HdrS3Profile        = aisPrefix + "Profile"
+	HdrS3BackendProfile = aisPrefix + "Backend_profile"
 
 	// including BucketProps.Extra.HTTP
 	HdrOrigURLBck = aisPrefix + "Original-Url"
@@ -61,6 +62,14 @@ const (
 	HdrObjCustomMD  = aisPrefix + "Custom-Md"      // Object custom metadata.
 	HdrObjVersion   = aisPrefix + "Version"        // Object version/generation - ais or cloud.
 
+	// cross-cluster replication: the replicated PUT or DELETE carries the origin's
+	// replication stamp (see cmn.ReplicationConf)
+	HdrReplStamp = aisPrefix + "Repl-Stamp"
+
+	// remote bucket change notifications pushed to aistore (webhook): the secret
+	// shared with the sender (see cmn.EventsConf)
+	HdrEventsToken = aisPrefix + "Events-Token"
+
 	// Append object header
 	HdrAppendHandle = aisPrefix + "Append-Handle"
 
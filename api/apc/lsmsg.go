@@ -78,6 +78,10 @@ const (
 
 	// Do not return virtual subdirectories - do not include them as `cmn.LsoEnt` entries
 	LsNoDirs
+
+	// Include retained prior versions (`cmn.LsoEnt.Versions`) of ais:// objects
+	// See also: bucket property `versioning.retain`
+	LsVersions
 )
 
 // max page sizes
@@ -128,6 +132,7 @@ const (
 	GetPropsEC       = "ec"
 	GetPropsCustom   = "custom"
 	GetPropsLocation = "location" // advanced usage
+	GetPropsTags     = "tags"     // object tags (see S3 object tagging)
 )
 
 const GetPropsNameSize = GetPropsName + LsPropsSepa + GetPropsSize
@@ -140,7 +145,8 @@ var (
 
 	GetPropsDefaultAIS = []string{GetPropsName, GetPropsSize, GetPropsChecksum, GetPropsAtime}
 	GetPropsAll        = []string{GetPropsName, GetPropsSize, GetPropsChecksum, GetPropsAtime,
-		GetPropsVersion, GetPropsCached, GetPropsStatus, GetPropsCopies, GetPropsEC, GetPropsCustom, GetPropsLocation}
+		GetPropsVersion, GetPropsCached, GetPropsStatus, GetPropsCopies, GetPropsEC, GetPropsCustom, GetPropsLocation,
+		GetPropsTags}
 )
 
 type LsoMsg struct {
@@ -150,6 +156,7 @@ type LsoMsg struct {
 	TimeFormat        string      `json:"time_format,omitempty"` // RFC822 is the default
 	Prefix            string      `json:"prefix"`                // return obj names starting with prefix (TODO: e.g. "A.tar/tutorials/")
 	StartAfter        string      `json:"start_after,omitempty"` // start listing after (AIS buckets only)
+	TagFilter         string      `json:"tag_filter,omitempty"`  // list only objects tagged "key" or "key=value" (in-cluster objects only)
 	ContinuationToken string      `json:"continuation_token"`    // => LsoResult.ContinuationToken => LsoMsg.ContinuationToken
 	SID               string      `json:"target"`                // selected target to solely execute backend.list-objects
 	Flags             uint64      `json:"flags,string"`          // enum {LsObjCached, ...} - "LsoMsg flags" above
@@ -161,6 +168,10 @@ type LsoMsg struct {
 ////////////
 
 func (lsmsg *LsoMsg) WantOnlyRemoteProps() bool {
+	// filtering by tags requires loading (in-cluster) object metadata
+	if lsmsg.TagFilter != "" {
+		return false
+	}
 	// set by user
 	if lsmsg.IsFlagSet(LsWantOnlyRemoteProps) {
 		return true
@@ -243,6 +254,9 @@ func (lsmsg *LsoMsg) Str(cname string) string {
 	if lsmsg.IsFlagSet(LsVerChanged) {
 		sb.WriteString("version-changed,")
 	}
+	if lsmsg.IsFlagSet(LsVersions) {
+		sb.WriteString("versions,")
+	}
 	s := sb.String()
 	return s[:len(s)-1]
 }
@@ -129,6 +129,11 @@ const (
 	// validate (ie., recompute and check) in-cluster object's checksums
 	QparamValidateCksum = "validate-checksum"
 
+	// GET, HEAD, or DELETE specific (possibly, prior) version of an ais:// object
+	// - requires bucket property `versioning.retain` > 0 to access prior versions
+	// - compare with S3 "versionId"
+	QparamObjVersion = "obj-version"
+
 	// when true, skip nlog.Error and friends
 	// (to opt-out logging too many messages and/or benign warnings)
 	QparamSilent = "sln"
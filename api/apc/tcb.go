@@ -6,11 +6,20 @@ package apc
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/NVIDIA/aistore/cmn/cos"
 )
 
+// ETL pipeline: ordered list of named ETLs (stages) - output of each stage is streamed into the next;
+// the list is specified either as TCBMsg.Pipeline or as comma-separated QparamETLName, e.g.:
+// `?etl_name=decode,augment,encode`
+const (
+	ETLPipelineSep = ","
+	MaxETLPipeline = 8
+)
+
 // copy & (offline) transform bucket to bucket
 type (
 	CopyBckMsg struct {
@@ -22,8 +31,17 @@ type (
 		Sync      bool   `json:"synchronize"` // see also: 'versioning.synchronize'
 	}
 	Transform struct {
-		Name    string       `json:"id,omitempty"`
-		Timeout cos.Duration `json:"request_timeout,omitempty"`
+		Archive  *ArchTransform `json:"archive,omitempty"` // transform archived files (shards) member by member
+		Name     string         `json:"id,omitempty"`
+		Pipeline []string       `json:"pipeline,omitempty"` // ETL pipeline (mutually exclusive with Name)
+		Timeout  cos.Duration   `json:"request_timeout,omitempty"`
+	}
+	// per-member transformation of archived files (shards), e.g. WebDataset tars:
+	// ETL gets called once per archived file, and the results get re-packed into the
+	// resulting shard; objects that are not archives (by extension) get transformed as a whole
+	ArchTransform struct {
+		Exts []string `json:"exts,omitempty"` // transform archived files with these extensions (empty: all); others are copied as is
+		Mime string   `json:"mime,omitempty"` // resulting archive format, one of archive.FileExtensions (empty: same as source)
 	}
 	TCBMsg struct {
 		// NOTE: objname extension ----------------------------------------------------------------------
@@ -43,10 +61,57 @@ type (
 ////////////
 
 func (msg *TCBMsg) Validate(isEtl bool) (err error) {
-	if isEtl && msg.Transform.Name == "" {
-		err = errors.New("ETL name can't be empty")
+	if !isEtl {
+		return nil
+	}
+	if msg.Transform.Name != "" && len(msg.Transform.Pipeline) > 0 {
+		return errors.New("ETL name and ETL pipeline are mutually exclusive")
+	}
+	if err := ValidateETLPipeline(msg.Transform.Names()); err != nil {
+		return err
+	}
+	if arch := msg.Transform.Archive; arch != nil {
+		for _, ext := range arch.Exts {
+			if strings.TrimLeft(ext, ".") == "" {
+				return fmt.Errorf("invalid archived file extension %q in %v", ext, arch.Exts)
+			}
+		}
+	}
+	return nil
+}
+
+///////////////
+// Transform //
+///////////////
+
+// returns ETL name(s) - single-stage pipeline in the most common case
+func (t *Transform) Names() []string {
+	if len(t.Pipeline) > 0 {
+		return t.Pipeline
+	}
+	return ParseETLPipeline(t.Name)
+}
+
+func ParseETLPipeline(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, ETLPipelineSep)
+}
+
+func ValidateETLPipeline(names []string) error {
+	switch {
+	case len(names) == 0:
+		return errors.New("ETL name can't be empty")
+	case len(names) > MaxETLPipeline:
+		return fmt.Errorf("ETL pipeline is too long: %d stages (max %d)", len(names), MaxETLPipeline)
+	}
+	for i, name := range names {
+		if name == "" {
+			return fmt.Errorf("ETL pipeline %q: stage #%d has empty name", names, i+1)
+		}
 	}
-	return
+	return nil
 }
 
 // Replace extension and add suffix if provided.
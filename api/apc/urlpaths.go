@@ -32,6 +32,7 @@ const (
 	Clusters  = "clusters" // AuthN
 	Roles     = "roles"    // AuthN
 	IC        = "ic"       // information center
+	Events    = "events"   // remote bucket change notifications
 
 	// l3 ---
 
@@ -106,6 +107,7 @@ var (
 	URLPathTxn      = urlpath(Version, Txn)
 	URLPathXactions = urlpath(Version, Xactions)
 	URLPathIC       = urlpath(Version, IC)
+	URLPathEvents   = urlpath(Version, Events)
 	URLPathHealth   = urlpath(Version, Health)
 	URLPathMetasync = urlpath(Version, Metasync)
 
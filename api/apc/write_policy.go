@@ -8,6 +8,9 @@ import "fmt"
 
 // write policy (enum and accessors)
 // applies to both AIS metadata and data; bucket-configurable with global defaults via cluster config
+//
+// data write policy "delayed" means write-back: PUT completes once the object is stored in-cluster,
+// while writing it to remote backend is done asynchronously (remote buckets only; see ais/tgtwb.go)
 type WritePolicy string
 
 const (
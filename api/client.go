@@ -331,6 +331,15 @@ func (reqParams *ReqParams) readValidate(resp *http.Response, w io.Writer) (*wra
 }
 
 func (reqParams *ReqParams) checkResp(resp *http.Response) error {
+	if resp.StatusCode == http.StatusNotModified {
+		// conditional GET or HEAD (no body)
+		return &cmn.ErrHTTP{
+			Message: "not modified",
+			Status:  resp.StatusCode,
+			Method:  reqParams.BaseParams.Method,
+			URLPath: reqParams.Path,
+		}
+	}
 	if resp.StatusCode < http.StatusBadRequest {
 		return nil
 	}
@@ -299,8 +299,16 @@ func RotateClusterLogs(bp BaseParams) error {
 	return _putCluster(bp, apc.ActMsg{Action: apc.ActRotateLogs})
 }
 
-func ReloadBackendCreds(bp BaseParams, provider string) error {
-	return _putCluster(bp, apc.ActMsg{Action: apc.ActReloadBackendCreds, Name: provider})
+// reload backend credentials:
+// - all providers (empty provider), or
+// - a given provider, or
+// - a given named backend profile (see cmn.BackendProfile), e.g.: ReloadBackendCreds(bp, apc.AWS, "minio")
+func ReloadBackendCreds(bp BaseParams, provider string, profile ...string) error {
+	msg := apc.ActMsg{Action: apc.ActReloadBackendCreds, Name: provider}
+	if len(profile) > 0 && profile[0] != "" {
+		msg.Value = profile[0]
+	}
+	return _putCluster(bp, msg)
 }
 
 func _putCluster(bp BaseParams, msg apc.ActMsg) error {
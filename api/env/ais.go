@@ -43,6 +43,17 @@ const (
 	// client and dev deployment; see also cluster config "net.http.skip_verify"
 	AisSkipVerifyCrt = "AIS_SKIP_VERIFY_CRT"
 
+	// server-side encryption at rest: keyring file with cluster-managed keys (target only)
+	// see also: cmn/sse
+	AisSSEKeyring = "AIS_SSE_KEYRING"
+
+	// ETL runtime (target only): where to run transformers when not in Kubernetes
+	// ("process" | "docker" | "podman"); see also ext/etl/local.go
+	AisETLRuntime = "AIS_ETL_RUNTIME"
+	// comma-separated host directories that ETL pod specs may mount as hostPath volumes
+	// (Kubernetes-free runtime; none when not set)
+	AisETLHostPaths = "AIS_ETL_HOST_PATHS"
+
 	// tests and CI
 	AisNumTarget = "NUM_TARGET"
 	AisNumProxy  = "NUM_PROXY"
@@ -36,10 +36,12 @@ type (
 		Writer io.Writer
 
 		// Currently, this optional Query field can (optionally) carry:
-		// - `apc.QparamETLName`: named ETL to transform the object (i.e., perform "inline transformation")
+		// - `apc.QparamETLName`: named ETL to transform the object (i.e., perform "inline transformation");
+		//   a comma-separated list of names (`apc.ETLPipelineSep`) denotes ETL pipeline
 		// - `apc.QparamOrigURL`: GET from a vanilla http(s) location (`ht://` bucket with the corresponding `OrigURLBck`)
 		// - `apc.QparamSilent`: do not log errors
 		// - `apc.QparamLatestVer`: get latest version from the associated Cloud bucket; see also: `ValidateWarmGet`
+		// - `apc.QparamObjVersion`: get specific (current or retained prior) version of an ais:// object
 		// - and also a group of parameters used to read aistore-supported serialized archives ("shards"),
 		//   namely:
 		//   - `apc.QparamArchpath`
@@ -56,6 +58,11 @@ type (
 		// E.g. blob download:
 		// * Header.Set(apc.HdrBlobDownload, "true")
 		Header http.Header
+
+		// Optional conditional GET (If-Match, If-None-Match, If-Modified-Since, If-Unmodified-Since).
+		// Returns `cmn.ErrHTTP` with status 304 (see `cmn.IsStatusNotModified`) or 412
+		// (`cmn.IsStatusPreconditionFailed`) when the respective precondition fails.
+		Preconds *cmn.Preconds
 	}
 
 	// `ObjAttrs` represents object attributes and can be further used to retrieve
@@ -94,6 +101,12 @@ type (
 		SkipVC bool
 
 		Header http.Header
+
+		// Optional conditional PUT, e.g.:
+		// - create-only: `IfNoneMatch: "*"`
+		// - compare-and-swap: `IfMatch: <current ETag, checksum value, or version>`
+		// Returns `cmn.ErrHTTP` with status 412 (`cmn.IsStatusPreconditionFailed`) when fails.
+		Preconds *cmn.Preconds
 	}
 )
 
@@ -101,10 +114,13 @@ type (
 type (
 	// optional
 	HeadArgs struct {
-		FltPresence   int  // `apc.QparamFltPresence`  - in-cluster vs remote; for enumerated values, see api/apc/query
-		Silent        bool // `apc.QparamSilent`       - when true, do not log (not-found) error
-		LatestVer     bool // `apc.QparamLatestVer`    - check (with remote backend) whether in-cluster version is the latest
-		ValidateCksum bool // `apc.QparamValidateCksum`- validate (ie., recompute and check) in-cluster object's checksums
+		FltPresence   int    // `apc.QparamFltPresence`  - in-cluster vs remote; for enumerated values, see api/apc/query
+		Silent        bool   // `apc.QparamSilent`       - when true, do not log (not-found) error
+		LatestVer     bool   // `apc.QparamLatestVer`    - check (with remote backend) whether in-cluster version is the latest
+		ValidateCksum bool   // `apc.QparamValidateCksum`- validate (ie., recompute and check) in-cluster object's checksums
+		ObjVersion    string // `apc.QparamObjVersion`   - specific (current or retained prior) version of an ais:// object
+
+		Preconds *cmn.Preconds // conditional HEAD (see GetArgs.Preconds)
 	}
 )
 
@@ -152,9 +168,23 @@ func (args *GetArgs) ret() (w io.Writer, q url.Values, hdr http.Header) {
 		w = args.Writer
 	}
 	q, hdr = args.Query, args.Header
+	if args.Preconds != nil {
+		hdr = precondsHeader(hdr, args.Preconds)
+	}
 	return
 }
 
+// (does not modify caller's header)
+func precondsHeader(hdr http.Header, pc *cmn.Preconds) http.Header {
+	if hdr == nil {
+		hdr = make(http.Header, 2)
+	} else {
+		hdr = hdr.Clone()
+	}
+	pc.ToHeader(hdr)
+	return hdr
+}
+
 func (oah *ObjAttrs) Size() int64 {
 	if oah.n == 0 { // unlikely
 		oah.n = oah.Attrs().Size
@@ -304,6 +334,9 @@ func PutObject(args *PutArgs) (oah ObjAttrs, err error) {
 		reqArgs.Query = query
 		reqArgs.BodyR = args.Reader
 		reqArgs.Header = args.Header
+		if args.Preconds != nil {
+			reqArgs.Header = precondsHeader(args.Header, args.Preconds)
+		}
 	}
 	resp, err = DoWithRetry(args.BaseParams.Client, args.put, reqArgs) //nolint:bodyclose // is closed inside
 	cmn.FreeHra(reqArgs)
@@ -333,6 +366,9 @@ func HeadObject(bp BaseParams, bck cmn.Bck, objName string, args HeadArgs) (*cmn
 	if args.ValidateCksum {
 		q.Set(apc.QparamValidateCksum, "true")
 	}
+	if args.ObjVersion != "" {
+		q.Set(apc.QparamObjVersion, args.ObjVersion)
+	}
 
 	reqParams := AllocRp()
 	defer FreeRp(reqParams)
@@ -340,6 +376,9 @@ func HeadObject(bp BaseParams, bck cmn.Bck, objName string, args HeadArgs) (*cmn
 		reqParams.BaseParams = bp
 		reqParams.Path = apc.URLPathObjects.Join(bck.Name, objName)
 		reqParams.Query = q
+		if args.Preconds != nil {
+			reqParams.Header = precondsHeader(nil, args.Preconds)
+		}
 	}
 	hdr, _, err := reqParams.doReqHdr()
 	if err != nil {
@@ -418,6 +457,24 @@ func DeleteObject(bp BaseParams, bck cmn.Bck, objName string) error {
 	return err
 }
 
+// Permanently delete the specified version of an ais:// object.
+// When `ver` is the current version, the most recent retained prior version (if any)
+// becomes current (see also: `versioning.retain` bucket property).
+func DeleteObjectVersion(bp BaseParams, bck cmn.Bck, objName, ver string) error {
+	bp.Method = http.MethodDelete
+	q := bck.NewQuery()
+	q.Set(apc.QparamObjVersion, ver)
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathObjects.Join(bck.Name, objName)
+		reqParams.Query = q
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	return err
+}
+
 // Evict(object) ======================================================================================
 
 func EvictObject(bp BaseParams, bck cmn.Bck, objName string) error {
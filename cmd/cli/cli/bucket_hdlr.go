@@ -182,6 +182,7 @@ var (
 			silentFlag,
 			dontWaitFlag,
 			verChangedFlag,
+			allVersionsFlag,
 			countAndTimeFlag,
 			// bucket inventory
 			useInventoryFlag,
@@ -222,8 +222,8 @@ var (
 			},
 			{
 				Name:         cmdReloadCreds,
-				Usage:        "reload (updated) backend credentials",
-				ArgsUsage:    "[PROVIDER]",
+				Usage:        "reload (updated) backend credentials (all providers, one provider, or one named s3 backend profile)",
+				ArgsUsage:    "[PROVIDER [BACKEND_PROFILE]]",
 				Action:       reloadCredsHandler,
 				BashComplete: suggestProvider,
 			},
@@ -677,7 +677,7 @@ func reloadCredsHandler(c *cli.Context) error {
 	if p == scopeAll {
 		p = ""
 	}
-	return api.ReloadBackendCreds(apiBP, p)
+	return api.ReloadBackendCreds(apiBP, p, c.Args().Get(1))
 }
 
 func downloadAllLogs(c *cli.Context) error {
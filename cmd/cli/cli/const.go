@@ -95,6 +95,7 @@ const (
 	cmdDsort        = apc.ActDsort
 	cmdRebalance    = apc.ActRebalance
 	cmdLRU          = apc.ActLRU
+	cmdWriteBack    = apc.ActWriteBack
 	cmdStgCleanup   = "cleanup" // display name for apc.ActStoreCleanup
 	cmdScrub        = "validate"
 	cmdSummary      = "summary" // ditto apc.ActSummaryBck
@@ -609,21 +610,27 @@ var (
 			indent4 + "\t- see related: 'ais get --latest', 'ais cp --sync', 'ais prefetch --latest'",
 	}
 
+	allVersionsFlag = cli.BoolFlag{
+		Name: "all-versions",
+		Usage: "list retained prior versions of each object, most recent first (ais:// buckets only);\n" +
+			indent4 + "\tsee also: bucket property 'versioning.retain'",
+	}
+
 	useInventoryFlag = cli.BoolFlag{
 		Name: "inventory",
-		Usage: "list objects using _bucket inventory_ (docs/s3inventory.md); requires s3:// backend; will provide significant performance\n" +
-			indent4 + "\tboost when used with very large s3 buckets; e.g. usage:\n" +
+		Usage: "list objects using _bucket inventory_ (docs/s3inventory.md); requires s3://, gs://, or az:// backend;\n" +
+			indent4 + "\twill provide significant performance boost when used with very large buckets; e.g. usage:\n" +
 			indent4 + "\t  1) 'ais ls s3://abc --inventory'\n" +
-			indent4 + "\t  2) 'ais ls s3://abc --inventory --paged --prefix=subdir/'\n" +
+			indent4 + "\t  2) 'ais ls gs://abc --inventory --paged --prefix=subdir/'\n" +
 			indent4 + "\t(see also: docs/s3inventory.md)",
 	}
 	invNameFlag = cli.StringFlag{
 		Name:  "inv-name", // compare w/ HdrInvName
-		Usage: "bucket inventory name (optional; system default name is '.inventory')",
+		Usage: "bucket inventory name (optional; system default name is '.inventory'; az:// - inventory destination container)",
 	}
 	invIDFlag = cli.StringFlag{
 		Name:  "inv-id", // cpmpare w/ HdrInvID
-		Usage: "bucket inventory ID (optional; by default, we use bucket name as the bucket's inventory ID)",
+		Usage: "bucket inventory ID (optional; by default, we use bucket name as the bucket's inventory ID; az:// - inventory rule name)",
 	}
 
 	keepMDFlag = cli.BoolFlag{Name: "keep-md", Usage: "keep bucket metadata"}
@@ -43,6 +43,12 @@ const blobDownloadUsage = "run a job to download large object(s) from remote sto
 	indent1 + "\t- 'blob-download s3://ab --list \"f1, f2\" --num-workers=4 --progress'\t- run 4 concurrent readers to download 2 (listed) blobs\n" +
 	indent1 + "When _not_ using '--progress' option, run 'ais show job' to monitor."
 
+const writeBackUsage = "flush write-back backlog of a given remote bucket (see bucket property 'write_policy.data'), e.g.:\n" +
+	indent1 + "\t- 'write-back s3://abc'\t- write all pending objects to the remote backend now (no retry backoff);\n" +
+	indent1 + "\t- 'write-back s3://abc --wait'\t- same as above, and wait until the backlog drains;\n" +
+	indent1 + "\t- 'write-back s3://abc --wait --timeout 10m'\t- same as above, with timeout.\n" +
+	indent1 + "Objects that keep failing to upload are retried in the background - see 'ais show job write-back'."
+
 const resilverUsage = "resilver user data on a given target (or all targets in the cluster); entails:\n" +
 	indent1 + "\t- fix data redundancy with respect to bucket configuration;\n" +
 	indent1 + "\t- remove migrated objects and old/obsolete workfiles."
@@ -157,6 +163,14 @@ var (
 		Action:       startPrefetchHandler,
 		BashComplete: bucketCompletions(bcmplop{multiple: true}),
 	}
+	writeBackStartCmd = cli.Command{
+		Name:         cmdWriteBack,
+		Usage:        writeBackUsage,
+		ArgsUsage:    bucketArgument,
+		Flags:        startCommonFlags,
+		Action:       startXactionHandler,
+		BashComplete: remoteBucketCompletions(bcmplop{}),
+	}
 	blobDownloadCmd = cli.Command{
 		Name:         cmdBlobDownload,
 		Usage:        blobDownloadUsage,
@@ -199,6 +213,7 @@ var (
 
 			jobStartRebalance,
 			jobStartResilver,
+			writeBackStartCmd,
 
 			cleanupCmd,
 
@@ -303,6 +318,7 @@ outer:
 				// - blob-download
 				// - rebalance
 				// - resilver
+				// - write-back
 				continue outer
 			}
 		}
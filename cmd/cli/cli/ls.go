@@ -286,6 +286,13 @@ func listObjects(c *cli.Context, bck cmn.Bck, prefix string, listArch, printEmpt
 		msg.SetFlag(apc.LsVerChanged)
 	}
 
+	if flagIsSet(c, allVersionsFlag) {
+		if !bck.IsAIS() {
+			return fmt.Errorf("flag %s requires ais:// bucket (have: %s)", qflprn(allVersionsFlag), bck.String())
+		}
+		msg.SetFlag(apc.LsVersions)
+	}
+
 	if flagIsSet(c, listObjCachedFlag) {
 		if flagIsSet(c, verChangedFlag) {
 			actionWarn(c, "checking remote versions may take some time...\n")
@@ -392,6 +399,10 @@ func listObjects(c *cli.Context, bck cmn.Bck, prefix string, listArch, printEmpt
 	}
 	propsStr = msg.Props // show these and _only_ these props
 	// finally:
+	if flagIsSet(c, allVersionsFlag) && !msg.WantProp(apc.GetPropsVersion) {
+		msg.AddProps(apc.GetPropsVersion)
+		propsStr = msg.Props
+	}
 	if flagIsSet(c, verChangedFlag) {
 		if !msg.WantProp(apc.GetPropsCustom) {
 			msg.AddProps(apc.GetPropsCustom)
@@ -596,6 +607,9 @@ func printLso(c *cli.Context, entries cmn.LsoEntries, lstFilter *lstFilter, prop
 	}
 
 	// otherwise, print names
+	if flagIsSet(c, allVersionsFlag) {
+		matched = withPriorVersions(matched)
+	}
 	tmpl := teb.LsoTemplate(propsList, hideHeader, addCachedCol, addStatusCol)
 	opts := teb.Opts{AltMap: teb.FuncMapUnits(units, false /*incl. calendar date*/)}
 	if err := teb.Print(matched, tmpl, opts); err != nil {
@@ -615,6 +629,25 @@ func printLso(c *cli.Context, entries cmn.LsoEntries, lstFilter *lstFilter, prop
 	return nil
 }
 
+// each object followed by its retained prior versions (apc.LsVersions)
+func withPriorVersions(entries cmn.LsoEntries) cmn.LsoEntries {
+	var n int
+	for _, en := range entries {
+		n += len(en.Versions)
+	}
+	if n == 0 {
+		return entries
+	}
+	out := make(cmn.LsoEntries, 0, len(entries)+n)
+	for _, en := range entries {
+		out = append(out, en)
+		for _, v := range en.Versions {
+			out = append(out, &cmn.LsoEnt{Name: en.Name, Version: v.Version, Size: v.Size, Atime: v.Mtime, Flags: en.Flags})
+		}
+	}
+	return out
+}
+
 ///////////////
 // lstFilter //
 ///////////////
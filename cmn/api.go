@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"math"
 	"reflect"
+	"slices"
 	"sort"
 	"strings"
 
@@ -43,20 +44,32 @@ const (
 
 type (
 	Bprops struct {
-		BackendBck  Bck             `json:"backend_bck,omitempty"` // makes remote bucket out of a given ais bucket
-		Extra       ExtraProps      `json:"extra,omitempty" list:"omitempty"`
-		WritePolicy WritePolicyConf `json:"write_policy"`
-		Provider    string          `json:"provider" list:"readonly"`       // backend provider
-		Renamed     string          `list:"omit"`                           // non-empty if the bucket has been renamed
-		Cksum       CksumConf       `json:"checksum"`                       // the bucket's checksum
-		EC          ECConf          `json:"ec"`                             // erasure coding
-		LRU         LRUConf         `json:"lru"`                            // LRU (watermarks and enabled/disabled)
-		Mirror      MirrorConf      `json:"mirror"`                         // mirroring
-		Access      apc.AccessAttrs `json:"access,string"`                  // access permissions
-		Features    feat.Flags      `json:"features,string"`                // assorted features from feat.Bucket
-		BID         uint64          `json:"bid,string" list:"omit"`         // unique ID
-		Created     int64           `json:"created,string" list:"readonly"` // creation timestamp
-		Versioning  VersionConf     `json:"versioning"`                     // versioning (see "inherit")
+		BackendBck  Bck              `json:"backend_bck,omitempty"` // makes remote bucket out of a given ais bucket
+		Extra       ExtraProps       `json:"extra,omitempty" list:"omitempty"`
+		WritePolicy WritePolicyConf  `json:"write_policy"`
+		Lifecycle   *LifecycleConf   `json:"lifecycle,omitempty" list:"omit"`
+		Policy      *BucketPolicy    `json:"policy,omitempty" list:"omit"`
+		CORS        *CORSConf        `json:"cors,omitempty" list:"omit"`
+		ObjLock     *ObjLockConf     `json:"object_lock,omitempty" list:"omit"`
+		SSE         *SSEConf         `json:"sse,omitempty" list:"omit"`
+		Inventory   *InventoryConf   `json:"inventory,omitempty" list:"omit"`
+		Tiering     *TieringConf     `json:"tiering,omitempty" list:"omit"`
+		Replication *ReplicationConf `json:"replication,omitempty" list:"omit"`
+		Events      *EventsConf      `json:"events,omitempty" list:"omit"`
+		HeadCache   *HeadCacheConf   `json:"head_cache,omitempty" list:"omit"`
+		ETL         *ETLConf         `json:"etl,omitempty" list:"omit"`
+		RateLimit   RateLimitConf    `json:"rate_limit"`                     // client-side rate limiting (remote buckets); 0: inherit
+		Provider    string           `json:"provider" list:"readonly"`       // backend provider
+		Renamed     string           `list:"omit"`                           // non-empty if the bucket has been renamed
+		Cksum       CksumConf        `json:"checksum"`                       // the bucket's checksum
+		EC          ECConf           `json:"ec"`                             // erasure coding
+		LRU         LRUConf          `json:"lru"`                            // LRU (watermarks and enabled/disabled)
+		Mirror      MirrorConf       `json:"mirror"`                         // mirroring
+		Access      apc.AccessAttrs  `json:"access,string"`                  // access permissions
+		Features    feat.Flags       `json:"features,string"`                // assorted features from feat.Bucket
+		BID         uint64           `json:"bid,string" list:"omit"`         // unique ID
+		Created     int64            `json:"created,string" list:"readonly"` // creation timestamp
+		Versioning  VersionConf      `json:"versioning"`                     // versioning (see "inherit")
 	}
 
 	ExtraProps struct {
@@ -89,12 +102,17 @@ type (
 		// vs OpenStack Swift: 10,000
 		// - https://docs.openstack.org/swift/latest/api/pagination.html
 		MaxPageSize int64 `json:"max_pagesize,omitempty"`
+
+		// named backend profile (S3-compatible endpoint) from the cluster config;
+		// takes precedence over the endpoint and profile above (see cmn/backendprof.go)
+		BackendProfile string `json:"backend_profile,omitempty"`
 	}
 	ExtraPropsAWSToSet struct {
-		CloudRegion *string `json:"cloud_region"`
-		Endpoint    *string `json:"endpoint"`
-		Profile     *string `json:"profile"`
-		MaxPageSize *int64  `json:"max_pagesize"`
+		CloudRegion    *string `json:"cloud_region"`
+		Endpoint       *string `json:"endpoint"`
+		Profile        *string `json:"profile"`
+		MaxPageSize    *int64  `json:"max_pagesize"`
+		BackendProfile *string `json:"backend_profile"`
 	}
 
 	ExtraPropsHTTP struct {
@@ -127,6 +145,12 @@ type (
 		Features    *feat.Flags           `json:"features,string,omitempty"`
 		WritePolicy *WritePolicyConfToSet `json:"write_policy,omitempty"`
 		Extra       *ExtraToSet           `json:"extra,omitempty"`
+		RateLimit   *RateLimitConfToSet   `json:"rate_limit,omitempty"`
+		Tiering     *TieringConf          `json:"tiering,omitempty" copy:"skip" list:"omit"`     // empty tiers: detach
+		Replication *ReplicationConf      `json:"replication,omitempty" copy:"skip" list:"omit"` // empty dst: detach
+		Events      *EventsConf           `json:"events,omitempty" copy:"skip" list:"omit"`      // empty source: detach
+		HeadCache   *HeadCacheConf        `json:"head_cache,omitempty" copy:"skip" list:"omit"`  // zero ttl: detach
+		ETL         *ETLConf              `json:"etl,omitempty" copy:"skip" list:"omit"`         // empty on_put: detach
 		Force       bool                  `json:"force,omitempty" copy:"skip" list:"omit"`
 	}
 
@@ -236,6 +260,54 @@ func (bp *Bprops) Validate(targetCnt int) error {
 			softErr = err
 		}
 	}
+	if bp.Versioning.Retain != 0 {
+		if bp.Provider != apc.AIS || !bp.BackendBck.IsEmpty() {
+			return fmt.Errorf("versioning.retain: retaining prior object versions is supported only for ais:// buckets without remote backend (have %q)",
+				bp.Provider)
+		}
+		if err := bp.Versioning.validateRetain(); err != nil {
+			return err
+		}
+	}
+	if err := bp.Lifecycle.Validate(); err != nil {
+		return err
+	}
+	if err := bp.Lifecycle.validateNoncurrent(bp); err != nil {
+		return err
+	}
+	if err := bp.Policy.Validate(); err != nil {
+		return err
+	}
+	if err := bp.CORS.Validate(); err != nil {
+		return err
+	}
+	if err := bp.ObjLock.Validate(); err != nil {
+		return err
+	}
+	if err := bp.SSE.Validate(bp); err != nil {
+		return err
+	}
+	if err := bp.Inventory.Validate(bp.Provider); err != nil {
+		return err
+	}
+	if err := bp.RateLimit.Validate(); err != nil {
+		return err
+	}
+	if err := bp.Replication.Validate(bp); err != nil {
+		return err
+	}
+	if err := bp.Tiering.Validate(bp); err != nil {
+		return err
+	}
+	if err := bp.Events.Validate(bp); err != nil {
+		return err
+	}
+	if err := bp.HeadCache.Validate(bp); err != nil {
+		return err
+	}
+	if err := bp.ETL.Validate(); err != nil {
+		return err
+	}
 	if bp.Mirror.Enabled && bp.EC.Enabled {
 		nlog.Warningln("n-way mirroring and EC are both enabled at the same time on the same bucket")
 	}
@@ -253,6 +325,53 @@ func (bp *Bprops) Validate(targetCnt int) error {
 func (bp *Bprops) Apply(propsToSet *BpropsToSet) {
 	err := CopyProps(propsToSet, bp, apc.Daemon)
 	debug.AssertNoErr(err)
+
+	// (copy:"skip" - replace as a whole)
+	if tiering := propsToSet.Tiering; tiering != nil {
+		if len(tiering.Tiers) == 0 {
+			bp.Tiering = nil
+		} else {
+			clone := *tiering
+			clone.Tiers = slices.Clone(tiering.Tiers)
+			bp.Tiering = &clone
+		}
+	}
+	if repl := propsToSet.Replication; repl != nil {
+		if repl.Dst.IsEmpty() {
+			bp.Replication = nil
+		} else {
+			clone := *repl
+			clone.Prefixes = slices.Clone(repl.Prefixes)
+			bp.Replication = &clone
+		}
+	}
+	if events := propsToSet.Events; events != nil {
+		if events.Source == "" {
+			bp.Events = nil
+		} else {
+			clone := *events
+			if clone.Token == EventsTokenRedacted && bp.Events != nil {
+				clone.Token = bp.Events.Token // (props as shown by HEAD)
+			}
+			bp.Events = &clone
+		}
+	}
+	if hc := propsToSet.HeadCache; hc != nil {
+		if hc.TTL == 0 {
+			bp.HeadCache = nil
+		} else {
+			clone := *hc
+			bp.HeadCache = &clone
+		}
+	}
+	if etl := propsToSet.ETL; etl != nil {
+		if etl.OnPut == "" {
+			bp.ETL = nil
+		} else {
+			clone := *etl
+			bp.ETL = &clone
+		}
+	}
 }
 
 //
@@ -101,6 +101,16 @@ func normalize(mime string) (string, error) {
 	return "", NewErrUnknownMime(mime)
 }
 
+// replace archive extension (if any) with the one that corresponds to a given (normalized) mime,
+// e.g. ("shard.tar", ".zip") => "shard.zip"
+func ChangeExt(filename, mime string) string {
+	ext, err := byExt(filename)
+	if err != nil {
+		return filename
+	}
+	return strings.TrimSuffix(filename, ext) + mime
+}
+
 // by filename extension
 func byExt(filename string) (string, error) {
 	for _, ext := range FileExtensions {
@@ -0,0 +1,158 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Named backend profiles: S3-compatible endpoints (MinIO, Ceph RGW, OpenStack Swift s3api, etc.)
+// - configured in the cluster config under the `aws` provider, e.g.:
+//   `backend.aws.profiles.minio = {"endpoint": "http://minio:9000", "path_style": true, ...}`
+// - a bucket references a profile via `extra.aws.backend_profile`; the profile then
+//   takes precedence over `extra.aws.endpoint` and `extra.aws.profile`
+// - the capability matrix (caps) tells aistore which S3 APIs the endpoint supports;
+//   when not specified, all is assumed supported except inventory
+// - see ais/backend/aws.go
+
+// credentials source (BackendProfile.Creds):
+// - ""                default AWS credential chain (environment, ~/.aws/credentials, etc.)
+// - "profile:<name>"  named profile in the shared AWS config and credentials files
+// - "env:<PREFIX>"    <PREFIX>_ACCESS_KEY_ID, <PREFIX>_SECRET_ACCESS_KEY, and optional <PREFIX>_SESSION_TOKEN
+// - "file:<path>"     shared credentials file (its `default` section)
+const (
+	CredsProfile = "profile"
+	CredsEnv     = "env"
+	CredsFile    = "file"
+)
+
+// S3 (additional) checksum algorithms (BackendProfile.Checksum)
+var profileCksums = []string{"", cos.ChecksumNone, "crc32", "crc32c", "sha1", "sha256"}
+
+type (
+	BackendProfile struct {
+		Endpoint    string       `json:"endpoint"`               // e.g. "http://minio.local:9000"
+		Region      string       `json:"region,omitempty"`       // default region (to skip bucket location lookups)
+		Creds       string       `json:"creds,omitempty"`        // credentials source (see above)
+		PathStyle   bool         `json:"path_style,omitempty"`   // path-style addressing (default: virtual-hosted)
+		MaxPageSize int64        `json:"max_pagesize,omitempty"` // list-objects page size (e.g., Swift: 10000); 0: 1000
+		Checksum    string       `json:"checksum,omitempty"`     // payload checksum (crc32, crc32c, sha1, sha256); none: MD5 (ETag) only
+		Caps        *BackendCaps `json:"caps,omitempty"`         // capability matrix
+	}
+	BackendCaps struct {
+		Versioning bool `json:"versioning"` // bucket versioning (GetBucketVersioning)
+		MPT        bool `json:"mpt"`        // multipart upload
+		Inventory  bool `json:"inventory"`  // bucket inventory (S3 Inventory)
+	}
+)
+
+var dfltBackendCaps = BackendCaps{Versioning: true, MPT: true}
+
+func ParseCredsSrc(s string) (kind, val string, err error) {
+	if s == "" {
+		return "", "", nil
+	}
+	kind, val, ok := strings.Cut(s, ":")
+	if ok && val != "" {
+		switch kind {
+		case CredsProfile, CredsEnv, CredsFile:
+			return kind, val, nil
+		}
+	}
+	return "", "", fmt.Errorf("invalid credentials source %q (expecting one of: %s:<name>, %s:<PREFIX>, %s:<path>)",
+		s, CredsProfile, CredsEnv, CredsFile)
+}
+
+func (p *BackendProfile) Validate(name string) error {
+	if err := cos.CheckAlphaPlus(name, "backend profile name"); err != nil {
+		return err
+	}
+	if p == nil {
+		return fmt.Errorf("backend profile %q: empty", name)
+	}
+	if p.Endpoint == "" {
+		return fmt.Errorf("backend profile %q: endpoint is required", name)
+	}
+	if _, _, err := ParseCredsSrc(p.Creds); err != nil {
+		return fmt.Errorf("backend profile %q: %v", name, err)
+	}
+	if p.MaxPageSize < 0 {
+		return fmt.Errorf("backend profile %q: invalid max_pagesize %d", name, p.MaxPageSize)
+	}
+	p.Checksum = strings.ToLower(p.Checksum)
+	if !cos.StringInSlice(p.Checksum, profileCksums) {
+		return fmt.Errorf("backend profile %q: unsupported checksum %q (expecting one of: %v)", name, p.Checksum, profileCksums[1:])
+	}
+	return nil
+}
+
+func (p *BackendProfile) Capabilities() BackendCaps {
+	if p.Caps == nil {
+		return dfltBackendCaps
+	}
+	return *p.Caps
+}
+
+// (all profiles)
+func (c *BackendConf) Profiles(provider string) map[string]*BackendProfile {
+	if v, ok := c.Conf[provider].(BackendConfCloud); ok {
+		return v.Profiles
+	}
+	return nil
+}
+
+func (c *BackendConf) Profile(provider, name string) (*BackendProfile, error) {
+	if p, ok := c.Profiles(provider)[name]; ok {
+		return p, nil
+	}
+	return nil, &ErrMissingProfile{Provider: provider, Name: name}
+}
+
+// profile referenced by a given (remote) bucket, if any
+func (c *BackendConf) ProfileOf(bck *Bck) *BackendProfile {
+	if bck.Props == nil || bck.Props.Extra.AWS.BackendProfile == "" {
+		return nil
+	}
+	p, err := c.Profile(apc.AWS, bck.Props.Extra.AWS.BackendProfile)
+	if err != nil {
+		return nil
+	}
+	return p
+}
+
+func validateProfiles(provider string, profiles map[string]*BackendProfile) error {
+	if len(profiles) == 0 {
+		return nil
+	}
+	if provider != apc.AWS {
+		return errors.New("backend profiles are supported only for S3 (and S3-compatible) endpoints")
+	}
+	for name, p := range profiles {
+		if err := p.Validate(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//
+// error
+//
+
+type ErrMissingProfile struct {
+	Provider string
+	Name     string
+}
+
+func (e *ErrMissingProfile) Error() string {
+	return fmt.Sprintf("%s backend profile %q does not exist (see 'backend.%s.profiles' in the cluster config)",
+		e.Provider, e.Name, e.Provider)
+}
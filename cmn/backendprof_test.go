@@ -0,0 +1,88 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package cmn_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	jsoniter "github.com/json-iterator/go"
+)
+
+func TestBackendProfiles(t *testing.T) {
+	var c cmn.BackendConf
+	err := jsoniter.Unmarshal([]byte(`{"aws": {"profiles": {
+		"minio": {"endpoint": "http://minio:9000", "creds": "env:MINIO", "path_style": true, "checksum": "CRC32C"},
+		"swift": {"endpoint": "https://swift:8080", "max_pagesize": 10000, "caps": {"versioning": false, "mpt": false, "inventory": false}}
+	}}}`), &c)
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, c.Validate())
+
+	minio, err := c.Profile(apc.AWS, "minio")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, minio.PathStyle && minio.Checksum == "crc32c", "unexpected %+v", minio)
+	caps := minio.Capabilities()
+	tassert.Errorf(t, caps.Versioning && caps.MPT && !caps.Inventory, "expected default caps, got %+v", caps)
+
+	swift, err := c.Profile(apc.AWS, "swift")
+	tassert.CheckFatal(t, err)
+	caps = swift.Capabilities()
+	tassert.Errorf(t, !caps.Versioning && !caps.MPT && !caps.Inventory, "unexpected caps %+v", caps)
+
+	_, err = c.Profile(apc.AWS, "ceph")
+	var errMissing *cmn.ErrMissingProfile
+	tassert.Errorf(t, errors.As(err, &errMissing), "expected missing profile error, got %v", err)
+
+	// bucket => profile
+	bck := &cmn.Bck{Name: "abc", Provider: apc.AWS, Props: &cmn.Bprops{}}
+	tassert.Errorf(t, c.ProfileOf(bck) == nil, "expected no profile")
+	bck.Props.Extra.AWS.BackendProfile = "swift"
+	tassert.Errorf(t, c.ProfileOf(bck) == swift, "expected swift profile")
+
+	// invalid
+	for _, s := range []string{
+		`{"aws": {"profiles": {"minio": {}}}}`,
+		`{"aws": {"profiles": {"minio": {"endpoint": "http://minio:9000", "creds": "vault:xyz"}}}}`,
+		`{"aws": {"profiles": {"minio": {"endpoint": "http://minio:9000", "creds": "env:"}}}}`,
+		`{"aws": {"profiles": {"minio": {"endpoint": "http://minio:9000", "checksum": "md5"}}}}`,
+		`{"aws": {"profiles": {"minio": {"endpoint": "http://minio:9000", "max_pagesize": -1}}}}`,
+		`{"aws": {"profiles": {"mi/nio": {"endpoint": "http://minio:9000"}}}}`,
+		`{"gcp": {"profiles": {"minio": {"endpoint": "http://minio:9000"}}}}`,
+	} {
+		var c cmn.BackendConf
+		tassert.CheckFatal(t, jsoniter.Unmarshal([]byte(s), &c))
+		tassert.Errorf(t, c.Validate() != nil, "expected error validating %s", s)
+	}
+}
+
+func TestParseCredsSrc(t *testing.T) {
+	tests := []struct {
+		src       string
+		kind, val string
+		fail      bool
+	}{
+		{src: ""},
+		{src: "profile:minio", kind: cmn.CredsProfile, val: "minio"},
+		{src: "env:RGW", kind: cmn.CredsEnv, val: "RGW"},
+		{src: "file:/etc/ais/creds", kind: cmn.CredsFile, val: "/etc/ais/creds"},
+		{src: "minio", fail: true},
+		{src: "profile:", fail: true},
+		{src: "secret:abc", fail: true},
+	}
+	for _, test := range tests {
+		kind, val, err := cmn.ParseCredsSrc(test.src)
+		if test.fail {
+			tassert.Errorf(t, err != nil, "%q: expected error", test.src)
+			continue
+		}
+		tassert.CheckError(t, err)
+		tassert.Errorf(t, kind == test.kind && val == test.val, "%q: got (%q, %q)", test.src, kind, val)
+	}
+}
@@ -410,7 +410,7 @@ func (b *Bck) IsRemote() bool {
 //
 
 func (b *Bck) IsBuiltTagged() bool {
-	return b.IsCloud() || b.Provider == apc.HT
+	return b.IsCloud() || b.Provider == apc.HT || b.Provider == apc.File
 }
 
 func (b *Bck) IsCloud() bool {
@@ -0,0 +1,137 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+)
+
+// Remote bucket change notifications: keep in-cluster copies of remote objects coherent
+// with the backend without waiting for a warm GET (feat.ValidateWarmGet) or sync prefetch to notice.
+//
+// Sources (one per bucket):
+// - "sqs":     S3 event notifications delivered to an SQS (or SQS-compatible) queue; polled by aistore
+// - "pubsub":  GCS notifications delivered to a Pub/Sub topic; pulled by aistore via the configured subscription
+// - "webhook": Azure Event Grid (Event Grid schema) pushing events to any aistore gateway, e.g.:
+//              POST https://gateway:8080/v1/events/<bucket>?provider=azure
+//              with the configured token in the `Ais-Events-Token` header (Event Grid: static delivery attribute)
+//
+// In each case, the queue (subscription) gets polled by a single target - the one that "owns" the bucket
+// (HRW), and all events get delivered to all targets, where:
+// - deleted objects are evicted (the in-cluster copy is removed);
+// - created and overwritten objects are evicted when their in-cluster copy is stale (different ETag or version);
+// - optionally (`prefetch`), created and overwritten objects are then cold-GET by their respective owners.
+//
+// See also: ais/tgtevents.go and ais/backend/events.go
+
+const (
+	EventsSQS     = "sqs"
+	EventsPubSub  = "pubsub"
+	EventsWebhook = "webhook"
+)
+
+// shown in place of the webhook token (HEAD(bucket) et al.)
+const EventsTokenRedacted = "**********"
+
+type (
+	EventsConf struct {
+		Source   string `json:"source"`             // one of the enumerated above
+		Queue    string `json:"queue,omitempty"`    // SQS queue URL or Pub/Sub subscription ("projects/<project>/subscriptions/<name>")
+		Endpoint string `json:"endpoint,omitempty"` // alternative service endpoint (e.g., local SQS or Pub/Sub emulator)
+		Token    string `json:"token,omitempty"`    // webhook only (required): pushed requests must carry matching apc.HdrEventsToken
+		Prefetch bool   `json:"prefetch,omitempty"` // cold-GET created and overwritten objects
+		Disabled bool   `json:"disabled,omitempty"` // pause (in particular, stop polling)
+	}
+
+	// normalized (provider-agnostic) change event
+	BckEvent struct {
+		Bucket  string `json:"bucket"` // remote bucket name, as reported by the provider
+		ObjName string `json:"name"`
+		ETag    string `json:"etag,omitempty"`
+		Version string `json:"version,omitempty"`
+		Size    int64  `json:"size,omitempty"`
+		Deleted bool   `json:"deleted,omitempty"`
+	}
+)
+
+func (c *EventsConf) IsActive() bool { return c != nil && c.Source != "" && !c.Disabled }
+
+// whether aistore must poll (pull) - as opposed to having events pushed
+func (c *EventsConf) Polled() bool { return c.IsActive() && c.Source != EventsWebhook }
+
+func (c *EventsConf) Validate(bp *Bprops) error {
+	if c == nil {
+		return nil
+	}
+	provider := bp.Provider
+	if !bp.BackendBck.IsEmpty() {
+		provider = bp.BackendBck.Provider
+	}
+	var expected string
+	switch c.Source {
+	case EventsSQS:
+		expected = apc.AWS
+	case EventsPubSub:
+		expected = apc.GCP
+	case EventsWebhook:
+		expected = apc.Azure
+	default:
+		return fmt.Errorf("events: invalid source %q (expecting one of: %s, %s, %s)", c.Source, EventsSQS, EventsPubSub, EventsWebhook)
+	}
+	if provider != expected {
+		return fmt.Errorf("events: source %q requires %s bucket (or ais bucket with %s backend), have %q",
+			c.Source, apc.DisplayProvider(expected), apc.DisplayProvider(expected), provider)
+	}
+	switch c.Source {
+	case EventsSQS:
+		if c.Queue == "" {
+			return errors.New("events: missing SQS queue URL")
+		}
+	case EventsPubSub:
+		if !strings.HasPrefix(c.Queue, "projects/") || !strings.Contains(c.Queue, "/subscriptions/") {
+			return fmt.Errorf("events: invalid Pub/Sub subscription %q (expecting projects/<project>/subscriptions/<name>)", c.Queue)
+		}
+	case EventsWebhook:
+		if c.Queue != "" {
+			return errors.New("events: webhook does not have a queue (events are pushed)")
+		}
+		if c.Token == "" || c.Token == EventsTokenRedacted {
+			return errors.New("events: webhook requires token (to authenticate pushed requests)")
+		}
+	}
+	return nil
+}
+
+// copy of the bucket props with webhook token hidden
+func (bp *Bprops) Redacted() *Bprops {
+	if bp.Events == nil || bp.Events.Token == "" {
+		return bp
+	}
+	clone, events := *bp, *bp.Events
+	events.Token = EventsTokenRedacted
+	clone.Events = &events
+	return &clone
+}
+
+// whether in-cluster copy with a given ETag and version must be evicted
+func (e *BckEvent) Stale(etag, version string) bool {
+	if e.Deleted {
+		// keep in-cluster copy when a different version gets deleted or archived
+		// (e.g., GCS: overwriting an object in a versioned bucket archives the previous generation)
+		return e.Version == "" || version == "" || e.Version == version
+	}
+	switch {
+	case e.Version != "" && version != "":
+		return e.Version != version
+	case e.ETag != "" && etag != "":
+		return UnquoteCEV(e.ETag) != UnquoteCEV(etag)
+	}
+	return true
+}
@@ -0,0 +1,79 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package cmn_test
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestEventsConfValidate(t *testing.T) {
+	tests := []struct {
+		bp   cmn.Bprops
+		conf cmn.EventsConf
+		fail bool
+	}{
+		{bp: cmn.Bprops{Provider: apc.AWS}, conf: cmn.EventsConf{Source: cmn.EventsSQS, Queue: "https://sqs.us-east-2.amazonaws.com/1234/q"}},
+		{bp: cmn.Bprops{Provider: apc.AWS}, conf: cmn.EventsConf{Source: cmn.EventsSQS}, fail: true},
+		{bp: cmn.Bprops{Provider: apc.GCP}, conf: cmn.EventsConf{Source: cmn.EventsSQS, Queue: "http://localhost:9324/q"}, fail: true},
+		{bp: cmn.Bprops{Provider: apc.GCP}, conf: cmn.EventsConf{Source: cmn.EventsPubSub, Queue: "projects/p/subscriptions/s"}},
+		{bp: cmn.Bprops{Provider: apc.GCP}, conf: cmn.EventsConf{Source: cmn.EventsPubSub, Queue: "s"}, fail: true},
+		{bp: cmn.Bprops{Provider: apc.Azure}, conf: cmn.EventsConf{Source: cmn.EventsWebhook, Token: "abc"}},
+		{bp: cmn.Bprops{Provider: apc.Azure}, conf: cmn.EventsConf{Source: cmn.EventsWebhook, Queue: "q", Token: "abc"}, fail: true},
+		{bp: cmn.Bprops{Provider: apc.Azure}, conf: cmn.EventsConf{Source: cmn.EventsWebhook}, fail: true},
+		{bp: cmn.Bprops{Provider: apc.Azure}, conf: cmn.EventsConf{Source: cmn.EventsWebhook, Token: cmn.EventsTokenRedacted}, fail: true},
+		{bp: cmn.Bprops{Provider: apc.AIS}, conf: cmn.EventsConf{Source: cmn.EventsWebhook, Token: "abc"}, fail: true},
+		{bp: cmn.Bprops{Provider: apc.AWS}, conf: cmn.EventsConf{Source: "kafka"}, fail: true},
+		{
+			bp:   cmn.Bprops{Provider: apc.AIS, BackendBck: cmn.Bck{Name: "b", Provider: apc.Azure}},
+			conf: cmn.EventsConf{Source: cmn.EventsWebhook, Token: "abc"},
+		},
+	}
+	for i, test := range tests {
+		err := test.conf.Validate(&test.bp)
+		if test.fail {
+			tassert.Errorf(t, err != nil, "%d: expected error (%+v)", i, test.conf)
+		} else {
+			tassert.Errorf(t, err == nil, "%d: unexpected error %v", i, err)
+		}
+	}
+}
+
+func TestEventsTokenRedacted(t *testing.T) {
+	bp := &cmn.Bprops{Provider: apc.Azure, Events: &cmn.EventsConf{Source: cmn.EventsWebhook, Token: "s3cr3t"}}
+	shown := bp.Redacted()
+	tassert.Errorf(t, shown.Events.Token == cmn.EventsTokenRedacted, "expected redacted token, got %q", shown.Events.Token)
+	tassert.Errorf(t, bp.Events.Token == "s3cr3t", "original props must not change")
+
+	// props as shown (e.g., by HEAD) and then set back keep the token
+	bp.Apply(&cmn.BpropsToSet{Events: shown.Events})
+	tassert.Errorf(t, bp.Events.Token == "s3cr3t", "expected original token, got %q", bp.Events.Token)
+}
+
+func TestBckEventStale(t *testing.T) {
+	tests := []struct {
+		ev            cmn.BckEvent
+		etag, version string
+		stale         bool
+	}{
+		{ev: cmn.BckEvent{ETag: "abc"}, etag: "\"abc\"", stale: false},
+		{ev: cmn.BckEvent{ETag: "abc"}, etag: "xyz", stale: true},
+		{ev: cmn.BckEvent{Version: "2"}, version: "2", stale: false},
+		{ev: cmn.BckEvent{Version: "3", ETag: "abc"}, etag: "abc", version: "2", stale: true},
+		{ev: cmn.BckEvent{}, etag: "abc", version: "2", stale: true},
+		{ev: cmn.BckEvent{Deleted: true}, etag: "abc", stale: true},
+		{ev: cmn.BckEvent{Deleted: true, Version: "1"}, version: "2", stale: false},
+		{ev: cmn.BckEvent{Deleted: true, Version: "2"}, version: "2", stale: true},
+	}
+	for i, test := range tests {
+		stale := test.ev.Stale(test.etag, test.version)
+		tassert.Errorf(t, stale == test.stale, "%d: expected stale=%t, got %t (%+v)", i, test.stale, stale, test.ev)
+	}
+}
@@ -167,6 +167,12 @@ type (
 	}
 	BackendConfAIS map[string][]string // cluster alias -> [urls...]
 
+	// file:// backend: each bucket is a (top-level) subdirectory of the root
+	BackendConfFile struct {
+		Root     string `json:"root"`                // absolute path, e.g. "/mnt/nfs/datasets"
+		ReadOnly bool   `json:"read_only,omitempty"` // disallow PUT and DELETE
+	}
+
 	MirrorConf struct {
 		Copies  int64 `json:"copies"`       // num copies
 		Burst   int   `json:"burst_buffer"` // xaction channel (buffer) size
@@ -465,11 +471,18 @@ type (
 		// - deleting in-cluster object if its remote ("cached") counterpart does not exist
 		// See also: apc.QparamSync, apc.CopyBckMsg
 		Sync bool `json:"synchronize"`
+
+		// Number of prior (noncurrent) object versions to keep when overwriting
+		// (zero means no retention). Applies only to ais:// buckets that have no remote backend.
+		// Prior versions can be listed (apc.LsVersions, S3 ListObjectVersions) and accessed
+		// by version ID (apc.QparamObjVersion, S3 versionId).
+		Retain int `json:"retain"`
 	}
 	VersionConfToSet struct {
 		Enabled         *bool `json:"enabled,omitempty"`
 		ValidateWarmGet *bool `json:"validate_warm_get,omitempty"`
 		Sync            *bool `json:"synchronize,omitempty"`
+		Retain          *int  `json:"retain,omitempty"`
 	}
 
 	NetConf struct {
@@ -667,7 +680,7 @@ type (
 		MD   apc.WritePolicy `json:"md"`
 	}
 	WritePolicyConfToSet struct {
-		Data *apc.WritePolicy `json:"data,omitempty" list:"readonly"` // NOTE: NIY
+		Data *apc.WritePolicy `json:"data,omitempty"`
 		MD   *apc.WritePolicy `json:"md,omitempty"`
 	}
 )
@@ -937,6 +950,32 @@ func (c *BackendConf) Validate() (err error) {
 				}
 			}
 			c.Conf[provider] = aisConf
+		case apc.File:
+			var fileConf BackendConfFile
+			if err := jsoniter.Unmarshal(b, &fileConf); err != nil {
+				return fmt.Errorf("invalid %s backend specification: %v", provider, err)
+			}
+			if err := fileConf.Validate(); err != nil {
+				return err
+			}
+			c.Conf[provider] = fileConf
+			c.setProvider(provider)
+		case apc.AWS, apc.GCP, apc.Azure, apc.OCI:
+			var cloudConf BackendConfCloud
+			if err := jsoniter.Unmarshal(b, &cloudConf); err != nil {
+				return fmt.Errorf("invalid %s backend specification: %v", provider, err)
+			}
+			if err := cloudConf.RateLimit.Validate(); err != nil {
+				return fmt.Errorf("invalid %s backend specification: %v", provider, err)
+			}
+			if err := cloudConf.Multipart.Validate(); err != nil {
+				return fmt.Errorf("invalid %s backend specification: %v", provider, err)
+			}
+			if err := validateProfiles(provider, cloudConf.Profiles); err != nil {
+				return fmt.Errorf("invalid %s backend specification: %v", provider, err)
+			}
+			c.Conf[provider] = cloudConf
+			c.setProvider(provider)
 		case "":
 			continue
 		default:
@@ -949,7 +988,7 @@ func (c *BackendConf) Validate() (err error) {
 func (c *BackendConf) setProvider(provider string) {
 	var ns Ns
 	switch provider {
-	case apc.AWS, apc.Azure, apc.GCP, apc.OCI, apc.HT:
+	case apc.AWS, apc.Azure, apc.GCP, apc.OCI, apc.HT, apc.File:
 		ns = NsGlobal
 	default:
 		debug.Assert(false, "unknown backend provider "+provider)
@@ -999,6 +1038,17 @@ func (c *BackendConf) EqualRemAIS(o *BackendConf, sname string) bool {
 	return true
 }
 
+func (c *BackendConfFile) Validate() error {
+	if c.Root == "" {
+		return fmt.Errorf("invalid %s backend: root directory is not defined", apc.File)
+	}
+	if !filepath.IsAbs(c.Root) {
+		return fmt.Errorf("invalid %s backend: root directory %q must be an absolute path", apc.File, c.Root)
+	}
+	c.Root = filepath.Clean(c.Root)
+	return nil
+}
+
 func (c BackendConfAIS) String() (s string) {
 	for a, urls := range c {
 		if s != "" {
@@ -1107,10 +1157,22 @@ func (c *CksumConf) String() string {
 // VersionConf //
 /////////////////
 
+const MaxRetainVersions = 100 // max number of retained prior versions (see `Retain` above)
+
 func (c *VersionConf) Validate() error {
 	if !c.Enabled && c.ValidateWarmGet {
 		return errors.New("versioning.validate_warm_get requires versioning to be enabled")
 	}
+	return c.validateRetain()
+}
+
+func (c *VersionConf) validateRetain() error {
+	if c.Retain < 0 || c.Retain > MaxRetainVersions {
+		return fmt.Errorf("invalid versioning.retain %d (expecting value in range [0, %d])", c.Retain, MaxRetainVersions)
+	}
+	if c.Retain > 0 && !c.Enabled {
+		return errors.New("versioning.retain requires versioning to be enabled")
+	}
 	return nil
 }
 
@@ -1243,7 +1305,8 @@ func (c *ECConf) RequiredRestoreTargets() int {
 func (c *WritePolicyConf) Validate() (err error) {
 	err = c.Data.Validate()
 	if err == nil {
-		if !c.Data.IsImmediate() {
+		// data: "delayed" means write-back (remote buckets only; no-op otherwise)
+		if c.Data == apc.WriteNever {
 			return fmt.Errorf("invalid write policy for data: %q not implemented yet", c.Data)
 		}
 		err = c.MD.Validate()
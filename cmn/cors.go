@@ -0,0 +1,167 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Bucket CORS (cross-origin resource sharing) configuration - a list of rules
+// modeled after S3:
+// https://docs.aws.amazon.com/AmazonS3/latest/userguide/cors.html
+// The first rule that matches (origin, method, and requested headers) applies.
+
+const (
+	MaxCORSRules = 100
+
+	CORSAnyOrigin = "*"
+)
+
+type (
+	CORSConf struct {
+		Rules []CORSRule `json:"rules"`
+	}
+	CORSRule struct {
+		ID             string   `json:"id,omitempty"`
+		AllowedOrigins []string `json:"allowed_origins"`           // e.g. "https://viewer.example.com", "https://*.example.com", "*"
+		AllowedMethods []string `json:"allowed_methods"`           // GET, PUT, POST, DELETE, HEAD
+		AllowedHeaders []string `json:"allowed_headers,omitempty"` // (preflight) request headers; wildcards allowed
+		ExposeHeaders  []string `json:"expose_headers,omitempty"`  // response headers accessible to browser applications
+		MaxAgeSeconds  int      `json:"max_age_seconds,omitempty"` // preflight response caching
+	}
+)
+
+var corsMethods = [...]string{http.MethodGet, http.MethodPut, http.MethodPost, http.MethodDelete, http.MethodHead}
+
+//////////////
+// CORSConf //
+//////////////
+
+func (c *CORSConf) IsEmpty() bool { return c == nil || len(c.Rules) == 0 }
+
+func (c *CORSConf) Validate() error {
+	if c == nil {
+		return nil
+	}
+	if len(c.Rules) == 0 {
+		return errors.New("CORS: no rules")
+	}
+	if len(c.Rules) > MaxCORSRules {
+		return fmt.Errorf("CORS: number of rules (%d) exceeds %d", len(c.Rules), MaxCORSRules)
+	}
+	for i := range c.Rules {
+		if err := c.Rules[i].validate(); err != nil {
+			return fmt.Errorf("CORS: rule %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+// returns the first matching rule, or nil
+func (c *CORSConf) Match(origin, method string, headers []string) *CORSRule {
+	if c.IsEmpty() {
+		return nil
+	}
+	for i := range c.Rules {
+		rule := &c.Rules[i]
+		if rule.matchOrigin(origin) && rule.matchMethod(method) && rule.matchHeaders(headers) {
+			return rule
+		}
+	}
+	return nil
+}
+
+//////////////
+// CORSRule //
+//////////////
+
+func (rule *CORSRule) validate() error {
+	if len(rule.AllowedOrigins) == 0 {
+		return errors.New("no allowed origins")
+	}
+	for _, o := range rule.AllowedOrigins {
+		if strings.Count(o, "*") > 1 {
+			return fmt.Errorf("origin %q can contain at most one wildcard", o)
+		}
+	}
+	if len(rule.AllowedMethods) == 0 {
+		return errors.New("no allowed methods")
+	}
+outer:
+	for _, m := range rule.AllowedMethods {
+		for _, cm := range corsMethods {
+			if m == cm {
+				continue outer
+			}
+		}
+		return fmt.Errorf("unsupported method %q (expecting one of %v)", m, corsMethods)
+	}
+	for _, h := range rule.AllowedHeaders {
+		if strings.Count(h, "*") > 1 {
+			return fmt.Errorf("header %q can contain at most one wildcard", h)
+		}
+	}
+	if rule.MaxAgeSeconds < 0 {
+		return fmt.Errorf("invalid max age %d", rule.MaxAgeSeconds)
+	}
+	return nil
+}
+
+func (rule *CORSRule) AnyOrigin() bool {
+	for _, o := range rule.AllowedOrigins {
+		if o == CORSAnyOrigin {
+			return true
+		}
+	}
+	return false
+}
+
+func (rule *CORSRule) matchOrigin(origin string) bool {
+	for _, o := range rule.AllowedOrigins {
+		if wildcardMatch(o, origin, false) {
+			return true
+		}
+	}
+	return false
+}
+
+func (rule *CORSRule) matchMethod(method string) bool {
+	for _, m := range rule.AllowedMethods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+// all requested headers must be allowed
+func (rule *CORSRule) matchHeaders(headers []string) bool {
+outer:
+	for _, h := range headers {
+		for _, ah := range rule.AllowedHeaders {
+			if wildcardMatch(ah, h, true) {
+				continue outer
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// (at most one '*')
+func wildcardMatch(pattern, s string, ignoreCase bool) bool {
+	if ignoreCase {
+		pattern, s = strings.ToLower(pattern), strings.ToLower(s)
+	}
+	prefix, suffix, ok := strings.Cut(pattern, "*")
+	if !ok {
+		return pattern == s
+	}
+	return len(s) >= len(prefix)+len(suffix) && strings.HasPrefix(s, prefix) && strings.HasSuffix(s, suffix)
+}
@@ -45,6 +45,23 @@ const (
 	HdrETag      = "ETag" // Ref: https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/ETag
 
 	HdrHSTS = "Strict-Transport-Security"
+
+	// conditional requests - Ref: https://www.rfc-editor.org/rfc/rfc9110#section-13.1
+	HdrIfMatch           = "If-Match"
+	HdrIfNoneMatch       = "If-None-Match"
+	HdrIfModifiedSince   = "If-Modified-Since"
+	HdrIfUnmodifiedSince = "If-Unmodified-Since"
+
+	// CORS - Ref: https://fetch.spec.whatwg.org/#http-cors-protocol
+	HdrOrigin           = "Origin"
+	HdrVary             = "Vary"
+	HdrACRequestMethod  = "Access-Control-Request-Method"
+	HdrACRequestHeaders = "Access-Control-Request-Headers"
+	HdrACAllowOrigin    = "Access-Control-Allow-Origin"
+	HdrACAllowMethods   = "Access-Control-Allow-Methods"
+	HdrACAllowHeaders   = "Access-Control-Allow-Headers"
+	HdrACExposeHeaders  = "Access-Control-Expose-Headers"
+	HdrACMaxAge         = "Access-Control-Max-Age"
 )
 
 //
@@ -0,0 +1,162 @@
+// Package cos provides common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package cos
+
+import (
+	"sync"
+	"time"
+)
+
+// AdaptRateLim is an adaptive token bucket that separately limits operations
+// and bytes per second:
+// - Reserve returns the time to wait (reservation semantics: the more callers
+//   ahead, the longer the wait)
+// - bytes can also be charged after the fact (e.g., upon GET) - incurring debt
+//   that subsequent callers pay off
+// - OnThrottle (e.g., 429 or 503 SlowDown) halves the ops rate, at most once per
+//   adaptIval; when unlimited, the current rate gets estimated first
+// - in the absence of throttling, the ops rate recovers by 25% every adaptIval
+//   up to the configured max (or, when unlimited, back to unlimited after recoverIval)
+// - all methods take the current (monotonic) time, e.g. mono.NanoTime()
+
+const (
+	adaptIval   = time.Second
+	recoverIval = time.Minute
+	minAdaptOps = 1.0
+)
+
+type AdaptRateLim struct {
+	maxOps    float64 // configured; 0: unlimited
+	ops       float64 // current (adapted); 0: unlimited
+	bps       float64 // bytes per second; 0: unlimited
+	otokens   float64
+	btokens   float64
+	last      int64 // last refill
+	lastThrot int64 // last time the rate was reduced
+	lastAdj   int64 // last time the rate recovered
+	wstart    int64 // observed rate: current (one-second) window
+	wcnt      int64
+	prate     float64 // observed rate: previous window
+	mu        sync.Mutex
+}
+
+func NewAdaptRateLim(ops, bps float64, now int64) *AdaptRateLim {
+	l := &AdaptRateLim{last: now, wstart: now}
+	l.Set(ops, bps)
+	return l
+}
+
+// (re)configure; changing the ops limit restarts adaptation
+func (l *AdaptRateLim) Set(ops, bps float64) {
+	l.mu.Lock()
+	if ops != l.maxOps {
+		l.maxOps, l.ops = ops, ops
+		l.otokens = max(ops, minAdaptOps)
+		l.lastThrot, l.lastAdj = 0, 0
+	}
+	if bps != l.bps {
+		l.bps, l.btokens = bps, bps
+	}
+	l.mu.Unlock()
+}
+
+// current (adapted) ops limit; 0: unlimited
+func (l *AdaptRateLim) Ops() (ops float64) {
+	l.mu.Lock()
+	ops = l.ops
+	l.mu.Unlock()
+	return ops
+}
+
+func (l *AdaptRateLim) Reserve(now, nbytes int64) (wait time.Duration) {
+	l.mu.Lock()
+	l.recover(now)
+	l.refill(now)
+
+	// observed rate
+	if elapsed := now - l.wstart; elapsed >= int64(adaptIval) {
+		l.prate = float64(l.wcnt) * float64(time.Second) / float64(elapsed)
+		l.wstart, l.wcnt = now, 0
+	}
+	l.wcnt++
+
+	if l.ops > 0 {
+		l.otokens--
+		if l.otokens < 0 {
+			wait = time.Duration(-l.otokens / l.ops * float64(time.Second))
+		}
+	}
+	if l.bps > 0 && nbytes > 0 {
+		l.btokens -= float64(nbytes)
+		if l.btokens < 0 {
+			wait = max(wait, time.Duration(-l.btokens/l.bps*float64(time.Second)))
+		}
+	}
+	l.mu.Unlock()
+	return wait
+}
+
+// charge bytes after the fact
+func (l *AdaptRateLim) Charge(now, nbytes int64) {
+	l.mu.Lock()
+	if l.bps > 0 {
+		l.refill(now)
+		l.btokens -= float64(nbytes)
+	}
+	l.mu.Unlock()
+}
+
+func (l *AdaptRateLim) OnThrottle(now int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.lastThrot != 0 && now-l.lastThrot < int64(adaptIval) {
+		return
+	}
+	cur := l.ops
+	if cur == 0 {
+		cur = l.prate
+		if elapsed := now - l.wstart; elapsed > 0 {
+			cur = max(cur, float64(l.wcnt)*float64(time.Second)/float64(max(elapsed, int64(adaptIval))))
+		}
+		l.refill(now)
+		l.otokens = 0
+	}
+	l.ops = max(cur/2, minAdaptOps)
+	l.otokens = min(l.otokens, l.ops)
+	l.lastThrot = now
+}
+
+func (l *AdaptRateLim) refill(now int64) {
+	elapsed := float64(now-l.last) / float64(time.Second)
+	if elapsed <= 0 {
+		return
+	}
+	l.last = now
+	if l.ops > 0 {
+		l.otokens = min(l.otokens+l.ops*elapsed, max(l.ops, minAdaptOps))
+	}
+	if l.bps > 0 {
+		l.btokens = min(l.btokens+l.bps*elapsed, l.bps)
+	}
+}
+
+func (l *AdaptRateLim) recover(now int64) {
+	if l.lastThrot == 0 || l.ops == 0 || l.ops == l.maxOps {
+		return
+	}
+	since := now - l.lastThrot
+	if since < 2*int64(adaptIval) || now-l.lastAdj < int64(adaptIval) {
+		return
+	}
+	l.refill(now)
+	l.lastAdj = now
+	l.ops *= 1.25
+	switch {
+	case l.maxOps > 0:
+		l.ops = min(l.ops, l.maxOps)
+	case since >= int64(recoverIval):
+		l.ops, l.lastThrot = 0, 0
+	}
+}
@@ -0,0 +1,87 @@
+// Package cos provides common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package cos_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestAdaptRateLimOps(t *testing.T) {
+	var (
+		now = int64(time.Hour)
+		l   = cos.NewAdaptRateLim(10, 0, now)
+	)
+	// burst
+	for i := range 10 {
+		wait := l.Reserve(now, 0)
+		tassert.Errorf(t, wait == 0, "%d: expected no wait, got %v", i, wait)
+	}
+	// reservations
+	for i := 1; i <= 5; i++ {
+		wait := l.Reserve(now, 0)
+		exp := time.Duration(i) * 100 * time.Millisecond
+		tassert.Errorf(t, wait == exp, "%d: expected %v, got %v", i, exp, wait)
+	}
+	// refill
+	now += int64(2 * time.Second)
+	wait := l.Reserve(now, 0)
+	tassert.Errorf(t, wait == 0, "expected no wait after refill, got %v", wait)
+}
+
+func TestAdaptRateLimBytes(t *testing.T) {
+	var (
+		now = int64(time.Hour)
+		l   = cos.NewAdaptRateLim(0, 1000, now)
+	)
+	wait := l.Reserve(now, 1000)
+	tassert.Errorf(t, wait == 0, "expected no wait, got %v", wait)
+	l.Charge(now, 500)
+	wait = l.Reserve(now, 0)
+	tassert.Errorf(t, wait == 0, "expected no wait w/ zero bytes, got %v", wait)
+	wait = l.Reserve(now, 500)
+	tassert.Errorf(t, wait == time.Second, "expected 1s, got %v", wait)
+}
+
+func TestAdaptRateLimAdapt(t *testing.T) {
+	var (
+		now = int64(time.Hour)
+		l   = cos.NewAdaptRateLim(100, 0, now)
+	)
+	l.OnThrottle(now)
+	tassert.Errorf(t, l.Ops() == 50, "expected 50, got %f", l.Ops())
+	l.OnThrottle(now + int64(time.Millisecond))
+	tassert.Errorf(t, l.Ops() == 50, "expected no change within adapt interval, got %f", l.Ops())
+	now += int64(time.Second)
+	l.OnThrottle(now)
+	tassert.Errorf(t, l.Ops() == 25, "expected 25, got %f", l.Ops())
+
+	// recover, up to the configured max
+	for range 100 {
+		now += int64(time.Second)
+		l.Reserve(now, 0)
+	}
+	tassert.Errorf(t, l.Ops() == 100, "expected full recovery, got %f", l.Ops())
+
+	// unlimited: estimate, halve, and eventually recover back to unlimited
+	l = cos.NewAdaptRateLim(0, 0, now)
+	for range 200 {
+		now += int64(5 * time.Millisecond)
+		l.Reserve(now, 0)
+	}
+	l.OnThrottle(now)
+	ops := l.Ops()
+	tassert.Errorf(t, ops >= 50 && ops <= 150, "expected ~100, got %f", ops)
+	wait := l.Reserve(now, 0)
+	tassert.Errorf(t, wait > 0, "expected wait")
+	for range 70 {
+		now += int64(time.Second)
+		l.Reserve(now, 0)
+	}
+	tassert.Errorf(t, l.Ops() == 0, "expected unlimited, got %f", l.Ops())
+}
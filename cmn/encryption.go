@@ -0,0 +1,47 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"errors"
+
+	"github.com/NVIDIA/aistore/api/apc"
+)
+
+// Server-side encryption at rest (SSE) for ais:// buckets - see cmn/sse for
+// the on-disk format and key providers.
+//
+// With bucket-level `Bprops.SSE` configured, all newly written objects get
+// encrypted with cluster-managed keys. Independently, S3 clients may request
+// encryption on a per-object basis, with either cluster-managed (SSE-S3) or
+// customer-provided (SSE-C) keys. Object's size and checksum remain those of
+// the plaintext.
+
+const SSEAlgAES256 = "AES256" // (S3) the only supported algorithm
+
+type SSEConf struct {
+	// key provider's key to wrap data keys of newly written objects;
+	// empty means provider's current key (enables key rotation)
+	KeyID string `json:"key_id,omitempty"`
+}
+
+func (c *SSEConf) Validate(bp *Bprops) error {
+	if c == nil {
+		return nil
+	}
+	if bp.Provider != apc.AIS || !bp.BackendBck.IsEmpty() {
+		return errors.New("server-side encryption is supported only for ais:// buckets without remote backend")
+	}
+	if bp.EC.Enabled {
+		return errors.New("server-side encryption and erasure coding cannot be enabled at the same time")
+	}
+	return nil
+}
+
+// (custom metadata that only the server can set)
+func IsSSEMD(key string) bool {
+	return key == SSEAlgObjMD || key == SSEKeyIDObjMD || key == SSEKeyObjMD || key == SSECustMD5ObjMD
+}
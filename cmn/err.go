@@ -113,6 +113,25 @@ type (
 		err error
 	}
 
+	ErrObjLocked struct {
+		cname  string
+		reason string
+	}
+
+	ErrSSEKey struct {
+		cname  string
+		reason string
+	}
+
+	// conditional requests (see cmn/precond.go)
+	ErrPrecondFailed struct {
+		cname string
+		hdr   string // failed precondition
+	}
+	ErrNotModified struct {
+		cname string
+	}
+
 	ErrBucketAccessDenied struct{ errAccessDenied }
 	ErrObjectAccessDenied struct{ errAccessDenied }
 	errAccessDenied       struct {
@@ -471,6 +490,66 @@ func NewObjectAccessDenied(object, oper string, aattrs apc.AccessAttrs) *ErrObje
 	return &ErrObjectAccessDenied{errAccessDenied{object, oper, aattrs}}
 }
 
+// ErrObjLocked
+
+func NewErrObjLocked(cname, reason string) *ErrObjLocked {
+	return &ErrObjLocked{cname, reason}
+}
+
+func (e *ErrObjLocked) Error() string {
+	return "object " + e.cname + " is locked (" + e.reason + ")"
+}
+
+func IsErrObjLocked(err error) bool {
+	_, ok := err.(*ErrObjLocked)
+	return ok
+}
+
+// ErrSSEKey
+
+func NewErrSSEKey(cname, reason string) *ErrSSEKey {
+	return &ErrSSEKey{cname, reason}
+}
+
+func (e *ErrSSEKey) Error() string {
+	return "object " + e.cname + " is encrypted: " + e.reason
+}
+
+func IsErrSSEKey(err error) bool {
+	_, ok := err.(*ErrSSEKey)
+	return ok
+}
+
+// ErrPrecondFailed
+
+func NewErrPrecondFailed(cname, hdr string) *ErrPrecondFailed {
+	return &ErrPrecondFailed{cname, hdr}
+}
+
+func (e *ErrPrecondFailed) Error() string {
+	return "precondition failed: " + e.cname + " (" + e.hdr + ")"
+}
+
+func IsErrPrecondFailed(err error) bool {
+	_, ok := err.(*ErrPrecondFailed)
+	return ok
+}
+
+// ErrNotModified
+
+func NewErrNotModified(cname string) *ErrNotModified {
+	return &ErrNotModified{cname}
+}
+
+func (e *ErrNotModified) Error() string {
+	return e.cname + " not modified"
+}
+
+func IsErrNotModified(err error) bool {
+	_, ok := err.(*ErrNotModified)
+	return ok
+}
+
 // ErrCapExceeded
 
 func NewErrCapExceeded(totalBytesUsed, totalBytes uint64, highWM, cleanupWM int64, usedPct int32, oos bool) *ErrCapExceeded {
@@ -1149,6 +1228,11 @@ func (e *ErrHTTP) _jsonError(buf *bytes.Buffer) {
 }
 
 func (e *ErrHTTP) write(w http.ResponseWriter, r *http.Request, silent bool) {
+	if e.Status == http.StatusNotModified {
+		// (conditional GET or HEAD; no body)
+		w.WriteHeader(e.Status)
+		return
+	}
 	if !silent {
 		s := e.StringEx()
 		if thisNodeName != "" && !strings.Contains(e.Message, thisNodeName) {
@@ -1218,6 +1302,16 @@ func IsStatusBadGateway(err error) (yes bool) {
 	return ok && herr.Status == http.StatusBadGateway
 }
 
+func IsStatusNotModified(err error) (yes bool) {
+	herr, ok := err.(*ErrHTTP)
+	return ok && herr.Status == http.StatusNotModified
+}
+
+func IsStatusPreconditionFailed(err error) (yes bool) {
+	herr, ok := err.(*ErrHTTP)
+	return ok && herr.Status == http.StatusPreconditionFailed
+}
+
 func IsStatusGone(err error) (yes bool) {
 	herr, ok := err.(*ErrHTTP)
 	return ok && herr.Status == http.StatusGone
@@ -1264,6 +1358,10 @@ func WriteErr(w http.ResponseWriter, r *http.Request, err error, opts ...int /*[
 			status = http.StatusRequestedRangeNotSatisfiable
 		case isErrUnsupp(err), isErrNotImpl(err):
 			status = http.StatusNotImplemented
+		case IsErrPrecondFailed(err):
+			status = http.StatusPreconditionFailed
+		case IsErrNotModified(err):
+			status = http.StatusNotModified
 		}
 	}
 
@@ -0,0 +1,50 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Write-path (on-PUT) ETL: new content that arrives into a given bucket via PUT,
+// APPEND, and promote gets transformed by the named (and running) ETL or ETL pipeline
+// (e.g., "strip-exif,resize") - before it is written:
+// - the stored object is the transformed output, with checksum computed accordingly
+// - the checksum of the original content (also used to validate end-to-end checksum
+//   that may arrive with the request) is kept in custom metadata (OrigCksumObjMD)
+// - PUT fails when the transformer rejects the input (422)
+// - transformer(s) must be able to transform streams (see etl.CheckStream)
+// - see ais/tgtetlput.go
+
+const (
+	ETLOnPutMaxTimeout = time.Hour
+)
+
+type ETLConf struct {
+	OnPut   string       `json:"on_put"`            // ETL name or comma-separated ETL pipeline (empty: detach)
+	Timeout cos.Duration `json:"timeout,omitempty"` // max time to transform a single object (zero: no limit)
+}
+
+func (c *ETLConf) IsActive() bool { return c != nil && c.OnPut != "" }
+
+func (c *ETLConf) Names() []string { return apc.ParseETLPipeline(c.OnPut) }
+
+func (c *ETLConf) Validate() error {
+	if c == nil {
+		return nil
+	}
+	if err := apc.ValidateETLPipeline(c.Names()); err != nil {
+		return fmt.Errorf("etl: invalid on_put %q: %v", c.OnPut, err)
+	}
+	if c.Timeout < 0 || c.Timeout.D() > ETLOnPutMaxTimeout {
+		return fmt.Errorf("etl: invalid timeout %v (expecting non-negative duration <= %v)", c.Timeout, ETLOnPutMaxTimeout)
+	}
+	return nil
+}
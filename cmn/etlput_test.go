@@ -0,0 +1,42 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package cmn_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestETLConfValidate(t *testing.T) {
+	tests := []struct {
+		conf cmn.ETLConf
+		fail bool
+	}{
+		{conf: cmn.ETLConf{OnPut: "strip-exif"}},
+		{conf: cmn.ETLConf{OnPut: "strip-exif,resize", Timeout: cos.Duration(time.Minute)}},
+		{conf: cmn.ETLConf{OnPut: "strip-exif,,resize"}, fail: true},
+		{conf: cmn.ETLConf{OnPut: "a,b,c,d,e,f,g,h,i"}, fail: true},
+		{conf: cmn.ETLConf{OnPut: "strip-exif", Timeout: -1}, fail: true},
+		{conf: cmn.ETLConf{OnPut: "strip-exif", Timeout: cos.Duration(2 * time.Hour)}, fail: true},
+	}
+	for i, test := range tests {
+		err := test.conf.Validate()
+		if test.fail {
+			tassert.Errorf(t, err != nil, "%d: expected error (%+v)", i, test.conf)
+		} else {
+			tassert.Errorf(t, err == nil, "%d: unexpected error %v", i, err)
+		}
+	}
+
+	conf := &cmn.ETLConf{OnPut: "strip-exif,resize"}
+	tassert.Errorf(t, conf.IsActive() && len(conf.Names()) == 2, "expected 2-stage pipeline, got %v", conf.Names())
+	tassert.Errorf(t, !(&cmn.ETLConf{}).IsActive() && !(*cmn.ETLConf)(nil).IsActive(), "expected inactive")
+}
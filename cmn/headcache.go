@@ -0,0 +1,61 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Remote HEAD cache: each target remembers recent results of HEAD(remote object) - including
+// "not found" - and reuses them for the configured time instead of calling the backend again.
+// Cached results are (locally) invalidated upon PUT and DELETE of the object via this target,
+// and upon change notifications (see EventsConf); otherwise, the cache is a trade-off
+// between the number (and cost) of backend requests and staleness bounded by the TTLs.
+//
+// See also: core/hcache.go
+
+const (
+	HeadCacheMaxTTL = 24 * time.Hour
+)
+
+type HeadCacheConf struct {
+	TTL    cos.Duration `json:"ttl"`               // how long to reuse (positive) HEAD results
+	NegTTL cos.Duration `json:"neg_ttl,omitempty"` // ditto, for "not found" (zero: same as TTL)
+}
+
+func (c *HeadCacheConf) IsActive() bool { return c != nil && c.TTL > 0 }
+
+func (c *HeadCacheConf) NegativeTTL() time.Duration {
+	if c.NegTTL > 0 {
+		return c.NegTTL.D()
+	}
+	return c.TTL.D()
+}
+
+func (c *HeadCacheConf) Validate(bp *Bprops) error {
+	if c == nil {
+		return nil
+	}
+	provider := bp.Provider
+	if !bp.BackendBck.IsEmpty() {
+		provider = bp.BackendBck.Provider
+	}
+	if !apc.IsCloudProvider(provider) {
+		return errors.New("head_cache: supported only for cloud buckets (and buckets with cloud backend)")
+	}
+	if c.TTL < 0 || c.TTL.D() > HeadCacheMaxTTL {
+		return fmt.Errorf("head_cache: invalid ttl %v (expecting positive duration <= %v)", c.TTL, HeadCacheMaxTTL)
+	}
+	if c.NegTTL < 0 || c.NegTTL.D() > HeadCacheMaxTTL {
+		return fmt.Errorf("head_cache: invalid neg_ttl %v (expecting non-negative duration <= %v)", c.NegTTL, HeadCacheMaxTTL)
+	}
+	return nil
+}
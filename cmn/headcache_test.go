@@ -0,0 +1,47 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package cmn_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestHeadCacheConfValidate(t *testing.T) {
+	tests := []struct {
+		bp   cmn.Bprops
+		conf cmn.HeadCacheConf
+		fail bool
+	}{
+		{bp: cmn.Bprops{Provider: apc.AWS}, conf: cmn.HeadCacheConf{TTL: cos.Duration(time.Minute)}},
+		{bp: cmn.Bprops{Provider: apc.GCP}, conf: cmn.HeadCacheConf{TTL: cos.Duration(time.Minute), NegTTL: cos.Duration(time.Hour)}},
+		{bp: cmn.Bprops{Provider: apc.AIS}, conf: cmn.HeadCacheConf{TTL: cos.Duration(time.Minute)}, fail: true},
+		{
+			bp:   cmn.Bprops{Provider: apc.AIS, BackendBck: cmn.Bck{Name: "b", Provider: apc.Azure}},
+			conf: cmn.HeadCacheConf{TTL: cos.Duration(time.Minute)},
+		},
+		{bp: cmn.Bprops{Provider: apc.AWS}, conf: cmn.HeadCacheConf{TTL: cos.Duration(-time.Minute)}, fail: true},
+		{bp: cmn.Bprops{Provider: apc.AWS}, conf: cmn.HeadCacheConf{TTL: cos.Duration(48 * time.Hour)}, fail: true},
+		{bp: cmn.Bprops{Provider: apc.AWS}, conf: cmn.HeadCacheConf{TTL: cos.Duration(time.Minute), NegTTL: -1}, fail: true},
+	}
+	for i, test := range tests {
+		err := test.conf.Validate(&test.bp)
+		if test.fail {
+			tassert.Errorf(t, err != nil, "%d: expected error (%+v)", i, test.conf)
+		} else {
+			tassert.Errorf(t, err == nil, "%d: unexpected error %v", i, err)
+		}
+	}
+
+	conf := &cmn.HeadCacheConf{TTL: cos.Duration(time.Minute)}
+	tassert.Errorf(t, conf.NegativeTTL() == time.Minute, "expected neg ttl to default to ttl, got %v", conf.NegativeTTL())
+}
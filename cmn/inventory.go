@@ -0,0 +1,154 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Bucket inventory: a (periodic) target-side xaction that walks ais:// bucket and
+// produces sharded CSV or Parquet listings of its objects - inventory "manifests" -
+// in the destination bucket. Compare with S3 inventory:
+// https://docs.aws.amazon.com/AmazonS3/latest/userguide/storage-inventory.html
+//
+// Each target lists its own (HRW-local) objects; the resulting objects are named as follows:
+//   <Prefix><source bucket>/<ID>/<run>/<target ID>-<NNNNN>.<csv|parquet>
+//   <Prefix><source bucket>/<ID>/<run>/<target ID>-manifest.json
+// where <run> is either the scheduled time slot (e.g., "2025-03-01T00-00Z") or, for
+// inventories generated on demand, the xaction ID.
+
+const (
+	InvFormatCSV     = "csv"
+	InvFormatParquet = "parquet"
+
+	InvFieldName     = "name" // (always included)
+	InvFieldSize     = "size"
+	InvFieldChecksum = "checksum"
+	InvFieldVersion  = "version"
+	InvFieldAtime    = "atime"
+	InvFieldCustom   = "custom" // custom metadata (JSON)
+
+	InvDefaultPrefix    = ".inventory/"
+	InvDefaultShardSize = 1024 * 1024 // number of records
+
+	InvManifest   = "manifest.json"
+	InvRunTimeFmt = "2006-01-02T15-04Z"
+
+	MinInvInterval = time.Hour
+
+	maxInvID = 64
+)
+
+var InvAllFields = []string{InvFieldName, InvFieldSize, InvFieldChecksum, InvFieldVersion, InvFieldAtime, InvFieldCustom}
+
+type (
+	InventoryConf struct {
+		ID        string       `json:"id"`
+		Dest      Bck          `json:"dest"`                 // destination bucket (ais://)
+		Prefix    string       `json:"prefix,omitempty"`     // destination prefix (default: InvDefaultPrefix)
+		ObjPrefix string       `json:"obj_prefix,omitempty"` // list only source objects with this prefix
+		Format    string       `json:"format"`               // InvFormatCSV | InvFormatParquet
+		Fields    []string     `json:"fields,omitempty"`     // in addition to name; empty means all
+		Interval  cos.Duration `json:"interval"`             // schedule (e.g., 24h for daily)
+		ShardSize int64        `json:"shard_size,omitempty"` // max records per output object
+		Disabled  bool         `json:"disabled,omitempty"`
+	}
+
+	// written by each target upon completion
+	InvManifestMD struct {
+		Bucket  string   `json:"bucket"`
+		ID      string   `json:"id"`
+		Run     string   `json:"run"`
+		Format  string   `json:"format"`
+		Fields  []string `json:"fields"`
+		Files   []string `json:"files"`
+		Records int64    `json:"records"`
+		Time    string   `json:"time"`
+	}
+)
+
+func (c *InventoryConf) IsActive() bool { return c != nil && !c.Disabled }
+
+func (c *InventoryConf) Validate(provider string) error {
+	if c == nil {
+		return nil
+	}
+	if provider != apc.AIS {
+		return fmt.Errorf("inventory: supported only for ais:// buckets (have %q)", provider)
+	}
+	if c.ID == "" {
+		return errors.New("inventory: ID is empty")
+	}
+	if len(c.ID) > maxInvID {
+		return fmt.Errorf("inventory: ID %q is too long (max %d)", c.ID, maxInvID)
+	}
+	if err := cos.CheckAlphaPlus(c.ID, "inventory ID"); err != nil {
+		return err
+	}
+	if c.Dest.Provider == "" {
+		c.Dest.Provider = apc.AIS
+	}
+	if c.Dest.Provider != apc.AIS {
+		return fmt.Errorf("inventory %q: destination must be ais:// bucket (have %q)", c.ID, c.Dest.String())
+	}
+	if err := c.Dest.ValidateName(); err != nil {
+		return fmt.Errorf("inventory %q: invalid destination: %v", c.ID, err)
+	}
+	switch c.Format {
+	case "":
+		c.Format = InvFormatCSV
+	case InvFormatCSV, InvFormatParquet:
+	default:
+		return fmt.Errorf("inventory %q: invalid format %q (expecting %q or %q)", c.ID, c.Format, InvFormatCSV, InvFormatParquet)
+	}
+	for _, f := range c.Fields {
+		if !cos.StringInSlice(f, InvAllFields) {
+			return fmt.Errorf("inventory %q: invalid field %q (expecting one of: %v)", c.ID, f, InvAllFields)
+		}
+	}
+	if c.Interval.D() < MinInvInterval {
+		return fmt.Errorf("inventory %q: interval %v is too short (min %v)", c.ID, c.Interval, MinInvInterval)
+	}
+	if c.ShardSize < 0 {
+		return fmt.Errorf("inventory %q: invalid shard size %d", c.ID, c.ShardSize)
+	}
+	if c.Prefix == "" {
+		c.Prefix = InvDefaultPrefix
+	}
+	return nil
+}
+
+// output fields in order; name first
+func (c *InventoryConf) AllFields() []string {
+	if len(c.Fields) == 0 {
+		return InvAllFields
+	}
+	out := make([]string, 0, len(c.Fields)+1)
+	out = append(out, InvFieldName)
+	for _, f := range InvAllFields[1:] {
+		if cos.StringInSlice(f, c.Fields) {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+func (c *InventoryConf) NumShardRecs() int64 {
+	if c.ShardSize > 0 {
+		return c.ShardSize
+	}
+	return InvDefaultShardSize
+}
+
+// destination "directory" of a given run
+func (c *InventoryConf) RunPrefix(src *Bck, run string) string {
+	return c.Prefix + src.Name + "/" + c.ID + "/" + run + "/"
+}
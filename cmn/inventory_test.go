@@ -0,0 +1,71 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package cmn_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestInventoryConfValidate(t *testing.T) {
+	day := cos.Duration(24 * time.Hour)
+	tests := []struct {
+		conf  cmn.InventoryConf
+		valid bool
+	}{
+		{cmn.InventoryConf{ID: "inv", Dest: cmn.Bck{Name: "dst"}, Interval: day}, true},
+		{cmn.InventoryConf{ID: "inv", Dest: cmn.Bck{Name: "dst"}, Interval: day, Format: cmn.InvFormatParquet, Fields: []string{"size"}}, true},
+		{cmn.InventoryConf{Dest: cmn.Bck{Name: "dst"}, Interval: day}, false},
+		{cmn.InventoryConf{ID: "a/b", Dest: cmn.Bck{Name: "dst"}, Interval: day}, false},
+		{cmn.InventoryConf{ID: "inv", Interval: day}, false},
+		{cmn.InventoryConf{ID: "inv", Dest: cmn.Bck{Name: "dst", Provider: apc.AWS}, Interval: day}, false},
+		{cmn.InventoryConf{ID: "inv", Dest: cmn.Bck{Name: "dst"}, Interval: day, Format: "orc"}, false},
+		{cmn.InventoryConf{ID: "inv", Dest: cmn.Bck{Name: "dst"}, Interval: day, Fields: []string{"mtime"}}, false},
+		{cmn.InventoryConf{ID: "inv", Dest: cmn.Bck{Name: "dst"}, Interval: cos.Duration(time.Minute)}, false},
+		{cmn.InventoryConf{ID: "inv", Dest: cmn.Bck{Name: "dst"}, Interval: day, ShardSize: -1}, false},
+	}
+	for i, test := range tests {
+		conf := test.conf
+		err := conf.Validate(apc.AIS)
+		tassert.Errorf(t, (err == nil) == test.valid, "%d: %+v: expected valid=%t, got %v", i, test.conf, test.valid, err)
+		if err == nil {
+			tassert.Errorf(t, conf.Dest.Provider == apc.AIS && conf.Prefix == cmn.InvDefaultPrefix && conf.Format != "",
+				"%d: expecting defaults, got %+v", i, conf)
+		}
+	}
+
+	// ais:// buckets only
+	conf := cmn.InventoryConf{ID: "inv", Dest: cmn.Bck{Name: "dst"}, Interval: day}
+	tassert.Errorf(t, conf.Validate(apc.AWS) != nil, "expecting error for s3:// source")
+
+	// fields and naming
+	tassert.CheckFatal(t, conf.Validate(apc.AIS))
+	conf.Fields = []string{cmn.InvFieldAtime, cmn.InvFieldSize}
+	tassert.Errorf(t, len(conf.AllFields()) == 3 && conf.AllFields()[1] == cmn.InvFieldSize, "got %v", conf.AllFields())
+	tassert.Errorf(t, conf.NumShardRecs() == cmn.InvDefaultShardSize, "got %d", conf.NumShardRecs())
+	src := cmn.Bck{Name: "src", Provider: apc.AIS}
+	tassert.Errorf(t, conf.RunPrefix(&src, "r1") == ".inventory/src/inv/r1/", "got %q", conf.RunPrefix(&src, "r1"))
+}
+
+func TestInventoryCustomMD(t *testing.T) {
+	internal := []string{
+		cmn.TagsObjMD, cmn.PriorVersionsObjMD, cmn.RetainModeObjMD, cmn.RetainUntilObjMD, cmn.LegalHoldObjMD,
+		cmn.SSEAlgObjMD, cmn.SSEKeyIDObjMD, cmn.SSEKeyObjMD, cmn.SSECustMD5ObjMD, cmn.WriteBackObjMD,
+		cmn.TierObjMD, cmn.ReplObjMD, cmn.ETLObjMD, cmn.OrigCksumTypeObjMD, cmn.OrigCksumObjMD, cmn.OrigFntl,
+	}
+	for _, k := range internal {
+		tassert.Errorf(t, cmn.IsInternalObjMD(k), "expected %q to be internal", k)
+	}
+	for _, k := range []string{"color", cmn.SourceObjMD, cmn.VersionObjMD, cmn.ETag, cmn.OrigURLObjMD} {
+		tassert.Errorf(t, !cmn.IsInternalObjMD(k), "expected %q to be user-visible", k)
+	}
+}
@@ -0,0 +1,159 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Bucket lifecycle: an ordered list of rules that a (periodic) target-side xaction
+// enforces against the objects stored in the bucket. Rules are part of the bucket
+// properties (`Bprops.Lifecycle`) and are, therefore, versioned and metasync-ed
+// along with the rest of the BMD.
+//
+// Currently supported actions (compare with S3 lifecycle configuration):
+//   - expiration:  delete objects older than `ExpirationDays` (mtime-wise)
+//   - transition:  evict in-cluster copies of the objects older than `Transition.Days`
+//     (remote buckets only, including ais:// buckets with remote backend)
+//   - noncurrent:  delete non-current (previous) object versions older than `NoncurrentDays`
+//     (ais:// buckets that retain prior versions only - see VersionConf.Retain)
+//   - abort-mpt:   abort incomplete multipart uploads initiated more than `AbortMptDays` ago
+
+const (
+	MaxLifecycleRules  = 1000 // (as per S3)
+	maxLifecycleRuleID = 255
+)
+
+type (
+	LifecycleConf struct {
+		Rules []LifecycleRule `json:"rules"`
+	}
+	LifecycleRule struct {
+		Transition     *LifecycleTransition `json:"transition,omitempty"`
+		ID             string               `json:"id"`
+		Prefix         string               `json:"prefix,omitempty"`
+		ExpirationDays int                  `json:"expiration_days,omitempty"`
+		NoncurrentDays int                  `json:"noncurrent_days,omitempty"`
+		AbortMptDays   int                  `json:"abort_mpt_days,omitempty"`
+		Disabled       bool                 `json:"disabled,omitempty"`
+	}
+	LifecycleTransition struct {
+		StorageClass string `json:"storage_class,omitempty"`
+		Days         int    `json:"days"`
+	}
+)
+
+///////////////////
+// LifecycleConf //
+///////////////////
+
+func (c *LifecycleConf) IsEmpty() bool { return c == nil || len(c.Rules) == 0 }
+
+// returns true if there's at least one enabled rule
+func (c *LifecycleConf) IsActive() bool {
+	if c == nil {
+		return false
+	}
+	for i := range c.Rules {
+		if !c.Rules[i].Disabled {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *LifecycleConf) Validate() error {
+	if c == nil {
+		return nil
+	}
+	if len(c.Rules) > MaxLifecycleRules {
+		return fmt.Errorf("lifecycle: too many rules (%d), max %d", len(c.Rules), MaxLifecycleRules)
+	}
+	ids := make(map[string]struct{}, len(c.Rules))
+	for i := range c.Rules {
+		rule := &c.Rules[i]
+		if err := rule.validate(); err != nil {
+			return err
+		}
+		if _, ok := ids[rule.ID]; ok {
+			return fmt.Errorf("lifecycle: duplicate rule ID %q", rule.ID)
+		}
+		ids[rule.ID] = struct{}{}
+	}
+	return nil
+}
+
+// non-current versions exist only when retained (see VersionConf.Retain)
+func (c *LifecycleConf) validateNoncurrent(bp *Bprops) error {
+	if c == nil || bp.Versioning.Retain > 0 {
+		return nil
+	}
+	for i := range c.Rules {
+		if rule := &c.Rules[i]; rule.NoncurrentDays > 0 {
+			return fmt.Errorf("lifecycle: rule %q: noncurrent version expiration requires retaining prior versions (versioning.retain)",
+				rule.ID)
+		}
+	}
+	return nil
+}
+
+///////////////////
+// LifecycleRule //
+///////////////////
+
+func (rule *LifecycleRule) validate() error {
+	if rule.ID == "" {
+		return errors.New("lifecycle: rule ID is empty")
+	}
+	if len(rule.ID) > maxLifecycleRuleID {
+		return fmt.Errorf("lifecycle: rule ID %q is too long (max %d)", rule.ID, maxLifecycleRuleID)
+	}
+	if rule.ExpirationDays < 0 || rule.NoncurrentDays < 0 || rule.AbortMptDays < 0 {
+		return fmt.Errorf("lifecycle: rule %q: number of days cannot be negative", rule.ID)
+	}
+	if rule.Transition != nil {
+		if rule.Transition.Days <= 0 {
+			return fmt.Errorf("lifecycle: rule %q: transition days must be positive", rule.ID)
+		}
+		if rule.ExpirationDays > 0 && rule.ExpirationDays <= rule.Transition.Days {
+			return fmt.Errorf("lifecycle: rule %q: expiration (%d days) must come after transition (%d days)",
+				rule.ID, rule.ExpirationDays, rule.Transition.Days)
+		}
+	}
+	if rule.ExpirationDays == 0 && rule.NoncurrentDays == 0 && rule.AbortMptDays == 0 && rule.Transition == nil {
+		return fmt.Errorf("lifecycle: rule %q does not specify any action", rule.ID)
+	}
+	return nil
+}
+
+func (rule *LifecycleRule) Match(objName string) bool {
+	return !rule.Disabled && strings.HasPrefix(objName, rule.Prefix)
+}
+
+// given object's mtime, returns true if the object has expired
+func (rule *LifecycleRule) Expired(mtime, now time.Time) bool {
+	return rule.ExpirationDays > 0 && now.Sub(mtime) >= days(rule.ExpirationDays)
+}
+
+// ditto, for transition (eviction)
+func (rule *LifecycleRule) Transitioned(mtime, now time.Time) bool {
+	return rule.Transition != nil && now.Sub(mtime) >= days(rule.Transition.Days)
+}
+
+// given the time a version became non-current
+func (rule *LifecycleRule) NoncurrentExpired(since, now time.Time) bool {
+	return rule.NoncurrentDays > 0 && now.Sub(since) >= days(rule.NoncurrentDays)
+}
+
+// given multipart upload initiation time
+func (rule *LifecycleRule) MptStale(initiated, now time.Time) bool {
+	return rule.AbortMptDays > 0 && now.Sub(initiated) >= days(rule.AbortMptDays)
+}
+
+func days(n int) time.Duration { return time.Duration(n) * 24 * time.Hour }
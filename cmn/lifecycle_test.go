@@ -0,0 +1,43 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package cmn_test
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestLifecycleNoncurrentRequiresRetain(t *testing.T) {
+	var (
+		expire     = cmn.LifecycleRule{ID: "expire", ExpirationDays: 30}
+		noncurrent = cmn.LifecycleRule{ID: "noncurrent", NoncurrentDays: 7}
+	)
+	tests := []struct {
+		rules  []cmn.LifecycleRule
+		retain int
+		valid  bool
+	}{
+		{[]cmn.LifecycleRule{expire}, 0, true},
+		{[]cmn.LifecycleRule{expire, noncurrent}, 0, false},
+		{[]cmn.LifecycleRule{expire, noncurrent}, 3, true},
+		{[]cmn.LifecycleRule{noncurrent}, 1, true},
+	}
+	for i, test := range tests {
+		bp := cmn.Bprops{
+			Provider:   apc.AIS,
+			Cksum:      cmn.CksumConf{Type: cos.ChecksumXXHash},
+			Versioning: cmn.VersionConf{Enabled: true, Retain: test.retain},
+			Lifecycle:  &cmn.LifecycleConf{Rules: test.rules},
+		}
+		err := bp.Validate(1 /*target count*/)
+		tassert.Errorf(t, (err == nil) == test.valid, "test %d: expected valid=%t, got err: %v", i, test.valid, err)
+	}
+}
@@ -0,0 +1,98 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"fmt"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Chunked (multipart) uploads of large objects to Azure (block blobs: stage and commit)
+// and GCS (resumable uploads):
+// - configured per provider (`backend.<provider>.multipart` in the cluster config)
+// - objects of size `threshold` and larger are uploaded in chunks of `chunk_size`;
+//   a failed chunk gets retried (at most `max_retries` times) without restarting the upload
+// - Azure stages up to `parallelism` blocks concurrently; GCS resumable upload is sequential
+//   by design (one chunk at a time)
+// - see ais/backend/azuremp.go and ais/backend/gcp.go
+
+const (
+	DfltMptThreshold   = 256 * cos.MiB
+	DfltMptChunkSize   = 64 * cos.MiB
+	DfltMptParallelism = 4
+	DfltMptRetries     = 5
+
+	MinMptChunkSize   = cos.MiB
+	MaxMptChunkSize   = 4000 * cos.MiB // (Azure max block size)
+	MptChunkAlign     = 256 * cos.KiB  // (GCS resumable upload granularity)
+	MaxMptParallelism = 64
+	MaxMptRetries     = 32
+)
+
+type MultipartConf struct {
+	Threshold   cos.SizeIEC `json:"threshold"`   // upload in chunks objects of this size and larger; 0: default
+	ChunkSize   cos.SizeIEC `json:"chunk_size"`  // multiple of 256KiB; 0: default
+	Parallelism int         `json:"parallelism"` // max chunks in flight (Azure); 0: default
+	MaxRetries  int         `json:"max_retries"` // max retries of a failed chunk; 0: default
+}
+
+func (c *MultipartConf) Validate() error {
+	if c == nil {
+		return nil
+	}
+	if c.Threshold < 0 {
+		return fmt.Errorf("invalid multipart.threshold %d (expecting non-negative)", c.Threshold)
+	}
+	if c.ChunkSize != 0 {
+		if c.ChunkSize < MinMptChunkSize || c.ChunkSize > MaxMptChunkSize {
+			return fmt.Errorf("invalid multipart.chunk_size %s (expecting [%s, %s] range)",
+				c.ChunkSize, cos.SizeIEC(MinMptChunkSize), cos.SizeIEC(MaxMptChunkSize))
+		}
+		if c.ChunkSize%MptChunkAlign != 0 {
+			return fmt.Errorf("invalid multipart.chunk_size %s (expecting a multiple of %s)",
+				c.ChunkSize, cos.SizeIEC(MptChunkAlign))
+		}
+	}
+	if c.Parallelism < 0 || c.Parallelism > MaxMptParallelism {
+		return fmt.Errorf("invalid multipart.parallelism %d (expecting [0, %d] range)", c.Parallelism, MaxMptParallelism)
+	}
+	if c.MaxRetries < 0 || c.MaxRetries > MaxMptRetries {
+		return fmt.Errorf("invalid multipart.max_retries %d (expecting [0, %d] range)", c.MaxRetries, MaxMptRetries)
+	}
+	return nil
+}
+
+// effective multipart config (defaults applied)
+func (c *BackendConf) Multipart(provider string) (mp MultipartConf) {
+	if v, ok := c.Conf[provider].(BackendConfCloud); ok && v.Multipart != nil {
+		mp = *v.Multipart
+	}
+	if mp.Threshold == 0 {
+		mp.Threshold = DfltMptThreshold
+	}
+	if mp.ChunkSize == 0 {
+		mp.ChunkSize = DfltMptChunkSize
+	}
+	if mp.Parallelism == 0 {
+		mp.Parallelism = DfltMptParallelism
+	}
+	if mp.MaxRetries == 0 {
+		mp.MaxRetries = DfltMptRetries
+	}
+	return mp
+}
+
+// chunk size for a given object size: at least the configured one,
+// large enough to fit into `maxChunks`, and aligned
+func (c *MultipartConf) ChunkSizeFor(size int64, maxChunks int) int64 {
+	chunk := int64(c.ChunkSize)
+	if n := (size + chunk - 1) / chunk; maxChunks > 0 && n > int64(maxChunks) {
+		chunk = (size + int64(maxChunks) - 1) / int64(maxChunks)
+		chunk = (chunk + MptChunkAlign - 1) / MptChunkAlign * MptChunkAlign
+	}
+	return chunk
+}
@@ -0,0 +1,57 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package cmn_test
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	jsoniter "github.com/json-iterator/go"
+)
+
+func TestMultipartBackendConf(t *testing.T) {
+	var c cmn.BackendConf
+	err := jsoniter.Unmarshal([]byte(`{"azure": {"multipart": {"chunk_size": "32MiB", "parallelism": 16}}, "gcp": {}}`), &c)
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, c.Validate())
+
+	mp := c.Multipart(apc.Azure)
+	tassert.Errorf(t, mp.ChunkSize == 32*cos.MiB, "expected 32MiB, got %s", mp.ChunkSize)
+	tassert.Errorf(t, mp.Parallelism == 16, "expected 16, got %d", mp.Parallelism)
+	tassert.Errorf(t, mp.Threshold == cmn.DfltMptThreshold && mp.MaxRetries == cmn.DfltMptRetries, "expected defaults, got %+v", mp)
+
+	mp = c.Multipart(apc.GCP)
+	tassert.Errorf(t, mp.ChunkSize == cmn.DfltMptChunkSize && mp.Parallelism == cmn.DfltMptParallelism, "expected defaults, got %+v", mp)
+
+	// invalid
+	for _, s := range []string{
+		`{"azure": {"multipart": {"chunk_size": "100KiB"}}}`,
+		`{"azure": {"multipart": {"chunk_size": "5000MiB"}}}`,
+		`{"gcp": {"multipart": {"chunk_size": "1000KiB"}}}`,
+		`{"gcp": {"multipart": {"parallelism": -1}}}`,
+		`{"aws": {"multipart": {"max_retries": 100}}}`,
+		`{"azure": {"multipart": {"threshold": -1}}}`,
+	} {
+		var c cmn.BackendConf
+		tassert.CheckFatal(t, jsoniter.Unmarshal([]byte(s), &c))
+		tassert.Errorf(t, c.Validate() != nil, "expected error: %s", s)
+	}
+}
+
+func TestMultipartChunkSize(t *testing.T) {
+	mp := cmn.MultipartConf{ChunkSize: 64 * cos.MiB}
+	tassert.Errorf(t, mp.ChunkSizeFor(cos.GiB, 50000) == 64*cos.MiB, "expected configured chunk size")
+
+	// too many chunks: grow (and align)
+	size := int64(10 * cos.TiB)
+	chunk := mp.ChunkSizeFor(size, 50000)
+	tassert.Errorf(t, chunk%cmn.MptChunkAlign == 0, "expected aligned, got %d", chunk)
+	tassert.Errorf(t, (size+chunk-1)/chunk <= 50000, "expected at most 50000 chunks, got %d", (size+chunk-1)/chunk)
+}
@@ -41,6 +41,47 @@ const (
 
 	// as the name implies
 	OrigFntl = "orig_fntl"
+
+	// reserved for system use: custom keys with this prefix cannot be set by users
+	// (see IsReservedObjMD)
+	ReservedObjMDPrefix = "__ais."
+
+	// object tags (url-encoded); see cmn/objtags.go
+	TagsObjMD = ReservedObjMDPrefix + "tags"
+
+	// retained prior versions of ais:// object (comma-separated, most recent first);
+	// see VersionConf.Retain
+	PriorVersionsObjMD = "prior_versions"
+
+	// object lock (WORM); see cmn/objlock.go
+	RetainModeObjMD  = "retain_mode"  // governance | compliance
+	RetainUntilObjMD = "retain_until" // RFC3339
+	LegalHoldObjMD   = "legal_hold"   // LegalHoldOn
+
+	// server-side encryption at rest; see cmn/encryption.go
+	SSEAlgObjMD     = "sse_alg"    // cmn/sse.Algorithm
+	SSEKeyIDObjMD   = "sse_key_id" // keyring key that wraps the object's data key (cluster-managed)
+	SSEKeyObjMD     = "sse_key"    // wrapped data key (base64)
+	SSECustMD5ObjMD = "sse_c_md5"  // customer-provided key's MD5 (base64)
+	SSEPrefixObjMD  = "sse_"       // (all of the above)
+
+	// delayed write-back: the object is yet to be written to remote backend (see ais/tgtwb.go);
+	// the value is the (unix nano) time of the in-cluster write
+	WriteBackObjMD = "wb_pending"
+
+	// multi-backend tiering: the (non-first) tier that holds the object, e.g. "gs://archive";
+	// see cmn/tiering.go
+	TierObjMD = "tier"
+
+	// cross-cluster replication: the object's replication stamp ("<unix nano>@<origin cluster UUID>");
+	// see cmn/replication.go
+	ReplObjMD = "repl"
+
+	// write-path (on-PUT) ETL: the ETL (pipeline) that transformed the object, and the checksum
+	// of the original (untransformed) content; see cmn/etlput.go
+	ETLObjMD           = "etl"
+	OrigCksumTypeObjMD = "orig_cksum_type"
+	OrigCksumObjMD     = "orig_cksum"
 )
 
 // object properties
@@ -137,6 +178,21 @@ func (oa *ObjAttrs) SetSize(size int64) {
 	oa.Size = size
 }
 
+func IsReservedObjMD(key string) bool { return strings.HasPrefix(key, ReservedObjMDPrefix) }
+
+// system-maintained custom metadata that is not to be exported (e.g., inventory)
+func IsInternalObjMD(key string) bool {
+	switch {
+	case IsReservedObjMD(key), IsObjLockMD(key), strings.HasPrefix(key, SSEPrefixObjMD):
+		return true
+	case key == PriorVersionsObjMD, key == OrigFntl, key == WriteBackObjMD, key == ReplObjMD, key == TierObjMD:
+		return true
+	case key == ETLObjMD, key == OrigCksumTypeObjMD, key == OrigCksumObjMD:
+		return true
+	}
+	return false
+}
+
 func (oa *ObjAttrs) GetCustomMD() cos.StrKVs   { return oa.CustomMD }
 func (oa *ObjAttrs) SetCustomMD(md cos.StrKVs) { oa.CustomMD = md }
 
@@ -157,6 +213,7 @@ func (oa *ObjAttrs) DelStdCustom() {
 	for _, key := range stdCustomProps {
 		delete(oa.CustomMD, key)
 	}
+	delete(oa.CustomMD, TierObjMD)
 }
 
 // clone OAH => ObjAttrs (see also lom.CopyAttrs)
@@ -13,7 +13,7 @@ const MsgpLsoBufSize = 32 * cos.KiB
 
 // [NOTE]
 // - changes in this source MAY require re-running `msgp` code generation - see docs/msgp.md for details.
-// - all json tags except `Flags` must belong to the (apc.GetPropsName, apc.GetPropsSize, etc.) enumeration
+// - all json tags except `Flags` and `Versions` must belong to the (apc.GetPropsName, apc.GetPropsSize, etc.) enumeration
 // [TODO]
 // - revisit (make) allocation of LsoEntries (optimize)
 
@@ -23,15 +23,25 @@ type (
 	// `Flags` is a bit field where `EntryStatusBits` bits [0-4] are reserved for object status
 	// (all statuses are mutually exclusive)
 	LsoEnt struct {
-		Name     string `json:"name" msg:"n"`                            // object name
-		Checksum string `json:"checksum,omitempty" msg:"cs,omitempty"`   // checksum
-		Atime    string `json:"atime,omitempty" msg:"a,omitempty"`       // last access time; formatted as ListObjsMsg.TimeFormat
-		Version  string `json:"version,omitempty" msg:"v,omitempty"`     // e.g., GCP int64 generation, AWS version (string), etc.
-		Location string `json:"location,omitempty" msg:"t,omitempty"`    // [tnode:mountpath]
-		Custom   string `json:"custom-md,omitempty" msg:"m,omitempty"`   // custom metadata: ETag, MD5, CRC, user-defined ...
-		Size     int64  `json:"size,string,omitempty" msg:"s,omitempty"` // size in bytes
-		Copies   int16  `json:"copies,omitempty" msg:"c,omitempty"`      // ## copies (NOTE: for non-replicated object copies == 1)
-		Flags    uint16 `json:"flags,omitempty" msg:"f,omitempty"`       // enum { EntryIsCached, EntryIsDir, EntryInArch, ...}
+		Name     string   `json:"name" msg:"n"`                            // object name
+		Checksum string   `json:"checksum,omitempty" msg:"cs,omitempty"`   // checksum
+		Atime    string   `json:"atime,omitempty" msg:"a,omitempty"`       // last access time; formatted as ListObjsMsg.TimeFormat
+		Version  string   `json:"version,omitempty" msg:"v,omitempty"`     // e.g., GCP int64 generation, AWS version (string), etc.
+		Location string   `json:"location,omitempty" msg:"t,omitempty"`    // [tnode:mountpath]
+		Custom   string   `json:"custom-md,omitempty" msg:"m,omitempty"`   // custom metadata: ETag, MD5, CRC, user-defined ...
+		Tags     string   `json:"tags,omitempty" msg:"g,omitempty"`        // object tags (url-encoded); see cmn.TagsObjMD
+		Size     int64    `json:"size,string,omitempty" msg:"s,omitempty"` // size in bytes
+		Copies   int16    `json:"copies,omitempty" msg:"c,omitempty"`      // ## copies (NOTE: for non-replicated object copies == 1)
+		Flags    uint16   `json:"flags,omitempty" msg:"f,omitempty"`       // enum { EntryIsCached, EntryIsDir, EntryInArch, ...}
+		Versions []LsoVer `json:"versions,omitempty" msg:"r,omitempty"`    // prior versions (only with apc.LsVersions)
+	}
+
+	// retained prior (noncurrent) version of an ais:// object, most recent first
+	// (see VersionConf.Retain)
+	LsoVer struct {
+		Version string `json:"version" msg:"v"`
+		Mtime   string `json:"mtime,omitempty" msg:"a,omitempty"` // formatted as ListObjsMsg.TimeFormat
+		Size    int64  `json:"size,string,omitempty" msg:"s,omitempty"`
 	}
 
 	LsoEntries []*LsoEnt
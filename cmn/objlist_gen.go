@@ -62,6 +62,12 @@ func (z *LsoEnt) DecodeMsg(dc *msgp.Reader) (err error) {
 				err = msgp.WrapError(err, "Custom")
 				return
 			}
+		case "g":
+			z.Tags, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Tags")
+				return
+			}
 		case "s":
 			z.Size, err = dc.ReadInt64()
 			if err != nil {
@@ -80,6 +86,25 @@ func (z *LsoEnt) DecodeMsg(dc *msgp.Reader) (err error) {
 				err = msgp.WrapError(err, "Flags")
 				return
 			}
+		case "r":
+			var zb0002 uint32
+			zb0002, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "Versions")
+				return
+			}
+			if cap(z.Versions) >= int(zb0002) {
+				z.Versions = (z.Versions)[:zb0002]
+			} else {
+				z.Versions = make([]LsoVer, zb0002)
+			}
+			for za0001 := range z.Versions {
+				err = z.Versions[za0001].DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "Versions", za0001)
+					return
+				}
+			}
 		default:
 			err = dc.Skip()
 			if err != nil {
@@ -94,8 +119,8 @@ func (z *LsoEnt) DecodeMsg(dc *msgp.Reader) (err error) {
 // EncodeMsg implements msgp.Encodable
 func (z *LsoEnt) EncodeMsg(en *msgp.Writer) (err error) {
 	// omitempty: check for empty values
-	zb0001Len := uint32(9)
-	var zb0001Mask uint16 /* 9 bits */
+	zb0001Len := uint32(11)
+	var zb0001Mask uint16 /* 11 bits */
 	if z.Checksum == "" {
 		zb0001Len--
 		zb0001Mask |= 0x2
@@ -116,18 +141,26 @@ func (z *LsoEnt) EncodeMsg(en *msgp.Writer) (err error) {
 		zb0001Len--
 		zb0001Mask |= 0x20
 	}
-	if z.Size == 0 {
+	if z.Tags == "" {
 		zb0001Len--
 		zb0001Mask |= 0x40
 	}
-	if z.Copies == 0 {
+	if z.Size == 0 {
 		zb0001Len--
 		zb0001Mask |= 0x80
 	}
-	if z.Flags == 0 {
+	if z.Copies == 0 {
 		zb0001Len--
 		zb0001Mask |= 0x100
 	}
+	if z.Flags == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x200
+	}
+	if z.Versions == nil {
+		zb0001Len--
+		zb0001Mask |= 0x400
+	}
 	// variable map header, size zb0001Len
 	err = en.Append(0x80 | uint8(zb0001Len))
 	if err != nil {
@@ -207,6 +240,18 @@ func (z *LsoEnt) EncodeMsg(en *msgp.Writer) (err error) {
 		}
 	}
 	if (zb0001Mask & 0x40) == 0 { // if not empty
+		// write "g"
+		err = en.Append(0xa1, 0x67)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(z.Tags)
+		if err != nil {
+			err = msgp.WrapError(err, "Tags")
+			return
+		}
+	}
+	if (zb0001Mask & 0x80) == 0 { // if not empty
 		// write "s"
 		err = en.Append(0xa1, 0x73)
 		if err != nil {
@@ -218,7 +263,7 @@ func (z *LsoEnt) EncodeMsg(en *msgp.Writer) (err error) {
 			return
 		}
 	}
-	if (zb0001Mask & 0x80) == 0 { // if not empty
+	if (zb0001Mask & 0x100) == 0 { // if not empty
 		// write "c"
 		err = en.Append(0xa1, 0x63)
 		if err != nil {
@@ -230,7 +275,7 @@ func (z *LsoEnt) EncodeMsg(en *msgp.Writer) (err error) {
 			return
 		}
 	}
-	if (zb0001Mask & 0x100) == 0 { // if not empty
+	if (zb0001Mask & 0x200) == 0 { // if not empty
 		// write "f"
 		err = en.Append(0xa1, 0x66)
 		if err != nil {
@@ -242,12 +287,34 @@ func (z *LsoEnt) EncodeMsg(en *msgp.Writer) (err error) {
 			return
 		}
 	}
+	if (zb0001Mask & 0x400) == 0 { // if not empty
+		// write "r"
+		err = en.Append(0xa1, 0x72)
+		if err != nil {
+			return
+		}
+		err = en.WriteArrayHeader(uint32(len(z.Versions)))
+		if err != nil {
+			err = msgp.WrapError(err, "Versions")
+			return
+		}
+		for za0001 := range z.Versions {
+			err = z.Versions[za0001].EncodeMsg(en)
+			if err != nil {
+				err = msgp.WrapError(err, "Versions", za0001)
+				return
+			}
+		}
+	}
 	return
 }
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *LsoEnt) Msgsize() (s int) {
-	s = 1 + 2 + msgp.StringPrefixSize + len(z.Name) + 3 + msgp.StringPrefixSize + len(z.Checksum) + 2 + msgp.StringPrefixSize + len(z.Atime) + 2 + msgp.StringPrefixSize + len(z.Version) + 2 + msgp.StringPrefixSize + len(z.Location) + 2 + msgp.StringPrefixSize + len(z.Custom) + 2 + msgp.Int64Size + 2 + msgp.Int16Size + 2 + msgp.Uint16Size
+	s = 1 + 2 + msgp.StringPrefixSize + len(z.Name) + 3 + msgp.StringPrefixSize + len(z.Checksum) + 2 + msgp.StringPrefixSize + len(z.Atime) + 2 + msgp.StringPrefixSize + len(z.Version) + 2 + msgp.StringPrefixSize + len(z.Location) + 2 + msgp.StringPrefixSize + len(z.Custom) + 2 + msgp.StringPrefixSize + len(z.Tags) + 2 + msgp.Int64Size + 2 + msgp.Int16Size + 2 + msgp.Uint16Size + 2 + msgp.ArrayHeaderSize
+	for za0001 := range z.Versions {
+		s += z.Versions[za0001].Msgsize()
+	}
 	return
 }
 
@@ -474,3 +541,114 @@ func (z *LsoRes) Msgsize() (s int) {
 	s += 6 + msgp.Uint32Size
 	return
 }
+
+// DecodeMsg implements msgp.Decodable
+func (z *LsoVer) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "v":
+			z.Version, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Version")
+				return
+			}
+		case "a":
+			z.Mtime, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Mtime")
+				return
+			}
+		case "s":
+			z.Size, err = dc.ReadInt64()
+			if err != nil {
+				err = msgp.WrapError(err, "Size")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *LsoVer) EncodeMsg(en *msgp.Writer) (err error) {
+	// omitempty: check for empty values
+	zb0001Len := uint32(3)
+	var zb0001Mask uint8 /* 3 bits */
+	if z.Mtime == "" {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	if z.Size == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x4
+	}
+	// variable map header, size zb0001Len
+	err = en.Append(0x80 | uint8(zb0001Len))
+	if err != nil {
+		return
+	}
+	if zb0001Len == 0 {
+		return
+	}
+	// write "v"
+	err = en.Append(0xa1, 0x76)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Version)
+	if err != nil {
+		err = msgp.WrapError(err, "Version")
+		return
+	}
+	if (zb0001Mask & 0x2) == 0 { // if not empty
+		// write "a"
+		err = en.Append(0xa1, 0x61)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(z.Mtime)
+		if err != nil {
+			err = msgp.WrapError(err, "Mtime")
+			return
+		}
+	}
+	if (zb0001Mask & 0x4) == 0 { // if not empty
+		// write "s"
+		err = en.Append(0xa1, 0x73)
+		if err != nil {
+			return
+		}
+		err = en.WriteInt64(z.Size)
+		if err != nil {
+			err = msgp.WrapError(err, "Size")
+			return
+		}
+	}
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *LsoVer) Msgsize() (s int) {
+	s = 1 + 2 + msgp.StringPrefixSize + len(z.Version) + 2 + msgp.StringPrefixSize + len(z.Mtime) + 2 + msgp.Int64Size
+	return
+}
@@ -0,0 +1,137 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Object Lock (WORM): write-once-read-many protection modeled after S3 Object Lock:
+// https://docs.aws.amazon.com/AmazonS3/latest/userguide/object-lock.html
+//
+// Bucket-level configuration (`Bprops.ObjLock`) enables object lock and, optionally,
+// specifies default retention for newly written objects. Per-object retention
+// (mode and retain-until date) and legal hold are stored in the object's custom
+// metadata (see RetainModeObjMD and friends).
+//
+// A locked object cannot be deleted, evicted, or overwritten - by any API or by any
+// of the (space cleanup, LRU, lifecycle) xactions:
+//   - compliance: until its retain-until date; retention cannot be shortened or removed
+//   - governance: same, unless the request explicitly bypasses governance retention
+//   - legal hold: regardless of retention, until the hold is removed
+//
+// Once enabled, bucket's object lock cannot be disabled.
+
+const (
+	ObjLockGovernance = "governance"
+	ObjLockCompliance = "compliance"
+
+	MaxObjLockDays = 100 * 365
+
+	LegalHoldOn = "on"
+)
+
+type (
+	ObjLockConf struct {
+		Mode string `json:"mode,omitempty"` // default retention mode (governance | compliance)
+		Days int    `json:"days,omitempty"` // default retention period; zero means no default retention
+	}
+
+	// per-object (ie., loaded from object's custom metadata)
+	ObjRetention struct {
+		Until     time.Time
+		Mode      string
+		LegalHold bool
+	}
+)
+
+/////////////////
+// ObjLockConf //
+/////////////////
+
+func (c *ObjLockConf) Validate() error {
+	if c == nil {
+		return nil
+	}
+	if c.Days < 0 || c.Days > MaxObjLockDays {
+		return fmt.Errorf("object lock: invalid default retention %d days (expecting [0, %d] range)", c.Days, MaxObjLockDays)
+	}
+	if c.Days == 0 {
+		if c.Mode != "" {
+			return fmt.Errorf("object lock: default retention mode %q requires retention period (days)", c.Mode)
+		}
+		return nil
+	}
+	return ValidateObjLockMode(c.Mode)
+}
+
+// default retention for a new object; zero `until` when there's none
+func (c *ObjLockConf) DefaultRetention(now time.Time) (mode string, until time.Time) {
+	if c == nil || c.Days == 0 {
+		return "", time.Time{}
+	}
+	return c.Mode, now.Add(days(c.Days)).UTC().Truncate(time.Second)
+}
+
+func ValidateObjLockMode(mode string) error {
+	if mode != ObjLockGovernance && mode != ObjLockCompliance {
+		return fmt.Errorf("object lock: invalid retention mode %q (expecting %q or %q)", mode, ObjLockGovernance, ObjLockCompliance)
+	}
+	return nil
+}
+
+// (custom metadata that only the object lock API can modify)
+func IsObjLockMD(key string) bool {
+	return key == RetainModeObjMD || key == RetainUntilObjMD || key == LegalHoldObjMD
+}
+
+//////////////////
+// ObjRetention //
+//////////////////
+
+func (r *ObjRetention) Retained(now time.Time) bool { return !r.Until.IsZero() && now.Before(r.Until) }
+
+// returns non-nil error if the object cannot be deleted (or overwritten)
+func (r *ObjRetention) Check(cname string, now time.Time, bypassGovernance bool) error {
+	switch {
+	case r.LegalHold:
+		return NewErrObjLocked(cname, "legal hold")
+	case !r.Retained(now):
+		return nil
+	case r.Mode == ObjLockGovernance && bypassGovernance:
+		return nil
+	default:
+		return NewErrObjLocked(cname, r.Mode+" retention until "+r.Until.Format(time.RFC3339))
+	}
+}
+
+// validate retention update: compliance retention can only be extended
+// (governance - ditto, unless bypassed)
+func (r *ObjRetention) CheckUpdate(cname string, mode string, until, now time.Time, bypassGovernance bool) error {
+	if mode != "" {
+		if err := ValidateObjLockMode(mode); err != nil {
+			return err
+		}
+		if until.IsZero() || !now.Before(until) {
+			return errors.New("object lock: retain-until date must be in the future")
+		}
+	}
+	if !r.Retained(now) {
+		return nil
+	}
+	if r.Mode == ObjLockGovernance && bypassGovernance {
+		return nil
+	}
+	if mode == r.Mode && !until.Before(r.Until) {
+		return nil // extending
+	}
+	if r.Mode == ObjLockGovernance && mode == ObjLockCompliance && !until.Before(r.Until) {
+		return nil // upgrading
+	}
+	return NewErrObjLocked(cname, "cannot shorten or remove "+r.Mode+" retention (until "+r.Until.Format(time.RFC3339)+")")
+}
@@ -0,0 +1,63 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Object tags: user-defined key/value pairs (e.g., S3 object tagging)
+// stored url-encoded in the object's custom metadata under `TagsObjMD`.
+// Limits are the same as in S3:
+// https://docs.aws.amazon.com/AmazonS3/latest/userguide/object-tagging.html
+
+const (
+	MaxObjTags      = 10
+	MaxObjTagKeyLen = 128
+	MaxObjTagValLen = 256
+)
+
+func ValidateObjTags(tags url.Values) error {
+	if len(tags) > MaxObjTags {
+		return fmt.Errorf("number of object tags (%d) exceeds %d", len(tags), MaxObjTags)
+	}
+	for k, vs := range tags {
+		if k == "" {
+			return errors.New("object tag key cannot be empty")
+		}
+		if len(k) > MaxObjTagKeyLen {
+			return fmt.Errorf("object tag key %q is too long (max %d)", k, MaxObjTagKeyLen)
+		}
+		if len(vs) != 1 {
+			return fmt.Errorf("duplicate object tag key %q", k)
+		}
+		if len(vs[0]) > MaxObjTagValLen {
+			return fmt.Errorf("object tag %q: value is too long (max %d)", k, MaxObjTagValLen)
+		}
+	}
+	return nil
+}
+
+// returns true if url-encoded object tags (custom metadata) satisfy the
+// filter that is either "key" (tag exists) or "key=value"
+func MatchObjTags(encoded, filter string) bool {
+	if encoded == "" {
+		return false
+	}
+	tags, err := url.ParseQuery(encoded)
+	if err != nil {
+		return false
+	}
+	key, val, hasVal := strings.Cut(filter, "=")
+	vs, ok := tags[key]
+	if !ok {
+		return false
+	}
+	return !hasVal || (len(vs) > 0 && vs[0] == val)
+}
@@ -0,0 +1,764 @@
+// Package parquet provides minimal (flat schema) Parquet file writer and reader.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package parquet
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v3"
+)
+
+// Reader reads flat-schema Parquet files produced by third-party tools (e.g., cloud
+// bucket inventories) one row at a time, with all values formatted as strings.
+//
+// Supported:
+// - flat schema of required and optional (nullable) columns of any physical type
+// - PLAIN and dictionary (PLAIN_DICTIONARY, RLE_DICTIONARY) encodings
+// - data pages v1 and v2
+// - codecs: uncompressed, snappy, gzip, zstd, and lz4 (raw)
+//
+// Formatting:
+// - nulls => empty strings
+// - timestamps (including legacy INT96) => RFC3339 (UTC); dates => YYYY-MM-DD
+// - fixed-length byte arrays => hex
+
+// parquet.thrift enums
+const (
+	typeBoolean  = 0
+	typeInt32    = 1
+	typeInt96    = 3
+	typeFloat    = 4
+	typeDouble   = 5
+	typeFixedLen = 7
+
+	convDate            = 6
+	convTimestampMillis = 9
+
+	repOptional = 1
+	repRepeated = 2
+
+	encPlainDict = 2
+	encRLEDict   = 8
+
+	codecSnappy = 1
+	codecGzip   = 2
+	codecZstd   = 6
+	codecLz4Raw = 7
+
+	pageDict   = 2
+	pageDataV2 = 3
+)
+
+// timestamp units
+const (
+	_ = iota // not a timestamp
+	tsMillis
+	tsMicros
+	tsNanos
+)
+
+const julianUnixEpoch = 2440588
+
+type (
+	Reader struct {
+		r      io.ReaderAt
+		zstd   *zstd.Decoder
+		cols   []rcol
+		names  []string
+		groups []rgroup
+		vals   [][]string // current row group, column-wise
+		row    []string
+		nrows  int64
+		gidx   int // next row group
+		ridx   int // next row in the current group
+		glen   int // rows in the current group
+	}
+	rcol struct {
+		name   string
+		typ    int32
+		tlen   int32 // fixed-length byte array
+		conv   int32
+		ts     int // timestamp unit
+		rep    int32
+		isDate bool
+	}
+	rgroup struct {
+		chunks []rchunk
+		nrows  int64
+	}
+	rchunk struct {
+		offset int64 // first page (dictionary, if present)
+		size   int64 // total compressed
+		codec  int32
+	}
+	pageHdr struct {
+		typ     int32
+		usize   int32 // uncompressed
+		csize   int32 // compressed
+		nvals   int32
+		enc     int32
+		deflen  int32 // v2 only
+		replen  int32 // v2 only
+		compr   bool  // v2 only
+		hdrSize int
+	}
+)
+
+func NewReader(r io.ReaderAt, size int64) (*Reader, error) {
+	const tail = 8
+	if size < int64(len(magic))+tail {
+		return nil, errors.New("parquet: file is too short")
+	}
+	var b [tail]byte
+	if _, err := r.ReadAt(b[:], size-tail); err != nil {
+		return nil, err
+	}
+	if string(b[4:]) != magic {
+		return nil, errors.New("parquet: invalid magic (not a parquet file?)")
+	}
+	flen := int64(binary.LittleEndian.Uint32(b[:4]))
+	if flen <= 0 || flen > size-tail-int64(len(magic)) {
+		return nil, fmt.Errorf("parquet: invalid footer length %d", flen)
+	}
+	footer := make([]byte, flen)
+	if _, err := r.ReadAt(footer, size-tail-flen); err != nil {
+		return nil, err
+	}
+	pr := &Reader{r: r}
+	if err := pr.meta(footer); err != nil {
+		return nil, err
+	}
+	pr.names = make([]string, len(pr.cols))
+	for i := range pr.cols {
+		pr.names[i] = pr.cols[i].name
+	}
+	pr.row = make([]string, len(pr.cols))
+	return pr, nil
+}
+
+func (pr *Reader) Columns() []string { return pr.names }
+func (pr *Reader) NumRows() int64    { return pr.nrows }
+
+// returns the next row or io.EOF; the returned slice is reused by subsequent calls
+func (pr *Reader) Read() ([]string, error) {
+	for pr.ridx >= pr.glen {
+		if pr.gidx >= len(pr.groups) {
+			return nil, io.EOF
+		}
+		if err := pr.load(pr.gidx); err != nil {
+			return nil, err
+		}
+		pr.gidx++
+	}
+	for i := range pr.vals {
+		pr.row[i] = pr.vals[i][pr.ridx]
+	}
+	pr.ridx++
+	return pr.row, nil
+}
+
+func (pr *Reader) Close() {
+	if pr.zstd != nil {
+		pr.zstd.Close()
+		pr.zstd = nil
+	}
+}
+
+//
+// metadata
+//
+
+// FileMetaData
+func (pr *Reader) meta(footer []byte) error {
+	d := &tdec{b: footer}
+	d.fields(func(id int16, ty byte) {
+		switch {
+		case id == 2 && ty == ctList:
+			_, n := d.listHdr()
+			for i := range n {
+				col, nchildren := pr.schemaElem(d)
+				if i == 0 {
+					continue // root
+				}
+				if nchildren > 0 {
+					d.err = fmt.Errorf("parquet: nested schema (group %q) is not supported", col.name)
+					return
+				}
+				if col.rep == repRepeated {
+					d.err = fmt.Errorf("parquet: repeated column %q is not supported", col.name)
+					return
+				}
+				pr.cols = append(pr.cols, col)
+			}
+		case id == 3 && ty == ctI64:
+			pr.nrows = d.varint()
+		case id == 4 && ty == ctList:
+			_, n := d.listHdr()
+			pr.groups = make([]rgroup, 0, n)
+			for range n {
+				pr.groups = append(pr.groups, readRowGroup(d))
+			}
+		default:
+			d.skip(ty)
+		}
+	})
+	if d.err != nil {
+		return d.err
+	}
+	if len(pr.cols) == 0 {
+		return errors.New("parquet: empty schema")
+	}
+	for i := range pr.groups {
+		if len(pr.groups[i].chunks) != len(pr.cols) {
+			return fmt.Errorf("parquet: row group #%d: expecting %d column chunks, got %d",
+				i, len(pr.cols), len(pr.groups[i].chunks))
+		}
+	}
+	return nil
+}
+
+// SchemaElement
+func (*Reader) schemaElem(d *tdec) (col rcol, nchildren int32) {
+	d.fields(func(id int16, ty byte) {
+		switch {
+		case id == 1 && ty == ctI32:
+			col.typ = int32(d.varint())
+		case id == 2 && ty == ctI32:
+			col.tlen = int32(d.varint())
+		case id == 3 && ty == ctI32:
+			col.rep = int32(d.varint())
+		case id == 4 && ty == ctBinary:
+			col.name = d.str()
+		case id == 5 && ty == ctI32:
+			nchildren = int32(d.varint())
+		case id == 6 && ty == ctI32:
+			col.conv = int32(d.varint())
+			switch col.conv {
+			case convTimestampMillis:
+				col.ts = tsMillis
+			case convTimestampMicros:
+				col.ts = tsMicros
+			case convDate:
+				col.isDate = true
+			}
+		case id == 10 && ty == ctStruct:
+			logicalType(d, &col)
+		default:
+			d.skip(ty)
+		}
+	})
+	return col, nchildren
+}
+
+// LogicalType union: DATE (6) and TIMESTAMP (8) are the only ones that affect formatting
+func logicalType(d *tdec, col *rcol) {
+	d.fields(func(id int16, ty byte) {
+		switch {
+		case id == 6 && ty == ctStruct:
+			col.isDate = true
+			d.skip(ty)
+		case id == 8 && ty == ctStruct:
+			d.fields(func(id int16, ty byte) {
+				if id != 2 || ty != ctStruct { // TimeUnit
+					d.skip(ty)
+					return
+				}
+				d.fields(func(id int16, ty byte) {
+					switch id {
+					case 1:
+						col.ts = tsMillis
+					case 2:
+						col.ts = tsMicros
+					case 3:
+						col.ts = tsNanos
+					}
+					d.skip(ty)
+				})
+			})
+		default:
+			d.skip(ty)
+		}
+	})
+}
+
+// RowGroup
+func readRowGroup(d *tdec) (rg rgroup) {
+	d.fields(func(id int16, ty byte) {
+		switch {
+		case id == 1 && ty == ctList:
+			_, n := d.listHdr()
+			rg.chunks = make([]rchunk, 0, n)
+			for range n {
+				rg.chunks = append(rg.chunks, readColumnChunk(d))
+			}
+		case id == 3 && ty == ctI64:
+			rg.nrows = d.varint()
+		default:
+			d.skip(ty)
+		}
+	})
+	return rg
+}
+
+// ColumnChunk => ColumnMetaData
+func readColumnChunk(d *tdec) (c rchunk) {
+	d.fields(func(id int16, ty byte) {
+		if id != 3 || ty != ctStruct {
+			d.skip(ty)
+			return
+		}
+		var dataOff, dictOff int64
+		d.fields(func(id int16, ty byte) {
+			switch {
+			case id == 4 && ty == ctI32:
+				c.codec = int32(d.varint())
+			case id == 7 && ty == ctI64:
+				c.size = d.varint()
+			case id == 9 && ty == ctI64:
+				dataOff = d.varint()
+			case id == 11 && ty == ctI64:
+				dictOff = d.varint()
+			default:
+				d.skip(ty)
+			}
+		})
+		c.offset = dataOff
+		if dictOff > 0 && dictOff < dataOff {
+			c.offset = dictOff
+		}
+	})
+	return c
+}
+
+// PageHeader
+func pageHeader(b []byte) (h pageHdr, err error) {
+	d := &tdec{b: b}
+	h.compr = true
+	d.fields(func(id int16, ty byte) {
+		switch {
+		case id == 1 && ty == ctI32:
+			h.typ = int32(d.varint())
+		case id == 2 && ty == ctI32:
+			h.usize = int32(d.varint())
+		case id == 3 && ty == ctI32:
+			h.csize = int32(d.varint())
+		case (id == 5 || id == 7) && ty == ctStruct: // DataPageHeader, DictionaryPageHeader
+			d.fields(func(id int16, ty byte) {
+				switch {
+				case id == 1 && ty == ctI32:
+					h.nvals = int32(d.varint())
+				case id == 2 && ty == ctI32:
+					h.enc = int32(d.varint())
+				default:
+					d.skip(ty)
+				}
+			})
+		case id == 8 && ty == ctStruct: // DataPageHeaderV2
+			d.fields(func(id int16, ty byte) {
+				switch {
+				case id == 1 && ty == ctI32:
+					h.nvals = int32(d.varint())
+				case id == 4 && ty == ctI32:
+					h.enc = int32(d.varint())
+				case id == 5 && ty == ctI32:
+					h.deflen = int32(d.varint())
+				case id == 6 && ty == ctI32:
+					h.replen = int32(d.varint())
+				case id == 7 && (ty == ctTrue || ty == ctFalse):
+					h.compr = ty == ctTrue
+				default:
+					d.skip(ty)
+				}
+			})
+		default:
+			d.skip(ty)
+		}
+	})
+	h.hdrSize = d.off
+	if d.err == nil && (h.csize < 0 || h.usize < 0 || h.nvals < 0) {
+		d.err = errThrift
+	}
+	return h, d.err
+}
+
+//
+// data
+//
+
+// read and decode all column chunks of a given row group
+func (pr *Reader) load(gidx int) error {
+	rg := &pr.groups[gidx]
+	if pr.vals == nil {
+		pr.vals = make([][]string, len(pr.cols))
+	}
+	for i := range pr.cols {
+		c := &rg.chunks[i]
+		if c.size <= 0 || c.offset <= 0 {
+			return fmt.Errorf("parquet: row group #%d, column %q: invalid chunk (offset %d, size %d)",
+				gidx, pr.cols[i].name, c.offset, c.size)
+		}
+		buf := make([]byte, c.size)
+		if _, err := pr.r.ReadAt(buf, c.offset); err != nil && err != io.EOF {
+			return err
+		}
+		vals, err := pr.chunk(&pr.cols[i], c, buf, int(rg.nrows), pr.vals[i][:0])
+		if err != nil {
+			return fmt.Errorf("parquet: row group #%d, column %q: %w", gidx, pr.cols[i].name, err)
+		}
+		if len(vals) != int(rg.nrows) {
+			return fmt.Errorf("parquet: row group #%d, column %q: expecting %d values, got %d",
+				gidx, pr.cols[i].name, rg.nrows, len(vals))
+		}
+		pr.vals[i] = vals
+	}
+	pr.ridx, pr.glen = 0, int(rg.nrows)
+	return nil
+}
+
+func (pr *Reader) chunk(col *rcol, c *rchunk, buf []byte, nrows int, vals []string) ([]string, error) {
+	var dict []string
+	for len(vals) < nrows && len(buf) > 0 {
+		h, err := pageHeader(buf)
+		if err != nil {
+			return nil, err
+		}
+		end := h.hdrSize + int(h.csize)
+		if end > len(buf) {
+			return nil, errors.New("page exceeds column chunk")
+		}
+		page := buf[h.hdrSize:end]
+		buf = buf[end:]
+
+		switch h.typ {
+		case pageDict:
+			if page, err = pr.decompress(c.codec, page, h.usize); err != nil {
+				return nil, err
+			}
+			if dict, err = plain(col, page, int(h.nvals), nil); err != nil {
+				return nil, err
+			}
+		case pageData:
+			if page, err = pr.decompress(c.codec, page, h.usize); err != nil {
+				return nil, err
+			}
+			var defs []byte
+			if col.rep == repOptional {
+				if len(page) < 4 {
+					return nil, errors.New("truncated definition levels")
+				}
+				l := int(binary.LittleEndian.Uint32(page))
+				if 4+l > len(page) {
+					return nil, errors.New("truncated definition levels")
+				}
+				if defs, err = rleLevels(page[4:4+l], int(h.nvals)); err != nil {
+					return nil, err
+				}
+				page = page[4+l:]
+			}
+			if vals, err = values(col, &h, page, defs, dict, vals); err != nil {
+				return nil, err
+			}
+		case pageDataV2:
+			lvl := int(h.replen) + int(h.deflen)
+			if h.replen < 0 || h.deflen < 0 || lvl > len(page) {
+				return nil, errors.New("invalid v2 levels length")
+			}
+			var defs []byte
+			if col.rep == repOptional {
+				if defs, err = rleLevels(page[h.replen:lvl], int(h.nvals)); err != nil {
+					return nil, err
+				}
+			}
+			data := page[lvl:]
+			if h.compr {
+				if data, err = pr.decompress(c.codec, data, h.usize-int32(lvl)); err != nil {
+					return nil, err
+				}
+			}
+			if vals, err = values(col, &h, data, defs, dict, vals); err != nil {
+				return nil, err
+			}
+		default:
+			// skip index pages and such
+		}
+	}
+	return vals, nil
+}
+
+func values(col *rcol, h *pageHdr, data, defs []byte, dict, vals []string) ([]string, error) {
+	n := int(h.nvals)
+	nonNull := n
+	if defs != nil {
+		nonNull = 0
+		for _, v := range defs {
+			if v != 0 {
+				nonNull++
+			}
+		}
+	}
+	var (
+		dense []string
+		err   error
+	)
+	switch h.enc {
+	case encPlain:
+		dense, err = plain(col, data, nonNull, nil)
+	case encPlainDict, encRLEDict:
+		if dict == nil {
+			return nil, errors.New("missing dictionary page")
+		}
+		if len(data) < 1 {
+			return nil, errors.New("truncated dictionary indices")
+		}
+		var idx []int32
+		if idx, err = rle(data[1:], int(data[0]), nonNull); err != nil {
+			return nil, err
+		}
+		dense = make([]string, nonNull)
+		for i, j := range idx {
+			if j < 0 || int(j) >= len(dict) {
+				return nil, fmt.Errorf("dictionary index %d out of range [0, %d)", j, len(dict))
+			}
+			dense[i] = dict[j]
+		}
+	default:
+		return nil, fmt.Errorf("unsupported encoding %d", h.enc)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if defs == nil {
+		return append(vals, dense...), nil
+	}
+	var j int
+	for _, v := range defs {
+		if v == 0 {
+			vals = append(vals, "")
+		} else {
+			vals = append(vals, dense[j])
+			j++
+		}
+	}
+	return vals, nil
+}
+
+func plain(col *rcol, b []byte, n int, out []string) ([]string, error) {
+	if out == nil {
+		out = make([]string, 0, n)
+	}
+	errShort := errors.New("truncated PLAIN-encoded values")
+	switch col.typ {
+	case typeBoolean:
+		if (n+7)/8 > len(b) {
+			return nil, errShort
+		}
+		for i := range n {
+			out = append(out, strconv.FormatBool(b[i/8]&(1<<(i%8)) != 0))
+		}
+	case typeInt32:
+		if 4*n > len(b) {
+			return nil, errShort
+		}
+		for i := range n {
+			v := int32(binary.LittleEndian.Uint32(b[4*i:]))
+			if col.isDate {
+				out = append(out, time.Unix(int64(v)*86400, 0).UTC().Format(time.DateOnly))
+			} else {
+				out = append(out, strconv.FormatInt(int64(v), 10))
+			}
+		}
+	case typeInt64:
+		if 8*n > len(b) {
+			return nil, errShort
+		}
+		for i := range n {
+			v := int64(binary.LittleEndian.Uint64(b[8*i:]))
+			out = append(out, fmtInt64(v, col.ts))
+		}
+	case typeInt96:
+		if 12*n > len(b) {
+			return nil, errShort
+		}
+		for i := range n {
+			var (
+				nanos = int64(binary.LittleEndian.Uint64(b[12*i:]))
+				days  = int64(binary.LittleEndian.Uint32(b[12*i+8:])) - julianUnixEpoch
+			)
+			out = append(out, time.Unix(days*86400, nanos).UTC().Format(time.RFC3339Nano))
+		}
+	case typeFloat:
+		if 4*n > len(b) {
+			return nil, errShort
+		}
+		for i := range n {
+			v := math.Float32frombits(binary.LittleEndian.Uint32(b[4*i:]))
+			out = append(out, strconv.FormatFloat(float64(v), 'g', -1, 32))
+		}
+	case typeDouble:
+		if 8*n > len(b) {
+			return nil, errShort
+		}
+		for i := range n {
+			v := math.Float64frombits(binary.LittleEndian.Uint64(b[8*i:]))
+			out = append(out, strconv.FormatFloat(v, 'g', -1, 64))
+		}
+	case typeByteArray:
+		for range n {
+			if len(b) < 4 {
+				return nil, errShort
+			}
+			l := int(binary.LittleEndian.Uint32(b))
+			if l < 0 || 4+l > len(b) {
+				return nil, errShort
+			}
+			out = append(out, string(b[4:4+l]))
+			b = b[4+l:]
+		}
+	case typeFixedLen:
+		l := int(col.tlen)
+		if l <= 0 || l*n > len(b) {
+			return nil, errShort
+		}
+		for i := range n {
+			out = append(out, hex.EncodeToString(b[l*i:l*(i+1)]))
+		}
+	default:
+		return nil, fmt.Errorf("unsupported physical type %d", col.typ)
+	}
+	return out, nil
+}
+
+func fmtInt64(v int64, ts int) string {
+	switch ts {
+	case tsMillis:
+		return time.UnixMilli(v).UTC().Format(time.RFC3339Nano)
+	case tsMicros:
+		return time.UnixMicro(v).UTC().Format(time.RFC3339Nano)
+	case tsNanos:
+		return time.Unix(0, v).UTC().Format(time.RFC3339Nano)
+	default:
+		return strconv.FormatInt(v, 10)
+	}
+}
+
+// definition levels (max level 1 => bit width 1)
+func rleLevels(b []byte, n int) ([]byte, error) {
+	idx, err := rle(b, 1, n)
+	if err != nil {
+		return nil, err
+	}
+	defs := make([]byte, n)
+	for i, v := range idx {
+		defs[i] = byte(v)
+	}
+	return defs, nil
+}
+
+// RLE/bit-packing hybrid
+// https://github.com/apache/parquet-format/blob/master/Encodings.md#run-length-encoding--bit-packing-hybrid-rle--3
+func rle(b []byte, width, n int) ([]int32, error) {
+	if width < 0 || width > 32 {
+		return nil, fmt.Errorf("invalid bit width %d", width)
+	}
+	out := make([]int32, 0, n)
+	if width == 0 {
+		return out[:n], nil // all zeros
+	}
+	nbytes := (width + 7) / 8
+	for len(out) < n {
+		hdr, k := binary.Uvarint(b)
+		if k <= 0 {
+			return nil, errors.New("truncated RLE run")
+		}
+		b = b[k:]
+		if hdr&1 == 0 { // RLE run
+			cnt := int(hdr >> 1)
+			if len(b) < nbytes {
+				return nil, errors.New("truncated RLE value")
+			}
+			var v uint32
+			for i := range nbytes {
+				v |= uint32(b[i]) << (8 * i)
+			}
+			b = b[nbytes:]
+			for range min(cnt, n-len(out)) {
+				out = append(out, int32(v))
+			}
+			continue
+		}
+		// bit-packed run: groups of 8 values, LSB first
+		cnt := int(hdr>>1) * 8
+		size := cnt * width / 8
+		if size > len(b) {
+			size = len(b) // (last run may be truncated)
+			cnt = size * 8 / width
+		}
+		var (
+			acc   uint64
+			nbits int
+			mask  = uint64(1)<<width - 1
+			p     = b[:size]
+		)
+		for i := 0; i < cnt && len(out) < n; i++ {
+			for nbits < width {
+				acc |= uint64(p[0]) << nbits
+				p = p[1:]
+				nbits += 8
+			}
+			out = append(out, int32(acc&mask))
+			acc >>= width
+			nbits -= width
+		}
+		b = b[size:]
+		if cnt == 0 && len(out) < n {
+			return nil, errors.New("truncated bit-packed run")
+		}
+	}
+	return out, nil
+}
+
+func (pr *Reader) decompress(codec int32, b []byte, usize int32) ([]byte, error) {
+	switch codec {
+	case codecUncompressed:
+		return b, nil
+	case codecSnappy:
+		return snappy.Decode(nil, b)
+	case codecGzip:
+		zr, err := gzip.NewReader(bytes.NewReader(b))
+		if err != nil {
+			return nil, err
+		}
+		out := bytes.NewBuffer(make([]byte, 0, max(usize, 0)))
+		_, err = io.Copy(out, zr) //nolint:gosec // (bounded by the file's own page size)
+		zr.Close()
+		return out.Bytes(), err
+	case codecZstd:
+		if pr.zstd == nil {
+			zd, err := zstd.NewReader(nil)
+			if err != nil {
+				return nil, err
+			}
+			pr.zstd = zd
+		}
+		return pr.zstd.DecodeAll(b, make([]byte, 0, max(usize, 0)))
+	case codecLz4Raw:
+		out := make([]byte, max(usize, 0))
+		n, err := lz4.UncompressBlock(b, out)
+		return out[:n], err
+	default:
+		return nil, fmt.Errorf("unsupported compression codec %d", codec)
+	}
+}
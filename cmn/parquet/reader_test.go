@@ -0,0 +1,197 @@
+// Package parquet provides minimal (flat schema) Parquet file writer and reader.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package parquet_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/parquet"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/klauspost/compress/snappy"
+)
+
+// minimal Thrift compact encoder (to craft files the Writer does not produce)
+type tenc struct {
+	b    []byte
+	last []int16
+}
+
+func (e *tenc) field(id int16, ty byte) {
+	l := len(e.last) - 1
+	e.b = append(e.b, byte(id-e.last[l])<<4|ty)
+	e.last[l] = id
+}
+
+func (e *tenc) varint(v int64) { e.b = binary.AppendUvarint(e.b, uint64((v<<1)^(v>>63))) }
+
+func (e *tenc) i32(id int16, v int) *tenc { e.field(id, 5); e.varint(int64(v)); return e }
+func (e *tenc) i64(id int16, v int) *tenc { e.field(id, 6); e.varint(int64(v)); return e }
+
+func (e *tenc) boolean(id int16, v bool) *tenc {
+	if v {
+		e.field(id, 1)
+	} else {
+		e.field(id, 2)
+	}
+	return e
+}
+
+func (e *tenc) str(id int16, s string) *tenc {
+	e.field(id, 8)
+	e.b = binary.AppendUvarint(e.b, uint64(len(s)))
+	e.b = append(e.b, s...)
+	return e
+}
+
+func (e *tenc) list(id int16, ety byte, n int) *tenc {
+	e.field(id, 9)
+	e.b = append(e.b, byte(n)<<4|ety)
+	return e
+}
+
+// id < 0: list element
+func (e *tenc) begin(id int16) *tenc {
+	if id >= 0 {
+		e.field(id, 12)
+	}
+	e.last = append(e.last, 0)
+	return e
+}
+
+func (e *tenc) end() *tenc {
+	e.b = append(e.b, 0)
+	e.last = e.last[:len(e.last)-1]
+	return e
+}
+
+func newTenc() *tenc { return &tenc{last: []int16{0}} }
+
+func TestReaderRoundTrip(t *testing.T) {
+	var (
+		buf  bytes.Buffer
+		cols = []parquet.Column{
+			{Name: "name", Type: parquet.String},
+			{Name: "size", Type: parquet.Int64},
+			{Name: "atime", Type: parquet.TimestampMicros},
+		}
+		num = 25
+		ts  = time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	)
+	pw, err := parquet.NewWriter(&buf, cols, 10 /*rows per group*/)
+	tassert.CheckFatal(t, err)
+	for i := range num {
+		tassert.CheckFatal(t, pw.WriteRow("obj-"+strconv.Itoa(i), int64(i*100), ts.Add(time.Duration(i)*time.Second).UnixMicro()))
+	}
+	tassert.CheckFatal(t, pw.Close())
+
+	pr, err := parquet.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	tassert.CheckFatal(t, err)
+	defer pr.Close()
+	tassert.Fatalf(t, pr.NumRows() == int64(num), "num rows %d", pr.NumRows())
+	names := pr.Columns()
+	tassert.Fatalf(t, len(names) == 3 && names[0] == "name" && names[2] == "atime", "columns %v", names)
+
+	for i := range num {
+		row, err := pr.Read()
+		tassert.CheckFatal(t, err)
+		exp := []string{"obj-" + strconv.Itoa(i), strconv.Itoa(i * 100), ts.Add(time.Duration(i) * time.Second).Format(time.RFC3339Nano)}
+		for j := range exp {
+			tassert.Errorf(t, row[j] == exp[j], "row %d, column %d: %q vs %q", i, j, row[j], exp[j])
+		}
+	}
+	_, err = pr.Read()
+	tassert.Errorf(t, err == io.EOF, "expecting EOF, got %v", err)
+}
+
+// optional dictionary-encoded (snappy) column and a v2 data page with timestamps (logical type)
+func TestReaderDictOptional(t *testing.T) {
+	const nrows = 5
+	var (
+		file = []byte("PAR1")
+		ts   = time.Date(2025, 1, 2, 3, 4, 5, 6000, time.UTC)
+	)
+
+	// column "name": dictionary page ["a", "bb"]
+	off0 := len(file)
+	var dict []byte
+	for _, s := range []string{"a", "bb"} {
+		dict = binary.LittleEndian.AppendUint32(dict, uint32(len(s)))
+		dict = append(dict, s...)
+	}
+	cdict := snappy.Encode(nil, dict)
+	hdr := newTenc().i32(1, 2).i32(2, len(dict)).i32(3, len(cdict)).begin(7).i32(1, 2).i32(2, 0).end().end()
+	file = append(file, hdr.b...)
+	file = append(file, cdict...)
+
+	// data page v1: def levels [1,1,0,1,1] (bit-packed), indices [0,1,1,0] (bit width 1, bit-packed)
+	dataOff0 := len(file)
+	page := []byte{2, 0, 0, 0, 3, 0x1b, 1, 3, 0x06}
+	cpage := snappy.Encode(nil, page)
+	hdr = newTenc().i32(1, 0).i32(2, len(page)).i32(3, len(cpage)).begin(5).i32(1, nrows).i32(2, 8).i32(3, 3).i32(4, 3).end().end()
+	file = append(file, hdr.b...)
+	file = append(file, cpage...)
+	size0 := len(file) - off0
+
+	// column "ts": uncompressed data page v2, plain int64 micros
+	off1 := len(file)
+	var vals []byte
+	for i := range nrows {
+		vals = binary.LittleEndian.AppendUint64(vals, uint64(ts.Add(time.Duration(i)*time.Hour).UnixMicro()))
+	}
+	hdr = newTenc().i32(1, 3).i32(2, len(vals)).i32(3, len(vals)).begin(8).
+		i32(1, nrows).i32(2, 0).i32(3, nrows).i32(4, 0).i32(5, 0).i32(6, 0).boolean(7, false).end().end()
+	file = append(file, hdr.b...)
+	file = append(file, vals...)
+	size1 := len(file) - off1
+
+	// footer
+	e := newTenc().i32(1, 1)
+	e.list(2, 12, 3)
+	e.begin(-1).str(4, "schema").i32(5, 2).end()
+	e.begin(-1).i32(1, 6).i32(3, 1).str(4, "name").i32(6, 0).end()
+	e.begin(-1).i32(1, 2).i32(3, 0).str(4, "ts").begin(10).begin(8).boolean(1, true).begin(2).begin(2).end().end().end().end().end()
+	e.i64(3, nrows)
+	e.list(4, 12, 1)
+	e.begin(-1).list(1, 12, 2)
+	e.begin(-1).i64(2, off0).begin(3).i32(1, 6).list(2, 5, 0).list(3, 8, 0).i32(4, 1).i64(5, nrows).
+		i64(6, size0).i64(7, size0).i64(9, dataOff0).i64(11, off0).end().end()
+	e.begin(-1).i64(2, off1).begin(3).i32(1, 2).list(2, 5, 0).list(3, 8, 0).i32(4, 0).i64(5, nrows).
+		i64(6, size1).i64(7, size1).i64(9, off1).end().end()
+	e.i64(2, size0+size1).i64(3, nrows).end()
+	e.b = append(e.b, 0)
+	file = append(file, e.b...)
+	file = binary.LittleEndian.AppendUint32(file, uint32(len(e.b)))
+	file = append(file, "PAR1"...)
+
+	pr, err := parquet.NewReader(bytes.NewReader(file), int64(len(file)))
+	tassert.CheckFatal(t, err)
+	defer pr.Close()
+
+	expNames := []string{"a", "bb", "", "bb", "a"}
+	for i := range nrows {
+		row, err := pr.Read()
+		tassert.CheckFatal(t, err)
+		expTs := ts.Add(time.Duration(i) * time.Hour).Format(time.RFC3339Nano)
+		tassert.Errorf(t, row[0] == expNames[i] && row[1] == expTs, "row %d: %v (expecting [%s %s])", i, row, expNames[i], expTs)
+	}
+	_, err = pr.Read()
+	tassert.Errorf(t, err == io.EOF, "expecting EOF, got %v", err)
+}
+
+func TestReaderInvalid(t *testing.T) {
+	for _, b := range [][]byte{
+		[]byte("PAR1"),
+		[]byte("PAR1xxxxxxxxxxxxPAR2"),
+		append([]byte("PAR1"), 0xff, 0xff, 0, 0, 'P', 'A', 'R', '1'),
+	} {
+		_, err := parquet.NewReader(bytes.NewReader(b), int64(len(b)))
+		tassert.Errorf(t, err != nil, "expecting error for %q", b)
+	}
+}
@@ -0,0 +1,229 @@
+// Package parquet provides minimal (flat schema) Parquet file writer and reader.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package parquet
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// Thrift compact protocol encoder - just enough to serialize Parquet metadata:
+// https://github.com/apache/thrift/blob/master/doc/specs/thrift-compact-protocol.md
+
+const (
+	ctStop   = 0
+	ctI32    = 5
+	ctI64    = 6
+	ctBinary = 8
+	ctList   = 9
+	ctStruct = 12
+)
+
+type tenc struct {
+	b    []byte
+	last []int16 // field ID stack (nested structs)
+}
+
+func (e *tenc) field(id int16, ty byte) {
+	l := len(e.last) - 1
+	if delta := id - e.last[l]; delta > 0 && delta <= 15 {
+		e.b = append(e.b, byte(delta)<<4|ty)
+	} else {
+		e.b = append(e.b, ty)
+		e.varint(int64(id))
+	}
+	e.last[l] = id
+}
+
+func (e *tenc) varint(v int64) { e.b = binary.AppendUvarint(e.b, uint64((v<<1)^(v>>63))) } // zigzag
+
+func (e *tenc) i32(id int16, v int32) {
+	e.field(id, ctI32)
+	e.varint(int64(v))
+}
+
+func (e *tenc) i64(id int16, v int64) {
+	e.field(id, ctI64)
+	e.varint(v)
+}
+
+func (e *tenc) str(id int16, s string) {
+	e.field(id, ctBinary)
+	e.b = binary.AppendUvarint(e.b, uint64(len(s)))
+	e.b = append(e.b, s...)
+}
+
+func (e *tenc) list(id int16, elemTy byte, n int) {
+	e.field(id, ctList)
+	if n < 15 {
+		e.b = append(e.b, byte(n)<<4|elemTy)
+	} else {
+		e.b = append(e.b, 0xf0|elemTy)
+		e.b = binary.AppendUvarint(e.b, uint64(n))
+	}
+}
+
+// list elements
+func (e *tenc) elemI32(v int32) { e.varint(int64(v)) }
+
+func (e *tenc) elemStr(s string) {
+	e.b = binary.AppendUvarint(e.b, uint64(len(s)))
+	e.b = append(e.b, s...)
+}
+
+// (id < 0: list element)
+func (e *tenc) begin(id int16) {
+	if id >= 0 {
+		e.field(id, ctStruct)
+	}
+	e.last = append(e.last, 0)
+}
+
+func (e *tenc) end() {
+	e.b = append(e.b, ctStop)
+	e.last = e.last[:len(e.last)-1]
+}
+
+// Thrift compact protocol decoder - generic enough to parse Parquet metadata and page headers
+// while skipping (unknown) fields
+
+const (
+	ctTrue   = 1
+	ctFalse  = 2
+	ctByte   = 3
+	ctI16    = 4
+	ctDouble = 7
+	ctSet    = 10
+	ctMap    = 11
+)
+
+var errThrift = errors.New("parquet: malformed thrift")
+
+type tdec struct {
+	b   []byte
+	off int
+	err error
+}
+
+func (d *tdec) uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(d.b[d.off:])
+	if n <= 0 {
+		d.err = errThrift
+		return 0
+	}
+	d.off += n
+	return v
+}
+
+func (d *tdec) varint() int64 {
+	u := d.uvarint()
+	return int64(u>>1) ^ -int64(u&1)
+}
+
+func (d *tdec) byte1() byte {
+	if d.err != nil {
+		return 0
+	}
+	if d.off >= len(d.b) {
+		d.err = errThrift
+		return 0
+	}
+	c := d.b[d.off]
+	d.off++
+	return c
+}
+
+func (d *tdec) bytes() []byte {
+	n := d.uvarint()
+	if d.err != nil {
+		return nil
+	}
+	if n > uint64(len(d.b)-d.off) {
+		d.err = errThrift
+		return nil
+	}
+	v := d.b[d.off : d.off+int(n)]
+	d.off += int(n)
+	return v
+}
+
+func (d *tdec) str() string { return string(d.bytes()) }
+
+// returns element type and number of elements
+func (d *tdec) listHdr() (byte, int) {
+	c := d.byte1()
+	n := int(c >> 4)
+	if n == 15 {
+		n = int(d.uvarint())
+	}
+	if n < 0 || n > len(d.b) {
+		d.err = errThrift
+		return 0, 0
+	}
+	return c & 0x0f, n
+}
+
+// iterate struct fields until ctStop; the callback must consume (or skip) the value
+func (d *tdec) fields(cb func(id int16, ty byte)) {
+	var last int16
+	for d.err == nil {
+		c := d.byte1()
+		if c == ctStop || d.err != nil {
+			return
+		}
+		ty := c & 0x0f
+		if delta := int16(c >> 4); delta != 0 {
+			last += delta
+		} else {
+			last = int16(d.varint())
+		}
+		cb(last, ty)
+	}
+}
+
+func (d *tdec) skip(ty byte) {
+	switch ty {
+	case ctTrue, ctFalse:
+		// (field) value is in the type nibble
+	case ctByte:
+		d.byte1()
+	case ctI16, ctI32, ctI64:
+		d.varint()
+	case ctDouble:
+		if d.off+8 > len(d.b) {
+			d.err = errThrift
+			return
+		}
+		d.off += 8
+	case ctBinary:
+		d.bytes()
+	case ctList, ctSet:
+		et, n := d.listHdr()
+		for range n {
+			if et == ctTrue || et == ctFalse {
+				d.byte1() // (element) booleans are encoded as bytes
+			} else {
+				d.skip(et)
+			}
+		}
+	case ctMap:
+		n := int(d.uvarint())
+		if n == 0 {
+			return
+		}
+		kv := d.byte1()
+		for range n {
+			d.skip(kv >> 4)
+			d.skip(kv & 0x0f)
+		}
+	case ctStruct:
+		d.fields(func(_ int16, ty byte) { d.skip(ty) })
+	default:
+		d.err = errThrift
+	}
+}
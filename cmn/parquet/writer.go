@@ -0,0 +1,245 @@
+// Package parquet provides minimal (flat schema) Parquet file writer and reader.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package parquet
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Format: https://github.com/apache/parquet-format
+//
+// Limitations (by design):
+// - flat schema of required (non-nullable) columns: strings and 64-bit integers
+// - PLAIN encoding, no compression, no statistics
+// - one data page per column chunk; row groups of (configurable) number of rows
+
+const magic = "PAR1"
+
+const DefaultRowGroupSize = 64 * 1024
+
+// column type
+const (
+	String          = iota // BYTE_ARRAY (UTF8)
+	Int64                  // INT64
+	TimestampMicros        // INT64 (TIMESTAMP_MICROS)
+)
+
+// parquet.thrift enums
+const (
+	typeInt64     = 2
+	typeByteArray = 6
+
+	convUTF8            = 0
+	convTimestampMicros = 10
+
+	repRequired = 0
+
+	encPlain = 0
+	encRLE   = 3
+
+	codecUncompressed = 0
+	pageData          = 0
+)
+
+const createdBy = "aistore"
+
+type (
+	Column struct {
+		Name string
+		Type int // String | Int64 | TimestampMicros
+	}
+
+	Writer struct {
+		w       io.Writer
+		cols    []Column
+		bufs    [][]byte // current row group: PLAIN-encoded values, column-wise
+		groups  []rowGroup
+		off     int64 // file offset
+		nrows   int64 // total
+		ngroup  int   // rows in the current group
+		maxrows int   // per group
+	}
+	rowGroup struct {
+		chunks []chunk
+		size   int64
+		nrows  int64
+	}
+	chunk struct {
+		offset int64 // page header
+		size   int64 // page header + data
+	}
+)
+
+func NewWriter(w io.Writer, cols []Column, rowGroupSize int) (*Writer, error) {
+	if len(cols) == 0 {
+		return nil, errors.New("parquet: empty schema")
+	}
+	if rowGroupSize <= 0 {
+		rowGroupSize = DefaultRowGroupSize
+	}
+	pw := &Writer{w: w, cols: cols, bufs: make([][]byte, len(cols)), maxrows: rowGroupSize}
+	if err := pw.write([]byte(magic)); err != nil {
+		return nil, err
+	}
+	return pw, nil
+}
+
+// each value must be either string (String column) or int64 (Int64 and TimestampMicros)
+func (pw *Writer) WriteRow(vals ...any) error {
+	if len(vals) != len(pw.cols) {
+		return fmt.Errorf("parquet: expecting %d values, got %d", len(pw.cols), len(vals))
+	}
+	for i, v := range vals {
+		var ok bool
+		if pw.cols[i].Type == String {
+			_, ok = v.(string)
+		} else {
+			_, ok = v.(int64)
+		}
+		if !ok {
+			return fmt.Errorf("parquet: column %q (type %d): unexpected value type %T", pw.cols[i].Name, pw.cols[i].Type, v)
+		}
+	}
+	for i, v := range vals {
+		if s, ok := v.(string); ok {
+			pw.bufs[i] = binary.LittleEndian.AppendUint32(pw.bufs[i], uint32(len(s)))
+			pw.bufs[i] = append(pw.bufs[i], s...)
+		} else {
+			pw.bufs[i] = binary.LittleEndian.AppendUint64(pw.bufs[i], uint64(v.(int64)))
+		}
+	}
+	pw.ngroup++
+	if pw.ngroup >= pw.maxrows {
+		return pw.flush()
+	}
+	return nil
+}
+
+func (pw *Writer) NumRows() int64 { return pw.nrows + int64(pw.ngroup) }
+
+// flush the remaining rows and write the footer (does not close the underlying writer)
+func (pw *Writer) Close() error {
+	if pw.ngroup > 0 {
+		if err := pw.flush(); err != nil {
+			return err
+		}
+	}
+	footer := pw.footer()
+	footer = binary.LittleEndian.AppendUint32(footer, uint32(len(footer)))
+	footer = append(footer, magic...)
+	return pw.write(footer)
+}
+
+// write row group: one data page per column
+func (pw *Writer) flush() error {
+	rg := rowGroup{chunks: make([]chunk, len(pw.cols)), nrows: int64(pw.ngroup)}
+	for i := range pw.cols {
+		data := pw.bufs[i]
+		e := &tenc{last: []int16{0}}
+		e.i32(1, pageData)
+		e.i32(2, int32(len(data)))
+		e.i32(3, int32(len(data)))
+		e.begin(5) // DataPageHeader
+		e.i32(1, int32(pw.ngroup))
+		e.i32(2, encPlain)
+		e.i32(3, encRLE)
+		e.i32(4, encRLE)
+		e.end()
+		e.end()
+
+		offset := pw.off
+		if err := pw.write(e.b); err != nil {
+			return err
+		}
+		if err := pw.write(data); err != nil {
+			return err
+		}
+		rg.chunks[i] = chunk{offset: offset, size: pw.off - offset}
+		rg.size += rg.chunks[i].size
+		pw.bufs[i] = data[:0]
+	}
+	pw.groups = append(pw.groups, rg)
+	pw.nrows += int64(pw.ngroup)
+	pw.ngroup = 0
+	return nil
+}
+
+// FileMetaData
+func (pw *Writer) footer() []byte {
+	e := &tenc{last: []int16{0}}
+	e.i32(1, 1) // version
+
+	// schema: root followed by columns
+	e.list(2, ctStruct, len(pw.cols)+1)
+	e.begin(-1)
+	e.str(4, "schema")
+	e.i32(5, int32(len(pw.cols)))
+	e.end()
+	for _, col := range pw.cols {
+		e.begin(-1)
+		switch col.Type {
+		case String:
+			e.i32(1, typeByteArray)
+			e.i32(3, repRequired)
+			e.str(4, col.Name)
+			e.i32(6, convUTF8)
+		case TimestampMicros:
+			e.i32(1, typeInt64)
+			e.i32(3, repRequired)
+			e.str(4, col.Name)
+			e.i32(6, convTimestampMicros)
+		default:
+			e.i32(1, typeInt64)
+			e.i32(3, repRequired)
+			e.str(4, col.Name)
+		}
+		e.end()
+	}
+	e.i64(3, pw.nrows)
+
+	// row groups
+	e.list(4, ctStruct, len(pw.groups))
+	for _, rg := range pw.groups {
+		e.begin(-1)
+		e.list(1, ctStruct, len(rg.chunks))
+		for i, c := range rg.chunks {
+			e.begin(-1) // ColumnChunk
+			e.i64(2, c.offset)
+			e.begin(3) // ColumnMetaData
+			if pw.cols[i].Type == String {
+				e.i32(1, typeByteArray)
+			} else {
+				e.i32(1, typeInt64)
+			}
+			e.list(2, ctI32, 2)
+			e.elemI32(encPlain)
+			e.elemI32(encRLE)
+			e.list(3, ctBinary, 1)
+			e.elemStr(pw.cols[i].Name)
+			e.i32(4, codecUncompressed)
+			e.i64(5, rg.nrows)
+			e.i64(6, c.size)
+			e.i64(7, c.size)
+			e.i64(9, c.offset)
+			e.end()
+			e.end()
+		}
+		e.i64(2, rg.size)
+		e.i64(3, rg.nrows)
+		e.end()
+	}
+	e.str(6, createdBy)
+	e.b = append(e.b, ctStop)
+	return e.b
+}
+
+func (pw *Writer) write(b []byte) error {
+	n, err := pw.w.Write(b)
+	pw.off += int64(n)
+	return err
+}
@@ -0,0 +1,145 @@
+// Package parquet provides minimal (flat schema) Parquet file writer and reader.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package parquet_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strconv"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/parquet"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+// minimal Thrift compact decoder: struct => map[field-id]value
+type tdec struct {
+	b   []byte
+	off int
+}
+
+func (d *tdec) uvarint() uint64 {
+	v, n := binary.Uvarint(d.b[d.off:])
+	d.off += n
+	return v
+}
+
+func (d *tdec) varint() int64 {
+	u := d.uvarint()
+	return int64(u>>1) ^ -int64(u&1)
+}
+
+func (d *tdec) value(ty byte) any {
+	switch ty {
+	case 5, 6:
+		return d.varint()
+	case 8:
+		n := int(d.uvarint())
+		s := string(d.b[d.off : d.off+n])
+		d.off += n
+		return s
+	case 9:
+		h := d.b[d.off]
+		d.off++
+		n, ety := int(h>>4), h&0xf
+		if n == 15 {
+			n = int(d.uvarint())
+		}
+		l := make([]any, n)
+		for i := range l {
+			l[i] = d.value(ety)
+		}
+		return l
+	case 12:
+		return d.structure()
+	}
+	panic("unexpected type " + strconv.Itoa(int(ty)))
+}
+
+func (d *tdec) structure() map[int]any {
+	var (
+		m    = make(map[int]any)
+		last int
+	)
+	for {
+		h := d.b[d.off]
+		d.off++
+		if h == 0 {
+			return m
+		}
+		id := last + int(h>>4)
+		if h>>4 == 0 {
+			id = int(d.varint())
+		}
+		m[id] = d.value(h & 0xf)
+		last = id
+	}
+}
+
+func TestWriter(t *testing.T) {
+	var (
+		buf  bytes.Buffer
+		cols = []parquet.Column{
+			{Name: "name", Type: parquet.String},
+			{Name: "size", Type: parquet.Int64},
+			{Name: "atime", Type: parquet.TimestampMicros},
+		}
+		num = 25
+	)
+	pw, err := parquet.NewWriter(&buf, cols, 10 /*rows per group*/)
+	tassert.CheckFatal(t, err)
+	for i := range num {
+		tassert.CheckFatal(t, pw.WriteRow("obj-"+strconv.Itoa(i), int64(i*100), int64(-i)))
+	}
+	tassert.Errorf(t, pw.WriteRow(1, "a", int64(0)) != nil, "expecting type error")
+	tassert.CheckFatal(t, pw.Close())
+
+	// layout
+	b := buf.Bytes()
+	tassert.Fatalf(t, string(b[:4]) == "PAR1" && string(b[len(b)-4:]) == "PAR1", "bad magic")
+	flen := int(binary.LittleEndian.Uint32(b[len(b)-8:]))
+	d := &tdec{b: b[len(b)-8-flen : len(b)-8]}
+	md := d.structure()
+	tassert.Fatalf(t, d.off == flen, "footer: decoded %d, expected %d", d.off, flen)
+
+	tassert.Errorf(t, md[3].(int64) == int64(num), "num rows %v", md[3])
+	schema := md[2].([]any)
+	tassert.Fatalf(t, len(schema) == len(cols)+1, "schema %v", schema)
+	for i, col := range cols {
+		el := schema[i+1].(map[int]any)
+		tassert.Errorf(t, el[4] == col.Name, "column %d: %v", i, el)
+	}
+
+	// read back
+	var (
+		names []string
+		sizes []int64
+	)
+	groups := md[4].([]any)
+	tassert.Fatalf(t, len(groups) == 3, "expecting 3 row groups, got %d", len(groups))
+	for _, g := range groups {
+		chunks := g.(map[int]any)[1].([]any)
+		for i, c := range chunks[:2] {
+			cmd := c.(map[int]any)[3].(map[int]any)
+			pd := &tdec{b: b, off: int(cmd[9].(int64))}
+			ph := pd.structure()
+			n := int(ph[5].(map[int]any)[1].(int64))
+			for range n {
+				if i == 0 {
+					l := int(binary.LittleEndian.Uint32(b[pd.off:]))
+					names = append(names, string(b[pd.off+4:pd.off+4+l]))
+					pd.off += 4 + l
+				} else {
+					sizes = append(sizes, int64(binary.LittleEndian.Uint64(b[pd.off:])))
+					pd.off += 8
+				}
+			}
+		}
+	}
+	tassert.Fatalf(t, len(names) == num && len(sizes) == num, "read back %d, %d", len(names), len(sizes))
+	for i := range num {
+		tassert.Errorf(t, names[i] == "obj-"+strconv.Itoa(i) && sizes[i] == int64(i*100), "row %d: %s, %d", i, names[i], sizes[i])
+	}
+}
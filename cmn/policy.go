@@ -0,0 +1,167 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+)
+
+// Bucket policy: user-provided (S3) bucket policy document that gets stored verbatim
+// and, separately, translated into a list of per-principal, per-prefix access entries.
+// The entries are evaluated by AIS proxies in addition to bucket ACL (`Bprops.Access`)
+// and AuthN permissions:
+//   - an explicit deny always wins;
+//   - an allow that covers all requested permissions substitutes for AuthN permissions
+//     (and grants anonymous access) but not for bucket ACL - the latter always applies;
+//   - otherwise, the regular (bucket ACL and AuthN) checks apply.
+//
+// Bucket-level entries (S3 resource "arn:aws:s3:::bucket") grant or deny bucket-level
+// permissions only, object-level entries ("arn:aws:s3:::bucket/...") - object-level only.
+
+const (
+	PolicyAnyone = "*" // any principal, including anonymous
+
+	MaxPolicyDocSize = 20 * 1024 // (as per S3)
+)
+
+// permissions by resource type (see PolicyACE.Bucket)
+const (
+	PolicyObjAccess = apc.AceGET | apc.AceObjHEAD | apc.AcePUT | apc.AceAPPEND | apc.AceObjDELETE |
+		apc.AceObjMOVE | apc.AcePromote | apc.AceObjUpdate
+	PolicyBckAccess = apc.AceBckHEAD | apc.AceObjLIST | apc.AcePATCH | apc.AceBckSetACL |
+		apc.AceDestroyBucket | apc.AceMoveBucket
+)
+
+type (
+	BucketPolicy struct {
+		Doc     string      `json:"doc"` // original policy document
+		Entries []PolicyACE `json:"entries"`
+	}
+	PolicyACE struct {
+		Principals []string        `json:"principals"`       // user names (AuthN), or PolicyAnyone
+		Prefix     string          `json:"prefix,omitempty"` // object name (list-objects, if Bucket) prefix; empty - any
+		Access     apc.AccessAttrs `json:"access,string"`
+		Exact      bool            `json:"exact,omitempty"`  // name must be equal to Prefix
+		Bucket     bool            `json:"bucket,omitempty"` // bucket-level entry (PolicyBckAccess only)
+		Deny       bool            `json:"deny,omitempty"`
+	}
+)
+
+//////////////////
+// BucketPolicy //
+//////////////////
+
+func (bp *BucketPolicy) Validate() error {
+	if bp == nil {
+		return nil
+	}
+	if len(bp.Doc) > MaxPolicyDocSize {
+		return fmt.Errorf("bucket policy: document size %d exceeds %d", len(bp.Doc), MaxPolicyDocSize)
+	}
+	if len(bp.Entries) == 0 {
+		return errors.New("bucket policy: no statements")
+	}
+	for i := range bp.Entries {
+		e := &bp.Entries[i]
+		if len(e.Principals) == 0 {
+			return fmt.Errorf("bucket policy: entry %d: no principals", i)
+		}
+		if e.Access == 0 {
+			return fmt.Errorf("bucket policy: entry %d: no actions", i)
+		}
+	}
+	return nil
+}
+
+// returns accumulated allowed and denied permissions for a given user and name, whereby
+// the name is object name (object-level entries) or list-objects prefix (bucket-level)
+func (bp *BucketPolicy) Eval(user, name string) (allow, deny apc.AccessAttrs) {
+	for i := range bp.Entries {
+		e := &bp.Entries[i]
+		if !e.matchPrincipal(user) || !e.matchName(name) {
+			continue
+		}
+		access := e.Access & e.scope()
+		if e.Deny {
+			deny |= access
+		} else {
+			allow |= access
+		}
+	}
+	return allow, deny
+}
+
+// ditto, for all objects that have the given prefix (multi-object operations by prefix
+// or template): an object-level deny applies when it may match any of those objects,
+// while allow - only when it matches all of them
+func (bp *BucketPolicy) EvalPrefix(user, prefix string) (allow, deny apc.AccessAttrs) {
+	for i := range bp.Entries {
+		e := &bp.Entries[i]
+		if !e.matchPrincipal(user) {
+			continue
+		}
+		access := e.Access & e.scope()
+		switch {
+		case e.Bucket:
+			if !e.matchName(prefix) {
+				continue
+			}
+		case e.Deny:
+			if !e.overlapPrefix(prefix) {
+				continue
+			}
+		default:
+			if e.Exact || !strings.HasPrefix(prefix, e.Prefix) {
+				continue
+			}
+		}
+		if e.Deny {
+			deny |= access
+		} else {
+			allow |= access
+		}
+	}
+	return allow, deny
+}
+
+///////////////
+// PolicyACE //
+///////////////
+
+func (e *PolicyACE) matchPrincipal(user string) bool {
+	for _, p := range e.Principals {
+		if p == PolicyAnyone || (user != "" && p == user) {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *PolicyACE) matchName(name string) bool {
+	if e.Exact {
+		return name == e.Prefix
+	}
+	return strings.HasPrefix(name, e.Prefix)
+}
+
+// whether some name that has the given prefix may match
+func (e *PolicyACE) overlapPrefix(prefix string) bool {
+	if e.Exact {
+		return strings.HasPrefix(e.Prefix, prefix)
+	}
+	return strings.HasPrefix(e.Prefix, prefix) || strings.HasPrefix(prefix, e.Prefix)
+}
+
+func (e *PolicyACE) scope() apc.AccessAttrs {
+	if e.Bucket {
+		return PolicyBckAccess
+	}
+	return PolicyObjAccess
+}
@@ -0,0 +1,51 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package cmn_test
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestBucketPolicyEvalPrefix(t *testing.T) {
+	policy := &cmn.BucketPolicy{
+		Entries: []cmn.PolicyACE{
+			{Principals: []string{cmn.PolicyAnyone}, Access: apc.AceObjDELETE, Prefix: "tmp/"},
+			{Principals: []string{cmn.PolicyAnyone}, Access: apc.AceObjDELETE, Prefix: "tmp/keep/", Deny: true},
+			{Principals: []string{cmn.PolicyAnyone}, Access: apc.AceObjDELETE, Prefix: "data/a.txt", Exact: true, Deny: true},
+			{Principals: []string{"bob"}, Access: apc.AceObjDELETE, Deny: true},
+		},
+	}
+	tests := []struct {
+		user, prefix string
+		allow, deny  bool
+	}{
+		{"", "tmp/x/", true, false},
+		{"", "tmp/", true, true},      // (may select tmp/keep/...)
+		{"", "tmp/keep/", true, true}, // ditto
+		{"", "tm", false, true},
+		{"", "", false, true},
+		{"", "data/", false, true},   // (exact match under prefix)
+		{"", "data/b", false, false}, // (cannot select data/a.txt)
+		{"", "logs/", false, false},
+		{"bob", "tmp/x/", true, true},
+	}
+	for i, test := range tests {
+		allow, deny := policy.EvalPrefix(test.user, test.prefix)
+		tassert.Errorf(t, allow.Has(apc.AceObjDELETE) == test.allow, "test %d (%q, %q): expected allow=%t", i, test.user, test.prefix, test.allow)
+		tassert.Errorf(t, deny.Has(apc.AceObjDELETE) == test.deny, "test %d (%q, %q): expected deny=%t", i, test.user, test.prefix, test.deny)
+	}
+
+	// (compare with per-object evaluation)
+	for _, name := range []string{"tmp/x/1", "tmp/keep/1", "data/a.txt"} {
+		_, deny := policy.Eval("", name)
+		tassert.Errorf(t, deny.Has(apc.AceObjDELETE) == (name != "tmp/x/1"), "%q: deny=%s", name, deny.Describe(false))
+	}
+}
@@ -0,0 +1,132 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Conditional requests: If-Match, If-None-Match, If-Modified-Since, and If-Unmodified-Since
+// - https://www.rfc-editor.org/rfc/rfc9110#section-13
+//
+// An entity tag matches the object when it equals (ignoring quotes and the weak "W/" prefix)
+// any of the following: object's ETag (custom metadata), its checksum value, or its version.
+// "*" matches any existing object; in particular, `If-None-Match: *` makes PUT create-only.
+//
+// Preconditions are evaluated in the RFC 9110 (section 13.2.2) order; failed precondition
+// results in 412 (Precondition Failed), except GET and HEAD that instead return 304 (Not Modified)
+// when If-None-Match or If-Modified-Since fails.
+
+const AnyETag = "*"
+
+type Preconds struct {
+	IfModifiedSince   time.Time // zero value: not specified
+	IfUnmodifiedSince time.Time // ditto
+	IfMatch           string    // comma-separated entity tags or "*"
+	IfNoneMatch       string    // ditto
+}
+
+// returns nil when none specified (note: invalid HTTP dates are ignored, as per RFC 9110)
+func PrecondsFromHeader(hdr http.Header) (pc *Preconds) {
+	var (
+		im, inm = hdr.Get(cos.HdrIfMatch), hdr.Get(cos.HdrIfNoneMatch)
+		ims     = hdr.Get(cos.HdrIfModifiedSince)
+		ius     = hdr.Get(cos.HdrIfUnmodifiedSince)
+	)
+	if im == "" && inm == "" && ims == "" && ius == "" {
+		return nil
+	}
+	pc = &Preconds{IfMatch: im, IfNoneMatch: inm}
+	if ims != "" {
+		pc.IfModifiedSince, _ = http.ParseTime(ims)
+	}
+	if ius != "" {
+		pc.IfUnmodifiedSince, _ = http.ParseTime(ius)
+	}
+	return pc
+}
+
+func (pc *Preconds) ToHeader(hdr http.Header) {
+	if pc.IfMatch != "" {
+		hdr.Set(cos.HdrIfMatch, pc.IfMatch)
+	}
+	if pc.IfNoneMatch != "" {
+		hdr.Set(cos.HdrIfNoneMatch, pc.IfNoneMatch)
+	}
+	if !pc.IfModifiedSince.IsZero() {
+		hdr.Set(cos.HdrIfModifiedSince, pc.IfModifiedSince.UTC().Format(http.TimeFormat))
+	}
+	if !pc.IfUnmodifiedSince.IsZero() {
+		hdr.Set(cos.HdrIfUnmodifiedSince, pc.IfUnmodifiedSince.UTC().Format(http.TimeFormat))
+	}
+}
+
+// Eval evaluates preconditions against the object (nil oah: object does not exist)
+// last modified at `mtime` (zero value: unknown - date preconditions are not evaluated);
+// `read` is true for GET and HEAD.
+// Returns nil, ErrNotModified, or ErrPrecondFailed.
+func (pc *Preconds) Eval(cname string, oah cos.OAH, mtime time.Time, read bool) error {
+	var (
+		exists = oah != nil
+		mt     = mtime.Truncate(time.Second) // HTTP-date resolution
+	)
+	// 1. If-Match, or else If-Unmodified-Since
+	switch {
+	case pc.IfMatch != "":
+		if !exists || !matchETag(pc.IfMatch, oah) {
+			return NewErrPrecondFailed(cname, cos.HdrIfMatch)
+		}
+	case !pc.IfUnmodifiedSince.IsZero() && !mtime.IsZero():
+		if exists && mt.After(pc.IfUnmodifiedSince) {
+			return NewErrPrecondFailed(cname, cos.HdrIfUnmodifiedSince)
+		}
+	}
+	// 2. If-None-Match, or else If-Modified-Since (GET and HEAD only)
+	switch {
+	case pc.IfNoneMatch != "":
+		if exists && matchETag(pc.IfNoneMatch, oah) {
+			if read {
+				return NewErrNotModified(cname)
+			}
+			return NewErrPrecondFailed(cname, cos.HdrIfNoneMatch)
+		}
+	case !pc.IfModifiedSince.IsZero() && !mtime.IsZero() && read:
+		if exists && !mt.After(pc.IfModifiedSince) {
+			return NewErrNotModified(cname)
+		}
+	}
+	return nil
+}
+
+func matchETag(list string, oah cos.OAH) bool {
+	var (
+		etag, _ = oah.GetCustomKey(ETag)
+		ver     = oah.Version()
+		cksum   string
+	)
+	if ck := oah.Checksum(); ck != nil {
+		cksum = ck.Val()
+	}
+	etag = strings.Trim(etag, "\"")
+	for _, tag := range strings.Split(list, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == AnyETag {
+			return true
+		}
+		tag = strings.Trim(strings.TrimPrefix(tag, "W/"), "\"")
+		if tag == "" {
+			continue
+		}
+		if tag == etag || tag == cksum || tag == ver {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,102 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package cmn_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+const (
+	pcOK = iota
+	pcNotModified
+	pcFailed
+)
+
+func TestPrecondsEval(t *testing.T) {
+	var (
+		mtime = time.Date(2025, 3, 1, 12, 0, 0, 500, time.UTC)
+		oa    = &cmn.ObjAttrs{Cksum: cos.NewCksum(cos.ChecksumMD5, "abc123")}
+	)
+	oa.SetVersion("7")
+	oa.SetCustomKey(cmn.ETag, `"etag-1"`)
+
+	tests := []struct {
+		pc     cmn.Preconds
+		exists bool
+		read   bool
+		res    int
+	}{
+		// If-Match
+		{cmn.Preconds{IfMatch: `"etag-1"`}, true, true, pcOK},
+		{cmn.Preconds{IfMatch: `W/"abc123"`}, true, true, pcOK},
+		{cmn.Preconds{IfMatch: `"x", 7`}, true, false, pcOK},
+		{cmn.Preconds{IfMatch: "*"}, true, false, pcOK},
+		{cmn.Preconds{IfMatch: `"x"`}, true, true, pcFailed},
+		{cmn.Preconds{IfMatch: "*"}, false, false, pcFailed},
+
+		// If-None-Match
+		{cmn.Preconds{IfNoneMatch: "*"}, false, false, pcOK},
+		{cmn.Preconds{IfNoneMatch: "*"}, true, false, pcFailed},
+		{cmn.Preconds{IfNoneMatch: `"etag-1"`}, true, true, pcNotModified},
+		{cmn.Preconds{IfNoneMatch: `"x"`}, true, true, pcOK},
+
+		// dates (HTTP-date resolution)
+		{cmn.Preconds{IfModifiedSince: mtime.Truncate(time.Second)}, true, true, pcNotModified},
+		{cmn.Preconds{IfModifiedSince: mtime.Add(-time.Second)}, true, true, pcOK},
+		{cmn.Preconds{IfModifiedSince: mtime.Add(time.Hour)}, true, false, pcOK}, // (not applicable)
+		{cmn.Preconds{IfUnmodifiedSince: mtime.Add(-time.Second)}, true, false, pcFailed},
+		{cmn.Preconds{IfUnmodifiedSince: mtime.Truncate(time.Second)}, true, false, pcOK},
+
+		// precedence
+		{cmn.Preconds{IfMatch: "7", IfUnmodifiedSince: mtime.Add(-time.Hour)}, true, false, pcOK},
+		{cmn.Preconds{IfNoneMatch: `"x"`, IfModifiedSince: mtime.Add(time.Hour)}, true, true, pcOK},
+	}
+	for i, test := range tests {
+		var oah cos.OAH
+		if test.exists {
+			oah = oa
+		}
+		err := test.pc.Eval("o", oah, mtime, test.read)
+		res := pcOK
+		switch {
+		case cmn.IsErrNotModified(err):
+			res = pcNotModified
+		case cmn.IsErrPrecondFailed(err):
+			res = pcFailed
+		default:
+			tassert.CheckFatal(t, err)
+		}
+		tassert.Errorf(t, res == test.res, "%d: %+v: expected %d, got %d (%v)", i, test.pc, test.res, res, err)
+	}
+}
+
+func TestPrecondsHeader(t *testing.T) {
+	hdr := http.Header{}
+	tassert.Fatalf(t, cmn.PrecondsFromHeader(hdr) == nil, "expecting nil")
+
+	since := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	pc := &cmn.Preconds{IfNoneMatch: "*", IfModifiedSince: since}
+	pc.ToHeader(hdr)
+	tassert.Errorf(t, hdr.Get(cos.HdrIfModifiedSince) == "Sat, 01 Mar 2025 12:00:00 GMT", "got %q", hdr.Get(cos.HdrIfModifiedSince))
+
+	out := cmn.PrecondsFromHeader(hdr)
+	tassert.Fatalf(t, out != nil, "expecting preconditions")
+	tassert.Errorf(t, out.IfNoneMatch == "*" && out.IfMatch == "", "got %+v", out)
+	tassert.Errorf(t, out.IfModifiedSince.Equal(since) && out.IfUnmodifiedSince.IsZero(), "got %+v", out)
+
+	// invalid date is ignored
+	hdr = http.Header{}
+	hdr.Set(cos.HdrIfUnmodifiedSince, "yesterday")
+	out = cmn.PrecondsFromHeader(hdr)
+	tassert.Errorf(t, out != nil && out.IfUnmodifiedSince.IsZero(), "got %+v", out)
+}
@@ -0,0 +1,84 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"fmt"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Client-side rate limiting of remote (cloud) backend requests:
+// - configured per provider (`backend.<provider>.rate_limit` in the cluster config) and,
+//   optionally, per bucket (`rate_limit` bucket property); both apply: provider-level limit
+//   is shared by all buckets of the provider, bucket-level limit - by the bucket alone
+// - the limits are cluster-wide: each target gets 1/N of the budget, N = number of active targets
+// - in addition, throttled requests (429, 503 SlowDown) are retried with exponential backoff,
+//   and the request rate adapts: halves upon throttling and gradually recovers
+// - see ais/backend/throttle.go
+
+const (
+	DfltRateLimitRetries = 5
+	MaxRateLimitRetries  = 32
+)
+
+type (
+	RateLimitConf struct {
+		OpsPerSec   int64       `json:"ops_per_sec"`   // max remote requests per second; 0: unlimited
+		BytesPerSec cos.SizeIEC `json:"bytes_per_sec"` // max GET and PUT payload bytes per second; 0: unlimited
+		MaxRetries  int         `json:"max_retries"`   // max retries of a throttled request; 0: default
+	}
+	RateLimitConfToSet struct {
+		OpsPerSec   *int64       `json:"ops_per_sec,omitempty"`
+		BytesPerSec *cos.SizeIEC `json:"bytes_per_sec,omitempty"`
+		MaxRetries  *int         `json:"max_retries,omitempty"`
+	}
+
+	// cloud backend (aws, gcp, azure, oci) configuration
+	BackendConfCloud struct {
+		RateLimit *RateLimitConf             `json:"rate_limit,omitempty"`
+		Multipart *MultipartConf             `json:"multipart,omitempty"` // see cmn/multipart.go
+		Profiles  map[string]*BackendProfile `json:"profiles,omitempty"`  // S3-compatible endpoints (see cmn/backendprof.go)
+	}
+)
+
+func (c *RateLimitConf) Validate() error {
+	if c == nil {
+		return nil
+	}
+	if c.OpsPerSec < 0 {
+		return fmt.Errorf("invalid rate_limit.ops_per_sec %d (expecting non-negative)", c.OpsPerSec)
+	}
+	if c.BytesPerSec < 0 {
+		return fmt.Errorf("invalid rate_limit.bytes_per_sec %d (expecting non-negative)", c.BytesPerSec)
+	}
+	if c.MaxRetries < 0 || c.MaxRetries > MaxRateLimitRetries {
+		return fmt.Errorf("invalid rate_limit.max_retries %d (expecting [0, %d] range)", c.MaxRetries, MaxRateLimitRetries)
+	}
+	return nil
+}
+
+// provider-level and bucket-level rate limits, to be enforced separately - the former
+// across all buckets of a given provider, the latter (when non-zero) per bucket;
+// max retries: bucket-level overrides provider-level (and is returned as part of the former)
+func (c *BackendConf) RateLimit(provider string, bp *Bprops) (prov, bck RateLimitConf) {
+	if v, ok := c.Conf[provider].(BackendConfCloud); ok && v.RateLimit != nil {
+		prov = *v.RateLimit
+	}
+	if bp != nil {
+		bck.OpsPerSec, bck.BytesPerSec = bp.RateLimit.OpsPerSec, bp.RateLimit.BytesPerSec
+		if bp.RateLimit.MaxRetries > 0 {
+			prov.MaxRetries = bp.RateLimit.MaxRetries
+		}
+	}
+	if prov.MaxRetries == 0 {
+		prov.MaxRetries = DfltRateLimitRetries
+	}
+	bck.MaxRetries = prov.MaxRetries
+	return prov, bck
+}
+
+func (c *RateLimitConf) IsSet() bool { return c.OpsPerSec > 0 || c.BytesPerSec > 0 }
@@ -0,0 +1,73 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package cmn_test
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	jsoniter "github.com/json-iterator/go"
+)
+
+func TestRateLimitBackendConf(t *testing.T) {
+	var c cmn.BackendConf
+	err := jsoniter.Unmarshal([]byte(`{"aws": {"rate_limit": {"ops_per_sec": 3500, "bytes_per_sec": "1GiB"}}, "gcp": {}}`), &c)
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, c.Validate())
+
+	rl, brl := c.RateLimit(apc.AWS, nil)
+	tassert.Errorf(t, rl.OpsPerSec == 3500, "expected 3500, got %d", rl.OpsPerSec)
+	tassert.Errorf(t, rl.BytesPerSec == cos.GiB, "expected 1GiB, got %s", rl.BytesPerSec)
+	tassert.Errorf(t, rl.MaxRetries == cmn.DfltRateLimitRetries, "expected default retries, got %d", rl.MaxRetries)
+	tassert.Errorf(t, !brl.IsSet(), "expected no bucket-level limit, got %+v", brl)
+
+	rl, _ = c.RateLimit(apc.GCP, nil)
+	tassert.Errorf(t, rl.OpsPerSec == 0 && rl.BytesPerSec == 0, "expected unlimited, got %+v", rl)
+
+	// bucket-level limit (in addition to provider-level), bucket-level max retries
+	bp := &cmn.Bprops{Provider: apc.AWS, RateLimit: cmn.RateLimitConf{OpsPerSec: 100, MaxRetries: 10}}
+	rl, brl = c.RateLimit(apc.AWS, bp)
+	tassert.Errorf(t, rl.OpsPerSec == 3500 && rl.BytesPerSec == cos.GiB && rl.MaxRetries == 10, "unexpected %+v", rl)
+	tassert.Errorf(t, brl.IsSet() && brl.OpsPerSec == 100 && brl.BytesPerSec == 0, "unexpected %+v", brl)
+
+	// (marshal and back)
+	b, err := jsoniter.Marshal(&c)
+	tassert.CheckFatal(t, err)
+	var c2 cmn.BackendConf
+	tassert.CheckFatal(t, jsoniter.Unmarshal(b, &c2))
+	tassert.CheckFatal(t, c2.Validate())
+	rl2, _ := c2.RateLimit(apc.AWS, nil)
+	rl, _ = c.RateLimit(apc.AWS, nil)
+	tassert.Errorf(t, rl2 == rl, "expected the same after round-trip")
+
+	// invalid
+	for _, s := range []string{
+		`{"aws": {"rate_limit": {"ops_per_sec": -1}}}`,
+		`{"gcp": {"rate_limit": {"max_retries": 1000}}}`,
+	} {
+		var c cmn.BackendConf
+		tassert.CheckFatal(t, jsoniter.Unmarshal([]byte(s), &c))
+		tassert.Errorf(t, c.Validate() != nil, "expected error: %s", s)
+	}
+}
+
+func TestRateLimitBprops(t *testing.T) {
+	nvs := cos.StrKVs{"rate_limit.ops_per_sec": "500", "rate_limit.bytes_per_sec": "10MiB"}
+	toSet, err := cmn.NewBpropsToSet(nvs)
+	tassert.CheckFatal(t, err)
+
+	bp := &cmn.Bprops{Provider: apc.AWS}
+	bp.Apply(toSet)
+	tassert.Errorf(t, bp.RateLimit.OpsPerSec == 500, "expected 500, got %d", bp.RateLimit.OpsPerSec)
+	tassert.Errorf(t, bp.RateLimit.BytesPerSec == 10*cos.MiB, "expected 10MiB, got %s", bp.RateLimit.BytesPerSec)
+
+	bp.RateLimit.OpsPerSec = -1
+	tassert.Errorf(t, bp.RateLimit.Validate() != nil, "expected error")
+}
@@ -0,0 +1,127 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+)
+
+// Cross-cluster replication: continuous asynchronous one-way replication of a given
+// ais:// bucket (optionally, selected prefixes) to a bucket in a remote AIS cluster,
+// e.g.: ais://images => ais://@dc2/images
+//
+// - every PUT and DELETE gets journaled and then shipped to the remote cluster
+// - bidirectional replication is simply two one-way replications (one per cluster);
+//   replicated writes are not journaled again (no loops)
+// - each replicated object carries a replication stamp (ReplObjMD): the object version
+//   and the UUID of the cluster where it was written; replicated PUT carries the version
+//   over, so that both copies have the same one
+// - conflicts are resolved by comparing versions (higher wins; equal versions are ordered
+//   by cluster UUID): the remote cluster rejects (409) replicated PUT or DELETE that is
+//   older than its own copy; DELETE supersedes the deleted version (version + 1)
+// - requires object versioning
+// - replicated PUT and DELETE are accepted only from cluster members or from peers
+//   authenticated as admin (AuthN)
+// - x-repl-resync catches up after a partition (or when enabling replication for
+//   a bucket that already has objects)
+// - see ais/tgtrepl.go
+
+type ReplicationConf struct {
+	Dst      Bck      `json:"dst"`                // remote AIS bucket, e.g. ais://@dc2/images
+	Prefixes []string `json:"prefixes,omitempty"` // replicate only objects with these name prefixes (empty: all)
+	Disabled bool     `json:"disabled,omitempty"` // pause shipping (the journal keeps accumulating)
+}
+
+var ErrReplConflict = errors.New("replication conflict: destination has a newer version")
+
+func (c *ReplicationConf) IsActive() bool { return c != nil && !c.Dst.IsEmpty() }
+
+func (c *ReplicationConf) Match(objName string) bool {
+	if len(c.Prefixes) == 0 {
+		return true
+	}
+	for _, pref := range c.Prefixes {
+		if strings.HasPrefix(objName, pref) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *ReplicationConf) Validate(bp *Bprops) error {
+	if c == nil {
+		return nil
+	}
+	if bp.Provider != apc.AIS || !bp.BackendBck.IsEmpty() {
+		return errors.New("replication: supported only for ais:// buckets (without remote backend)")
+	}
+	if !bp.Versioning.Enabled {
+		return errors.New("replication: requires object versioning")
+	}
+	if c.Dst.Name == "" {
+		return errors.New("replication: destination bucket name is empty")
+	}
+	provider, err := NormalizeProvider(c.Dst.Provider)
+	if err != nil {
+		return fmt.Errorf("replication: %v", err)
+	}
+	c.Dst.Provider = provider
+	if !c.Dst.IsRemoteAIS() {
+		return fmt.Errorf("replication: destination %q must be a bucket in a remote AIS cluster (e.g., ais://@alias/name)",
+			c.Dst.String())
+	}
+	for _, pref := range c.Prefixes {
+		if pref == "" {
+			return errors.New("replication: empty prefix (hint: to replicate all objects, specify no prefixes)")
+		}
+	}
+	return nil
+}
+
+//
+// replication stamp
+//
+
+func NewReplStamp(version int64, clusterID string) string {
+	return strconv.FormatInt(version, 10) + "@" + clusterID
+}
+
+func ParseReplStamp(stamp string) (version int64, clusterID string, err error) {
+	s, cid, ok := strings.Cut(stamp, "@")
+	if ok {
+		version, err = strconv.ParseInt(s, 10, 64)
+	}
+	if !ok || err != nil || version < 0 || cid == "" {
+		return 0, "", fmt.Errorf("invalid replication stamp %q", stamp)
+	}
+	return version, cid, nil
+}
+
+// compare two stamps: -1, 0, or +1 (with empty or invalid stamp being the oldest);
+// equal versions are ordered by cluster ID
+func CmpReplStamp(a, b string) int {
+	va, ca, erra := ParseReplStamp(a)
+	vb, cb, errb := ParseReplStamp(b)
+	switch {
+	case erra != nil && errb != nil:
+		return 0
+	case erra != nil:
+		return -1
+	case errb != nil:
+		return 1
+	case va != vb:
+		if va < vb {
+			return -1
+		}
+		return 1
+	}
+	return strings.Compare(ca, cb)
+}
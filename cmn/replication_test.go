@@ -0,0 +1,83 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package cmn_test
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestReplicationConfValidate(t *testing.T) {
+	var (
+		dst     = cmn.Bck{Name: "images", Provider: apc.AIS, Ns: cmn.Ns{UUID: "dc2"}}
+		backend = cmn.Bck{Name: "onprem", Provider: apc.AWS}
+		vc      = cmn.VersionConf{Enabled: true}
+	)
+	tests := []struct {
+		conf  cmn.ReplicationConf
+		bp    cmn.Bprops
+		valid bool
+	}{
+		{cmn.ReplicationConf{Dst: dst}, cmn.Bprops{Provider: apc.AIS, Versioning: vc}, true},
+		{cmn.ReplicationConf{Dst: dst, Prefixes: []string{"train/", "val/"}}, cmn.Bprops{Provider: apc.AIS, Versioning: vc}, true},
+		{cmn.ReplicationConf{Dst: dst, Prefixes: []string{""}}, cmn.Bprops{Provider: apc.AIS}, false},
+		{cmn.ReplicationConf{Dst: cmn.Bck{Name: "images", Provider: apc.AIS}}, cmn.Bprops{Provider: apc.AIS}, false},
+		{cmn.ReplicationConf{Dst: cmn.Bck{Name: "images", Provider: apc.GCP}}, cmn.Bprops{Provider: apc.AIS}, false},
+		{cmn.ReplicationConf{Dst: cmn.Bck{Provider: apc.AIS, Ns: dst.Ns}}, cmn.Bprops{Provider: apc.AIS}, false},
+		{cmn.ReplicationConf{Dst: dst}, cmn.Bprops{Provider: apc.AIS, BackendBck: backend}, false},
+		{cmn.ReplicationConf{Dst: dst}, cmn.Bprops{Provider: apc.AWS}, false},
+		{cmn.ReplicationConf{Dst: dst}, cmn.Bprops{Provider: apc.AIS}, false}, // (versioning disabled)
+	}
+	for i, test := range tests {
+		err := test.conf.Validate(&test.bp)
+		tassert.Errorf(t, (err == nil) == test.valid, "test %d: expected valid=%t, got err: %v", i, test.valid, err)
+	}
+
+	conf := cmn.ReplicationConf{Dst: dst, Prefixes: []string{"train/"}}
+	tassert.Errorf(t, conf.Match("train/a.jpg") && !conf.Match("test/a.jpg"), "prefix mismatch")
+	conf.Prefixes = nil
+	tassert.Errorf(t, conf.Match("test/a.jpg"), "expected match-all")
+}
+
+func TestReplStamp(t *testing.T) {
+	var (
+		a = cmn.NewReplStamp(100, "cluster-a")
+		b = cmn.NewReplStamp(100, "cluster-b")
+		c = cmn.NewReplStamp(200, "cluster-a")
+	)
+	ver, cid, err := cmn.ParseReplStamp(c)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, ver == 200 && cid == "cluster-a", "unexpected (%d, %q)", ver, cid)
+
+	for _, s := range []string{"", "100", "100@", "abc@cluster-a", "-1@cluster-a"} {
+		_, _, err := cmn.ParseReplStamp(s)
+		tassert.Errorf(t, err != nil, "expected error parsing %q", s)
+	}
+
+	tassert.Errorf(t, cmn.CmpReplStamp(a, c) < 0 && cmn.CmpReplStamp(c, a) > 0, "expected version order")
+	tassert.Errorf(t, cmn.CmpReplStamp(a, b) < 0, "expected tie broken by cluster ID")
+	tassert.Errorf(t, cmn.CmpReplStamp(a, a) == 0, "expected equal")
+	tassert.Errorf(t, cmn.CmpReplStamp("", a) < 0 && cmn.CmpReplStamp(a, "invalid") > 0, "expected empty (invalid) to be the oldest")
+}
+
+func TestReplicationApply(t *testing.T) {
+	bp := &cmn.Bprops{Provider: apc.AIS}
+	repl := &cmn.ReplicationConf{Dst: cmn.Bck{Name: "images", Provider: apc.AIS, Ns: cmn.Ns{UUID: "dc2"}}, Prefixes: []string{"a/"}}
+	bp.Apply(&cmn.BpropsToSet{Replication: repl})
+	tassert.Fatalf(t, bp.Replication.IsActive() && bp.Replication != repl, "expected a copy of replication conf")
+	repl.Prefixes[0] = "modified/"
+	tassert.Errorf(t, bp.Replication.Prefixes[0] == "a/", "expected deep copy")
+
+	bp.Apply(&cmn.BpropsToSet{Versioning: &cmn.VersionConfToSet{Enabled: apc.Ptr(true)}})
+	tassert.Errorf(t, bp.Replication.IsActive(), "replication must stay when not specified")
+
+	bp.Apply(&cmn.BpropsToSet{Replication: &cmn.ReplicationConf{}})
+	tassert.Errorf(t, bp.Replication == nil, "expected replication detached")
+}
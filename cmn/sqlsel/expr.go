@@ -0,0 +1,587 @@
+// Package sqlsel implements the SQL subset of S3 Select (SelectObjectContent)
+// over CSV and JSON records.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package sqlsel
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+type (
+	expr interface {
+		eval(r *row) (Value, error)
+	}
+	// current row: record or its element (see FROM S3Object[*].path)
+	row struct {
+		v   Value
+		csv bool // positional _1, _2, ... references
+	}
+
+	lit struct {
+		v Value
+	}
+	seg struct {
+		name   string
+		idx    int // array index when name == ""
+		quoted bool
+	}
+	colref struct {
+		path []seg
+	}
+	unary struct {
+		x  expr
+		op string
+	}
+	binary struct {
+		l, r expr
+		op   string
+	}
+	like struct {
+		x, pat, esc expr
+		not         bool
+	}
+	isnull struct {
+		x       expr
+		not     bool
+		missing bool // IS [NOT] MISSING
+	}
+	inlist struct {
+		x    expr
+		list []expr
+		not  bool
+	}
+	between struct {
+		x, lo, hi expr
+		not       bool
+	}
+	call struct {
+		name string
+		args []expr
+	}
+	cast struct {
+		x   expr
+		typ string
+	}
+	agg struct {
+		x     expr // nil for COUNT(*)
+		name  string
+		res   Value
+		sum   float64
+		isum  int64
+		cnt   int64
+		float bool
+	}
+)
+
+//
+// column references
+//
+
+func (c *colref) eval(r *row) (Value, error) {
+	v := r.v
+	for i, s := range c.path {
+		switch {
+		case s.name == "":
+			if v.k != Array {
+				return Value{}, nil
+			}
+			if s.idx < 0 || s.idx >= len(v.a) {
+				return Value{}, nil
+			}
+			v = v.a[s.idx]
+		case i == 0 && r.csv && isPositional(s.name):
+			idx, _ := strconv.Atoi(s.name[1:])
+			if idx < 1 || idx > len(v.o.Vals) {
+				return Value{}, errorf(codeColumn, "invalid column index %s (have %d columns)", s.name, len(v.o.Vals))
+			}
+			v = v.o.Vals[idx-1]
+		default:
+			if v.k != Obj {
+				return Value{}, nil
+			}
+			fv, ok := v.o.Get(s.name, !s.quoted)
+			if !ok {
+				return Value{}, nil
+			}
+			v = fv
+		}
+	}
+	return v, nil
+}
+
+// output name of the projected column
+func (c *colref) name() string {
+	for i := len(c.path) - 1; i >= 0; i-- {
+		if c.path[i].name != "" {
+			return c.path[i].name
+		}
+	}
+	return ""
+}
+
+func isPositional(name string) bool {
+	if len(name) < 2 || name[0] != '_' {
+		return false
+	}
+	for i := 1; i < len(name); i++ {
+		if !isDigit(name[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+//
+// operators
+//
+
+func (l *lit) eval(*row) (Value, error) { return l.v, nil }
+
+func (u *unary) eval(r *row) (Value, error) {
+	v, err := u.x.eval(r)
+	if err != nil || v.isNull() {
+		return vnull, err
+	}
+	if u.op == "NOT" {
+		b, ok := v.truth()
+		if !ok {
+			return vnull, nil
+		}
+		return BoolVal(!b), nil
+	}
+	n, ok := v.num()
+	if !ok {
+		return vnull, errorf(codeEval, "cannot negate %q", v.String())
+	}
+	if n.k == Int {
+		return IntVal(-n.i), nil
+	}
+	return FloatVal(-n.f), nil
+}
+
+func (b *binary) eval(r *row) (Value, error) {
+	switch b.op {
+	case "AND", "OR":
+		return b.logical(r)
+	}
+	lv, err := b.l.eval(r)
+	if err != nil {
+		return vnull, err
+	}
+	rv, err := b.r.eval(r)
+	if err != nil {
+		return vnull, err
+	}
+	if lv.isNull() || rv.isNull() {
+		return vnull, nil
+	}
+	switch b.op {
+	case "=", "<>", "!=", "<", "<=", ">", ">=":
+		c, ok := compare(lv, rv)
+		if !ok {
+			return vnull, nil
+		}
+		switch b.op {
+		case "=":
+			return BoolVal(c == 0), nil
+		case "<>", "!=":
+			return BoolVal(c != 0), nil
+		case "<":
+			return BoolVal(c < 0), nil
+		case "<=":
+			return BoolVal(c <= 0), nil
+		case ">":
+			return BoolVal(c > 0), nil
+		default:
+			return BoolVal(c >= 0), nil
+		}
+	case "||":
+		return StrVal(lv.String() + rv.String()), nil
+	}
+	return arith(b.op, lv, rv)
+}
+
+// three-valued logic
+func (b *binary) logical(r *row) (Value, error) {
+	lv, err := b.l.eval(r)
+	if err != nil {
+		return vnull, err
+	}
+	l, lok := lv.truth()
+	if lok && l == (b.op == "OR") {
+		return BoolVal(l), nil // short-circuit
+	}
+	rv, err := b.r.eval(r)
+	if err != nil {
+		return vnull, err
+	}
+	rr, rok := rv.truth()
+	switch {
+	case rok && rr == (b.op == "OR"):
+		return BoolVal(rr), nil
+	case lok && rok:
+		return BoolVal(rr), nil
+	}
+	return vnull, nil
+}
+
+func arith(op string, a, b Value) (Value, error) {
+	na, oka := a.num()
+	nb, okb := b.num()
+	if !oka || !okb {
+		return vnull, errorf(codeEval, "invalid arithmetic operands %q %s %q", a.String(), op, b.String())
+	}
+	if na.k == Int && nb.k == Int {
+		x, y := na.i, nb.i
+		switch op {
+		case "+":
+			return IntVal(x + y), nil
+		case "-":
+			return IntVal(x - y), nil
+		case "*":
+			return IntVal(x * y), nil
+		case "/", "%":
+			if y == 0 {
+				return vnull, errorf(codeEval, "division by zero")
+			}
+			if op == "%" {
+				return IntVal(x % y), nil
+			}
+			if x%y == 0 {
+				return IntVal(x / y), nil
+			}
+			return FloatVal(float64(x) / float64(y)), nil
+		}
+	}
+	x, y := na.float(), nb.float()
+	switch op {
+	case "+":
+		return FloatVal(x + y), nil
+	case "-":
+		return FloatVal(x - y), nil
+	case "*":
+		return FloatVal(x * y), nil
+	}
+	if y == 0 {
+		return vnull, errorf(codeEval, "division by zero")
+	}
+	if op == "%" {
+		return FloatVal(math.Mod(x, y)), nil
+	}
+	return FloatVal(x / y), nil
+}
+
+func (l *like) eval(r *row) (Value, error) {
+	v, err := l.x.eval(r)
+	if err != nil {
+		return vnull, err
+	}
+	p, err := l.pat.eval(r)
+	if err != nil {
+		return vnull, err
+	}
+	if v.isNull() || p.isNull() {
+		return vnull, nil
+	}
+	esc := rune(-1)
+	if l.esc != nil {
+		e, err := l.esc.eval(r)
+		if err != nil {
+			return vnull, err
+		}
+		if utf8.RuneCountInString(e.String()) != 1 {
+			return vnull, errorf(codeEval, "LIKE escape must be a single character, got %q", e.String())
+		}
+		esc, _ = utf8.DecodeRuneInString(e.String())
+	}
+	return BoolVal(matchLike([]rune(v.String()), []rune(p.String()), esc) != l.not), nil
+}
+
+// '%' matches any sequence, '_' - any single character
+func matchLike(s, p []rune, esc rune) bool {
+	var (
+		si, pi     int
+		star, mark = -1, 0
+	)
+	for si < len(s) {
+		if pi < len(p) {
+			switch c := p[pi]; {
+			case c == esc && pi+1 < len(p):
+				if s[si] == p[pi+1] {
+					si++
+					pi += 2
+					continue
+				}
+			case c == '%':
+				star, mark = pi, si
+				pi++
+				continue
+			case c == '_' || c == s[si]:
+				si++
+				pi++
+				continue
+			}
+		}
+		if star < 0 {
+			return false
+		}
+		pi = star + 1
+		mark++
+		si = mark
+	}
+	for pi < len(p) && p[pi] == '%' {
+		pi++
+	}
+	return pi == len(p)
+}
+
+func (n *isnull) eval(r *row) (Value, error) {
+	v, err := n.x.eval(r)
+	if err != nil {
+		return vnull, err
+	}
+	res := v.isNull()
+	if n.missing {
+		res = v.k == Missing
+	}
+	return BoolVal(res != n.not), nil
+}
+
+func (in *inlist) eval(r *row) (Value, error) {
+	v, err := in.x.eval(r)
+	if err != nil || v.isNull() {
+		return vnull, err
+	}
+	unknown := false
+	for _, e := range in.list {
+		ev, err := e.eval(r)
+		if err != nil {
+			return vnull, err
+		}
+		if ev.isNull() {
+			unknown = true
+			continue
+		}
+		if c, ok := compare(v, ev); ok && c == 0 {
+			return BoolVal(!in.not), nil
+		}
+	}
+	if unknown {
+		return vnull, nil
+	}
+	return BoolVal(in.not), nil
+}
+
+func (b *between) eval(r *row) (Value, error) {
+	v, err := b.x.eval(r)
+	if err != nil {
+		return vnull, err
+	}
+	lo, err := b.lo.eval(r)
+	if err != nil {
+		return vnull, err
+	}
+	hi, err := b.hi.eval(r)
+	if err != nil {
+		return vnull, err
+	}
+	if v.isNull() || lo.isNull() || hi.isNull() {
+		return vnull, nil
+	}
+	c1, ok1 := compare(v, lo)
+	c2, ok2 := compare(v, hi)
+	if !ok1 || !ok2 {
+		return vnull, nil
+	}
+	return BoolVal((c1 >= 0 && c2 <= 0) != b.not), nil
+}
+
+//
+// functions
+//
+
+var funcs = map[string][2]int{ // min/max number of arguments
+	"LOWER":            {1, 1},
+	"UPPER":            {1, 1},
+	"TRIM":             {1, 1},
+	"CHAR_LENGTH":      {1, 1},
+	"CHARACTER_LENGTH": {1, 1},
+	"SUBSTRING":        {2, 3},
+	"ABS":              {1, 1},
+	"COALESCE":         {1, 64},
+	"NULLIF":           {2, 2},
+}
+
+func (c *call) eval(r *row) (Value, error) {
+	args := make([]Value, len(c.args))
+	for i, a := range c.args {
+		v, err := a.eval(r)
+		if err != nil {
+			return vnull, err
+		}
+		args[i] = v
+	}
+	switch c.name {
+	case "COALESCE":
+		for _, v := range args {
+			if !v.isNull() {
+				return v, nil
+			}
+		}
+		return vnull, nil
+	case "NULLIF":
+		if c, ok := compare(args[0], args[1]); ok && c == 0 {
+			return vnull, nil
+		}
+		return args[0], nil
+	}
+	for _, v := range args {
+		if v.isNull() {
+			return vnull, nil
+		}
+	}
+	s := args[0].String()
+	switch c.name {
+	case "LOWER":
+		return StrVal(strings.ToLower(s)), nil
+	case "UPPER":
+		return StrVal(strings.ToUpper(s)), nil
+	case "TRIM":
+		return StrVal(strings.TrimSpace(s)), nil
+	case "CHAR_LENGTH", "CHARACTER_LENGTH":
+		return IntVal(int64(utf8.RuneCountInString(s))), nil
+	case "ABS":
+		n, ok := args[0].num()
+		if !ok {
+			return vnull, errorf(codeEval, "ABS: invalid argument %q", s)
+		}
+		if n.k == Int {
+			return IntVal(max(n.i, -n.i)), nil
+		}
+		return FloatVal(math.Abs(n.f)), nil
+	default: // SUBSTRING (1-based)
+		rs := []rune(s)
+		length := int64(len(rs))
+		n, ok := args[1].num()
+		if !ok || n.k != Int {
+			return vnull, errorf(codeEval, "SUBSTRING: invalid start %q", args[1].String())
+		}
+		start := n.i
+		if len(args) > 2 {
+			n, ok := args[2].num()
+			if !ok || n.k != Int || n.i < 0 {
+				return vnull, errorf(codeEval, "SUBSTRING: invalid length %q", args[2].String())
+			}
+			length = n.i
+		}
+		end := min(start+length, int64(len(rs)+1))
+		start = max(start, 1)
+		if start >= end {
+			return StrVal(""), nil
+		}
+		return StrVal(string(rs[start-1 : end-1])), nil
+	}
+}
+
+func (c *cast) eval(r *row) (Value, error) {
+	v, err := c.x.eval(r)
+	if err != nil || v.isNull() {
+		return vnull, err
+	}
+	switch c.typ {
+	case "INT", "INTEGER":
+		n, ok := v.num()
+		if !ok {
+			return vnull, errorf(codeCast, "cannot cast %q to %s", v.String(), c.typ)
+		}
+		if n.k == Float {
+			return IntVal(int64(n.f)), nil
+		}
+		return n, nil
+	case "FLOAT", "DECIMAL", "NUMERIC", "DOUBLE", "REAL":
+		n, ok := v.num()
+		if !ok {
+			return vnull, errorf(codeCast, "cannot cast %q to %s", v.String(), c.typ)
+		}
+		return FloatVal(n.float()), nil
+	case "BOOL", "BOOLEAN":
+		b, ok := v.truth()
+		if !ok {
+			return vnull, errorf(codeCast, "cannot cast %q to %s", v.String(), c.typ)
+		}
+		return BoolVal(b), nil
+	default: // STRING, VARCHAR, CHAR
+		return StrVal(v.String()), nil
+	}
+}
+
+//
+// aggregates
+//
+
+func (a *agg) eval(*row) (Value, error) {
+	switch a.name {
+	case "COUNT":
+		return IntVal(a.cnt), nil
+	case "SUM":
+		if a.cnt == 0 {
+			return vnull, nil
+		}
+		if a.float {
+			return FloatVal(a.sum), nil
+		}
+		return IntVal(a.isum), nil
+	case "AVG":
+		if a.cnt == 0 {
+			return vnull, nil
+		}
+		return FloatVal(a.sum / float64(a.cnt)), nil
+	default: // MIN, MAX
+		if a.cnt == 0 {
+			return vnull, nil
+		}
+		return a.res, nil
+	}
+}
+
+func (a *agg) accum(r *row) error {
+	if a.x == nil {
+		a.cnt++ // COUNT(*)
+		return nil
+	}
+	v, err := a.x.eval(r)
+	if err != nil || v.isNull() {
+		return err
+	}
+	switch a.name {
+	case "COUNT":
+	case "SUM", "AVG":
+		n, ok := v.num()
+		if !ok {
+			return errorf(codeEval, "%s: invalid argument %q", a.name, v.String())
+		}
+		if n.k == Float {
+			a.float = true
+		}
+		a.isum += n.i
+		a.sum += n.float()
+	default: // MIN, MAX
+		if n, ok := v.num(); ok {
+			v = n
+		}
+		if a.cnt > 0 {
+			c, ok := compare(v, a.res)
+			if !ok || (a.name == "MIN" && c >= 0) || (a.name == "MAX" && c <= 0) {
+				break
+			}
+		}
+		a.res = v
+	}
+	a.cnt++
+	return nil
+}
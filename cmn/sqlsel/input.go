@@ -0,0 +1,307 @@
+// Package sqlsel implements the SQL subset of S3 Select (SelectObjectContent)
+// over CSV and JSON records.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package sqlsel
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	HeaderUse    = "USE"
+	HeaderIgnore = "IGNORE"
+	HeaderNone   = "NONE"
+
+	JSONDocument = "DOCUMENT"
+	JSONLines    = "LINES"
+)
+
+type (
+	CSVInput struct {
+		FileHeaderInfo       string // USE | IGNORE | NONE (default)
+		FieldDelimiter       string
+		QuoteCharacter       string
+		QuoteEscapeCharacter string
+		RecordDelimiter      string
+		Comments             string
+	}
+	JSONInput struct {
+		Type string // DOCUMENT | LINES
+	}
+
+	csvReader struct {
+		r      *csv.Reader
+		keys   []string
+		header string
+	}
+	jsonReader struct {
+		dec *json.Decoder
+	}
+)
+
+// interface guard
+var (
+	_ Reader = (*csvReader)(nil)
+	_ Reader = (*jsonReader)(nil)
+)
+
+/////////
+// CSV //
+/////////
+
+func NewCSVReader(r io.Reader, in *CSVInput) (Reader, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.LazyQuotes = true
+	if in.FieldDelimiter != "" {
+		c, err := oneRune("FieldDelimiter", in.FieldDelimiter)
+		if err != nil {
+			return nil, err
+		}
+		cr.Comma = c
+	}
+	if in.Comments != "" {
+		c, err := oneRune("Comments", in.Comments)
+		if err != nil {
+			return nil, err
+		}
+		cr.Comment = c
+	}
+	if in.QuoteCharacter != "" && in.QuoteCharacter != `"` {
+		return nil, errorf(codeArg, "unsupported QuoteCharacter %q (expecting '\"')", in.QuoteCharacter)
+	}
+	if in.QuoteEscapeCharacter != "" && in.QuoteEscapeCharacter != `"` {
+		return nil, errorf(codeArg, "unsupported QuoteEscapeCharacter %q (expecting '\"')", in.QuoteEscapeCharacter)
+	}
+	switch in.RecordDelimiter {
+	case "", "\n", "\r\n":
+	default:
+		return nil, errorf(codeArg, "unsupported RecordDelimiter %q (expecting '\\n' or '\\r\\n')", in.RecordDelimiter)
+	}
+	header := strings.ToUpper(in.FileHeaderInfo)
+	switch header {
+	case "":
+		header = HeaderNone
+	case HeaderUse, HeaderIgnore, HeaderNone:
+	default:
+		return nil, errorf(codeArg, "invalid FileHeaderInfo %q", in.FileHeaderInfo)
+	}
+	return &csvReader{r: cr, header: header}, nil
+}
+
+func oneRune(name, s string) (rune, error) {
+	c, n := utf8.DecodeRuneInString(s)
+	if n != len(s) || c == '\n' || c == '\r' || c == '"' {
+		return 0, errorf(codeArg, "invalid %s %q", name, s)
+	}
+	return c, nil
+}
+
+func (*csvReader) positional() bool { return true }
+
+func (cr *csvReader) Read() (Value, error) {
+	rec, err := cr.read()
+	if err != nil {
+		return Value{}, err
+	}
+	if cr.header != HeaderNone {
+		if cr.header == HeaderUse {
+			cr.keys = rec
+		}
+		cr.header = HeaderNone
+		if rec, err = cr.read(); err != nil {
+			return Value{}, err
+		}
+	}
+	o := &Object{Keys: cr.keys, Vals: make([]Value, len(rec))}
+	for i, s := range rec {
+		o.Vals[i] = StrVal(s)
+	}
+	// positional names when no header (or the header is shorter)
+	if len(o.Keys) < len(rec) {
+		keys := make([]string, len(rec))
+		n := copy(keys, o.Keys)
+		for i := n; i < len(rec); i++ {
+			keys[i] = "_" + strconv.Itoa(i+1)
+		}
+		o.Keys = keys
+		if n == 0 {
+			cr.keys = keys // ditto next records
+		}
+	} else if len(o.Keys) > len(rec) {
+		o.Keys = o.Keys[:len(rec)]
+	}
+	return Value{k: Obj, o: o}, nil
+}
+
+func (cr *csvReader) read() ([]string, error) {
+	rec, err := cr.r.Read()
+	if err != nil && err != io.EOF {
+		var perr *csv.ParseError
+		if errors.As(err, &perr) {
+			return nil, errorf(codeCSV, "%v", perr)
+		}
+	}
+	return rec, err
+}
+
+//////////
+// JSON //
+//////////
+
+// both DOCUMENT and LINES are a sequence of JSON values
+func NewJSONReader(r io.Reader, in *JSONInput) (Reader, error) {
+	switch strings.ToUpper(in.Type) {
+	case JSONDocument, JSONLines:
+	default:
+		return nil, errorf(codeArg, "invalid JSON Type %q (expecting %s or %s)", in.Type, JSONDocument, JSONLines)
+	}
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	return &jsonReader{dec: dec}, nil
+}
+
+func (*jsonReader) positional() bool { return false }
+
+func (jr *jsonReader) Read() (Value, error) {
+	tok, err := jr.dec.Token()
+	if err != nil {
+		return Value{}, jr.err(err)
+	}
+	return jr.value(tok)
+}
+
+func (jr *jsonReader) err(err error) error {
+	if err == io.EOF {
+		return err
+	}
+	if err == io.ErrUnexpectedEOF {
+		return errorf(codeJSON, "unexpected end of JSON input")
+	}
+	return errorf(codeJSON, "%v", err)
+}
+
+// decode preserving the order of keys
+func (jr *jsonReader) value(tok json.Token) (Value, error) {
+	switch t := tok.(type) {
+	case json.Delim:
+		var (
+			o   *Object
+			arr []Value
+		)
+		if t == '{' {
+			o = &Object{}
+		}
+		for jr.dec.More() {
+			var key string
+			if o != nil {
+				ktok, err := jr.dec.Token()
+				if err != nil {
+					return Value{}, jr.err(err)
+				}
+				key, _ = ktok.(string)
+			}
+			vtok, err := jr.dec.Token()
+			if err != nil {
+				return Value{}, jr.err(err)
+			}
+			v, err := jr.value(vtok)
+			if err != nil {
+				return Value{}, err
+			}
+			if o != nil {
+				o.Keys = append(o.Keys, key)
+				o.Vals = append(o.Vals, v)
+			} else {
+				arr = append(arr, v)
+			}
+		}
+		if _, err := jr.dec.Token(); err != nil { // closing delimiter
+			return Value{}, jr.err(err)
+		}
+		if o != nil {
+			return Value{k: Obj, o: o}, nil
+		}
+		return Value{k: Array, a: arr}, nil
+	case string:
+		return StrVal(t), nil
+	case json.Number:
+		if i, err := t.Int64(); err == nil {
+			return IntVal(i), nil
+		}
+		f, err := t.Float64()
+		if err != nil {
+			return Value{}, errorf(codeJSON, "invalid number %q", t.String())
+		}
+		return FloatVal(f), nil
+	case bool:
+		return BoolVal(t), nil
+	case nil:
+		return vnull, nil
+	}
+	return Value{}, errorf(codeJSON, "unexpected token %v", tok)
+}
+
+////////////////
+// scan range //
+////////////////
+
+// NewRangeReader returns the records (lines) that start within the given byte
+// range [start, end] (both inclusive) - see S3 ScanRange.
+func NewRangeReader(ra io.ReaderAt, size, start, end int64) io.Reader {
+	end = min(end, size-1)
+	off := max(start-1, 0)
+	rr := &rangeReader{br: bufio.NewReader(io.NewSectionReader(ra, off, size-off)), pos: off, end: end}
+	if start > 0 {
+		// skip the record that starts prior to the range (unless the range starts at a record boundary)
+		skipped, err := rr.br.ReadSlice('\n')
+		for err == bufio.ErrBufferFull {
+			rr.pos += int64(len(skipped))
+			skipped, err = rr.br.ReadSlice('\n')
+		}
+		rr.pos += int64(len(skipped))
+		rr.last = '\n'
+	}
+	return rr
+}
+
+type rangeReader struct {
+	br   *bufio.Reader
+	pos  int64 // absolute offset of the next byte
+	end  int64
+	last byte
+}
+
+func (rr *rangeReader) Read(p []byte) (n int, err error) {
+	if rr.pos <= rr.end {
+		p = p[:min(int64(len(p)), rr.end-rr.pos+1)]
+		n, err = rr.br.Read(p)
+	} else {
+		// finish the record that started within the range
+		if rr.last == '\n' {
+			return 0, io.EOF
+		}
+		var b byte
+		for n < len(p) && b != '\n' {
+			if b, err = rr.br.ReadByte(); err != nil {
+				break
+			}
+			p[n] = b
+			n++
+		}
+	}
+	if n > 0 {
+		rr.pos += int64(n)
+		rr.last = p[n-1]
+	}
+	return n, err
+}
@@ -0,0 +1,128 @@
+// Package sqlsel implements the SQL subset of S3 Select (SelectObjectContent)
+// over CSV and JSON records.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package sqlsel
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type tokType uint8
+
+const (
+	tEOF tokType = iota
+	tIdent
+	tQident // "quoted identifier"
+	tString // 'string literal'
+	tNumber
+	tOp // punctuation and operators
+)
+
+type token struct {
+	s   string
+	pos int
+	typ tokType
+}
+
+func (t *token) is(kw string) bool { return t.typ == tIdent && strings.EqualFold(t.s, kw) }
+
+func (t *token) str() string {
+	if t.typ == tEOF {
+		return "end of query"
+	}
+	return "'" + t.s + "'"
+}
+
+func lex(q string) ([]token, error) {
+	var (
+		toks = make([]token, 0, 16)
+		i    int
+	)
+	for i < len(q) {
+		c := q[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '\'' || c == '"':
+			s, n, ok := unquote(q[i:], c)
+			if !ok {
+				return nil, errorf(codeParse, "unterminated quoted string at position %d", i)
+			}
+			typ := tString
+			if c == '"' {
+				typ = tQident
+			}
+			toks = append(toks, token{s: s, pos: i, typ: typ})
+			i += n
+		case isDigit(c) || (c == '.' && i+1 < len(q) && isDigit(q[i+1])):
+			j := i
+			for j < len(q) && (isDigit(q[j]) || q[j] == '.') {
+				j++
+			}
+			if j < len(q) && (q[j] == 'e' || q[j] == 'E') {
+				k := j + 1
+				if k < len(q) && (q[k] == '+' || q[k] == '-') {
+					k++
+				}
+				if k < len(q) && isDigit(q[k]) {
+					for j = k; j < len(q) && isDigit(q[j]); j++ {
+					}
+				}
+			}
+			toks = append(toks, token{s: q[i:j], pos: i, typ: tNumber})
+			i = j
+		case c == '_' || c >= utf8.RuneSelf || unicode.IsLetter(rune(c)):
+			j := i
+			for j < len(q) {
+				r, n := utf8.DecodeRuneInString(q[j:])
+				if r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+					break
+				}
+				j += n
+			}
+			if j == i {
+				return nil, errorf(codeParse, "unexpected character at position %d", i)
+			}
+			toks = append(toks, token{s: q[i:j], pos: i, typ: tIdent})
+			i = j
+		default:
+			op := string(c)
+			if i+1 < len(q) {
+				switch two := q[i : i+2]; two {
+				case "<=", ">=", "<>", "!=", "||":
+					op = two
+				}
+			}
+			if !strings.Contains("=<>!|+-*/%(),.[];", op[:1]) || op == "!" || op == "|" {
+				return nil, errorf(codeParse, "unexpected character %q at position %d", c, i)
+			}
+			toks = append(toks, token{s: op, pos: i, typ: tOp})
+			i += len(op)
+		}
+	}
+	return append(toks, token{pos: len(q), typ: tEOF}), nil
+}
+
+// quoted string with doubled-quote escapes; returns unquoted value and consumed length
+func unquote(s string, quote byte) (string, int, bool) {
+	var sb strings.Builder
+	for i := 1; i < len(s); i++ {
+		if s[i] != quote {
+			sb.WriteByte(s[i])
+			continue
+		}
+		if i+1 < len(s) && s[i+1] == quote {
+			sb.WriteByte(quote)
+			i++
+			continue
+		}
+		return sb.String(), i + 1, true
+	}
+	return "", 0, false
+}
+
+func isDigit(c byte) bool { return c >= '0' && c <= '9' }
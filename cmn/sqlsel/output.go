@@ -0,0 +1,203 @@
+// Package sqlsel implements the SQL subset of S3 Select (SelectObjectContent)
+// over CSV and JSON records.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package sqlsel
+
+import (
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	QuoteAlways   = "ALWAYS"
+	QuoteAsNeeded = "ASNEEDED"
+)
+
+type (
+	CSVOutput struct {
+		QuoteFields          string // ALWAYS | ASNEEDED (default)
+		FieldDelimiter       string
+		QuoteCharacter       string
+		QuoteEscapeCharacter string
+		RecordDelimiter      string
+	}
+	JSONOutput struct {
+		RecordDelimiter string
+	}
+
+	csvWriter struct {
+		w       io.Writer
+		delim   string
+		rdelim  string
+		quote   string
+		escaped string // escaped quote
+		buf     []byte
+		always  bool
+	}
+	jsonWriter struct {
+		w      io.Writer
+		rdelim string
+		buf    []byte
+	}
+)
+
+// interface guard
+var (
+	_ Writer = (*csvWriter)(nil)
+	_ Writer = (*jsonWriter)(nil)
+)
+
+/////////
+// CSV //
+/////////
+
+func NewCSVWriter(w io.Writer, out *CSVOutput) (Writer, error) {
+	cw := &csvWriter{w: w, delim: ",", rdelim: "\n", quote: `"`}
+	if out.FieldDelimiter != "" {
+		cw.delim = out.FieldDelimiter
+	}
+	if out.RecordDelimiter != "" {
+		cw.rdelim = out.RecordDelimiter
+	}
+	if out.QuoteCharacter != "" {
+		if utf8.RuneCountInString(out.QuoteCharacter) != 1 {
+			return nil, errorf(codeArg, "invalid QuoteCharacter %q", out.QuoteCharacter)
+		}
+		cw.quote = out.QuoteCharacter
+	}
+	cw.escaped = cw.quote + cw.quote
+	if out.QuoteEscapeCharacter != "" {
+		cw.escaped = out.QuoteEscapeCharacter + cw.quote
+	}
+	switch strings.ToUpper(out.QuoteFields) {
+	case QuoteAlways:
+		cw.always = true
+	case "", QuoteAsNeeded:
+	default:
+		return nil, errorf(codeArg, "invalid QuoteFields %q", out.QuoteFields)
+	}
+	return cw, nil
+}
+
+func (cw *csvWriter) Write(_ []string, vals []Value) error {
+	b := cw.buf[:0]
+	for i, v := range vals {
+		if i > 0 {
+			b = append(b, cw.delim...)
+		}
+		s := v.String()
+		if cw.always || strings.Contains(s, cw.delim) || strings.Contains(s, cw.quote) ||
+			strings.ContainsAny(s, "\r\n") || strings.Contains(s, cw.rdelim) {
+			b = append(b, cw.quote...)
+			b = append(b, strings.ReplaceAll(s, cw.quote, cw.escaped)...)
+			b = append(b, cw.quote...)
+		} else {
+			b = append(b, s...)
+		}
+	}
+	b = append(b, cw.rdelim...)
+	cw.buf = b
+	_, err := cw.w.Write(b)
+	return err
+}
+
+//////////
+// JSON //
+//////////
+
+func NewJSONWriter(w io.Writer, out *JSONOutput) Writer {
+	jw := &jsonWriter{w: w, rdelim: "\n"}
+	if out.RecordDelimiter != "" {
+		jw.rdelim = out.RecordDelimiter
+	}
+	return jw
+}
+
+// MISSING values are omitted
+func (jw *jsonWriter) Write(names []string, vals []Value) error {
+	b := append(jw.buf[:0], '{')
+	for i, v := range vals {
+		if v.k == Missing {
+			continue
+		}
+		if len(b) > 1 {
+			b = append(b, ',')
+		}
+		b = appendJSONString(b, names[i])
+		b = append(b, ':')
+		b = appendJSON(b, v)
+	}
+	b = append(b, '}')
+	b = append(b, jw.rdelim...)
+	jw.buf = b
+	_, err := jw.w.Write(b)
+	return err
+}
+
+func appendJSON(b []byte, v Value) []byte {
+	switch v.k {
+	case String:
+		return appendJSONString(b, v.s)
+	case Int:
+		return strconv.AppendInt(b, v.i, 10)
+	case Float:
+		return append(b, fmtFloat(v.f)...)
+	case Bool:
+		return strconv.AppendBool(b, v.b)
+	case Obj:
+		b = append(b, '{')
+		n := 0
+		for i, fv := range v.o.Vals {
+			if fv.k == Missing {
+				continue
+			}
+			if n > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONString(b, v.o.Keys[i])
+			b = append(b, ':')
+			b = appendJSON(b, fv)
+			n++
+		}
+		return append(b, '}')
+	case Array:
+		b = append(b, '[')
+		for i, ev := range v.a {
+			if i > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSON(b, ev)
+		}
+		return append(b, ']')
+	default:
+		return append(b, "null"...)
+	}
+}
+
+const hex = "0123456789abcdef"
+
+func appendJSONString(b []byte, s string) []byte {
+	b = append(b, '"')
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '"' || c == '\\':
+			b = append(b, '\\', c)
+		case c == '\n':
+			b = append(b, '\\', 'n')
+		case c == '\r':
+			b = append(b, '\\', 'r')
+		case c == '\t':
+			b = append(b, '\\', 't')
+		case c < 0x20:
+			b = append(b, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xf])
+		default:
+			b = append(b, c)
+		}
+	}
+	return append(b, '"')
+}
@@ -0,0 +1,526 @@
+// Package sqlsel implements the SQL subset of S3 Select (SelectObjectContent)
+// over CSV and JSON records.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package sqlsel
+
+import (
+	"strconv"
+	"strings"
+)
+
+// SELECT <projection> FROM S3Object[path] [[AS] alias] [WHERE <predicate>] [LIMIT <n>]
+
+const s3object = "S3Object"
+
+var keywords = map[string]bool{
+	"SELECT": true, "FROM": true, "WHERE": true, "LIMIT": true, "AS": true,
+	"AND": true, "OR": true, "NOT": true, "LIKE": true, "ESCAPE": true, "IN": true,
+	"BETWEEN": true, "IS": true, "NULL": true, "MISSING": true, "TRUE": true, "FALSE": true,
+	"CAST": true, "FOR": true,
+}
+
+var aggs = map[string]bool{"COUNT": true, "SUM": true, "AVG": true, "MIN": true, "MAX": true}
+
+type parser struct {
+	toks  []token
+	cols  []*colref // to resolve alias when done
+	aggs  []*agg
+	i     int
+	inAgg int
+	bare  bool // column reference outside aggregate
+}
+
+func Parse(query string) (*Query, error) {
+	toks, err := lex(query)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		p = &parser{toks: toks}
+		q = &Query{limit: -1}
+	)
+	if err := p.keyword("SELECT"); err != nil {
+		return nil, err
+	}
+	if err := p.projection(q); err != nil {
+		return nil, err
+	}
+	if err := p.from(q); err != nil {
+		return nil, err
+	}
+	if p.peek().is("WHERE") {
+		p.i++
+		naggs := len(p.aggs)
+		if q.where, err = p.or(); err != nil {
+			return nil, err
+		}
+		if len(p.aggs) > naggs {
+			return nil, errorf(codeUnsupported, "aggregate functions are not allowed in WHERE clause")
+		}
+	}
+	if p.peek().is("LIMIT") {
+		p.i++
+		t := p.next()
+		n, err := strconv.ParseInt(t.s, 10, 64)
+		if t.typ != tNumber || err != nil || n < 0 {
+			return nil, errorf(codeParse, "invalid LIMIT %s", t.str())
+		}
+		q.limit = n
+	}
+	if t := p.peek(); t.typ == tOp && t.s == ";" {
+		p.i++
+	}
+	if t := p.peek(); t.typ != tEOF {
+		return nil, errorf(codeParse, "unexpected token %s at position %d", t.str(), t.pos)
+	}
+
+	// strip table alias (or S3Object) from column references
+	for _, c := range p.cols {
+		if len(c.path) > 0 && c.path[0].name != "" &&
+			((q.alias != "" && strings.EqualFold(c.path[0].name, q.alias)) || strings.EqualFold(c.path[0].name, s3object)) {
+			c.path = c.path[1:]
+		}
+	}
+	q.aggs = p.aggs
+	return q, nil
+}
+
+func (p *parser) peek() *token { return &p.toks[p.i] }
+
+func (p *parser) next() *token {
+	t := &p.toks[p.i]
+	if t.typ != tEOF {
+		p.i++
+	}
+	return t
+}
+
+func (p *parser) isOp(op string) bool {
+	t := p.peek()
+	return t.typ == tOp && t.s == op
+}
+
+func (p *parser) op(op string) error {
+	if t := p.next(); t.typ != tOp || t.s != op {
+		return errorf(codeParse, "expected '%s', got %s at position %d", op, t.str(), t.pos)
+	}
+	return nil
+}
+
+func (p *parser) keyword(kw string) error {
+	if t := p.next(); !t.is(kw) {
+		return errorf(codeParse, "expected %s, got %s at position %d", kw, t.str(), t.pos)
+	}
+	return nil
+}
+
+// optional [AS] alias
+func (p *parser) alias() (string, error) {
+	t := p.peek()
+	if t.is("AS") {
+		p.i++
+		t = p.next()
+		if t.typ != tIdent && t.typ != tQident {
+			return "", errorf(codeParse, "expected alias, got %s at position %d", t.str(), t.pos)
+		}
+		return t.s, nil
+	}
+	if t.typ == tQident || (t.typ == tIdent && !keywords[strings.ToUpper(t.s)]) {
+		p.i++
+		return t.s, nil
+	}
+	return "", nil
+}
+
+func (p *parser) projection(q *Query) error {
+	if p.isOp("*") {
+		p.i++
+		q.star = true
+		return nil
+	}
+	for {
+		x, err := p.or()
+		if err != nil {
+			return err
+		}
+		item := &projItem{x: x}
+		if item.name, err = p.alias(); err != nil {
+			return err
+		}
+		if item.name == "" {
+			if c, ok := x.(*colref); ok {
+				item.name = c.name()
+			}
+		}
+		q.proj = append(q.proj, item)
+		if !p.isOp(",") {
+			break
+		}
+		p.i++
+	}
+	if len(p.aggs) > 0 && p.bare {
+		return errorf(codeUnsupported, "cannot mix aggregate functions and column references (GROUP BY is not supported)")
+	}
+	for i, item := range q.proj {
+		if item.name == "" {
+			item.name = "_" + strconv.Itoa(i+1)
+		}
+	}
+	return nil
+}
+
+func (p *parser) from(q *Query) (err error) {
+	if err = p.keyword("FROM"); err != nil {
+		return err
+	}
+	if t := p.next(); !t.is(s3object) {
+		return errorf(codeParse, "expected %s, got %s at position %d", s3object, t.str(), t.pos)
+	}
+	for {
+		switch {
+		case p.isOp("["):
+			p.i++
+			if err := p.op("*"); err != nil {
+				return err
+			}
+			if err := p.op("]"); err != nil {
+				return err
+			}
+			q.from = append(q.from, seg{idx: -1})
+			continue
+		case p.isOp("."):
+			p.i++
+			t := p.next()
+			if t.typ != tIdent && t.typ != tQident {
+				return errorf(codeParse, "expected path element, got %s at position %d", t.str(), t.pos)
+			}
+			q.from = append(q.from, seg{name: t.s, quoted: t.typ == tQident})
+			continue
+		}
+		break
+	}
+	q.alias, err = p.alias()
+	return err
+}
+
+//
+// expressions (in the order of precedence)
+//
+
+func (p *parser) or() (expr, error) {
+	l, err := p.and()
+	for err == nil && p.peek().is("OR") {
+		p.i++
+		var r expr
+		if r, err = p.and(); err == nil {
+			l = &binary{l: l, r: r, op: "OR"}
+		}
+	}
+	return l, err
+}
+
+func (p *parser) and() (expr, error) {
+	l, err := p.not()
+	for err == nil && p.peek().is("AND") {
+		p.i++
+		var r expr
+		if r, err = p.not(); err == nil {
+			l = &binary{l: l, r: r, op: "AND"}
+		}
+	}
+	return l, err
+}
+
+func (p *parser) not() (expr, error) {
+	if p.peek().is("NOT") {
+		p.i++
+		x, err := p.not()
+		return &unary{x: x, op: "NOT"}, err
+	}
+	return p.cmp()
+}
+
+func (p *parser) cmp() (expr, error) {
+	x, err := p.add()
+	if err != nil {
+		return nil, err
+	}
+	t := p.peek()
+	if t.typ == tOp {
+		switch t.s {
+		case "=", "<>", "!=", "<", "<=", ">", ">=":
+			p.i++
+			r, err := p.add()
+			return &binary{l: x, r: r, op: t.s}, err
+		}
+		return x, nil
+	}
+	if t.is("IS") {
+		p.i++
+		n := &isnull{x: x}
+		if p.peek().is("NOT") {
+			p.i++
+			n.not = true
+		}
+		switch t := p.next(); {
+		case t.is("NULL"):
+		case t.is("MISSING"):
+			n.missing = true
+		default:
+			return nil, errorf(codeParse, "expected NULL or MISSING, got %s at position %d", t.str(), t.pos)
+		}
+		return n, nil
+	}
+	not := t.is("NOT")
+	if not {
+		p.i++
+		t = p.peek()
+	}
+	switch {
+	case t.is("LIKE"):
+		p.i++
+		l := &like{x: x, not: not}
+		if l.pat, err = p.add(); err != nil {
+			return nil, err
+		}
+		if p.peek().is("ESCAPE") {
+			p.i++
+			l.esc, err = p.add()
+		}
+		return l, err
+	case t.is("IN"):
+		p.i++
+		in := &inlist{x: x, not: not}
+		if in.list, err = p.list(); err != nil {
+			return nil, err
+		}
+		return in, nil
+	case t.is("BETWEEN"):
+		p.i++
+		b := &between{x: x, not: not}
+		if b.lo, err = p.add(); err != nil {
+			return nil, err
+		}
+		if err := p.keyword("AND"); err != nil {
+			return nil, err
+		}
+		b.hi, err = p.add()
+		return b, err
+	case not:
+		return nil, errorf(codeParse, "unexpected NOT at position %d", t.pos)
+	}
+	return x, nil
+}
+
+func (p *parser) add() (expr, error) {
+	l, err := p.mul()
+	for err == nil && (p.isOp("+") || p.isOp("-") || p.isOp("||")) {
+		op := p.next().s
+		var r expr
+		if r, err = p.mul(); err == nil {
+			l = &binary{l: l, r: r, op: op}
+		}
+	}
+	return l, err
+}
+
+func (p *parser) mul() (expr, error) {
+	l, err := p.unary()
+	for err == nil && (p.isOp("*") || p.isOp("/") || p.isOp("%")) {
+		op := p.next().s
+		var r expr
+		if r, err = p.unary(); err == nil {
+			l = &binary{l: l, r: r, op: op}
+		}
+	}
+	return l, err
+}
+
+func (p *parser) unary() (expr, error) {
+	switch {
+	case p.isOp("-"):
+		p.i++
+		x, err := p.unary()
+		return &unary{x: x, op: "-"}, err
+	case p.isOp("+"):
+		p.i++
+		return p.unary()
+	}
+	return p.primary()
+}
+
+func (p *parser) primary() (expr, error) {
+	t := p.next()
+	switch t.typ {
+	case tNumber:
+		if i, err := strconv.ParseInt(t.s, 10, 64); err == nil {
+			return &lit{IntVal(i)}, nil
+		}
+		f, err := strconv.ParseFloat(t.s, 64)
+		if err != nil {
+			return nil, errorf(codeParse, "invalid number %s at position %d", t.str(), t.pos)
+		}
+		return &lit{FloatVal(f)}, nil
+	case tString:
+		return &lit{StrVal(t.s)}, nil
+	case tOp:
+		if t.s != "(" {
+			break
+		}
+		x, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		return x, p.op(")")
+	case tQident:
+		return p.colref(t)
+	case tIdent:
+		kw := strings.ToUpper(t.s)
+		switch kw {
+		case "TRUE":
+			return &lit{vtrue}, nil
+		case "FALSE":
+			return &lit{vfalse}, nil
+		case "NULL":
+			return &lit{vnull}, nil
+		case "MISSING":
+			return &lit{Value{}}, nil
+		case "CAST":
+			return p.cast()
+		}
+		if p.isOp("(") {
+			return p.call(kw, t)
+		}
+		if keywords[kw] {
+			break
+		}
+		return p.colref(t)
+	}
+	return nil, errorf(codeParse, "unexpected token %s at position %d", t.str(), t.pos)
+}
+
+func (p *parser) colref(t *token) (expr, error) {
+	c := &colref{path: []seg{{name: t.s, quoted: t.typ == tQident}}}
+	for {
+		if p.isOp(".") {
+			p.i++
+			t := p.next()
+			if t.typ != tIdent && t.typ != tQident {
+				return nil, errorf(codeParse, "expected field name, got %s at position %d", t.str(), t.pos)
+			}
+			c.path = append(c.path, seg{name: t.s, quoted: t.typ == tQident})
+			continue
+		}
+		if p.isOp("[") {
+			p.i++
+			t := p.next()
+			idx, err := strconv.Atoi(t.s)
+			if t.typ != tNumber || err != nil {
+				return nil, errorf(codeParse, "expected array index, got %s at position %d", t.str(), t.pos)
+			}
+			if err := p.op("]"); err != nil {
+				return nil, err
+			}
+			c.path = append(c.path, seg{idx: idx})
+			continue
+		}
+		break
+	}
+	if p.inAgg == 0 {
+		p.bare = true
+	}
+	p.cols = append(p.cols, c)
+	return c, nil
+}
+
+func (p *parser) cast() (expr, error) {
+	if err := p.op("("); err != nil {
+		return nil, err
+	}
+	x, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.keyword("AS"); err != nil {
+		return nil, err
+	}
+	t := p.next()
+	typ := strings.ToUpper(t.s)
+	switch typ {
+	case "INT", "INTEGER", "FLOAT", "DECIMAL", "NUMERIC", "DOUBLE", "REAL", "BOOL", "BOOLEAN", "STRING", "VARCHAR", "CHAR":
+	default:
+		return nil, errorf(codeCast, "unsupported CAST type %s at position %d", t.str(), t.pos)
+	}
+	return &cast{x: x, typ: typ}, p.op(")")
+}
+
+func (p *parser) call(name string, t *token) (expr, error) {
+	p.i++ // '('
+	if aggs[name] {
+		if p.inAgg > 0 {
+			return nil, errorf(codeUnsupported, "nested aggregate function %s at position %d", name, t.pos)
+		}
+		a := &agg{name: name}
+		if name == "COUNT" && p.isOp("*") {
+			p.i++
+		} else {
+			p.inAgg++
+			x, err := p.or()
+			p.inAgg--
+			if err != nil {
+				return nil, err
+			}
+			a.x = x
+		}
+		p.aggs = append(p.aggs, a)
+		return a, p.op(")")
+	}
+	nargs, ok := funcs[name]
+	if !ok {
+		return nil, errorf(codeFunc, "unsupported function %s at position %d", name, t.pos)
+	}
+	c := &call{name: name}
+	if !p.isOp(")") {
+		for {
+			x, err := p.or()
+			if err != nil {
+				return nil, err
+			}
+			c.args = append(c.args, x)
+			// SUBSTRING(s FROM start [FOR length])
+			if name == "SUBSTRING" && (p.peek().is("FROM") || p.peek().is("FOR")) {
+				p.i++
+				continue
+			}
+			if !p.isOp(",") {
+				break
+			}
+			p.i++
+		}
+	}
+	if len(c.args) < nargs[0] || len(c.args) > nargs[1] {
+		return nil, errorf(codeFunc, "invalid number of arguments (%d) in %s at position %d", len(c.args), name, t.pos)
+	}
+	return c, p.op(")")
+}
+
+func (p *parser) list() ([]expr, error) {
+	if err := p.op("("); err != nil {
+		return nil, err
+	}
+	var list []expr
+	for {
+		x, err := p.add()
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, x)
+		if !p.isOp(",") {
+			break
+		}
+		p.i++
+	}
+	return list, p.op(")")
+}
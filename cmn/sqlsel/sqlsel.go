@@ -0,0 +1,197 @@
+// Package sqlsel implements the SQL subset of S3 Select (SelectObjectContent)
+// over CSV and JSON records.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package sqlsel
+
+import (
+	"fmt"
+	"io"
+)
+
+// Supported SQL:
+// - projection: `*`, column references (by name or position: _1, _2, ...), nested JSON paths
+//   (a.b[0].c), arithmetic and string (||) expressions, scalar functions (LOWER, UPPER,
+//   TRIM, CHAR_LENGTH, SUBSTRING, ABS, COALESCE, NULLIF, CAST), and aggregates (COUNT, SUM,
+//   AVG, MIN, MAX) - the latter without GROUP BY;
+// - FROM S3Object, with an optional JSON path (e.g., S3Object[*].items[*]) and table alias;
+// - WHERE predicates: comparisons, AND/OR/NOT, [NOT] LIKE, [NOT] IN, [NOT] BETWEEN,
+//   IS [NOT] NULL, IS [NOT] MISSING;
+// - LIMIT.
+//
+// Unlike SQL proper (and similar to other S3 Select implementations), a string compared
+// with a number is converted to a number, if possible - CSV fields are always strings.
+
+// error codes (compatible with S3)
+const (
+	codeParse       = "ParseUnexpectedToken"
+	codeUnsupported = "UnsupportedSqlOperation"
+	codeFunc        = "UnsupportedFunction"
+	codeCast        = "InvalidCast"
+	codeEval        = "EvaluatorInvalidArguments"
+	codeColumn      = "InvalidColumnIndex"
+	codeCSV         = "CSVParsingError"
+	codeJSON        = "JSONParsingError"
+	codeArg         = "InvalidRequestParameter"
+)
+
+type (
+	Error struct {
+		Code string
+		Msg  string
+	}
+
+	Query struct {
+		where expr
+		alias string
+		proj  []*projItem
+		from  []seg // JSON path; idx -1 stands for [*]
+		aggs  []*agg
+		limit int64 // -1 when unlimited
+		star  bool
+	}
+	projItem struct {
+		x    expr
+		name string
+	}
+
+	// input records
+	Reader interface {
+		Read() (Value, error) // io.EOF when done
+		positional() bool     // CSV
+	}
+	// output records; must not retain `vals`
+	Writer interface {
+		Write(names []string, vals []Value) error
+	}
+)
+
+func errorf(code, format string, a ...any) *Error {
+	return &Error{Code: code, Msg: fmt.Sprintf(format, a...)}
+}
+
+func (e *Error) Error() string { return e.Code + ": " + e.Msg }
+
+// Run executes the query: reads all input records (or until LIMIT is reached)
+// and writes resulting records. A Query is stateful (aggregates) - parse a new one
+// for each execution.
+func (q *Query) Run(r Reader, w Writer) error {
+	var (
+		rw    = row{csv: r.positional()}
+		ex    = &exec{q: q, w: w}
+		names []string
+	)
+	if !q.star {
+		names = make([]string, len(q.proj))
+		for i, item := range q.proj {
+			names[i] = item.name
+		}
+		ex.names = names
+		ex.vals = make([]Value, len(q.proj))
+	}
+	if q.limit == 0 {
+		return nil
+	}
+	for {
+		v, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if stop, err := ex.each(&rw, v, q.from); stop || err != nil {
+			return err
+		}
+	}
+	if len(q.aggs) == 0 {
+		return nil
+	}
+	for i, item := range q.proj {
+		v, err := item.x.eval(&rw)
+		if err != nil {
+			return err
+		}
+		ex.vals[i] = v
+	}
+	return w.Write(names, ex.vals)
+}
+
+type exec struct {
+	q     *Query
+	w     Writer
+	names []string
+	vals  []Value
+	n     int64
+}
+
+// traverse FROM path
+func (ex *exec) each(r *row, v Value, path []seg) (bool, error) {
+	for i, s := range path {
+		if s.name == "" {
+			if v.k != Array {
+				continue
+			}
+			for _, elem := range v.a {
+				if stop, err := ex.each(r, elem, path[i+1:]); stop || err != nil {
+					return stop, err
+				}
+			}
+			return false, nil
+		}
+		if v.k != Obj {
+			return false, nil
+		}
+		fv, ok := v.o.Get(s.name, !s.quoted)
+		if !ok {
+			return false, nil
+		}
+		v = fv
+	}
+	r.v = v
+	return ex.do(r)
+}
+
+func (ex *exec) do(r *row) (bool, error) {
+	q := ex.q
+	if q.where != nil {
+		v, err := q.where.eval(r)
+		if err != nil {
+			return true, err
+		}
+		if b, ok := v.truth(); !ok || !b {
+			return false, nil
+		}
+	}
+	if len(q.aggs) > 0 {
+		for _, a := range q.aggs {
+			if err := a.accum(r); err != nil {
+				return true, err
+			}
+		}
+		return false, nil
+	}
+
+	var err error
+	switch {
+	case !q.star:
+		for i, item := range q.proj {
+			if ex.vals[i], err = item.x.eval(r); err != nil {
+				return true, err
+			}
+		}
+		err = ex.w.Write(ex.names, ex.vals)
+	case r.v.k == Obj:
+		err = ex.w.Write(r.v.o.Keys, r.v.o.Vals)
+	default:
+		err = ex.w.Write(scalarName, []Value{r.v})
+	}
+	if err != nil {
+		return true, err
+	}
+	ex.n++
+	return q.limit > 0 && ex.n >= q.limit, nil
+}
+
+var scalarName = []string{"_1"}
@@ -0,0 +1,159 @@
+// Package sqlsel implements the SQL subset of S3 Select (SelectObjectContent)
+// over CSV and JSON records.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package sqlsel_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/sqlsel"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+const people = `name,age,city
+Alice,34,Boston
+Bob,27,"San Francisco, CA"
+Carol,45,Denver
+Dave,,Boston
+`
+
+const events = `{"id": 1, "user": {"name": "alice", "tags": ["a", "b"]}, "size": 10.5}
+{"id": 2, "user": {"name": "bob"}, "size": 3}
+{"id": 3, "user": {"name": "carol", "tags": []}, "size": null}
+`
+
+func run(t *testing.T, query, input string, csvin *sqlsel.CSVInput, jsonout bool) string {
+	t.Helper()
+	q, err := sqlsel.Parse(query)
+	tassert.CheckFatal(t, err)
+	var (
+		out bytes.Buffer
+		rr  sqlsel.Reader
+		rw  sqlsel.Writer
+	)
+	if csvin != nil {
+		rr, err = sqlsel.NewCSVReader(strings.NewReader(input), csvin)
+	} else {
+		rr, err = sqlsel.NewJSONReader(strings.NewReader(input), &sqlsel.JSONInput{Type: sqlsel.JSONLines})
+	}
+	tassert.CheckFatal(t, err)
+	if jsonout {
+		rw = sqlsel.NewJSONWriter(&out, &sqlsel.JSONOutput{})
+	} else {
+		rw, err = sqlsel.NewCSVWriter(&out, &sqlsel.CSVOutput{})
+		tassert.CheckFatal(t, err)
+	}
+	tassert.CheckFatal(t, q.Run(rr, rw))
+	return out.String()
+}
+
+func TestSelectCSV(t *testing.T) {
+	use := &sqlsel.CSVInput{FileHeaderInfo: sqlsel.HeaderUse}
+	tests := []struct {
+		query, expected string
+		in              *sqlsel.CSVInput
+		json            bool
+	}{
+		{query: "SELECT * FROM S3Object", in: use,
+			expected: "Alice,34,Boston\nBob,27,\"San Francisco, CA\"\nCarol,45,Denver\nDave,,Boston\n"},
+		{query: "SELECT s.name FROM S3Object s WHERE s.age > 30", in: use, expected: "Alice\nCarol\n"},
+		{query: "select name, age + 1 from s3object where city = 'Boston' and age <> ''", in: use, expected: "Alice,35\n"},
+		{query: "SELECT _1 FROM S3Object WHERE _3 LIKE 'San%' OR _1 IN ('Dave', 'Eve')", in: use, expected: "Bob\nDave\n"},
+		{query: "SELECT _1, _2 FROM S3Object LIMIT 2", in: &sqlsel.CSVInput{}, expected: "name,age\nAlice,34\n"},
+		{query: "SELECT _1 FROM S3Object WHERE _2 BETWEEN 27 AND 40", in: &sqlsel.CSVInput{FileHeaderInfo: "ignore"}, expected: "Alice\nBob\n"},
+		{query: "SELECT COUNT(*), MAX(age), AVG(CAST(age AS INT)) FROM S3Object WHERE age <> ''", in: use, expected: "3,45,35.333333333333336\n"},
+		{query: "SELECT UPPER(name) AS n, city FROM S3Object WHERE NOT city = 'Boston'", in: use, json: true,
+			expected: "{\"n\":\"BOB\",\"city\":\"San Francisco, CA\"}\n{\"n\":\"CAROL\",\"city\":\"Denver\"}\n"},
+		{query: "SELECT SUBSTRING(name, 2, 3), CHAR_LENGTH(city) FROM S3Object WHERE name = 'Carol'", in: use, expected: "aro,6\n"},
+	}
+	for _, test := range tests {
+		out := run(t, test.query, people, test.in, test.json)
+		tassert.Errorf(t, out == test.expected, "%q:\n got %q\nwant %q", test.query, out, test.expected)
+	}
+}
+
+func TestSelectJSON(t *testing.T) {
+	tests := []struct {
+		query, expected string
+	}{
+		{"SELECT s.id, s.user.name FROM S3Object s WHERE s.size >= 3.5", "{\"id\":1,\"name\":\"alice\"}\n"},
+		{"SELECT s.user.tags[1] AS t FROM S3Object s", "{\"t\":\"b\"}\n{}\n{}\n"},
+		{"SELECT id FROM S3Object WHERE user.tags IS MISSING", "{\"id\":2}\n"},
+		{"SELECT id FROM S3Object WHERE size IS NULL", "{\"id\":3}\n"},
+		{"SELECT SUM(size), COUNT(size), MIN(user.name) FROM S3Object", "{\"_1\":13.5,\"_2\":2,\"_3\":\"alice\"}\n"},
+		{"SELECT * FROM S3Object s WHERE s.id = 2", "{\"id\":2,\"user\":{\"name\":\"bob\"},\"size\":3}\n"},
+	}
+	for _, test := range tests {
+		out := run(t, test.query, events, nil, true)
+		tassert.Errorf(t, out == test.expected, "%q:\n got %q\nwant %q", test.query, out, test.expected)
+	}
+
+	// JSON document and FROM path
+	doc := `{"items": [{"k": "a", "v": 1}, {"k": "b", "v": 2}, {"k": "c", "v": 3}]}`
+	q, err := sqlsel.Parse("SELECT d.k FROM S3Object[*].items[*] d WHERE d.v % 2 = 1")
+	tassert.CheckFatal(t, err)
+	rr, err := sqlsel.NewJSONReader(strings.NewReader(doc), &sqlsel.JSONInput{Type: sqlsel.JSONDocument})
+	tassert.CheckFatal(t, err)
+	var out bytes.Buffer
+	rw, _ := sqlsel.NewCSVWriter(&out, &sqlsel.CSVOutput{QuoteFields: sqlsel.QuoteAlways, RecordDelimiter: ";"})
+	tassert.CheckFatal(t, q.Run(rr, rw))
+	tassert.Errorf(t, out.String() == `"a";"c";`, "got %q", out.String())
+}
+
+func TestSelectErrors(t *testing.T) {
+	for query, code := range map[string]string{
+		"SELECT FROM S3Object":                           "ParseUnexpectedToken",
+		"SELECT * FROM table":                            "ParseUnexpectedToken",
+		"SELECT * FROM S3Object WHERE a = 'b":            "ParseUnexpectedToken",
+		"SELECT a, COUNT(*) FROM S3Object":               "UnsupportedSqlOperation",
+		"SELECT * FROM S3Object WHERE COUNT(*) > 1":      "UnsupportedSqlOperation",
+		"SELECT REVERSE(a) FROM S3Object":                "UnsupportedFunction",
+		"SELECT CAST(a AS DATE) FROM S3Object":           "InvalidCast",
+		"SELECT * FROM S3Object LIMIT x":                 "ParseUnexpectedToken",
+		"SELECT * FROM S3Object WHERE a NOT = 1":         "ParseUnexpectedToken",
+		"SELECT * FROM S3Object WHERE a BETWEEN 1 OR 2":  "ParseUnexpectedToken",
+		"SELECT * FROM S3Object s WHERE s.a = 1 extra 1": "ParseUnexpectedToken",
+	} {
+		_, err := sqlsel.Parse(query)
+		var serr *sqlsel.Error
+		tassert.Errorf(t, errors.As(err, &serr) && serr.Code == code, "%q: expected %s, got %v", query, code, err)
+	}
+
+	// runtime
+	q, err := sqlsel.Parse("SELECT _5 FROM S3Object")
+	tassert.CheckFatal(t, err)
+	rr, _ := sqlsel.NewCSVReader(strings.NewReader("a,b\n"), &sqlsel.CSVInput{})
+	rw, _ := sqlsel.NewCSVWriter(io.Discard, &sqlsel.CSVOutput{})
+	var serr *sqlsel.Error
+	err = q.Run(rr, rw)
+	tassert.Errorf(t, errors.As(err, &serr) && serr.Code == "InvalidColumnIndex", "expected invalid column index, got %v", err)
+}
+
+func TestRangeReader(t *testing.T) {
+	const data = "aaa\nbbb\nccc\nddd\n"
+	tests := []struct {
+		start, end int64
+		expected   string
+	}{
+		{0, 0, "aaa\n"},
+		{0, 4, "aaa\nbbb\n"},
+		{1, 4, "bbb\n"},
+		{4, 7, "bbb\n"},
+		{4, 8, "bbb\nccc\n"},
+		{5, 6, ""},
+		{12, 100, "ddd\n"},
+		{13, 100, ""},
+		{0, 100, data},
+	}
+	for _, test := range tests {
+		b, err := io.ReadAll(sqlsel.NewRangeReader(strings.NewReader(data), int64(len(data)), test.start, test.end))
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, string(b) == test.expected, "[%d, %d]: got %q, want %q", test.start, test.end, b, test.expected)
+	}
+}
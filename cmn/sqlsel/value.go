@@ -0,0 +1,187 @@
+// Package sqlsel implements the SQL subset of S3 Select (SelectObjectContent)
+// over CSV and JSON records.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package sqlsel
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+type Kind uint8
+
+const (
+	Missing Kind = iota // e.g., JSON record without the referenced field
+	Null
+	Bool
+	Int
+	Float
+	String
+	Obj
+	Array
+)
+
+type (
+	Value struct {
+		o *Object
+		s string
+		a []Value
+		i int64
+		f float64
+		k Kind
+		b bool
+	}
+	// (ordered) JSON object; CSV record
+	Object struct {
+		Keys []string
+		Vals []Value
+	}
+)
+
+var (
+	vnull  = Value{k: Null}
+	vtrue  = Value{k: Bool, b: true}
+	vfalse = Value{k: Bool}
+)
+
+func StrVal(s string) Value    { return Value{k: String, s: s} }
+func IntVal(i int64) Value     { return Value{k: Int, i: i} }
+func FloatVal(f float64) Value { return Value{k: Float, f: f} }
+func BoolVal(b bool) Value {
+	if b {
+		return vtrue
+	}
+	return vfalse
+}
+
+func (v Value) Kind() Kind { return v.k }
+
+func (v Value) isNull() bool { return v.k == Null || v.k == Missing }
+
+// number, including numeric string (CSV fields are always strings)
+func (v Value) num() (Value, bool) {
+	switch v.k {
+	case Int, Float:
+		return v, true
+	case String:
+		s := strings.TrimSpace(v.s)
+		if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return IntVal(i), true
+		}
+		if f, err := strconv.ParseFloat(s, 64); err == nil {
+			return FloatVal(f), true
+		}
+	}
+	return vnull, false
+}
+
+func (v Value) float() float64 {
+	if v.k == Int {
+		return float64(v.i)
+	}
+	return v.f
+}
+
+// (SQL) text representation
+func (v Value) String() string {
+	switch v.k {
+	case String:
+		return v.s
+	case Int:
+		return strconv.FormatInt(v.i, 10)
+	case Float:
+		return fmtFloat(v.f)
+	case Bool:
+		return strconv.FormatBool(v.b)
+	case Obj, Array:
+		return string(appendJSON(nil, v))
+	default:
+		return ""
+	}
+}
+
+func fmtFloat(f float64) string {
+	if f == math.Trunc(f) && math.Abs(f) < 1e15 {
+		return strconv.FormatFloat(f, 'f', 1, 64)
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// compare two non-null values; ok == false when not comparable
+func compare(a, b Value) (int, bool) {
+	if a.k == String && b.k == String {
+		return strings.Compare(a.s, b.s), true
+	}
+	if a.k == Bool && b.k == Bool {
+		switch {
+		case a.b == b.b:
+			return 0, true
+		case b.b:
+			return -1, true
+		default:
+			return 1, true
+		}
+	}
+	na, oka := a.num()
+	nb, okb := b.num()
+	if !oka || !okb {
+		if a.k == String || b.k == String {
+			// e.g. bool vs string
+			return strings.Compare(a.String(), b.String()), true
+		}
+		return 0, false
+	}
+	if na.k == Int && nb.k == Int {
+		switch {
+		case na.i < nb.i:
+			return -1, true
+		case na.i > nb.i:
+			return 1, true
+		}
+		return 0, true
+	}
+	fa, fb := na.float(), nb.float()
+	switch {
+	case fa < fb:
+		return -1, true
+	case fa > fb:
+		return 1, true
+	}
+	return 0, true
+}
+
+// truth value: true, false, or NULL (unknown)
+func (v Value) truth() (val, known bool) {
+	switch v.k {
+	case Bool:
+		return v.b, true
+	case String:
+		if b, err := strconv.ParseBool(v.s); err == nil {
+			return b, true
+		}
+	}
+	return false, false
+}
+
+////////////
+// Object //
+////////////
+
+func (o *Object) Get(name string, fold bool) (Value, bool) {
+	for i, k := range o.Keys {
+		if k == name {
+			return o.Vals[i], true
+		}
+	}
+	if fold {
+		for i, k := range o.Keys {
+			if strings.EqualFold(k, name) {
+				return o.Vals[i], true
+			}
+		}
+	}
+	return Value{}, false
+}
@@ -0,0 +1,113 @@
+// Package sse provides server-side encryption at rest: chunked AES-GCM
+// framing, data key wrapping, and key providers.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package sse
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// KeyProvider supplies cluster-managed key-encryption keys (KEKs) by ID.
+// The built-in provider is a local keyring file; external key management
+// (e.g., KMIP) plugs in via Init.
+type KeyProvider interface {
+	Name() string
+	// the key to wrap data keys of newly written objects
+	CurrentKey() (id string, kek []byte, err error)
+	// any key, current or retired, that may still wrap existing objects' data keys
+	Key(id string) (kek []byte, err error)
+}
+
+var (
+	provider KeyProvider
+	pmu      sync.RWMutex
+)
+
+var ErrNoProvider = errors.New("sse: no key provider (see AIS_SSE_KEYRING)")
+
+func Init(p KeyProvider) {
+	pmu.Lock()
+	provider = p
+	pmu.Unlock()
+}
+
+func Provider() (KeyProvider, error) {
+	pmu.RLock()
+	p := provider
+	pmu.RUnlock()
+	if p == nil {
+		return nil, ErrNoProvider
+	}
+	return p, nil
+}
+
+/////////////
+// Keyring //
+/////////////
+
+// Keyring is a JSON file, e.g.:
+//
+//	{
+//	  "current": "k2",
+//	  "keys": {"k1": "<base64 32 bytes>", "k2": "<base64 32 bytes>"}
+//	}
+//
+// Keys are never removed from the keyring while there are objects that use them.
+type (
+	Keyring struct {
+		keys    map[string][]byte
+		fqn     string
+		current string
+	}
+	keyringFile struct {
+		Keys    map[string]string `json:"keys"`
+		Current string            `json:"current"`
+	}
+)
+
+// interface guard
+var _ KeyProvider = (*Keyring)(nil)
+
+func LoadKeyring(fqn string) (*Keyring, error) {
+	b, err := os.ReadFile(fqn)
+	if err != nil {
+		return nil, err
+	}
+	var kf keyringFile
+	if err := jsoniter.Unmarshal(b, &kf); err != nil {
+		return nil, fmt.Errorf("sse: invalid keyring %q: %v", fqn, err)
+	}
+	kr := &Keyring{fqn: fqn, current: kf.Current, keys: make(map[string][]byte, len(kf.Keys))}
+	for id, s := range kf.Keys {
+		key, err := base64.StdEncoding.DecodeString(s)
+		if err != nil || len(key) != KeySize {
+			return nil, fmt.Errorf("sse: keyring %q: key %q must be %d bytes (base64-encoded)", fqn, id, KeySize)
+		}
+		kr.keys[id] = key
+	}
+	if _, ok := kr.keys[kr.current]; !ok {
+		return nil, fmt.Errorf("sse: keyring %q: current key %q not found", fqn, kr.current)
+	}
+	return kr, nil
+}
+
+func (kr *Keyring) Name() string { return "keyring(" + kr.fqn + ")" }
+
+func (kr *Keyring) CurrentKey() (string, []byte, error) {
+	return kr.current, kr.keys[kr.current], nil
+}
+
+func (kr *Keyring) Key(id string) ([]byte, error) {
+	if key, ok := kr.keys[id]; ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("sse: %s: key %q not found", kr.Name(), id)
+}
@@ -0,0 +1,292 @@
+// Package sse provides server-side encryption at rest: chunked AES-GCM
+// framing, data key wrapping, and key providers.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package sse
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/md5"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// On-disk format
+//
+// Each object is encrypted with its own randomly generated 256-bit data key (DEK).
+// The DEK, in turn, is wrapped (AES-GCM) either with a cluster-managed key (KEK)
+// from the key provider, or with a customer-provided key (SSE-C) - and stored
+// in the object's metadata.
+//
+// Plaintext is split into frames of FrameSize bytes (the last frame may be shorter).
+// Each frame is sealed separately, so that range reads only need to decrypt
+// the frames that overlap the range:
+//
+//   [ciphertext(frame 0) | tag] [ciphertext(frame 1) | tag] ... [ciphertext(last) | tag]
+//
+// Frame nonce is the (big-endian) frame index, with the "final" byte set for the
+// last frame - to detect truncation and reordering. Empty object is a single
+// empty (final) frame.
+//
+// Object's size and checksum are those of the plaintext.
+
+const (
+	Algorithm = "AES256-GCM" // (stored in object metadata)
+
+	KeySize   = 32
+	FrameSize = 64 * 1024
+	TagSize   = 16
+
+	nonceSize  = 12
+	finalFrame = 1
+)
+
+var (
+	ErrAuth       = errors.New("sse: message authentication failed")
+	ErrInvalidKey = errors.New("sse: invalid key")
+)
+
+// ciphertext size given plaintext size
+func EncSize(size int64) int64 {
+	return size + nframes(size)*TagSize
+}
+
+// plaintext size given ciphertext size (compare w/ EncSize)
+func PlainSize(encSize int64) int64 {
+	n := (encSize + FrameSize + TagSize - 1) / (FrameSize + TagSize)
+	return encSize - max(n, 1)*TagSize
+}
+
+func nframes(size int64) int64 {
+	if size == 0 {
+		return 1
+	}
+	return (size + FrameSize - 1) / FrameSize
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != KeySize {
+		return nil, ErrInvalidKey
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func nonce(b []byte, idx int64, final bool) []byte {
+	clear(b[:4])
+	if final {
+		b[0] = finalFrame
+	}
+	binary.BigEndian.PutUint64(b[4:], uint64(idx))
+	return b[:nonceSize]
+}
+
+//
+// data keys
+//
+
+func GenKey() []byte {
+	key := make([]byte, KeySize)
+	if _, err := rand.Read(key); err != nil {
+		panic(err) // (never happens)
+	}
+	return key
+}
+
+// wrap data key with the key-encryption key; returns base64
+func Wrap(kek, dek []byte) (string, error) {
+	aead, err := newAEAD(kek)
+	if err != nil {
+		return "", err
+	}
+	out := make([]byte, nonceSize, nonceSize+len(dek)+TagSize)
+	if _, err := rand.Read(out); err != nil {
+		return "", err
+	}
+	out = aead.Seal(out, out[:nonceSize], dek, nil)
+	return base64.StdEncoding.EncodeToString(out), nil
+}
+
+func Unwrap(kek []byte, wrapped string) ([]byte, error) {
+	aead, err := newAEAD(kek)
+	if err != nil {
+		return nil, err
+	}
+	b, err := base64.StdEncoding.DecodeString(wrapped)
+	if err != nil || len(b) < nonceSize+TagSize {
+		return nil, fmt.Errorf("sse: invalid wrapped key: %v", err)
+	}
+	dek, err := aead.Open(nil, b[:nonceSize], b[nonceSize:], nil)
+	if err != nil {
+		return nil, ErrAuth
+	}
+	return dek, nil
+}
+
+//
+// customer-provided key (SSE-C)
+//
+
+type CustKey struct {
+	Key []byte
+	MD5 string // base64
+}
+
+// base64-encoded key and (optionally) its base64-encoded MD5
+func NewCustKey(b64key, b64md5 string) (*CustKey, error) {
+	key, err := base64.StdEncoding.DecodeString(b64key)
+	if err != nil || len(key) != KeySize {
+		return nil, fmt.Errorf("%w: customer key must be %d bytes (base64-encoded)", ErrInvalidKey, KeySize)
+	}
+	sum := md5.Sum(key)
+	ck := &CustKey{Key: key, MD5: base64.StdEncoding.EncodeToString(sum[:])}
+	if b64md5 != "" && b64md5 != ck.MD5 {
+		return nil, fmt.Errorf("%w: customer key MD5 mismatch", ErrInvalidKey)
+	}
+	return ck, nil
+}
+
+////////////
+// Writer //
+////////////
+
+// Writer encrypts plaintext into the underlying writer; the caller must Close
+// it to write the last (final) frame - Close does not close the underlying writer.
+type Writer struct {
+	w     io.Writer
+	aead  cipher.AEAD
+	buf   []byte // plaintext frame, sealed in place
+	nonce [nonceSize]byte
+	idx   int64
+	n     int
+}
+
+// interface guard
+var _ io.WriteCloser = (*Writer)(nil)
+
+func NewWriter(w io.Writer, dek []byte) (*Writer, error) {
+	aead, err := newAEAD(dek)
+	if err != nil {
+		return nil, err
+	}
+	return &Writer{w: w, aead: aead, buf: make([]byte, FrameSize+TagSize)}, nil
+}
+
+func (ew *Writer) Write(p []byte) (written int, err error) {
+	for len(p) > 0 {
+		if ew.n == FrameSize {
+			// (not final - more to come)
+			if err = ew.seal(false); err != nil {
+				return written, err
+			}
+		}
+		n := copy(ew.buf[ew.n:FrameSize], p)
+		ew.n += n
+		written += n
+		p = p[n:]
+	}
+	return written, nil
+}
+
+func (ew *Writer) Close() error { return ew.seal(true) }
+
+func (ew *Writer) seal(final bool) error {
+	out := ew.aead.Seal(ew.buf[:0], nonce(ew.nonce[:], ew.idx, final), ew.buf[:ew.n], nil)
+	if _, err := ew.w.Write(out); err != nil {
+		return err
+	}
+	ew.idx++
+	ew.n = 0
+	return nil
+}
+
+////////////
+// Reader //
+////////////
+
+// Reader decrypts (ciphertext) reader-at given plaintext size; supports
+// sequential reads and random access (io.ReaderAt) - but not concurrently.
+type Reader struct {
+	ra    io.ReaderAt
+	aead  cipher.AEAD
+	cbuf  []byte // ciphertext frame
+	frame []byte // decrypted frame `fidx`
+	nonce [nonceSize]byte
+	size  int64
+	off   int64
+	fidx  int64
+}
+
+// interface guard
+var _ io.ReaderAt = (*Reader)(nil)
+
+func NewReader(ra io.ReaderAt, dek []byte, size int64) (*Reader, error) {
+	aead, err := newAEAD(dek)
+	if err != nil {
+		return nil, err
+	}
+	return &Reader{ra: ra, aead: aead, cbuf: make([]byte, FrameSize+TagSize), size: size, fidx: -1}, nil
+}
+
+func (r *Reader) Size() int64 { return r.size }
+
+func (r *Reader) Read(p []byte) (n int, err error) {
+	n, err = r.ReadAt(p, r.off)
+	r.off += int64(n)
+	if err == io.EOF && n > 0 {
+		err = nil
+	}
+	return n, err
+}
+
+func (r *Reader) ReadAt(p []byte, off int64) (n int, err error) {
+	if off < 0 {
+		return 0, errors.New("sse: negative offset")
+	}
+	for n < len(p) {
+		if off >= r.size {
+			return n, io.EOF
+		}
+		idx := off / FrameSize
+		if idx != r.fidx {
+			if err = r.load(idx); err != nil {
+				return n, err
+			}
+		}
+		m := copy(p[n:], r.frame[off-idx*FrameSize:])
+		n += m
+		off += int64(m)
+	}
+	return n, nil
+}
+
+func (r *Reader) load(idx int64) error {
+	var (
+		plen  = min(FrameSize, r.size-idx*FrameSize)
+		clen  = int(plen) + TagSize
+		final = idx == nframes(r.size)-1
+	)
+	m, err := r.ra.ReadAt(r.cbuf[:clen], idx*(FrameSize+TagSize))
+	if m < clen {
+		if err == nil || err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	r.fidx = -1
+	r.frame, err = r.aead.Open(r.cbuf[:0], nonce(r.nonce[:], idx, final), r.cbuf[:clen], nil)
+	if err != nil {
+		return fmt.Errorf("%w (frame %d)", ErrAuth, idx)
+	}
+	r.fidx = idx
+	return nil
+}
@@ -0,0 +1,131 @@
+// Package sse provides server-side encryption at rest: chunked AES-GCM
+// framing, data key wrapping, and key providers.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package sse_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/sse"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func encrypt(t *testing.T, dek, plain []byte) []byte {
+	var (
+		out bytes.Buffer
+		w   = &out
+	)
+	ew, err := sse.NewWriter(w, dek)
+	tassert.CheckFatal(t, err)
+	// write in odd-size pieces to cross frame boundaries
+	for b := plain; len(b) > 0; {
+		n := min(len(b), 1000+len(b)%777)
+		_, err = ew.Write(b[:n])
+		tassert.CheckFatal(t, err)
+		b = b[n:]
+	}
+	tassert.CheckFatal(t, ew.Close())
+	return out.Bytes()
+}
+
+func TestRoundtrip(t *testing.T) {
+	dek := sse.GenKey()
+	for _, size := range []int{0, 1, sse.FrameSize - 1, sse.FrameSize, sse.FrameSize + 1, 3*sse.FrameSize + 123} {
+		plain := make([]byte, size)
+		rand.Read(plain)
+
+		enc := encrypt(t, dek, plain)
+		tassert.Fatalf(t, int64(len(enc)) == sse.EncSize(int64(size)), "size %d: enc size %d != %d", size, len(enc), sse.EncSize(int64(size)))
+		tassert.Fatalf(t, sse.PlainSize(int64(len(enc))) == int64(size), "size %d: plain size %d", size, sse.PlainSize(int64(len(enc))))
+
+		r, err := sse.NewReader(bytes.NewReader(enc), dek, int64(size))
+		tassert.CheckFatal(t, err)
+		got, err := io.ReadAll(r)
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, bytes.Equal(got, plain), "size %d: plaintext mismatch", size)
+
+		// range reads
+		if size > 10 {
+			for _, off := range []int64{0, 5, int64(size / 2), int64(size - 7)} {
+				n := min(int64(sse.FrameSize+3), int64(size)-off)
+				got, err := io.ReadAll(io.NewSectionReader(r, off, n))
+				tassert.CheckFatal(t, err)
+				tassert.Fatalf(t, bytes.Equal(got, plain[off:off+n]), "size %d: range [%d, %d) mismatch", size, off, off+n)
+			}
+		}
+	}
+}
+
+func TestTamper(t *testing.T) {
+	var (
+		dek   = sse.GenKey()
+		plain = make([]byte, 2*sse.FrameSize+10)
+	)
+	rand.Read(plain)
+	enc := encrypt(t, dek, plain)
+
+	// flip a bit
+	bad := bytes.Clone(enc)
+	bad[sse.FrameSize+sse.TagSize+1] ^= 1
+	r, _ := sse.NewReader(bytes.NewReader(bad), dek, int64(len(plain)))
+	_, err := io.ReadAll(r)
+	tassert.Fatalf(t, errors.Is(err, sse.ErrAuth), "expected auth error, got %v", err)
+
+	// truncate to whole frames - the last one is not final
+	short := enc[:2*(sse.FrameSize+sse.TagSize)]
+	r, _ = sse.NewReader(bytes.NewReader(short), dek, 2*sse.FrameSize)
+	_, err = io.ReadAll(r)
+	tassert.Fatalf(t, errors.Is(err, sse.ErrAuth), "expected auth error, got %v", err)
+
+	// wrong key
+	r, _ = sse.NewReader(bytes.NewReader(enc), sse.GenKey(), int64(len(plain)))
+	_, err = io.ReadAll(r)
+	tassert.Fatalf(t, errors.Is(err, sse.ErrAuth), "expected auth error, got %v", err)
+}
+
+func TestWrapKeyring(t *testing.T) {
+	var (
+		k1, k2 = sse.GenKey(), sse.GenKey()
+		fqn    = filepath.Join(t.TempDir(), "keyring.json")
+		b64    = base64.StdEncoding.EncodeToString
+	)
+	err := os.WriteFile(fqn, []byte(`{"current": "k2", "keys": {"k1": "`+b64(k1)+`", "k2": "`+b64(k2)+`"}}`), 0o600)
+	tassert.CheckFatal(t, err)
+	kr, err := sse.LoadKeyring(fqn)
+	tassert.CheckFatal(t, err)
+
+	id, kek, err := kr.CurrentKey()
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, id == "k2" && bytes.Equal(kek, k2), "unexpected current key %q", id)
+
+	dek := sse.GenKey()
+	wrapped, err := sse.Wrap(k1, dek)
+	tassert.CheckFatal(t, err)
+	kek, err = kr.Key("k1")
+	tassert.CheckFatal(t, err)
+	unwrapped, err := sse.Unwrap(kek, wrapped)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, bytes.Equal(unwrapped, dek), "data key mismatch")
+
+	_, err = sse.Unwrap(k2, wrapped)
+	tassert.Fatalf(t, err != nil, "expected error unwrapping with a wrong key")
+	_, err = kr.Key("k3")
+	tassert.Fatalf(t, err != nil, "expected error: no such key")
+
+	// SSE-C
+	ck, err := sse.NewCustKey(b64(k1), "")
+	tassert.CheckFatal(t, err)
+	_, err = sse.NewCustKey(b64(k1), ck.MD5[1:])
+	tassert.Fatalf(t, errors.Is(err, sse.ErrInvalidKey), "expected key MD5 mismatch, got %v", err)
+	_, err = sse.NewCustKey(b64(k1[:16]), "")
+	tassert.Fatalf(t, errors.Is(err, sse.ErrInvalidKey), "expected invalid key, got %v", err)
+}
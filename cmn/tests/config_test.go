@@ -72,3 +72,20 @@ func TestValidateMpath(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateWritePolicy(t *testing.T) {
+	tests := []struct {
+		conf cmn.WritePolicyConf
+		ok   bool
+	}{
+		{cmn.WritePolicyConf{}, true},
+		{cmn.WritePolicyConf{Data: apc.WriteDelayed}, true}, // write-back
+		{cmn.WritePolicyConf{Data: apc.WriteNever}, false},
+		{cmn.WritePolicyConf{MD: apc.WriteNever}, true},
+		{cmn.WritePolicyConf{Data: "write-back"}, false},
+	}
+	for _, test := range tests {
+		err := test.conf.Validate()
+		tassert.Errorf(t, (err == nil) == test.ok, "%+v: unexpected validation result (err: %v)", test.conf, err)
+	}
+}
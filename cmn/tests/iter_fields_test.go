@@ -80,6 +80,7 @@ var _ = Describe("IterFields", func() {
 					"versioning.enabled":           false,
 					"versioning.validate_warm_get": false,
 					"versioning.synchronize":       false,
+					"versioning.retain":            0,
 
 					"checksum.type":              cos.ChecksumXXHash,
 					"checksum.validate_warm_get": false,
@@ -91,10 +92,11 @@ var _ = Describe("IterFields", func() {
 					"lru.dont_evict_time":   cos.Duration(0),
 					"lru.capacity_upd_time": cos.Duration(0),
 
-					"extra.aws.cloud_region": "us-central",
-					"extra.aws.endpoint":     "",
-					"extra.aws.profile":      "",
-					"extra.aws.max_pagesize": int64(0),
+					"extra.aws.cloud_region":    "us-central",
+					"extra.aws.endpoint":        "",
+					"extra.aws.profile":         "",
+					"extra.aws.max_pagesize":    int64(0),
+					"extra.aws.backend_profile": "",
 
 					"access":   apc.AccessAttrs(0),
 					"features": feat.Flags(0),
@@ -102,6 +104,10 @@ var _ = Describe("IterFields", func() {
 
 					"write_policy.data": apc.WritePolicy(""),
 					"write_policy.md":   apc.WritePolicy(""),
+
+					"rate_limit.ops_per_sec":   int64(0),
+					"rate_limit.bytes_per_sec": cos.SizeIEC(0),
+					"rate_limit.max_retries":   0,
 				},
 			),
 			Entry("list BpropsToSet fields",
@@ -139,6 +145,7 @@ var _ = Describe("IterFields", func() {
 					"versioning.enabled":           (*bool)(nil),
 					"versioning.validate_warm_get": (*bool)(nil),
 					"versioning.synchronize":       (*bool)(nil),
+					"versioning.retain":            (*int)(nil),
 
 					"checksum.type":              apc.Ptr(cos.ChecksumXXHash),
 					"checksum.validate_warm_get": (*bool)(nil),
@@ -156,12 +163,17 @@ var _ = Describe("IterFields", func() {
 					"write_policy.data": (*apc.WritePolicy)(nil),
 					"write_policy.md":   apc.Ptr(apc.WriteDelayed),
 
-					"extra.hdfs.ref_directory": (*string)(nil),
-					"extra.aws.cloud_region":   (*string)(nil),
-					"extra.aws.endpoint":       (*string)(nil),
-					"extra.aws.profile":        (*string)(nil),
-					"extra.aws.max_pagesize":   (*int64)(nil),
-					"extra.http.original_url":  (*string)(nil),
+					"rate_limit.ops_per_sec":   (*int64)(nil),
+					"rate_limit.bytes_per_sec": (*cos.SizeIEC)(nil),
+					"rate_limit.max_retries":   (*int)(nil),
+
+					"extra.hdfs.ref_directory":  (*string)(nil),
+					"extra.aws.cloud_region":    (*string)(nil),
+					"extra.aws.endpoint":        (*string)(nil),
+					"extra.aws.profile":         (*string)(nil),
+					"extra.aws.max_pagesize":    (*int64)(nil),
+					"extra.aws.backend_profile": (*string)(nil),
+					"extra.http.original_url":   (*string)(nil),
 				},
 			),
 			Entry("check for omit tag",
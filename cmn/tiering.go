@@ -0,0 +1,137 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Multi-backend tiering: an ais:// bucket with remote backend (`backend_bck`) and
+// an ordered chain of additional (colder) remote buckets, e.g.:
+//   tier 0: s3://onprem (the backend itself; all new objects get written here)
+//   tier 1: gs://nearline
+//   tier 2: gs://archive
+//
+// - cold GET (and HEAD) tries the tiers in order and stops at the first one that has the object
+// - the (non-first) tier that holds a given object is recorded in the object's custom metadata (TierObjMD)
+// - x-tier-migrate periodically walks the bucket and moves cold in-cluster objects down the chain:
+//   an object that has not been accessed (atime) for at least `Tiers[i].Age` gets migrated to tier i+1
+//   (written there and then deleted from its current tier)
+// - objects that are not present in-cluster (e.g., evicted) do not get migrated
+
+const (
+	MaxTiers        = 8
+	MinTierAge      = time.Hour
+	TierMigrateIval = time.Hour // (see x-tier-migrate)
+)
+
+type (
+	TierConf struct {
+		Bck Bck          `json:"bck"`           // remote bucket
+		Age cos.Duration `json:"age,omitempty"` // migrate objects not accessed for this long; zero: never migrate into this tier
+	}
+	TieringConf struct {
+		Tiers    []TierConf `json:"tiers"`              // in addition to (and following) backend bucket
+		Disabled bool       `json:"disabled,omitempty"` // disable migration (cold GET keeps using all tiers)
+	}
+)
+
+func (c *TieringConf) IsActive() bool { return c != nil && len(c.Tiers) > 0 }
+
+func (c *TieringConf) HasMigration() bool {
+	if !c.IsActive() || c.Disabled {
+		return false
+	}
+	for i := range c.Tiers {
+		if c.Tiers[i].Age > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *TieringConf) Validate(bp *Bprops) error {
+	if c == nil {
+		return nil
+	}
+	if bp.Provider != apc.AIS || bp.BackendBck.IsEmpty() {
+		return errors.New("tiering: supported only for ais:// buckets with remote backend")
+	}
+	if len(c.Tiers) == 0 {
+		return errors.New("tiering: no tiers (hint: to detach all tiers, set empty \"tiering\")")
+	}
+	if len(c.Tiers) > MaxTiers {
+		return fmt.Errorf("tiering: too many tiers (%d, max %d)", len(c.Tiers), MaxTiers)
+	}
+	var prevAge cos.Duration
+	for i := range c.Tiers {
+		tier := &c.Tiers[i]
+		if tier.Bck.Name == "" {
+			return fmt.Errorf("tiering: tier %d: bucket name is empty", i+1)
+		}
+		provider, err := NormalizeProvider(tier.Bck.Provider)
+		if err != nil {
+			return fmt.Errorf("tiering: tier %d: %v", i+1, err)
+		}
+		tier.Bck.Provider = provider
+		if !tier.Bck.IsRemote() {
+			return fmt.Errorf("tiering: tier %d: bucket %q must be remote", i+1, tier.Bck.String())
+		}
+		if tier.Bck.Equal(&bp.BackendBck) {
+			return fmt.Errorf("tiering: tier %d: bucket %q is the backend itself", i+1, tier.Bck.String())
+		}
+		for j := range i {
+			if tier.Bck.Equal(&c.Tiers[j].Bck) {
+				return fmt.Errorf("tiering: duplicate tier %q", tier.Bck.String())
+			}
+		}
+		switch {
+		case tier.Age < 0:
+			return fmt.Errorf("tiering: tier %q: invalid age %v", tier.Bck.String(), tier.Age)
+		case tier.Age == 0:
+		case tier.Age.D() < MinTierAge:
+			return fmt.Errorf("tiering: tier %q: age %v is too short (min %v)", tier.Bck.String(), tier.Age, MinTierAge)
+		case tier.Age <= prevAge:
+			return fmt.Errorf("tiering: tier %q: age %v must be greater than the previous tier's (%v)",
+				tier.Bck.String(), tier.Age, prevAge)
+		default:
+			prevAge = tier.Age
+		}
+	}
+	return nil
+}
+
+// given tier (bucket), return its position in the chain:
+// 0 for the backend itself (or nil), i+1 for `Tiers[i]`, and -1 when not found
+func (c *TieringConf) Index(bp *Bprops, tier *Bck) int {
+	if tier == nil || tier.Equal(&bp.BackendBck) {
+		return 0
+	}
+	if c != nil {
+		for i := range c.Tiers {
+			if tier.Equal(&c.Tiers[i].Bck) {
+				return i + 1
+			}
+		}
+	}
+	return -1
+}
+
+// the (coldest) tier an object that has not been accessed for `age` belongs to;
+// returns 0 (the backend) if none
+func (c *TieringConf) MigrateTo(age time.Duration) (idx int) {
+	for i := range c.Tiers {
+		if a := c.Tiers[i].Age; a > 0 && age >= a.D() {
+			idx = i + 1
+		}
+	}
+	return idx
+}
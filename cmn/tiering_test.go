@@ -0,0 +1,106 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package cmn_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestTieringConfValidate(t *testing.T) {
+	var (
+		day     = cos.Duration(24 * time.Hour)
+		backend = cmn.Bck{Name: "onprem", Provider: apc.AWS}
+		gs1     = cmn.Bck{Name: "nearline", Provider: apc.GCP}
+		gs2     = cmn.Bck{Name: "archive", Provider: "gs"}
+	)
+	tests := []struct {
+		conf    cmn.TieringConf
+		backend cmn.Bck
+		valid   bool
+	}{
+		{cmn.TieringConf{Tiers: []cmn.TierConf{{Bck: gs1}}}, backend, true},
+		{cmn.TieringConf{Tiers: []cmn.TierConf{{Bck: gs1, Age: day}, {Bck: gs2, Age: 30 * day}}}, backend, true},
+		{cmn.TieringConf{Tiers: []cmn.TierConf{{Bck: gs1}, {Bck: gs2, Age: day}}}, backend, true},
+		{cmn.TieringConf{Tiers: []cmn.TierConf{{Bck: gs1}}}, cmn.Bck{}, false},
+		{cmn.TieringConf{}, backend, false},
+		{cmn.TieringConf{Tiers: []cmn.TierConf{{Bck: backend}}}, backend, false},
+		{cmn.TieringConf{Tiers: []cmn.TierConf{{Bck: gs1}, {Bck: gs1}}}, backend, false},
+		{cmn.TieringConf{Tiers: []cmn.TierConf{{Bck: cmn.Bck{Name: "x", Provider: apc.AIS}}}}, backend, false},
+		{cmn.TieringConf{Tiers: []cmn.TierConf{{Bck: cmn.Bck{Name: "x"}}}}, backend, false},
+		{cmn.TieringConf{Tiers: []cmn.TierConf{{Bck: gs1, Age: cos.Duration(time.Minute)}}}, backend, false},
+		{cmn.TieringConf{Tiers: []cmn.TierConf{{Bck: gs1, Age: 30 * day}, {Bck: gs2, Age: day}}}, backend, false},
+	}
+	for i, test := range tests {
+		bp := &cmn.Bprops{Provider: apc.AIS, BackendBck: test.backend}
+		err := test.conf.Validate(bp)
+		tassert.Errorf(t, (err == nil) == test.valid, "test %d: expected valid=%t, got err: %v", i, test.valid, err)
+	}
+
+	// provider normalized
+	conf := cmn.TieringConf{Tiers: []cmn.TierConf{{Bck: gs2}}}
+	tassert.CheckFatal(t, conf.Validate(&cmn.Bprops{Provider: apc.AIS, BackendBck: backend}))
+	tassert.Errorf(t, conf.Tiers[0].Bck.Provider == apc.GCP, "expected %q, got %q", apc.GCP, conf.Tiers[0].Bck.Provider)
+
+	// not an ais:// bucket
+	conf = cmn.TieringConf{Tiers: []cmn.TierConf{{Bck: gs1}}}
+	tassert.Errorf(t, conf.Validate(&cmn.Bprops{Provider: apc.AWS}) != nil, "expected error for s3:// bucket")
+}
+
+func TestTieringConfMigrate(t *testing.T) {
+	var (
+		day  = cos.Duration(24 * time.Hour)
+		gs1  = cmn.Bck{Name: "nearline", Provider: apc.GCP}
+		gs2  = cmn.Bck{Name: "archive", Provider: apc.GCP}
+		bp   = &cmn.Bprops{Provider: apc.AIS, BackendBck: cmn.Bck{Name: "onprem", Provider: apc.AWS}}
+		conf = &cmn.TieringConf{Tiers: []cmn.TierConf{{Bck: gs1, Age: day}, {Bck: gs2, Age: 30 * day}}}
+	)
+	tassert.Fatalf(t, conf.HasMigration(), "expected migration")
+	for _, test := range []struct {
+		age time.Duration
+		to  int
+	}{
+		{time.Hour, 0},
+		{day.D(), 1},
+		{10 * day.D(), 1},
+		{30 * day.D(), 2},
+		{365 * day.D(), 2},
+	} {
+		to := conf.MigrateTo(test.age)
+		tassert.Errorf(t, to == test.to, "age %v: expected tier %d, got %d", test.age, test.to, to)
+	}
+
+	tassert.Errorf(t, conf.Index(bp, nil) == 0, "nil => backend")
+	tassert.Errorf(t, conf.Index(bp, &bp.BackendBck) == 0, "backend => 0")
+	tassert.Errorf(t, conf.Index(bp, &gs2) == 2, "expected 2")
+	tassert.Errorf(t, conf.Index(bp, &cmn.Bck{Name: "x", Provider: apc.GCP}) == -1, "expected -1")
+
+	conf.Disabled = true
+	tassert.Errorf(t, !conf.HasMigration(), "disabled: expected no migration")
+	conf = &cmn.TieringConf{Tiers: []cmn.TierConf{{Bck: gs1}}}
+	tassert.Errorf(t, conf.IsActive() && !conf.HasMigration(), "no ages: expected active w/ no migration")
+}
+
+func TestTieringApply(t *testing.T) {
+	bp := &cmn.Bprops{Provider: apc.AIS, BackendBck: cmn.Bck{Name: "onprem", Provider: apc.AWS}}
+	tiering := &cmn.TieringConf{Tiers: []cmn.TierConf{{Bck: cmn.Bck{Name: "archive", Provider: apc.GCP}}}}
+	bp.Apply(&cmn.BpropsToSet{Tiering: tiering})
+	tassert.Fatalf(t, bp.Tiering.IsActive() && bp.Tiering != tiering, "expected a copy of tiering conf")
+	tiering.Tiers[0].Bck.Name = "modified"
+	tassert.Errorf(t, bp.Tiering.Tiers[0].Bck.Name == "archive", "expected deep copy")
+
+	bp.Apply(&cmn.BpropsToSet{Versioning: &cmn.VersionConfToSet{Enabled: apc.Ptr(true)}})
+	tassert.Errorf(t, bp.Tiering.IsActive(), "tiering must stay when not specified")
+
+	bp.Apply(&cmn.BpropsToSet{Tiering: &cmn.TieringConf{}})
+	tassert.Errorf(t, bp.Tiering == nil, "expected tiering detached")
+}
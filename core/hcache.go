@@ -0,0 +1,160 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/hk"
+)
+
+// Remote HEAD cache (hcache): bounded, in-memory, per target.
+// Caches results of HEAD(remote object) - both attributes and "not found" - for buckets
+// configured with cmn.HeadCacheConf. Callers (notably, T.HeadCold) consult the cache and
+// populate it; local PUT and DELETE of remote objects invalidate respective entries.
+//
+// NOTE: the cache is not persistent - it starts empty upon target restart (and is not
+// shared between targets). This is intentional: entries are short-lived (see TTLs), and
+// persisting them would trade one remote HEAD for a local metadata write.
+
+const (
+	hcacheShards     = 64
+	hcacheMaxEntries = 128 * 1024 // total, all buckets
+	hcacheShardMax   = hcacheMaxEntries / hcacheShards
+
+	hcacheIval = 5 * time.Minute // HK: purge expired
+)
+
+type (
+	hentry struct {
+		oa      *cmn.ObjAttrs // nil when not found
+		expires int64         // mono time
+	}
+	hshard struct {
+		m  map[string]hentry // by uname
+		mu sync.Mutex
+	}
+	hcache struct {
+		shards [hcacheShards]hshard
+		cnt    atomic.Int64
+	}
+)
+
+func (hc *hcache) init() {
+	hk.Reg("hcache"+hk.NameSuffix, hc.housekeep, hcacheIval)
+}
+
+func (hc *hcache) shard(lom *LOM) *hshard { return &hc.shards[lom.Digest()%hcacheShards] }
+
+// returns cached attributes (a copy) or, when remembered as non-existent, http.StatusNotFound
+func HcacheGet(lom *LOM) (oa *cmn.ObjAttrs, ecode int, ok bool) {
+	if g.hcache.cnt.Load() == 0 {
+		return nil, 0, false
+	}
+	var (
+		s     = g.hcache.shard(lom)
+		uname = lom.Uname()
+	)
+	s.mu.Lock()
+	e, ok := s.m[uname]
+	if ok && e.expires < mono.NanoTime() {
+		delete(s.m, uname)
+		g.hcache.cnt.Dec()
+		ok = false
+	}
+	s.mu.Unlock()
+	if !ok {
+		return nil, 0, false
+	}
+	if e.oa == nil {
+		return nil, http.StatusNotFound, true
+	}
+	oa = &cmn.ObjAttrs{}
+	oa.CopyFrom(e.oa, false /*skip cksum*/)
+	return oa, 0, true
+}
+
+// nil oa: remember as non-existent
+func HcachePut(lom *LOM, oa *cmn.ObjAttrs, ttl time.Duration) {
+	var (
+		s = g.hcache.shard(lom)
+		e = hentry{expires: mono.NanoTime() + int64(ttl)}
+	)
+	if oa != nil {
+		e.oa = &cmn.ObjAttrs{}
+		e.oa.CopyFrom(oa, false /*skip cksum*/)
+	}
+	s.mu.Lock()
+	if s.m == nil {
+		s.m = make(map[string]hentry, 64)
+	}
+	uname := lom.Uname()
+	if _, ok := s.m[uname]; !ok {
+		if len(s.m) >= hcacheShardMax {
+			g.hcache.cnt.Sub(int64(s.evict(e.expires - int64(ttl))))
+		}
+		g.hcache.cnt.Inc()
+	}
+	s.m[uname] = e
+	s.mu.Unlock()
+}
+
+// invalidate (upon local PUT, DELETE, etc.)
+func HcacheDel(lom *LOM) {
+	if g.hcache.cnt.Load() == 0 {
+		return
+	}
+	s := g.hcache.shard(lom)
+	s.mu.Lock()
+	if _, ok := s.m[lom.Uname()]; ok {
+		delete(s.m, lom.Uname())
+		g.hcache.cnt.Dec()
+	}
+	s.mu.Unlock()
+}
+
+// make room: expired entries first; otherwise, any 1/8 (map iteration order is random)
+// (under lock)
+func (s *hshard) evict(now int64) (n int) {
+	for uname, e := range s.m {
+		if e.expires < now {
+			delete(s.m, uname)
+			n++
+		}
+	}
+	if n > 0 {
+		return n
+	}
+	for uname := range s.m {
+		delete(s.m, uname)
+		if n++; n >= hcacheShardMax/8 {
+			break
+		}
+	}
+	return n
+}
+
+func (hc *hcache) housekeep(now int64) time.Duration {
+	if hc.cnt.Load() == 0 {
+		return hcacheIval
+	}
+	for i := range hc.shards {
+		s := &hc.shards[i]
+		s.mu.Lock()
+		for uname, e := range s.m {
+			if e.expires < now {
+				delete(s.m, uname)
+				hc.cnt.Dec()
+			}
+		}
+		s.mu.Unlock()
+	}
+	return hcacheIval
+}
@@ -50,6 +50,17 @@ func (r *deferROC) Close() (err error) {
 
 // is called under rlock; unlocks on fail
 func (lom *LOM) NewDeferROC() (cos.ReadOpenCloser, error) {
+	fh, err := lom.NewHandle()
+	if err == nil {
+		return &deferROC{fh, lom.LIF()}, nil
+	}
+	lom.Unlock(false)
+	return nil, cmn.NewErrFailedTo(T, "open", lom.Cname(), err)
+}
+
+// same as above but reading encrypted content as is (ciphertext) - for the object
+// to move with its encryption attributes (see lom.IsEncrypted)
+func (lom *LOM) NewDeferRawROC() (cos.ReadOpenCloser, error) {
 	fh, err := cos.NewFileHandle(lom.FQN)
 	if err == nil {
 		return &deferROC{fh, lom.LIF()}, nil
@@ -93,7 +104,7 @@ func (*LDP) Reader(lom *LOM, latestVer, sync bool) (cos.ReadOpenCloser, cos.OAH,
 	}
 
 remote:
-	// GetObjReader and return remote (object) reader and oah for object metadata
+	// GetObjReader (from the first tier that has it) and return remote (object) reader and oah for object metadata
 	// (compare w/ T.GetCold)
 	lom.SetAtimeUnix(time.Now().UnixNano())
 	oah := &cmn.ObjAttrs{
@@ -101,7 +112,7 @@ remote:
 		Cksum: cos.NoneCksum, // will likely reassign (below)
 		Atime: lom.AtimeUnix(),
 	}
-	res := T.Backend(lom.Bck()).GetObjReader(context.Background(), lom, 0, 0)
+	res := GetObjReaderTiered(context.Background(), lom, 0, 0)
 
 	if lom.Checksum() != nil {
 		oah.Cksum = lom.Checksum()
@@ -125,6 +136,10 @@ func (lom *LOM) CheckRemoteMD(locked, sync bool, origReq *http.Request) (res CRM
 		// that doesn't provide any versioning metadata
 		return CRMD{Eq: true}
 	}
+	if lom.WriteBackPending() {
+		// the in-cluster object is the latest (yet to be written back)
+		return CRMD{Eq: true}
+	}
 
 	oa, ecode, err := T.HeadCold(lom, origReq)
 	if err == nil {
@@ -36,18 +36,10 @@ func (e *errBdir) Error() string {
 // open
 //
 
-// open read-only, return os.File
-func (lom *LOM) OpenFile() (fh *os.File, _ error) {
-	reader, err := lom.Open()
-	if err != nil {
-		return nil, err
-	}
-	fh = reader.(*os.File)
-	return fh, nil
-}
+// open read-only; decrypt if encrypted (see also lom.OpenSSE)
+func (lom *LOM) Open() (cos.LomReader, error) { return lom.OpenSSE(nil) }
 
-// same as above but return a reader
-func (lom *LOM) Open() (fh cos.LomReader, err error) {
+func (lom *LOM) open() (fh *os.File, err error) {
 	fh, err = os.Open(lom.FQN)
 	switch {
 	case err == nil:
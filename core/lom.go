@@ -73,6 +73,7 @@ type (
 		smm      *memsys.MMSA
 		locker   nameLocker
 		lchk     lchk
+		hcache   hcache
 		maxLmeta atomic.Int64
 	}
 )
@@ -109,6 +110,7 @@ func Tinit(t Target, tstats cos.StatsUpdater, config *cmn.Config, runHK bool) {
 	}
 	if runHK {
 		g.lchk.init(config)
+		g.hcache.init()
 	}
 	for i := range recordSepa {
 		recdupSepa[i] = recordSepa[i]
@@ -237,6 +239,38 @@ func (lom *LOM) WritePolicy() (p apc.WritePolicy) {
 	return
 }
 
+// data write policy "delayed": PUT completes without writing to remote backend (see ais/tgtwb.go)
+// (not supported with presigned S3 requests - the latter require the original request)
+func (lom *LOM) IsWriteBack() bool {
+	bprops := lom.Bprops()
+	return bprops != nil && bprops.WritePolicy.Data == apc.WriteDelayed && lom.Bck().IsRemote() &&
+		!bprops.Features.IsSet(feat.S3PresignedRequest)
+}
+
+// stored in-cluster but not yet written back to remote backend
+func (lom *LOM) WriteBackPending() bool {
+	_, ok := lom.GetCustomKey(cmn.WriteBackObjMD)
+	return ok
+}
+
+func (lom *LOM) ClearWriteBack() { delete(lom.md.CustomMD, cmn.WriteBackObjMD) }
+
+// write-path (on-PUT) ETL: record the ETL and the original content's checksum
+// (or drop stale ones, if any, when etlName is empty)
+func (lom *LOM) SetOrigCksum(etlName string, cksum *cos.Cksum) {
+	delete(lom.md.CustomMD, cmn.ETLObjMD)
+	delete(lom.md.CustomMD, cmn.OrigCksumTypeObjMD)
+	delete(lom.md.CustomMD, cmn.OrigCksumObjMD)
+	if etlName == "" {
+		return
+	}
+	lom.SetCustomKey(cmn.ETLObjMD, etlName)
+	if ty, val := cksum.Get(); ty != cos.ChecksumNone && val != "" {
+		lom.SetCustomKey(cmn.OrigCksumTypeObjMD, ty)
+		lom.SetCustomKey(cmn.OrigCksumObjMD, val)
+	}
+}
+
 func (lom *LOM) loaded() bool { return lom.md.lid != 0 }
 
 func (lom *LOM) HrwTarget(smap *meta.Smap) (tsi *meta.Snode, local bool, err error) {
@@ -334,6 +368,9 @@ recomp:
 	if cksumType == cos.ChecksumNone { // as far as do-no-checksum-checking bucket rules
 		return
 	}
+	if lom.SSECustMD5() != "" { // cannot decrypt without customer-provided key (and decryption authenticates)
+		return
+	}
 	if !lom.md.Cksum.IsEmpty() {
 		cksumType = lom.md.Cksum.Ty() // takes precedence on the other hand
 	}
@@ -611,7 +648,7 @@ exist:
 		return err
 	}
 	// fstat & atime
-	if lom.md.Size != size { // corruption or tampering
+	if lom.fsize() != size { // corruption or tampering
 		return cmn.NewErrLmetaCorrupted(lom.whingeSize(size))
 	}
 	lom.md.Atime = atimefs
@@ -0,0 +1,171 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// Object lock (WORM) attributes are stored in the object's custom metadata -
+// see cmn/objlock.go
+//
+// Per-bucket summary of locked objects (bckLocks) caches the result of the most
+// recent bucket walk and gets updated every time a locked object (or version) is
+// persisted. Destroying (evicting) or renaming a bucket walks the bucket only when
+// the summary is missing (e.g., upon restart) or indicates that some objects may
+// still be locked.
+
+type (
+	bckLocks struct {
+		until time.Time // max retain-until
+		gen   int64     // incremented upon each update
+		hold  bool      // legal hold
+	}
+	objLocks struct {
+		m  map[uint64]*bckLocks // by bucket ID
+		mu sync.Mutex
+	}
+)
+
+var olocks = objLocks{m: make(map[uint64]*bckLocks, 4)}
+
+func (lom *LOM) Retention() (r cmn.ObjRetention) {
+	r.Mode, _ = lom.GetCustomKey(cmn.RetainModeObjMD)
+	if s, ok := lom.GetCustomKey(cmn.RetainUntilObjMD); ok {
+		r.Until, _ = time.Parse(time.RFC3339, s)
+	}
+	if s, ok := lom.GetCustomKey(cmn.LegalHoldObjMD); ok {
+		r.LegalHold = s == cmn.LegalHoldOn
+	}
+	return r
+}
+
+// returns cmn.ErrObjLocked if the (loaded) object must not be deleted, evicted, or overwritten
+func (lom *LOM) CheckObjLock(bypassGovernance bool) error {
+	if len(lom.md.CustomMD) == 0 {
+		return nil
+	}
+	r := lom.Retention()
+	return r.Check(lom.Cname(), time.Now(), bypassGovernance)
+}
+
+// Called prior to overwriting the object with new content: returns error
+// if the existing object is locked.
+func (lom *LOM) CheckOverwrite(locked bool) error {
+	if lom.Bprops().ObjLock == nil {
+		return nil
+	}
+	prev := AllocLOM("")
+	defer FreeLOM(prev)
+	if err := prev.InitFQN(lom.FQN, lom.Bucket()); err != nil {
+		return err
+	}
+	if err := prev.Load(false /*cache it*/, locked); err != nil {
+		if cmn.IsErrObjNought(err) {
+			return nil
+		}
+		return err
+	}
+	return prev.CheckObjLock(false)
+}
+
+// New content: no legal hold and bucket's default retention, if configured
+// (object lock attributes that may have come with the content get dropped).
+func (lom *LOM) InitRetention() {
+	if len(lom.md.CustomMD) > 0 {
+		lom.setRetention("", time.Time{})
+		delete(lom.md.CustomMD, cmn.LegalHoldObjMD)
+	}
+	if conf := lom.Bprops().ObjLock; conf != nil {
+		lom.setRetention(conf.DefaultRetention(time.Now()))
+	}
+}
+
+// (caller must write-lock and load; empty mode removes retention)
+func (lom *LOM) PersistRetention(mode string, until time.Time) error {
+	debug.Assert(lom.isLockedExcl(), lom.Cname())
+	lom.setRetention(mode, until)
+	return lom.persistObjLock()
+}
+
+// ditto
+func (lom *LOM) PersistLegalHold(on bool) error {
+	debug.Assert(lom.isLockedExcl(), lom.Cname())
+	if on {
+		lom.SetCustomKey(cmn.LegalHoldObjMD, cmn.LegalHoldOn)
+	} else {
+		delete(lom.md.CustomMD, cmn.LegalHoldObjMD)
+	}
+	return lom.persistObjLock()
+}
+
+// (called upon persisting object's metadata)
+func (lom *LOM) markObjLock() {
+	if lom.Bprops() == nil || lom.Bprops().ObjLock == nil || len(lom.md.CustomMD) == 0 {
+		return
+	}
+	r := lom.Retention()
+	if !r.LegalHold && r.Until.IsZero() {
+		return
+	}
+	olocks.mu.Lock()
+	bl, ok := olocks.m[lom.Bprops().BID]
+	if ok {
+		bl.hold = bl.hold || r.LegalHold
+		if r.Until.After(bl.until) {
+			bl.until = r.Until
+		}
+		bl.gen++
+	}
+	olocks.mu.Unlock()
+}
+
+// Returns true when the bucket is known to contain no locked objects; otherwise,
+// returns generation to pass to BckObjLocksClean upon walking the bucket.
+func BckObjLocksNone(bck *meta.Bck) (bool, int64) {
+	olocks.mu.Lock()
+	defer olocks.mu.Unlock()
+	bl, ok := olocks.m[bck.Props.BID]
+	if !ok {
+		bl = &bckLocks{hold: true} // (unknown)
+		olocks.m[bck.Props.BID] = bl
+	}
+	if !bl.hold && !time.Now().Before(bl.until) {
+		return true, 0
+	}
+	return false, bl.gen
+}
+
+// Is called after having walked the bucket and found no locked objects; a concurrent
+// update (see markObjLock) takes precedence.
+func BckObjLocksClean(bck *meta.Bck, gen int64) {
+	olocks.mu.Lock()
+	if bl, ok := olocks.m[bck.Props.BID]; ok && bl.gen == gen {
+		bl.hold, bl.until = false, time.Time{}
+	}
+	olocks.mu.Unlock()
+}
+
+func (lom *LOM) setRetention(mode string, until time.Time) {
+	if mode == "" {
+		delete(lom.md.CustomMD, cmn.RetainModeObjMD)
+		delete(lom.md.CustomMD, cmn.RetainUntilObjMD)
+		return
+	}
+	lom.SetCustomKey(cmn.RetainModeObjMD, mode)
+	lom.SetCustomKey(cmn.RetainUntilObjMD, until.UTC().Format(time.RFC3339))
+}
+
+func (lom *LOM) persistObjLock() error {
+	if err := lom.syncMetaWithCopies(); err != nil {
+		return err
+	}
+	return lom.Persist()
+}
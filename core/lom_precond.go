@@ -0,0 +1,44 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+)
+
+// Conditional requests - see cmn/precond.go
+
+// evaluates preconditions against the loaded object or, when `exists` is false, against none;
+// last-modified time is the object's (in-cluster) mtime
+func (lom *LOM) EvalPreconds(pc *cmn.Preconds, exists, read bool) error {
+	if !exists {
+		return pc.Eval(lom.Cname(), nil, time.Time{}, read)
+	}
+	_, _, mtime, err := lom.Fstat(false)
+	if err != nil {
+		return err
+	}
+	return pc.Eval(lom.Cname(), lom, mtime, read)
+}
+
+// Called prior to overwriting the object with new content (compare w/ CheckOverwrite):
+// evaluates write preconditions (e.g., `If-Match`, `If-None-Match: *`) against the
+// existing object, if any.
+func (lom *LOM) CheckPreconds(pc *cmn.Preconds, locked bool) error {
+	prev := AllocLOM("")
+	defer FreeLOM(prev)
+	if err := prev.InitFQN(lom.FQN, lom.Bucket()); err != nil {
+		return err
+	}
+	if err := prev.Load(false /*cache it*/, locked); err != nil {
+		if cmn.IsErrObjNought(err) {
+			return prev.EvalPreconds(pc, false, false)
+		}
+		return err
+	}
+	return prev.EvalPreconds(pc, true, false)
+}
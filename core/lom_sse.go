@@ -0,0 +1,194 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"os"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/sse"
+)
+
+// Server-side encryption at rest: encryption attributes, including the object's
+// wrapped data key, are stored in the object's custom metadata - see cmn/encryption.go
+
+type (
+	// decrypting reader
+	sseReader struct {
+		*sse.Reader
+		fh *os.File
+	}
+	// ditto, reopenable
+	sseHandle struct {
+		sseReader
+		fqn  string
+		dek  []byte
+		size int64
+	}
+)
+
+// interface guard
+var (
+	_ cos.LomReader      = (*sseReader)(nil)
+	_ cos.ReadOpenCloser = (*sseHandle)(nil)
+)
+
+func (lom *LOM) IsEncrypted() bool {
+	_, ok := lom.GetCustomKey(cmn.SSEAlgObjMD)
+	return ok
+}
+
+// customer-provided key's MD5 (SSE-C), if any
+func (lom *LOM) SSECustMD5() string {
+	md5, _ := lom.GetCustomKey(cmn.SSECustMD5ObjMD)
+	return md5
+}
+
+// New content: drop encryption attributes that may have come with it and, if
+// encryption is required, generate (and wrap) the object's data key.
+// Returns nil data key when the object is to be stored as plaintext.
+// - ck: customer-provided key (SSE-C)
+// - encrypt: use cluster-managed key regardless of bucket configuration
+func (lom *LOM) InitSSE(ck *sse.CustKey, encrypt bool) ([]byte, error) {
+	for _, k := range [...]string{cmn.SSEAlgObjMD, cmn.SSEKeyIDObjMD, cmn.SSEKeyObjMD, cmn.SSECustMD5ObjMD} {
+		delete(lom.md.CustomMD, k)
+	}
+	var (
+		kek  []byte
+		id   string
+		err  error
+		conf = lom.Bprops().SSE
+	)
+	switch {
+	case ck != nil:
+		kek = ck.Key
+	case encrypt || conf != nil:
+		var p sse.KeyProvider
+		if p, err = sse.Provider(); err != nil {
+			return nil, err
+		}
+		if conf != nil && conf.KeyID != "" {
+			id = conf.KeyID
+			kek, err = p.Key(id)
+		} else {
+			id, kek, err = p.CurrentKey()
+		}
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, nil
+	}
+
+	dek := sse.GenKey()
+	wrapped, err := sse.Wrap(kek, dek)
+	if err != nil {
+		return nil, err
+	}
+	lom.SetCustomKey(cmn.SSEAlgObjMD, sse.Algorithm)
+	lom.SetCustomKey(cmn.SSEKeyObjMD, wrapped)
+	if ck != nil {
+		lom.SetCustomKey(cmn.SSECustMD5ObjMD, ck.MD5)
+	} else {
+		lom.SetCustomKey(cmn.SSEKeyIDObjMD, id)
+	}
+	return dek, nil
+}
+
+// unwrap the object's data key
+func (lom *LOM) dataKey(ck *sse.CustKey) ([]byte, error) {
+	wrapped, _ := lom.GetCustomKey(cmn.SSEKeyObjMD)
+	if md5 := lom.SSECustMD5(); md5 != "" {
+		switch {
+		case ck == nil:
+			return nil, cmn.NewErrSSEKey(lom.Cname(), "requires customer-provided key")
+		case ck.MD5 != md5:
+			return nil, cmn.NewErrSSEKey(lom.Cname(), "customer-provided key does not match")
+		}
+		return sse.Unwrap(ck.Key, wrapped)
+	}
+	p, err := sse.Provider()
+	if err != nil {
+		return nil, err
+	}
+	id, _ := lom.GetCustomKey(cmn.SSEKeyIDObjMD)
+	kek, err := p.Key(id)
+	if err != nil {
+		return nil, err
+	}
+	return sse.Unwrap(kek, wrapped)
+}
+
+// same as lom.Open() but with customer-provided key (SSE-C), if any
+func (lom *LOM) OpenSSE(ck *sse.CustKey) (cos.LomReader, error) {
+	fh, err := lom.open()
+	if err != nil {
+		return nil, err
+	}
+	if !lom.IsEncrypted() {
+		return fh, nil
+	}
+	dek, err := lom.dataKey(ck)
+	if err != nil {
+		fh.Close()
+		return nil, err
+	}
+	r, err := sse.NewReader(fh, dek, lom.md.Size)
+	if err != nil {
+		fh.Close()
+		return nil, err
+	}
+	return &sseReader{r, fh}, nil
+}
+
+// reopenable reader - decrypting, if need be
+func (lom *LOM) NewHandle() (cos.ReadOpenCloser, error) {
+	if !lom.IsEncrypted() {
+		fh, err := cos.NewFileHandle(lom.FQN)
+		if err != nil {
+			return nil, err
+		}
+		return fh, nil
+	}
+	dek, err := lom.dataKey(nil)
+	if err != nil {
+		return nil, err
+	}
+	return newSSEHandle(lom.FQN, dek, lom.md.Size)
+}
+
+// on-disk size
+func (lom *LOM) fsize() int64 {
+	if lom.IsEncrypted() {
+		return sse.EncSize(lom.md.Size)
+	}
+	return lom.md.Size
+}
+
+///////////////
+// sseReader //
+///////////////
+
+func (r *sseReader) Close() error { return r.fh.Close() }
+
+///////////////
+// sseHandle //
+///////////////
+
+func newSSEHandle(fqn string, dek []byte, size int64) (*sseHandle, error) {
+	fh, err := os.Open(fqn)
+	if err != nil {
+		return nil, err
+	}
+	r, err := sse.NewReader(fh, dek, size)
+	if err != nil {
+		fh.Close()
+		return nil, err
+	}
+	return &sseHandle{sseReader{r, fh}, fqn, dek, size}, nil
+}
+
+func (h *sseHandle) Open() (cos.ReadOpenCloser, error) { return newSSEHandle(h.fqn, h.dek, h.size) }
@@ -7,8 +7,10 @@ package core_test
 
 import (
 	cryptorand "crypto/rand"
+	"encoding/base64"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -17,6 +19,7 @@ import (
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/sse"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/core/mock"
@@ -33,6 +36,9 @@ var _ = Describe("LOM", func() {
 		bucketLocalA = "LOM_TEST_Local_A"
 		bucketLocalB = "LOM_TEST_Local_B"
 		bucketLocalC = "LOM_TEST_Local_C"
+		bucketLocalV = "LOM_TEST_Local_V"
+		bucketLocalL = "LOM_TEST_Local_L"
+		bucketLocalW = "LOM_TEST_Local_W" // (versioned and locked)
 
 		bucketCloudA = "LOM_TEST_Cloud_A"
 		bucketCloudB = "LOM_TEST_Cloud_B"
@@ -43,6 +49,9 @@ var _ = Describe("LOM", func() {
 	var (
 		localBckA = cmn.Bck{Name: bucketLocalA, Provider: apc.AIS, Ns: cmn.NsGlobal}
 		localBckB = cmn.Bck{Name: bucketLocalB, Provider: apc.AIS, Ns: cmn.NsGlobal}
+		localBckV = cmn.Bck{Name: bucketLocalV, Provider: apc.AIS, Ns: cmn.NsGlobal}
+		localBckL = cmn.Bck{Name: bucketLocalL, Provider: apc.AIS, Ns: cmn.NsGlobal}
+		localBckW = cmn.Bck{Name: bucketLocalW, Provider: apc.AIS, Ns: cmn.NsGlobal}
 		cloudBckA = cmn.Bck{Name: bucketCloudA, Provider: apc.AWS, Ns: cmn.NsGlobal}
 	)
 
@@ -71,6 +80,7 @@ var _ = Describe("LOM", func() {
 
 	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}, true)
 	fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{}, true)
+	fs.CSM.Reg(fs.ObjVersionType, &fs.ObjVersionContentResolver{}, true)
 
 	bmd := mock.NewBaseBownerMock(
 		meta.NewBck(
@@ -94,6 +104,31 @@ var _ = Describe("LOM", func() {
 		meta.NewBck(bucketCloudA, apc.AWS, cmn.NsGlobal, &cmn.Bprops{BID: 5}),
 		meta.NewBck(bucketCloudB, apc.AWS, cmn.NsGlobal, &cmn.Bprops{BID: 6}),
 		meta.NewBck(sameBucketName, apc.AWS, cmn.NsGlobal, &cmn.Bprops{BID: 7}),
+		meta.NewBck(
+			bucketLocalV, apc.AIS, cmn.NsGlobal,
+			&cmn.Bprops{
+				Cksum:      cmn.CksumConf{Type: cos.ChecksumXXHash},
+				Versioning: cmn.VersionConf{Enabled: true, Retain: 2},
+				BID:        8,
+			},
+		),
+		meta.NewBck(
+			bucketLocalL, apc.AIS, cmn.NsGlobal,
+			&cmn.Bprops{
+				Cksum:   cmn.CksumConf{Type: cos.ChecksumXXHash},
+				ObjLock: &cmn.ObjLockConf{Mode: cmn.ObjLockGovernance, Days: 1},
+				BID:     9,
+			},
+		),
+		meta.NewBck(
+			bucketLocalW, apc.AIS, cmn.NsGlobal,
+			&cmn.Bprops{
+				Cksum:      cmn.CksumConf{Type: cos.ChecksumXXHash},
+				Versioning: cmn.VersionConf{Enabled: true, Retain: 1},
+				ObjLock:    &cmn.ObjLockConf{},
+				BID:        10,
+			},
+		),
 	)
 
 	BeforeEach(func() {
@@ -610,6 +645,316 @@ var _ = Describe("LOM", func() {
 		})
 	})
 
+	Describe("prior versions", func() {
+		testObject := "foldr/test-obj-ver.ext"
+		fqn := mis[0].MakePathFQN(&localBckV, fs.ObjectType, testObject)
+
+		overwrite := func(lom *core.LOM, size int) string {
+			lom.Lock(true)
+			defer lom.Unlock(true)
+			retained, err := lom.RetainVersion()
+			Expect(err).NotTo(HaveOccurred())
+			createTestFile(fqn, size)
+			lom.SetSize(int64(size))
+			Expect(lom.IncVersion()).NotTo(HaveOccurred())
+			Expect(persist(lom)).NotTo(HaveOccurred())
+			return retained
+		}
+
+		It("should retain, load, and remove prior versions", func() {
+			lom := filePut(fqn, 10)
+			Expect(lom.Version()).To(Equal("1"))
+
+			Expect(overwrite(lom, 20)).To(Equal("1"))
+			Expect(overwrite(lom, 30)).To(Equal("2"))
+			Expect(overwrite(lom, 40)).To(Equal("3"))
+			Expect(lom.Version()).To(Equal("4"))
+
+			// retain = 2: the oldest is gone
+			Expect(lom.PriorVersions()).To(Equal([]string{"3", "2"}))
+			Expect(lom.VerFQN("1")).NotTo(BeAnExistingFile())
+			Expect(lom.VerFQN("2")).To(BeAnExistingFile())
+
+			vlom, err := lom.LoadVersion("2")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vlom.Lsize()).To(BeEquivalentTo(20))
+			Expect(vlom.Version()).To(Equal("2"))
+			core.FreeLOM(vlom)
+
+			_, err = lom.LoadVersion("1")
+			Expect(cos.IsNotExist(err, 0)).To(BeTrue())
+
+			lom.Lock(true)
+			Expect(lom.RemoveVersion("3")).NotTo(HaveOccurred())
+			Expect(lom.PriorVersions()).To(Equal([]string{"2"}))
+
+			// the most recent prior version becomes current
+			Expect(lom.RemoveCurrentVersion()).NotTo(HaveOccurred())
+			lom.Unlock(true)
+			Expect(lom.Version()).To(Equal("2"))
+			Expect(lom.Lsize()).To(BeEquivalentTo(20))
+			Expect(lom.PriorVersions()).To(BeEmpty())
+			Expect(lom.VerFQN("2")).NotTo(BeAnExistingFile())
+		})
+
+		It("should move prior versions together with the object", func() {
+			lom := filePut(fqn, 10)
+			overwrite(lom, 20)
+			overwrite(lom, 30)
+			Expect(lom.PriorVersions()).To(Equal([]string{"2", "1"}))
+			vfinfo, err := os.Stat(lom.VerFQN("1"))
+			Expect(err).NotTo(HaveOccurred())
+
+			// resilver (another mountpath)
+			dstFQN := mis[1].MakePathFQN(&localBckV, fs.ObjectType, testObject)
+			dst := lom.CloneMD(dstFQN)
+			Expect(dst.InitFQN(dstFQN, nil)).NotTo(HaveOccurred())
+			buf := make([]byte, cos.KiB)
+			lom.Lock(true)
+			Expect(lom.MoveVersions(dst, buf)).NotTo(HaveOccurred())
+			lom.Unlock(true)
+			for _, ver := range []string{"1", "2"} {
+				Expect(lom.VerFQN(ver)).NotTo(BeAnExistingFile())
+				Expect(dst.VerFQN(ver)).To(BeAnExistingFile())
+			}
+			vlom, err := dst.LoadVersion("1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vlom.Lsize()).To(BeEquivalentTo(10))
+			Expect(vlom.Version()).To(Equal("1"))
+			core.FreeLOM(vlom)
+			finfo, err := os.Stat(dst.VerFQN("1"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(finfo.ModTime()).To(Equal(vfinfo.ModTime()))
+
+			// global rebalance (send => receive)
+			roc, fsize, oa, err := dst.OpenVersion("2")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fsize).To(BeEquivalentTo(20))
+			Expect(lom.PutVersion(oa, roc, buf)).NotTo(HaveOccurred())
+			roc.Close()
+			vlom, err = lom.LoadVersion("2")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vlom.Lsize()).To(BeEquivalentTo(20))
+			Expect(vlom.Version()).To(Equal("2"))
+			Expect(vlom.Checksum().Equal(oa.Cksum)).To(BeTrue())
+			core.FreeLOM(vlom)
+			core.FreeLOM(dst)
+		})
+	})
+
+	Describe("object lock", func() {
+		testObject := "foldr/test-obj-lock.ext"
+		fqn := mis[0].MakePathFQN(&localBckL, fs.ObjectType, testObject)
+
+		It("should apply default retention and honor legal hold", func() {
+			lom := filePut(fqn, 10)
+			Expect(lom.CheckOverwrite(false)).NotTo(HaveOccurred())
+
+			lom.Lock(true)
+			lom.SetCustomKey(cmn.LegalHoldObjMD, cmn.LegalHoldOn) // (dropped with new content)
+			lom.InitRetention()
+			Expect(persist(lom)).NotTo(HaveOccurred())
+			lom.Unlock(true)
+
+			r := lom.Retention()
+			Expect(r.Mode).To(Equal(cmn.ObjLockGovernance))
+			Expect(r.LegalHold).To(BeFalse())
+			Expect(r.Until).To(BeTemporally(">", time.Now().Add(23*time.Hour)))
+
+			Expect(cmn.IsErrObjLocked(lom.CheckObjLock(false))).To(BeTrue())
+			Expect(lom.CheckObjLock(true /*bypass governance*/)).NotTo(HaveOccurred())
+			Expect(cmn.IsErrObjLocked(lom.CheckOverwrite(false))).To(BeTrue())
+
+			lom.Lock(true)
+			Expect(lom.PersistRetention("", time.Time{})).NotTo(HaveOccurred())
+			Expect(lom.PersistLegalHold(true)).NotTo(HaveOccurred())
+			lom.Unlock(true)
+			Expect(cmn.IsErrObjLocked(lom.CheckObjLock(true))).To(BeTrue())
+
+			lom.Lock(true)
+			Expect(lom.PersistLegalHold(false)).NotTo(HaveOccurred())
+			lom.Unlock(true)
+			Expect(lom.CheckObjLock(false)).NotTo(HaveOccurred())
+			Expect(lom.CheckOverwrite(false)).NotTo(HaveOccurred())
+		})
+
+		It("should keep locked prior versions", func() {
+			fqnW := mis[0].MakePathFQN(&localBckW, fs.ObjectType, testObject)
+			lom := filePut(fqnW, 10)
+			overwrite := func(size int) {
+				lom.Lock(true)
+				defer lom.Unlock(true)
+				_, err := lom.RetainVersion()
+				Expect(err).NotTo(HaveOccurred())
+				createTestFile(fqnW, size)
+				lom.SetSize(int64(size))
+				lom.InitRetention()
+				Expect(lom.IncVersion()).NotTo(HaveOccurred())
+				Expect(persist(lom)).NotTo(HaveOccurred())
+			}
+
+			lom.Lock(true)
+			Expect(lom.PersistLegalHold(true)).NotTo(HaveOccurred())
+			lom.Unlock(true)
+			overwrite(20)
+			Expect(lom.PriorVersions()).To(Equal([]string{"1"}))
+			Expect(cmn.IsErrObjLocked(lom.CheckVersionLock("1", true))).To(BeTrue())
+
+			// retain = 1 but the version on legal hold must stay
+			overwrite(30)
+			Expect(lom.PriorVersions()).To(Equal([]string{"2", "1"}))
+			Expect(lom.VerFQN("1")).To(BeAnExistingFile())
+			Expect(lom.CheckVersionLock("2", false)).NotTo(HaveOccurred())
+			Expect(cmn.IsErrObjLocked(lom.CheckPriorVersionsLock(true))).To(BeTrue())
+
+			// unlocked: trimmed as usual
+			overwrite(40)
+			Expect(lom.PriorVersions()).To(Equal([]string{"3", "1"}))
+			Expect(lom.VerFQN("2")).NotTo(BeAnExistingFile())
+		})
+
+		It("should summarize locked objects per bucket", func() {
+			lom := filePut(fqn, 10)
+			bck := lom.Bck()
+			none, gen := core.BckObjLocksNone(bck)
+			Expect(none).To(BeFalse()) // (unknown - never walked)
+			core.BckObjLocksClean(bck, gen)
+			none, _ = core.BckObjLocksNone(bck)
+			Expect(none).To(BeTrue())
+
+			lom.Lock(true)
+			lom.InitRetention()
+			Expect(persist(lom)).NotTo(HaveOccurred())
+			lom.Unlock(true)
+			none, gen = core.BckObjLocksNone(bck)
+			Expect(none).To(BeFalse())
+
+			// concurrent update takes precedence over (the walk's) clean state
+			lom.Lock(true)
+			Expect(lom.PersistLegalHold(true)).NotTo(HaveOccurred())
+			lom.Unlock(true)
+			core.BckObjLocksClean(bck, gen)
+			none, gen = core.BckObjLocksNone(bck)
+			Expect(none).To(BeFalse())
+
+			core.BckObjLocksClean(bck, gen)
+			none, _ = core.BckObjLocksNone(bck)
+			Expect(none).To(BeTrue())
+		})
+	})
+
+	Describe("server-side encryption", func() {
+		testObject := "foldr/test-obj-sse.ext"
+		fqn := mis[0].MakePathFQN(&localBckA, fs.ObjectType, testObject)
+
+		It("should decrypt with the customer-provided key only", func() {
+			ck, err := sse.NewCustKey(base64.StdEncoding.EncodeToString(sse.GenKey()), "")
+			Expect(err).NotTo(HaveOccurred())
+
+			plain := make([]byte, sse.FrameSize+100)
+			_, _ = cryptorand.Read(plain)
+
+			lom := NewBasicLom(fqn)
+			dek, err := lom.InitSSE(ck, false)
+			Expect(err).NotTo(HaveOccurred())
+			fh, err := cos.CreateFile(fqn)
+			Expect(err).NotTo(HaveOccurred())
+			encw, err := sse.NewWriter(fh, dek)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = encw.Write(plain)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(encw.Close()).NotTo(HaveOccurred())
+			Expect(fh.Close()).NotTo(HaveOccurred())
+			lom.SetSize(int64(len(plain)))
+			Expect(persist(lom)).NotTo(HaveOccurred())
+			lom.UncacheUnless()
+
+			lom = NewBasicLom(fqn)
+			Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+			Expect(lom.IsEncrypted()).To(BeTrue())
+			Expect(lom.Lsize()).To(BeEquivalentTo(len(plain)))
+			Expect(lom.SSECustMD5()).To(Equal(ck.MD5))
+
+			_, err = lom.Open()
+			Expect(cmn.IsErrSSEKey(err)).To(BeTrue())
+
+			r, err := lom.OpenSSE(ck)
+			Expect(err).NotTo(HaveOccurred())
+			b, err := io.ReadAll(r)
+			r.Close()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(b).To(Equal(plain))
+		})
+	})
+
+	Describe("conditional requests", func() {
+		testObject := "foldr/test-obj-precond.ext"
+		fqn := mis[0].MakePathFQN(&localBckA, fs.ObjectType, testObject)
+
+		It("should evaluate preconditions against existing object", func() {
+			_ = os.Remove(fqn)
+			lom := NewBasicLom(fqn)
+			createOnly := &cmn.Preconds{IfNoneMatch: cmn.AnyETag}
+			Expect(lom.CheckPreconds(createOnly, false)).NotTo(HaveOccurred())
+			Expect(cmn.IsErrPrecondFailed(lom.CheckPreconds(&cmn.Preconds{IfMatch: cmn.AnyETag}, false))).To(BeTrue())
+
+			lom = filePut(fqn, 1024)
+			Expect(cmn.IsErrPrecondFailed(lom.CheckPreconds(createOnly, false))).To(BeTrue())
+
+			lom = NewBasicLom(fqn)
+			Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+			Expect(lom.CheckPreconds(&cmn.Preconds{IfMatch: `"` + lom.Version() + `"`}, false)).NotTo(HaveOccurred())
+			Expect(cmn.IsErrPrecondFailed(lom.CheckPreconds(&cmn.Preconds{IfMatch: "v0"}, false))).To(BeTrue())
+
+			// GET: not modified since
+			err := lom.EvalPreconds(&cmn.Preconds{IfModifiedSince: time.Now().Add(time.Hour)}, true, true)
+			Expect(cmn.IsErrNotModified(err)).To(BeTrue())
+			err = lom.EvalPreconds(&cmn.Preconds{IfModifiedSince: time.Now().Add(-time.Hour)}, true, true)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("remote HEAD cache", func() {
+		testObject := "foldr/test-obj-hcache.ext"
+		fqn := mis[0].MakePathFQN(&cloudBckA, fs.ObjectType, testObject)
+
+		It("should cache, invalidate, and expire HEAD results", func() {
+			lom := NewBasicLom(fqn)
+			_, _, ok := core.HcacheGet(lom)
+			Expect(ok).To(BeFalse())
+
+			oa := &cmn.ObjAttrs{Size: 1024}
+			oa.SetVersion("v1")
+			oa.SetCustomKey(cmn.ETag, "abc")
+			core.HcachePut(lom, oa, time.Minute)
+			cached, ecode, ok := core.HcacheGet(lom)
+			Expect(ok).To(BeTrue())
+			Expect(ecode).To(BeZero())
+			Expect(cached.Size).To(BeEquivalentTo(1024))
+			Expect(cached.Version()).To(Equal("v1"))
+			etag, _ := cached.GetCustomKey(cmn.ETag)
+			Expect(etag).To(Equal("abc"))
+			cached.SetCustomKey(cmn.ETag, "xyz") // (a copy)
+			cached, _, _ = core.HcacheGet(lom)
+			etag, _ = cached.GetCustomKey(cmn.ETag)
+			Expect(etag).To(Equal("abc"))
+
+			core.HcacheDel(lom)
+			_, _, ok = core.HcacheGet(lom)
+			Expect(ok).To(BeFalse())
+
+			// not found
+			core.HcachePut(lom, nil, time.Millisecond)
+			cached, ecode, ok = core.HcacheGet(lom)
+			Expect(ok).To(BeTrue())
+			Expect(cached).To(BeNil())
+			Expect(ecode).To(Equal(http.StatusNotFound))
+			time.Sleep(5 * time.Millisecond)
+			_, _, ok = core.HcacheGet(lom)
+			Expect(ok).To(BeFalse())
+		})
+	})
+
 	Describe("copy object methods", func() {
 		const (
 			testObjectName = "foldr/test-obj.ext"
@@ -0,0 +1,353 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"errors"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// Retained prior versions of ais:// objects (see cmn.VersionConf.Retain):
+// - each prior version is stored on the object's mountpath as fs.ObjVersionType
+//   content, with its own (xattr) metadata
+// - the current object lists its prior versions (most recent first) in its custom
+//   metadata (cmn.PriorVersionsObjMD)
+// - prior versions move together with the current object: resilver (MoveVersions)
+//   and global rebalance (OpenVersion => PutVersion)
+// - all methods below require the caller to hold the object's lock
+
+const priorVersSepa = ","
+
+func (lom *LOM) VerFQN(ver string) string { return fs.CSM.Gen(lom, fs.ObjVersionType, ver) }
+
+// most recent first
+func (lom *LOM) PriorVersions() []string {
+	s, ok := lom.GetCustomKey(cmn.PriorVersionsObjMD)
+	if !ok || s == "" {
+		return nil
+	}
+	return strings.Split(s, priorVersSepa)
+}
+
+func (lom *LOM) HasPriorVersion(ver string) bool { return slices.Contains(lom.PriorVersions(), ver) }
+
+func (lom *LOM) setPriorVersions(vers []string) {
+	if len(vers) == 0 {
+		delete(lom.md.CustomMD, cmn.PriorVersionsObjMD)
+		return
+	}
+	lom.SetCustomKey(cmn.PriorVersionsObjMD, strings.Join(vers, priorVersSepa))
+}
+
+// Called (under write-lock) prior to overwriting the object with new content:
+// - renames the existing object (if any) into its prior version, and
+// - removes the oldest prior versions beyond the configured number to retain.
+// Returns the retained version (empty if none) that the caller may use to undo.
+// NOTE: sets lom's version to the one being retained, expecting the caller to
+// increment it (see lom.IncVersion).
+func (lom *LOM) RetainVersion() (string, error) {
+	debug.Assert(lom.isLockedExcl(), lom.Cname())
+	prev := AllocLOM(lom.ObjName)
+	defer FreeLOM(prev)
+	if err := prev.InitBck(lom.Bucket()); err != nil {
+		return "", err
+	}
+	if err := prev.Load(false /*cache it*/, true /*locked*/); err != nil {
+		if cmn.IsErrObjNought(err) {
+			return "", nil // nothing to retain
+		}
+		return "", err
+	}
+	ver := prev.Version()
+	if ver == "" {
+		return "", nil
+	}
+	if err := cos.Rename(prev.FQN, prev.VerFQN(ver)); err != nil {
+		return "", err
+	}
+	vers := append([]string{ver}, prev.PriorVersions()...)
+	if retain := lom.VersionConf().Retain; len(vers) > retain {
+		keep := vers[:retain:retain]
+		for _, v := range vers[retain:] {
+			// (WORM) locked versions are kept beyond the configured number
+			if err := prev.CheckVersionLock(v, false /*bypass governance*/); err != nil {
+				if !cmn.IsErrObjLocked(err) {
+					nlog.Warningln("failed to check", lom.Cname(), "version", v, "[", err, "]")
+				}
+				keep = append(keep, v)
+				continue
+			}
+			if err := cos.RemoveFile(lom.VerFQN(v)); err != nil {
+				nlog.Warningln("failed to remove", lom.Cname(), "version", v, "[", err, "]")
+			}
+		}
+		vers = keep
+	}
+	lom.SetVersion(ver)
+	lom.setPriorVersions(vers)
+	return ver, nil
+}
+
+// undo the above
+func (lom *LOM) UnretainVersion(ver string) error {
+	return cos.Rename(lom.VerFQN(ver), lom.FQN)
+}
+
+// Loads metadata of the specified prior version into a newly allocated LOM
+// that the caller can then use to read the content (`vlom.Open`) and must free.
+// The resulting `vlom` must not be cached or persisted.
+func (lom *LOM) LoadVersion(ver string) (*LOM, error) {
+	if !lom.HasPriorVersion(ver) {
+		return nil, cos.NewErrNotFound(T, lom.Cname()+" version "+ver)
+	}
+	vlom := AllocLOM(lom.ObjName)
+	if err := vlom.InitBck(lom.Bucket()); err != nil {
+		FreeLOM(vlom)
+		return nil, err
+	}
+	vlom.FQN = lom.VerFQN(ver)
+	if err := vlom.FromFS(); err != nil {
+		FreeLOM(vlom)
+		if os.IsNotExist(err) {
+			return nil, cos.NewErrNotFound(T, lom.Cname()+" version "+ver)
+		}
+		return nil, err
+	}
+	vlom.setbid(vlom.Bprops().BID)
+	vlom.md.uname = lom.md.uname
+	return vlom, nil
+}
+
+// returns cmn.ErrObjLocked if the specified prior version must not be deleted
+// (compare with lom.CheckObjLock)
+func (lom *LOM) CheckVersionLock(ver string, bypassGovernance bool) error {
+	if lom.Bprops().ObjLock == nil {
+		return nil
+	}
+	vlom, err := lom.LoadVersion(ver)
+	if err != nil {
+		if cos.IsNotExist(err, 0) {
+			return nil
+		}
+		return err
+	}
+	err = vlom.CheckObjLock(bypassGovernance)
+	FreeLOM(vlom)
+	return err
+}
+
+// ditto, for all prior versions
+func (lom *LOM) CheckPriorVersionsLock(bypassGovernance bool) error {
+	for _, ver := range lom.PriorVersions() {
+		if err := lom.CheckVersionLock(ver, bypassGovernance); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// (for listing) size and modification time of the specified prior version
+func (lom *LOM) StatVersion(ver string) (size int64, mtime time.Time, err error) {
+	finfo, err := os.Stat(lom.VerFQN(ver))
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	return finfo.Size(), finfo.ModTime(), nil
+}
+
+// Permanently removes the specified prior version (requires write-lock and loaded lom).
+func (lom *LOM) RemoveVersion(ver string) error {
+	debug.Assert(lom.isLockedExcl(), lom.Cname())
+	vers := lom.PriorVersions()
+	idx := slices.Index(vers, ver)
+	if idx < 0 {
+		return cos.NewErrNotFound(T, lom.Cname()+" version "+ver)
+	}
+	if err := cos.RemoveFile(lom.VerFQN(ver)); err != nil {
+		return err
+	}
+	lom.setPriorVersions(slices.Delete(vers, idx, idx+1))
+	if err := lom.syncMetaWithCopies(); err != nil {
+		return err
+	}
+	return lom.Persist()
+}
+
+// Permanently removes the current version (requires write-lock and loaded lom)
+// and promotes the most recent prior version, if any, to become current.
+func (lom *LOM) RemoveCurrentVersion() error {
+	debug.Assert(lom.isLockedExcl(), lom.Cname())
+	vers := lom.PriorVersions()
+	if err := lom.RemoveObj(); err != nil {
+		return err
+	}
+	if len(vers) == 0 {
+		return nil
+	}
+	if err := cos.Rename(lom.VerFQN(vers[0]), lom.FQN); err != nil {
+		return err
+	}
+	if err := lom.FromFS(); err != nil {
+		return err
+	}
+	lom.setbid(lom.Bprops().BID)
+	lom.md.copies = nil // copies, if any, were removed when the version became noncurrent
+	lom.setPriorVersions(vers[1:])
+	return lom.Persist()
+}
+
+// Removes all prior versions (best effort); is called upon object deletion
+// (when deleting, the caller must first check CheckPriorVersionsLock).
+func (lom *LOM) RemovePriorVersions() {
+	for _, ver := range lom.PriorVersions() {
+		if err := cos.RemoveFile(lom.VerFQN(ver)); err != nil {
+			nlog.Warningln("failed to remove", lom.Cname(), "version", ver, "[", err, "]")
+		}
+	}
+}
+
+// (resilver) Moves prior versions, if any, to the mountpath of the `dst` - the current
+// object's new location; requires write-lock and loaded lom.
+func (lom *LOM) MoveVersions(dst *LOM, buf []byte) error {
+	for _, ver := range lom.PriorVersions() {
+		src, dstFQN := lom.VerFQN(ver), dst.VerFQN(ver)
+		if src == dstFQN {
+			continue
+		}
+		vlom, err := lom.LoadVersion(ver)
+		if err != nil {
+			if cos.IsNotExist(err, 0) {
+				continue // (nothing to move)
+			}
+			return err
+		}
+		err = vlom.copyVersion(dstFQN, buf)
+		FreeLOM(vlom)
+		if err != nil {
+			return err
+		}
+		if err := cos.RemoveFile(src); err != nil {
+			nlog.Warningln("failed to remove", lom.Cname(), "version", ver, "old copy [", err, "]")
+		}
+	}
+	return nil
+}
+
+func (vlom *LOM) copyVersion(dstFQN string, buf []byte) error {
+	finfo, err := os.Stat(vlom.FQN)
+	if err != nil {
+		return err
+	}
+	if _, _, err := cos.CopyFile(vlom.FQN, dstFQN, buf, cos.ChecksumNone); err != nil {
+		return err
+	}
+	return _persistVersion(vlom, dstFQN, finfo.ModTime())
+}
+
+// (global rebalance: sending side) Returns the reader of the specified prior version,
+// its on-disk size, and attributes, including modification time (as atime).
+// NOTE: the version is read as is (e.g., encrypted) - the attributes carry everything
+// that's needed to store it elsewhere (see PutVersion).
+func (lom *LOM) OpenVersion(ver string) (cos.ReadOpenCloser, int64, *cmn.ObjAttrs, error) {
+	vlom, err := lom.LoadVersion(ver)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	defer FreeLOM(vlom)
+	finfo, err := os.Stat(vlom.FQN)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	fh, err := cos.NewFileHandle(vlom.FQN)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	oa := &cmn.ObjAttrs{}
+	oa.CopyFrom(vlom, false /*skip cksum*/)
+	oa.Atime = finfo.ModTime().UnixNano()
+	oa.SetVersion(ver)
+	return fh, finfo.Size(), oa, nil
+}
+
+// (global rebalance: receiving side) Stores the prior version of the (not necessarily
+// yet received) current object; compare with OpenVersion above.
+func (lom *LOM) PutVersion(oa *cmn.ObjAttrs, r io.Reader, buf []byte) error {
+	ver := oa.Version()
+	if ver == "" {
+		return errors.New(lom.Cname() + ": missing version")
+	}
+	vlom := AllocLOM(lom.ObjName)
+	defer FreeLOM(vlom)
+	if err := vlom.InitBck(lom.Bucket()); err != nil {
+		return err
+	}
+	vlom.CopyAttrs(oa, false /*skip cksum*/)
+
+	var (
+		verFQN  = lom.VerFQN(ver)
+		workFQN = fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfilePut)
+	)
+	wfh, err := cos.CreateFile(workFQN)
+	if err != nil {
+		return err
+	}
+	_, err = io.CopyBuffer(wfh, r, buf)
+	if errC := wfh.Close(); err == nil {
+		err = errC
+	}
+	if err == nil {
+		err = cos.Rename(workFQN, verFQN)
+	}
+	if err != nil {
+		if errRm := cos.RemoveFile(workFQN); errRm != nil && !os.IsNotExist(errRm) {
+			nlog.Errorln("nested err:", errRm)
+		}
+		return err
+	}
+	return _persistVersion(vlom, verFQN, time.Unix(0, oa.Atime))
+}
+
+// (not caching)
+func _persistVersion(vlom *LOM, fqn string, mtime time.Time) error {
+	mdbuf := vlom.pack()
+	err := fs.SetXattr(fqn, XattrLOM, mdbuf)
+	g.smm.Free(mdbuf)
+	if err == nil {
+		err = os.Chtimes(fqn, mtime, mtime)
+	}
+	if err == nil {
+		vlom.markObjLock()
+	}
+	if err != nil {
+		if errRm := cos.RemoveFile(fqn); errRm != nil && !os.IsNotExist(errRm) {
+			nlog.Errorln("nested err:", errRm)
+		}
+	}
+	return err
+}
+
+// (space cleanup) Returns true if the specified prior version is referenced by
+// the current object; requires (at least) read-lock.
+func (lom *LOM) RefsVersion(verFQN string) bool {
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return false
+	}
+	for _, ver := range lom.PriorVersions() {
+		if lom.VerFQN(ver) == verFQN {
+			return true
+		}
+	}
+	return false
+}
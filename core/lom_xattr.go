@@ -9,6 +9,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"net/url"
 	"os"
 	"strings"
 	"syscall"
@@ -212,6 +213,7 @@ func (lom *LOM) Persist() (err error) {
 	atime := lom.AtimeUnix()
 	debug.Assert(cos.IsValidAtime(atime), atime)
 
+	lom.markObjLock()
 	if atime < 0 || !lom.WritePolicy().IsImmediate() {
 		lom.md.makeDirty()
 		if lom.Bprops() != nil {
@@ -240,6 +242,26 @@ func (lom *LOM) Persist() (err error) {
 	return
 }
 
+// object tags are stored (url-encoded) as custom metadata - see cmn/objtags.go
+func (lom *LOM) Tags() (tags string) {
+	tags, _ = lom.GetCustomKey(cmn.TagsObjMD)
+	return tags
+}
+
+// (caller must write-lock and load; empty tags remove all)
+func (lom *LOM) PersistTags(tags url.Values) error {
+	debug.Assert(lom.isLockedExcl(), lom.Cname())
+	if len(tags) == 0 {
+		delete(lom.md.CustomMD, cmn.TagsObjMD)
+	} else {
+		lom.SetCustomKey(cmn.TagsObjMD, tags.Encode())
+	}
+	if err := lom.syncMetaWithCopies(); err != nil {
+		return err
+	}
+	return lom.Persist()
+}
+
 func (lom *LOM) persistMdOnCopies() (copyFQN string, err error) {
 	buf := lom.pack()
 	// replicate across copies
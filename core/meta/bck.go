@@ -70,6 +70,14 @@ func (b *Bck) IsRemoteS3() bool {
 	return backend != nil && backend.Provider == apc.AWS
 }
 
+func (b *Bck) IsRemoteAzure() bool {
+	if b.Provider == apc.Azure {
+		return true
+	}
+	backend := b.Backend()
+	return backend != nil && backend.Provider == apc.Azure
+}
+
 func (b *Bck) NewQuery() url.Values               { return (*cmn.Bck)(b).NewQuery() }
 func (b *Bck) AddToQuery(q url.Values) url.Values { return (*cmn.Bck)(b).AddToQuery(q) }
 
@@ -247,10 +255,18 @@ func (b *Bck) MaxPageSize() int64 {
 		// ref:
 		// - https://docs.aws.amazon.com/cli/latest/userguide/cli-usage-pagination.html#cli-usage-pagination-serverside
 		// - https://docs.openstack.org/swift/latest/api/pagination.html
-		if b == nil || b.Props == nil || b.Props.Extra.AWS.MaxPageSize == 0 {
+		if b == nil || b.Props == nil {
 			return apc.MaxPageSizeAWS
 		}
-		return b.Props.Extra.AWS.MaxPageSize
+		if b.Props.Extra.AWS.MaxPageSize != 0 {
+			return b.Props.Extra.AWS.MaxPageSize
+		}
+		if name := b.Props.Extra.AWS.BackendProfile; name != "" {
+			if prof, err := cmn.GCO.Get().Backend.Profile(apc.AWS, name); err == nil && prof.MaxPageSize > 0 {
+				return prof.MaxPageSize
+			}
+		}
+		return apc.MaxPageSizeAWS
 	case apc.GCP:
 		// ref: https://cloud.google.com/storage/docs/json_api/v1/objects/list#parameters
 		return apc.MaxPageSizeGCP
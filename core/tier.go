@@ -0,0 +1,120 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+)
+
+// multi-backend tiering (see cmn/tiering.go)
+// - tier 0 is the bucket's backend; cold GET and HEAD try the remaining tiers in order
+// - the (non-first) tier that holds a given object is recorded as cmn.TierObjMD
+
+// returns nil when the object's tier is the backend itself (or is unknown)
+func (lom *LOM) Tier() *cmn.Bck {
+	v, ok := lom.GetCustomKey(cmn.TierObjMD)
+	if !ok {
+		return nil
+	}
+	conf := lom.Bprops().Tiering
+	if !conf.IsActive() {
+		return nil
+	}
+	for i := range conf.Tiers {
+		if tier := &conf.Tiers[i].Bck; tier.Cname("") == v {
+			return tier
+		}
+	}
+	return nil
+}
+
+// nil: the backend
+func (lom *LOM) SetTier(tier *cmn.Bck) {
+	if tier == nil {
+		delete(lom.md.CustomMD, cmn.TierObjMD)
+		return
+	}
+	lom.SetCustomKey(cmn.TierObjMD, tier.Cname(""))
+}
+
+// same object in a given tier (bucket); caller must free
+func (lom *LOM) TierLOM(tier *cmn.Bck) (*LOM, error) {
+	tlom := AllocLOM(lom.ObjName)
+	if err := tlom.InitBck(tier); err != nil {
+		FreeLOM(tlom)
+		return nil, err
+	}
+	return tlom, nil
+}
+
+// GetObjReader from the first tier that has the object;
+// sets the object's version and custom metadata (including its tier)
+func GetObjReaderTiered(ctx context.Context, lom *LOM, offset, length int64) GetReaderResult {
+	res := T.Backend(lom.Bck()).GetObjReader(ctx, lom, offset, length)
+	conf := lom.Bprops().Tiering
+	if res.Err == nil || !conf.IsActive() || !cos.IsNotExist(res.Err, res.ErrCode) {
+		return res
+	}
+	atime := lom.AtimeUnix()
+	for i := range conf.Tiers {
+		tier := &conf.Tiers[i].Bck
+		tlom, err := lom.TierLOM(tier)
+		if err != nil {
+			nlog.Warningln("tier", tier.Cname(""), "[", lom.Cname(), err, "]")
+			continue
+		}
+		tres := T.Backend(tlom.Bck()).GetObjReader(ctx, tlom, offset, length)
+		if tres.Err == nil {
+			lom.CopyAttrs(tlom.ObjAttrs(), true /*skip cksum*/)
+			lom.SetAtimeUnix(atime)
+			lom.SetTier(tier)
+		}
+		FreeLOM(tlom)
+		if tres.Err == nil || !cos.IsNotExist(tres.Err, tres.ErrCode) {
+			return tres
+		}
+	}
+	return res
+}
+
+// HeadObj the object's own tier, if known; otherwise, the first tier that has it
+func HeadObjTiered(ctx context.Context, lom *LOM, origReq *http.Request) (*cmn.ObjAttrs, int, error) {
+	var (
+		tier = lom.Tier()
+		conf = lom.Bprops().Tiering
+	)
+	if tier != nil {
+		return headTier(ctx, lom, tier)
+	}
+	oa, ecode, err := T.Backend(lom.Bck()).HeadObj(ctx, lom, origReq)
+	if err == nil || !conf.IsActive() || !cos.IsNotExist(err, ecode) {
+		return oa, ecode, err
+	}
+	for i := range conf.Tiers {
+		toa, tcode, terr := headTier(ctx, lom, &conf.Tiers[i].Bck)
+		if terr == nil || !cos.IsNotExist(terr, tcode) {
+			return toa, tcode, terr
+		}
+	}
+	return oa, ecode, err
+}
+
+func headTier(ctx context.Context, lom *LOM, tier *cmn.Bck) (*cmn.ObjAttrs, int, error) {
+	tlom, err := lom.TierLOM(tier)
+	if err != nil {
+		return nil, 0, err
+	}
+	oa, ecode, err := T.Backend(tlom.Bck()).HeadObj(ctx, tlom, nil /*origReq*/)
+	FreeLOM(tlom)
+	if err == nil {
+		oa.SetCustomKey(cmn.TierObjMD, tier.Cname(""))
+	}
+	return oa, ecode, err
+}
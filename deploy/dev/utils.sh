@@ -38,7 +38,7 @@ run_cmd() {
 # 3. when adding/deleting backends, update the 3 (three) functions that follow below:
 
 set_env_backends() {
-  known_backends=( aws gcp azure oci ht )
+  known_backends=( aws gcp azure oci ht file )
   if [[ ! -z $TAGS ]]; then
     ## environment var TAGS may contain any/all build tags, including backends
     for b in "${known_backends[@]}"; do
@@ -65,6 +65,7 @@ set_env_backends() {
         gcp)   ;;
         oci)   ;;
         ht)    ;;
+        file)  ;;
         *)     echo "fatal: unknown backend '$b' in 'AIS_BACKEND_PROVIDERS=${AIS_BACKEND_PROVIDERS}'"; exit 1;;
       esac
     done
@@ -128,6 +129,7 @@ make_backend_conf() {
       gcp)   backend_conf+=('"gcp":   {}') ;;
       oci)   backend_conf+=('"oci":   {}') ;;
       ht)    backend_conf+=('"ht":    {}') ;;
+      file)  backend_conf+=('"file":  {"root": "'"${AIS_FILE_BACKEND_ROOT:-/tmp/ais_file}"'"}') ;;
     esac
   done
   echo {$(IFS=$','; echo "${backend_conf[*]}")}
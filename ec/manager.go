@@ -258,6 +258,9 @@ func (mgr *Manager) EncodeObject(lom *core.LOM, cb onFin) error {
 	if !lom.ECEnabled() {
 		return ErrorECDisabled
 	}
+	if lom.IsEncrypted() {
+		return cmn.NewErrUnsupp("erasure-code", "encrypted object "+lom.Cname())
+	}
 	cs := fs.Cap()
 	if err := cs.Err(); err != nil {
 		return err
@@ -370,7 +370,7 @@ func (m *Manager) createShard(s *shard.Shard, lom *core.LOM) (err error) {
 			goto exit
 		}
 
-		file, err := cos.NewFileHandle(lom.FQN)
+		file, err := lom.NewHandle()
 		if err != nil {
 			return err
 		}
@@ -25,6 +25,7 @@ const PrefixXactID = "etl-"
 const (
 	Spec = "spec"
 	Code = "code"
+	Wasm = "wasm"
 )
 
 // consistent with rfc2396.txt "Uniform Resource Identifiers (URI): Generic Syntax"
@@ -32,6 +33,9 @@ const CommTypeSeparator = "://"
 
 const DefaultTimeout = 45 * time.Second
 
+// max size of the (JSON) init message - in particular, base64-encoded WASM module
+const MaxInitMsgSize = 2 * WasmMaxModuleSize
+
 // enum communication types (`commTypes`)
 const (
 	// ETL container receives POST request from target with the data. It
@@ -58,7 +62,7 @@ const (
 type (
 	InitMsg interface {
 		Name() string
-		MsgType() string // Code or Spec
+		MsgType() string // Code, Spec, or Wasm
 		CommType() string
 		ArgType() string
 		Validate() error
@@ -96,6 +100,20 @@ type (
 		// bitwise flags: (streaming | debug | strict | ...) future enhancements
 		Flags int64 `json:"flags"`
 	}
+
+	// InitWasmMsg carries WebAssembly module that each target compiles once and then
+	// executes in-process, in a sandbox - no containers, no external processes.
+	// See wasm.go for the host ABI.
+	InitWasmMsg struct {
+		InitMsgBase
+		Module []byte `json:"wasm"` // binary module (".wasm")
+		// linear memory limit, per running instance (zero: WasmDefMemLimit)
+		MemLimit int64 `json:"mem_limit,omitempty"`
+		// max (wall-clock) time to transform a single object (zero: WasmDefExecTimeout)
+		ExecTimeout cos.Duration `json:"exec_timeout,omitempty"`
+		// max number of objects transformed concurrently, per target (zero: number of CPUs)
+		Concurrency int `json:"concurrency,omitempty"`
+	}
 )
 
 type (
@@ -141,6 +159,7 @@ var (
 var (
 	_ InitMsg = (*InitCodeMsg)(nil)
 	_ InitMsg = (*InitSpecMsg)(nil)
+	_ InitMsg = (*InitWasmMsg)(nil)
 )
 
 func (m InitMsgBase) CommType() string { return m.CommTypeX }
@@ -148,6 +167,7 @@ func (m InitMsgBase) ArgType() string  { return m.ArgTypeX }
 func (m InitMsgBase) Name() string     { return m.IDX }
 func (*InitCodeMsg) MsgType() string   { return Code }
 func (*InitSpecMsg) MsgType() string   { return Spec }
+func (*InitWasmMsg) MsgType() string   { return Wasm }
 
 func (m *InitCodeMsg) String() string {
 	return fmt.Sprintf("init-%s[%s-%s-%s-%s]", Code, m.IDX, m.CommTypeX, m.ArgTypeX, m.Runtime)
@@ -157,6 +177,10 @@ func (m *InitSpecMsg) String() string {
 	return fmt.Sprintf("init-%s[%s-%s-%s]", Spec, m.IDX, m.CommTypeX, m.ArgTypeX)
 }
 
+func (m *InitWasmMsg) String() string {
+	return fmt.Sprintf("init-%s[%s-%s]", Wasm, m.IDX, cos.ToSizeIEC(int64(len(m.Module)), 0))
+}
+
 // TODO: double-take, unmarshaling-wise. To avoid, include (`Spec`, `Code`) in API calls
 func UnmarshalInitMsg(b []byte) (msg InitMsg, err error) {
 	var msgInf map[string]json.RawMessage
@@ -173,6 +197,11 @@ func UnmarshalInitMsg(b []byte) (msg InitMsg, err error) {
 		err = jsoniter.Unmarshal(b, msg)
 		return
 	}
+	if _, ok := msgInf[Wasm]; ok {
+		msg = &InitWasmMsg{}
+		err = jsoniter.Unmarshal(b, msg)
+		return
+	}
 	err = fmt.Errorf("invalid etl.InitMsg: %+v", msgInf)
 	return
 }
@@ -299,6 +328,42 @@ func (m *InitSpecMsg) Validate() (err error) {
 	return nil
 }
 
+func (m *InitWasmMsg) Validate() error {
+	// (in-process: there's no container to push to, or pull from)
+	if m.CommTypeX != "" && m.CommTypeX != Hpush {
+		return fmt.Errorf("comm-type %q is not applicable to in-process %s transforms", m.CommTypeX, Wasm)
+	}
+	if m.ArgTypeX != ArgTypeDefault {
+		return fmt.Errorf("arg-type %q is not applicable to in-process %s transforms", m.ArgTypeX, Wasm)
+	}
+	if err := m.InitMsgBase.validate(m.String()); err != nil {
+		return err
+	}
+	if len(m.Module) < len(wasmMagic) || string(m.Module[:len(wasmMagic)]) != wasmMagic {
+		return fmt.Errorf("%s: not a WebAssembly binary module (expecting %q magic)", m, wasmMagic)
+	}
+	if len(m.Module) > WasmMaxModuleSize {
+		return fmt.Errorf("%s: module size exceeds %s", m, cos.ToSizeIEC(WasmMaxModuleSize, 0))
+	}
+	switch {
+	case m.MemLimit == 0:
+		m.MemLimit = WasmDefMemLimit
+	case m.MemLimit < wasmPageSize || m.MemLimit > WasmMaxMemLimit:
+		return fmt.Errorf("%s: invalid mem-limit %d (expecting %s <= mem-limit <= %s)", m, m.MemLimit,
+			cos.ToSizeIEC(wasmPageSize, 0), cos.ToSizeIEC(WasmMaxMemLimit, 0))
+	}
+	switch {
+	case m.ExecTimeout == 0:
+		m.ExecTimeout = cos.Duration(WasmDefExecTimeout)
+	case m.ExecTimeout < 0:
+		return fmt.Errorf("%s: invalid exec-timeout %v", m, m.ExecTimeout)
+	}
+	if m.Concurrency < 0 || m.Concurrency > WasmMaxConcurrency {
+		return fmt.Errorf("%s: invalid concurrency %d (expecting 0 <= concurrency <= %d)", m, m.Concurrency, WasmMaxConcurrency)
+	}
+	return nil
+}
+
 func ParsePodSpec(errCtx *cmn.ETLErrCtx, spec []byte) (*corev1.Pod, error) {
 	obj, _, err := scheme.Codecs.UniversalDeserializer().Decode(spec, nil, nil)
 	if err != nil {
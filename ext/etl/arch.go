@@ -0,0 +1,204 @@
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"context"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/archive"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// Per-member transformation of archived files (shards) - see apc.ArchTransform:
+// - iterate archived files using cmn/archive readers;
+// - stream each matching file through the ETL pipeline - which is why all stages must be
+//   able to transform streams (see CheckStream);
+// - re-pack the results (and non-matching files as is) into the resulting shard of the
+//   same or a different format (archive.FileExtensions) - in the latter case, the resulting
+//   shard gets the respective extension (see xs.tcbName).
+//
+// Remote shards that are not present in the cluster get cold-GET first.
+// The resulting shard is produced on the fly while the source remains read-locked.
+// Archived files are buffered in memory - one at a time - to provide (tar, zip) headers
+// with transformed sizes, and to decouple each transformer's input from the source shard.
+
+type (
+	archXform struct {
+		exts []string // with leading '.'
+		mime string   // resulting format (empty: same as source)
+	}
+	// single shard
+	archRepack struct {
+		lom      *core.LOM // (own read-locked copy)
+		fh       cos.LomReader
+		pw       *io.PipeWriter
+		aw       archive.Writer
+		pipeline Pipeline
+		xform    *archXform
+		mime     string // source format
+		timeout  time.Duration
+	}
+)
+
+// interface guard
+var _ archive.ArchRCB = (*archRepack)(nil)
+
+func newArchXform(msg *apc.ArchTransform) (*archXform, error) {
+	xform := &archXform{exts: make([]string, 0, len(msg.Exts))}
+	for _, ext := range msg.Exts {
+		xform.exts = append(xform.exts, "."+strings.TrimLeft(ext, "."))
+	}
+	if msg.Mime != "" {
+		mime, err := archive.Mime(msg.Mime, "")
+		if err != nil {
+			return nil, err
+		}
+		xform.mime = mime
+	}
+	return xform, nil
+}
+
+func (xform *archXform) match(filename string) bool {
+	if len(xform.exts) == 0 {
+		return true
+	}
+	for _, ext := range xform.exts {
+		if strings.HasSuffix(filename, ext) {
+			return true
+		}
+	}
+	return false
+}
+
+// returns the resulting shard that is being produced by a separate goroutine
+func (dp *OfflineDP) archReader(lom *core.LOM, mime string) (cos.ReadCloseSizer, error) {
+	src := core.AllocLOM(lom.ObjName)
+	if err := src.InitBck(lom.Bck().Bucket()); err != nil {
+		core.FreeLOM(src)
+		return nil, err
+	}
+	src.Lock(false)
+	fh, err := _openArch(src)
+	if err != nil && cos.IsNotExist(err, 0) && src.Bck().IsRemote() {
+		// (compare w/ wasmComm.open)
+		src.Unlock(false)
+		if _, err = core.T.GetCold(context.Background(), src, cmn.OwtGetLock); err != nil {
+			core.FreeLOM(src)
+			return nil, err
+		}
+		src.Lock(false)
+		fh, err = _openArch(src)
+	}
+	if err != nil {
+		src.Unlock(false)
+		core.FreeLOM(src)
+		return nil, err
+	}
+	pr, pw := io.Pipe()
+	rp := &archRepack{
+		lom:      src,
+		fh:       fh,
+		pw:       pw,
+		pipeline: dp.pipeline,
+		xform:    dp.arch,
+		mime:     mime,
+		timeout:  dp.requestTimeout,
+	}
+	go rp.run()
+	return cos.NewReaderWithArgs(cos.ReaderArgs{R: pr, Size: -1 /*unknown*/}), nil
+}
+
+func _openArch(lom *core.LOM) (cos.LomReader, error) {
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return nil, err
+	}
+	return lom.Open()
+}
+
+////////////////
+// archRepack //
+////////////////
+
+func (rp *archRepack) run() {
+	ar, err := archive.NewReader(rp.mime, rp.fh, rp.lom.Lsize())
+	if err == nil {
+		mime := rp.mime
+		if rp.xform.mime != "" {
+			mime = rp.xform.mime
+		}
+		rp.aw = archive.NewWriter(mime, rp.pw, nil /*cksum*/, nil /*opts*/)
+		err = ar.ReadUntil(rp, cos.EmptyMatchAll, "")
+		rp.aw.Fini()
+	}
+	if err != nil && cmn.Rom.FastV(4, cos.SmoduleETL) {
+		nlog.Warningln(rp.pipeline.String(), "failed to transform", rp.lom.Cname(), "member-wise:", err)
+	}
+	cos.Close(rp.fh)
+	rp.lom.Unlock(false)
+	core.FreeLOM(rp.lom)
+	rp.pw.CloseWithError(err) // (nil => EOF)
+}
+
+// (archive.ArchRCB)
+func (rp *archRepack) Call(filename string, reader cos.ReadCloseSizer, hdr any) (bool, error) {
+	defer reader.Close()
+
+	var mtime time.Time
+	switch h := hdr.(type) {
+	case *tar.Header:
+		if h.Typeflag != tar.TypeReg && h.Typeflag != tar.TypeRegA { //nolint:staticcheck // (TypeRegA - legacy)
+			return false, nil // (not re-packing directories, links, etc.)
+		}
+		mtime = h.ModTime
+	case *zip.FileHeader:
+		mtime = h.Modified
+	}
+	if !rp.xform.match(filename) {
+		oah := &cos.SimpleOAH{Size: reader.Size(), Atime: mtime.UnixNano()}
+		return false, rp.aw.Write(filename, oah, reader)
+	}
+
+	// transform
+	var (
+		mm  = core.T.PageMM()
+		in  = mm.NewSGL(reader.Size())
+		out = mm.NewSGL(0)
+	)
+	defer func() {
+		in.Free()
+		out.Free()
+	}()
+	if _, err := in.ReadFrom(reader); err != nil {
+		return false, err
+	}
+	// (to name the archived file, e.g. "shard.tar/0001.jpg")
+	member := core.AllocLOM(rp.lom.ObjName + "/" + filename)
+	defer core.FreeLOM(member)
+	if err := member.InitBck(rp.lom.Bucket()); err != nil {
+		return false, err
+	}
+	r, err := rp.pipeline.TransformReader(cos.NewReaderWithArgs(cos.ReaderArgs{R: memsys.NewReader(in), Size: in.Size()}),
+		member, rp.timeout)
+	if err != nil {
+		return false, err
+	}
+	_, err = out.ReadFrom(r)
+	r.Close()
+	if err != nil {
+		return false, err
+	}
+	oah := &cos.SimpleOAH{Size: out.Size(), Atime: mtime.UnixNano()}
+	return false, rp.aw.Write(filename, oah, out)
+}
@@ -0,0 +1,190 @@
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/archive"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+)
+
+type (
+	archMembers map[string]string
+	// cold-GETs (creates) the shard
+	archColdTarget struct {
+		*mock.TargetMock
+		put  func(lom *core.LOM)
+		cold int
+	}
+)
+
+func (t *archColdTarget) GetCold(_ context.Context, lom *core.LOM, _ cmn.OWT) (int, error) {
+	t.cold++
+	t.put(lom)
+	return 0, nil
+}
+
+// (archive.ArchRCB)
+func (m archMembers) Call(filename string, reader cos.ReadCloseSizer, _ any) (bool, error) {
+	b, err := cos.ReadAll(reader)
+	reader.Close()
+	m[filename] = string(b)
+	return false, err
+}
+
+var _ = Describe("ArchTransformTest", func() {
+	var (
+		tmpDir  string
+		lom     *core.LOM
+		upper   *httptest.Server
+		members = archMembers{
+			"0001.txt": "first sample",
+			"0001.cls": "cat",
+			"0002.txt": "second sample",
+			"0002.cls": "dog",
+		}
+
+		clusterBck = meta.NewBck("archBck", apc.AIS, cmn.NsGlobal, &cmn.Bprops{Cksum: cmn.CksumConf{Type: cos.ChecksumNone}})
+		remoteBck  = meta.NewBck("archRemote", apc.AWS, cmn.NsGlobal, &cmn.Bprops{Cksum: cmn.CksumConf{Type: cos.ChecksumNone}})
+		bmdMock    = mock.NewBaseBownerMock(clusterBck, remoteBck)
+	)
+
+	putShard := func(lom *core.LOM) {
+		f, err := cos.CreateFile(lom.FQN)
+		Expect(err).NotTo(HaveOccurred())
+		aw := archive.NewWriter(archive.ExtTar, f, nil, nil)
+		for _, name := range []string{"0001.txt", "0001.cls", "0002.txt", "0002.cls"} {
+			data := members[name]
+			oah := &cos.SimpleOAH{Size: int64(len(data)), Atime: time.Now().UnixNano()}
+			Expect(aw.Write(name, oah, bytes.NewReader([]byte(data)))).NotTo(HaveOccurred())
+		}
+		aw.Fini()
+		fi, err := f.Stat()
+		Expect(err).NotTo(HaveOccurred())
+		f.Close()
+		lom.SetAtimeUnix(time.Now().UnixNano())
+		lom.SetSize(fi.Size())
+		Expect(lom.Persist()).NotTo(HaveOccurred())
+	}
+
+	newDP := func(arch *apc.ArchTransform) *OfflineDP {
+		pod := &corev1.Pod{}
+		pod.SetName("etl-upper")
+		boot := &etlBootstrapper{
+			msg:             InitSpecMsg{InitMsgBase: InitMsgBase{IDX: "etl-upper", CommTypeX: Hpush}},
+			pod:             pod,
+			uri:             upper.URL,
+			xctn:            mock.NewXact(apc.ActETLBck),
+			originalPodName: "etl-upper",
+		}
+		xform, err := newArchXform(arch)
+		Expect(err).NotTo(HaveOccurred())
+		return &OfflineDP{pipeline: Pipeline{newCommunicator(nil, boot)}, arch: xform}
+	}
+	transform := func(dp *OfflineDP, mime string) archMembers {
+		r, _, err := dp.Reader(lom, false, false)
+		Expect(err).NotTo(HaveOccurred())
+		b, err := cos.ReadAll(r)
+		r.Close()
+		Expect(err).NotTo(HaveOccurred())
+
+		ar, err := archive.NewReader(mime, bytes.NewReader(b), int64(len(b)))
+		Expect(err).NotTo(HaveOccurred())
+		out := archMembers{}
+		Expect(ar.ReadUntil(out, cos.EmptyMatchAll, "")).NotTo(HaveOccurred())
+		return out
+	}
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = os.MkdirTemp("", "")
+		Expect(err).NotTo(HaveOccurred())
+		mpath := filepath.Join(tmpDir, "mpath")
+		Expect(cos.CreateDir(mpath)).NotTo(HaveOccurred())
+		fs.TestNew(nil)
+		_, err = fs.Add(mpath, "daeID")
+		Expect(err).NotTo(HaveOccurred())
+		_ = mock.NewTarget(bmdMock)
+
+		// source shard
+		lom = &core.LOM{ObjName: "shard-0000.tar"}
+		Expect(lom.InitBck(clusterBck.Bucket())).NotTo(HaveOccurred())
+		putShard(lom)
+
+		upper = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			b, _ := cos.ReadAll(r.Body)
+			w.Write(bytes.ToUpper(b))
+		}))
+	})
+
+	AfterEach(func() {
+		_ = os.RemoveAll(tmpDir)
+		upper.Close()
+	})
+
+	It("should transform archived files member by member", func() {
+		dp := newDP(&apc.ArchTransform{})
+		out := transform(dp, archive.ExtTar)
+		Expect(out).To(Equal(archMembers{
+			"0001.txt": "FIRST SAMPLE",
+			"0001.cls": "CAT",
+			"0002.txt": "SECOND SAMPLE",
+			"0002.cls": "DOG",
+		}))
+		Expect(dp.pipeline[0].Xact().Objs()).To(BeEquivalentTo(len(members)))
+	})
+
+	It("should transform selected members and re-pack into a different format", func() {
+		dp := newDP(&apc.ArchTransform{Exts: []string{"txt"}, Mime: "zip"})
+		out := transform(dp, archive.ExtZip)
+		Expect(out).To(Equal(archMembers{
+			"0001.txt": "FIRST SAMPLE",
+			"0001.cls": "cat",
+			"0002.txt": "SECOND SAMPLE",
+			"0002.cls": "dog",
+		}))
+		Expect(dp.pipeline[0].Xact().Objs()).To(BeEquivalentTo(2))
+	})
+
+	It("should cold-GET remote shard", func() {
+		tgt := &archColdTarget{TargetMock: mock.NewTarget(bmdMock), put: putShard}
+		core.Tinit(tgt, mock.NewStatsTracker(), nil /*config*/, false /*run HK*/)
+
+		lom = &core.LOM{ObjName: "shard-remote.tar"}
+		Expect(lom.InitBck(remoteBck.Bucket())).NotTo(HaveOccurred())
+		out := transform(newDP(&apc.ArchTransform{Exts: []string{"cls"}}), archive.ExtTar)
+		Expect(out["0001.cls"]).To(Equal("CAT"))
+		Expect(out["0001.txt"]).To(Equal("first sample"))
+		Expect(tgt.cold).To(Equal(1))
+	})
+
+	It("should name re-packed shards by the resulting format", func() {
+		Expect(archive.ChangeExt("a/shard-0000.tar", archive.ExtZip)).To(Equal("a/shard-0000.zip"))
+		Expect(archive.ChangeExt("shard.tar.gz", archive.ExtTarLz4)).To(Equal("shard.tar.lz4"))
+		Expect(archive.ChangeExt("shard.tgz", archive.ExtTar)).To(Equal("shard.tar"))
+		Expect(archive.ChangeExt("image.jpg", archive.ExtTar)).To(Equal("image.jpg"))
+	})
+
+	It("should reject unknown archive format", func() {
+		_, err := newArchXform(&apc.ArchTransform{Mime: "rar"})
+		Expect(err).To(HaveOccurred())
+	})
+})
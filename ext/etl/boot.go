@@ -31,6 +31,7 @@ type etlBootstrapper struct {
 	config *cmn.Config
 	msg    InitSpecMsg
 	env    map[string]string
+	rt     string // ETL runtime (see local.go)
 
 	// runtime
 	xctn            core.Xact
@@ -39,6 +40,8 @@ type etlBootstrapper struct {
 	uri             string
 	originalPodName string
 	originalCommand []string
+	local           *localInst // Kubernetes-free runtime
+	wasm            *wasmMod   // in-process WebAssembly
 }
 
 func (b *etlBootstrapper) createPodSpec() (err error) {
@@ -61,11 +64,14 @@ func (b *etlBootstrapper) _prepSpec() (err error) {
 	// 1. The ETL container is always scheduled on the target invoking it.
 	// 2. No more than a single ETL container with the same target is scheduled on
 	//    the same node at any given point in time.
-	if err = b._setAffinity(); err != nil {
-		return
-	}
-	if err = b._setAntiAffinity(); err != nil {
-		return
+	// (not applicable when running locally)
+	if b.rt == RtK8s {
+		if err = b._setAffinity(); err != nil {
+			return
+		}
+		if err = b._setAntiAffinity(); err != nil {
+			return
+		}
 	}
 
 	b._updPodCommand()
@@ -218,8 +224,21 @@ func (b *etlBootstrapper) waitPodReady() error {
 	return err
 }
 
-func (b *etlBootstrapper) setupXaction(xid string) {
-	rns := xreg.RenewETL(&b.msg, xid)
+// terminate the transformer: K8s pod and service, local process (container), or in-process WASM
+func (b *etlBootstrapper) cleanup(podName, svcName string) error {
+	switch {
+	case b.wasm != nil:
+		b.wasm.close()
+	case b.local != nil:
+		b.local.stop()
+	case b.rt == RtK8s:
+		return cleanupEntities(b.errCtx, podName, svcName)
+	}
+	return nil
+}
+
+func (b *etlBootstrapper) setupXaction(msg InitMsg, xid string) {
+	rns := xreg.RenewETL(msg, xid)
 	debug.AssertNoErr(rns.Err)
 	debug.Assert(!rns.IsRunning())
 	b.xctn = rns.Entry.Get()
@@ -56,9 +56,18 @@ type (
 		// See also, and separately: on-the-fly transformation as part of a user (e.g. training model) GET request handling
 		OfflineTransform(lom *core.LOM, timeout time.Duration) (cos.ReadCloseSizer, error)
 
+		// TransformReader transforms the output of the previous stage of an ETL pipeline
+		// (see pipeline.go) and takes ownership of the reader (closes it)
+		// Implementations include:
+		// - pushComm (with default arg-type)
+		// - wasmComm
+		TransformReader(r cos.ReadCloseSizer, lom *core.LOM, timeout time.Duration) (cos.ReadCloseSizer, error)
+
 		Stop()
 
 		CommStats
+
+		bootstrapper() *etlBootstrapper
 	}
 
 	baseComm struct {
@@ -153,6 +162,14 @@ func (c *baseComm) OutBytes() int64 { return c.boot.xctn.OutBytes() }
 
 func (c *baseComm) Stop() { c.boot.xctn.Finish() }
 
+func (c *baseComm) bootstrapper() *etlBootstrapper { return c.boot }
+
+// (not supported by default)
+func (c *baseComm) TransformReader(r cos.ReadCloseSizer, _ *core.LOM, _ time.Duration) (cos.ReadCloseSizer, error) {
+	r.Close()
+	return nil, CheckStream(&c.boot.msg)
+}
+
 func (c *baseComm) getWithTimeout(url string, timeout time.Duration) (r cos.ReadCloseSizer, err error) {
 	if err := c.boot.xctn.AbortErr(); err != nil {
 		return nil, err
@@ -219,11 +236,8 @@ func (pc *pushComm) doRequest(lom *core.LOM, timeout time.Duration) (r cos.ReadC
 
 func (pc *pushComm) do(lom *core.LOM, timeout time.Duration) (_ cos.ReadCloseSizer, ecode int, err error) {
 	var (
-		body   io.ReadCloser
-		cancel func()
-		req    *http.Request
-		resp   *http.Response
-		u      string
+		body io.ReadCloser
+		u    string
 	)
 	if err := pc.boot.xctn.AbortErr(); err != nil {
 		return nil, 0, err
@@ -241,18 +255,30 @@ func (pc *pushComm) do(lom *core.LOM, timeout time.Duration) (_ cos.ReadCloseSiz
 		debug.Assert(lom.Bck().Ns.IsGlobal(), lom.Bck().Cname(""), " - bucket with namespace")
 		u = pc.boot.uri + "/" + lom.Bck().Name + "/" + lom.ObjName
 
-		fh, err := cos.NewFileHandle(lom.FQN)
+		fh, err := lom.NewHandle()
 		if err != nil {
 			return nil, 0, err
 		}
 		body = fh
 	case ArgTypeFQN:
+		if lom.IsEncrypted() {
+			return nil, 0, errEncryptedFQN(lom)
+		}
 		body = http.NoBody
 		u = cos.JoinPath(pc.boot.uri, url.PathEscape(lom.FQN)) // compare w/ rc.redirectURL()
 	default:
 		debug.Assert(false, "unexpected msg type:", pc.boot.msg.ArgTypeX) // is validated at construction time
 	}
+	return pc.send(u, body, size, timeout)
+}
 
+// PUT body to the transformer; returns transformed output
+func (pc *pushComm) send(u string, body io.ReadCloser, size int64, timeout time.Duration) (_ cos.ReadCloseSizer, ecode int, err error) {
+	var (
+		cancel func()
+		req    *http.Request
+		resp   *http.Response
+	)
 	if timeout != 0 {
 		var ctx context.Context
 		ctx, cancel = context.WithTimeout(context.Background(), timeout)
@@ -278,6 +304,11 @@ func (pc *pushComm) do(lom *core.LOM, timeout time.Duration) (_ cos.ReadCloseSiz
 	// Do it
 	//
 	resp, err = core.T.DataClient().Do(req) //nolint:bodyclose // Closed by the caller.
+	if err == nil && resp.StatusCode >= http.StatusBadRequest {
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, cos.KiB))
+		resp.Body.Close()
+		err = fmt.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(b)))
+	}
 
 finish:
 	if err != nil {
@@ -322,6 +353,19 @@ func (pc *pushComm) InlineTransform(w http.ResponseWriter, _ *http.Request, lom
 	return err
 }
 
+func (pc *pushComm) TransformReader(r cos.ReadCloseSizer, lom *core.LOM, timeout time.Duration) (cos.ReadCloseSizer, error) {
+	if pc.boot.msg.ArgTypeX != ArgTypeDefault {
+		return pc.baseComm.TransformReader(r, lom, timeout)
+	}
+	if err := pc.boot.xctn.AbortErr(); err != nil {
+		r.Close()
+		return nil, err
+	}
+	u := pc.boot.uri + "/" + lom.Bck().Name + "/" + lom.ObjName // (compare w/ pc.do)
+	out, _, err := pc.send(u, r, r.Size(), timeout)
+	return out, err
+}
+
 func (pc *pushComm) OfflineTransform(lom *core.LOM, timeout time.Duration) (r cos.ReadCloseSizer, err error) {
 	clone := *lom
 	r, err = pc.doRequest(&clone, timeout)
@@ -343,6 +387,9 @@ func (rc *redirectComm) InlineTransform(w http.ResponseWriter, r *http.Request,
 	if err != nil {
 		return err
 	}
+	if rc.boot.msg.ArgTypeX == ArgTypeFQN && lom.IsEncrypted() {
+		return errEncryptedFQN(lom)
+	}
 	http.Redirect(w, r, rc.redirectURL(lom), http.StatusTemporaryRedirect)
 
 	if cmn.Rom.FastV(5, cos.SmoduleETL) {
@@ -368,6 +415,9 @@ func (rc *redirectComm) OfflineTransform(lom *core.LOM, timeout time.Duration) (
 	if errV != nil {
 		return nil, errV
 	}
+	if rc.boot.msg.ArgTypeX == ArgTypeFQN && clone.IsEncrypted() {
+		return nil, errEncryptedFQN(&clone)
+	}
 
 	etlURL := rc.redirectURL(&clone)
 	r, err := rc.getWithTimeout(etlURL, timeout)
@@ -455,3 +505,8 @@ func lomLoad(lom *core.LOM) (err error) {
 	}
 	return err
 }
+
+// (transformer reading directly from the local filesystem would get ciphertext)
+func errEncryptedFQN(lom *core.LOM) error {
+	return cmn.NewErrUnsupp("pass FQN of", "encrypted object "+lom.Cname()+" to ETL")
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/archive"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/nlog"
@@ -19,7 +20,8 @@ import (
 
 type (
 	OfflineDP struct {
-		comm           Communicator
+		pipeline       Pipeline
+		arch           *archXform // per-member transformation of archived files (see arch.go)
 		tcbmsg         *apc.TCBMsg
 		config         *cmn.Config
 		requestTimeout time.Duration
@@ -30,12 +32,17 @@ type (
 var _ core.DP = (*OfflineDP)(nil)
 
 func NewOfflineDP(msg *apc.TCBMsg, config *cmn.Config) (*OfflineDP, error) {
-	comm, err := GetCommunicator(msg.Transform.Name)
+	pipeline, err := GetPipeline(msg.Transform.Names())
 	if err != nil {
 		return nil, err
 	}
-	pr := &OfflineDP{comm: comm, tcbmsg: msg, config: config}
+	pr := &OfflineDP{pipeline: pipeline, tcbmsg: msg, config: config}
 	pr.requestTimeout = time.Duration(msg.Transform.Timeout)
+	if msg.Transform.Archive != nil {
+		if pr.arch, err = newArchXform(msg.Transform.Archive); err != nil {
+			return nil, err
+		}
+	}
 	return pr, nil
 }
 
@@ -45,13 +52,22 @@ func (dp *OfflineDP) Reader(lom *core.LOM, latestVer, sync bool) (cos.ReadOpenCl
 	var (
 		r      cos.ReadCloseSizer // note: +sizer
 		err    error
-		action = "read [" + dp.tcbmsg.Transform.Name + "]-transformed " + lom.Cname()
+		action = "read " + dp.pipeline.String() + "-transformed " + lom.Cname()
 	)
 	debug.Assert(!latestVer && !sync, "NIY") // TODO -- FIXME
 	call := func() (int, error) {
-		r, err = dp.comm.OfflineTransform(lom, dp.requestTimeout)
+		r, err = dp.pipeline.OfflineTransform(lom, dp.requestTimeout)
 		return 0, err
 	}
+	if dp.arch != nil {
+		// archived files (shards) - member by member
+		if mime, errV := archive.Mime("", lom.ObjName); errV == nil {
+			call = func() (int, error) {
+				r, err = dp.archReader(lom, mime)
+				return 0, err
+			}
+		}
+	}
 	// TODO: Check if ETL pod is healthy and wait some more if not (yet).
 	err = cmn.NetworkCallWithRetry(&cmn.RetryArgs{
 		Call:      call,
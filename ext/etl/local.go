@@ -0,0 +1,644 @@
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/env"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/k8s"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/sys"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Kubernetes-free ETL runtime: when not running in Kubernetes (or when explicitly configured
+// via env.AisETLRuntime), each target runs its transformer locally as:
+// - "process":          child process executing container's command and args
+//                       (the respective binary or script must be present on the host);
+// - "docker", "podman": container started via the respective engine CLI.
+//
+// The pod spec - user-provided (InitSpecMsg) or generated from InitCodeMsg - remains the single source
+// of truth: image, command, args, env, port, readiness probe, resource limits, (emptyDir and hostPath) volumes;
+// init containers (containers only) run to completion before the main one.
+// Host paths must be explicitly allowed (env.AisETLHostPaths); published container ports get bound
+// to the loopback (except Hpull, where clients get redirected to the transformer).
+//
+// Lifecycle is the same as with K8s pods: the transformer listens on a (free) local port and gets
+// health-checked via its readiness probe; when it crashes, it gets restarted (with backoff);
+// etl.Stop (or cluster membership change) terminates it.
+// Communication is also the same (Hpush, Hpull, Hrev, HpushStdin), with HpushStdin requiring
+// a container (that provides "/server" - see _updPodCommand).
+
+// ETL runtimes
+const (
+	RtK8s     = "k8s"
+	RtProcess = "process"
+	RtDocker  = "docker"
+	RtPodman  = "podman"
+)
+
+const (
+	localPortEnv = "PORT" // (process) the port to listen on
+
+	localLogSize     = cos.MiB // last logs to keep
+	localRestartMax  = 30 * time.Second
+	localStopTimeout = 10 * time.Second
+
+	localRunning = "Running" // (compare w/ K8s pod phases)
+	localPending = "Pending"
+)
+
+type (
+	localInst struct {
+		boot    *etlBootstrapper
+		logs    logRing
+		cmd     *exec.Cmd
+		stopCh  chan struct{}
+		done    chan struct{} // closed by monitor
+		rt      string        // enum above
+		name    string        // (same as pod name)
+		workDir string
+		host    string // published port's (and transformer's URI) host
+		status  string
+		main    []string   // process: command and args; container: engine's `run` arguments
+		env     []string   // ditto: process or engine CLI environment
+		inits   [][]string // container: init containers' `run` arguments and environment, in pairs
+		port    int
+		started int64 // mono time
+		cpu     struct {
+			total uint64 // ms
+			at    int64
+		}
+		mu       sync.Mutex
+		stopping bool
+		exited   bool // (and reaped - its pid may get recycled)
+	}
+	logRing struct {
+		buf []byte
+		mu  sync.Mutex
+	}
+)
+
+var (
+	rtConf struct {
+		name string
+		once sync.Once
+	}
+
+	ErrRuntimeRequired = errors.New("the operation requires Kubernetes or Kubernetes-free ETL runtime (" +
+		env.AisETLRuntime + "=" + RtProcess + "|" + RtDocker + "|" + RtPodman + ")")
+)
+
+// Returns configured ETL runtime (enum above) or empty string when ETL is not available.
+func Runtime() string {
+	rtConf.once.Do(func() {
+		name := os.Getenv(env.AisETLRuntime)
+		switch name {
+		case "":
+			if k8s.IsK8s() {
+				name = RtK8s
+			}
+		case RtK8s:
+			if !k8s.IsK8s() {
+				nlog.Errorln(env.AisETLRuntime+"="+name, "-", k8s.ErrK8sRequired)
+				name = ""
+			}
+		case RtProcess, RtDocker, RtPodman:
+			nlog.Infoln("ETL runtime:", name)
+		default:
+			nlog.Errorf("invalid %s=%q (expecting one of: %q, %q, %q, %q) - ETL is not available",
+				env.AisETLRuntime, name, RtK8s, RtProcess, RtDocker, RtPodman)
+			name = ""
+		}
+		rtConf.name = name
+	})
+	return rtConf.name
+}
+
+func CheckRuntime() error {
+	if Runtime() == "" {
+		return ErrRuntimeRequired
+	}
+	return nil
+}
+
+// (compare w/ K8s flow in `start`)
+func (b *etlBootstrapper) startLocal() error {
+	inst := &localInst{
+		boot:   b,
+		rt:     b.rt,
+		name:   b.pod.GetName(),
+		status: localPending,
+		stopCh: make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	inst.workDir = filepath.Join(os.TempDir(), "ais-etl", inst.name)
+	if err := inst.init(); err != nil {
+		inst.cleanup()
+		return cmn.NewErrETL(b.errCtx, err.Error())
+	}
+	if err := inst.runInits(); err != nil {
+		inst.cleanup()
+		return cmn.NewErrETL(b.errCtx, err.Error())
+	}
+	if err := inst.launch(); err != nil {
+		inst.cleanup()
+		return cmn.NewErrETLf(b.errCtx, "failed to start %s: %v", inst, err)
+	}
+	b.local = inst
+	go inst.monitor()
+
+	if err := inst.waitReady(); err != nil {
+		inst.stop()
+		return cmn.NewErrETL(b.errCtx, err.Error())
+	}
+	b.uri = inst.uri()
+	if cmn.Rom.FastV(4, cos.SmoduleETL) {
+		nlog.Infof("%s is ready, %s, %+v", inst, b.uri, b.errCtx)
+	}
+	return nil
+}
+
+///////////////
+// localInst //
+///////////////
+
+func (inst *localInst) String() string { return "etl-" + inst.rt + "[" + inst.name + "]" }
+
+func (inst *localInst) init() error {
+	var (
+		spec = &inst.boot.pod.Spec
+		c    = &spec.Containers[0]
+		vols = make(map[string]string, len(spec.Volumes))
+	)
+	if err := os.RemoveAll(inst.workDir); err != nil {
+		return err
+	}
+	if err := cos.CreateDir(inst.workDir); err != nil {
+		return err
+	}
+	for i := range spec.Volumes {
+		v := &spec.Volumes[i]
+		switch {
+		case v.EmptyDir != nil:
+			dir := filepath.Join(inst.workDir, "vol", v.Name)
+			if err := cos.CreateDir(dir); err != nil {
+				return err
+			}
+			vols[v.Name] = dir
+		case v.HostPath != nil:
+			dir, err := allowedHostPath(v.HostPath.Path)
+			if err != nil {
+				return fmt.Errorf("volume %q: %v", v.Name, err)
+			}
+			vols[v.Name] = dir
+		default:
+			return fmt.Errorf("volume %q: runtime %q supports only emptyDir and hostPath volumes", v.Name, inst.rt)
+		}
+	}
+	port, err := freePort()
+	if err != nil {
+		return err
+	}
+	inst.port = port
+	if inst.host, err = pubHost(inst.boot.msg.CommTypeX); err != nil {
+		return err
+	}
+
+	if inst.rt != RtProcess {
+		for i := range spec.InitContainers {
+			args, env, err := inst.runArgs(&spec.InitContainers[i], vols, inst.name+"-init-"+strconv.Itoa(i), false)
+			if err != nil {
+				return err
+			}
+			inst.inits = append(inst.inits, args, env)
+		}
+		inst.main, inst.env, err = inst.runArgs(c, vols, inst.name, true)
+		return err
+	}
+
+	// process
+	switch {
+	case len(spec.InitContainers) > 0:
+		return fmt.Errorf("init containers require container runtime (%q or %q)", RtDocker, RtPodman)
+	case len(c.VolumeMounts) > 0:
+		return fmt.Errorf("volume mounts require container runtime (%q or %q)", RtDocker, RtPodman)
+	case inst.boot.msg.CommTypeX == HpushStdin:
+		return fmt.Errorf("comm-type %q requires container runtime (%q or %q)", HpushStdin, RtDocker, RtPodman)
+	case len(c.Command) == 0:
+		return fmt.Errorf("container command is required to run ETL as a local process (image %q)", c.Image)
+	}
+	inst.main = append(slices.Clone(c.Command), c.Args...)
+	// (not inheriting aisnode environment)
+	inst.env = []string{"PATH=" + os.Getenv("PATH"), "HOME=" + inst.workDir, localPortEnv + "=" + strconv.Itoa(port)}
+	for _, e := range c.Env {
+		if e.ValueFrom != nil {
+			return fmt.Errorf("env %q: valueFrom is not supported by runtime %q", e.Name, inst.rt)
+		}
+		inst.env = append(inst.env, e.Name+"="+e.Value)
+	}
+	return nil
+}
+
+// `docker run` (`podman run`) arguments and environment - the latter to pass (possibly, multi-line) values
+// via `-e NAME` rather than command line
+func (inst *localInst) runArgs(c *corev1.Container, vols map[string]string, name string, publish bool) (args, env []string, _ error) {
+	args = []string{"run", "--rm", "--name", name}
+	env = os.Environ()
+	if publish {
+		args = append(args, "-p", net.JoinHostPort(inst.host, strconv.Itoa(inst.port))+":"+strconv.Itoa(int(c.Ports[0].ContainerPort)))
+	}
+	switch c.ImagePullPolicy {
+	case corev1.PullAlways:
+		args = append(args, "--pull=always")
+	case corev1.PullNever:
+		args = append(args, "--pull=never")
+	default:
+		args = append(args, "--pull=missing")
+	}
+	for _, e := range c.Env {
+		if e.ValueFrom != nil {
+			return nil, nil, fmt.Errorf("env %q: valueFrom is not supported by runtime %q", e.Name, inst.rt)
+		}
+		args = append(args, "-e", e.Name)
+		env = append(env, e.Name+"="+e.Value)
+	}
+	for _, m := range c.VolumeMounts {
+		dir, ok := vols[m.Name]
+		if !ok {
+			return nil, nil, fmt.Errorf("container %q: volume %q not found", c.Name, m.Name)
+		}
+		v := dir + ":" + m.MountPath
+		if m.ReadOnly {
+			v += ":ro"
+		}
+		args = append(args, "-v", v)
+	}
+	if q, ok := c.Resources.Limits[corev1.ResourceCPU]; ok {
+		args = append(args, "--cpus", strconv.FormatFloat(q.AsApproximateFloat64(), 'f', -1, 64))
+	}
+	if q, ok := c.Resources.Limits[corev1.ResourceMemory]; ok {
+		args = append(args, "--memory", strconv.FormatInt(q.Value(), 10))
+	}
+	if c.WorkingDir != "" {
+		args = append(args, "-w", c.WorkingDir)
+	}
+	if len(c.Command) > 0 {
+		args = append(args, "--entrypoint", c.Command[0])
+	}
+	args = append(args, c.Image)
+	if len(c.Command) > 1 {
+		args = append(args, c.Command[1:]...)
+	}
+	args = append(args, c.Args...)
+	return args, env, nil
+}
+
+// init containers: one at a time, to completion
+func (inst *localInst) runInits() error {
+	for i := 0; i < len(inst.inits); i += 2 {
+		args, env := inst.inits[i], inst.inits[i+1]
+		inst.rmContainer(args[3]) // (leftovers, if any)
+		cmd := exec.Command(inst.rt, args...)
+		cmd.Env = env
+		cmd.Stdout, cmd.Stderr = &inst.logs, &inst.logs
+		if err := cmd.Run(); err != nil {
+			return fmt.Errorf("init container %q failed: %v\n%s", args[3], err, inst.logs.tail(cos.KiB))
+		}
+	}
+	return nil
+}
+
+func (inst *localInst) launch() error {
+	var cmd *exec.Cmd
+	if inst.rt == RtProcess {
+		cmd = exec.Command(inst.main[0], inst.main[1:]...)
+		cmd.Dir = inst.workDir
+	} else {
+		inst.rmContainer(inst.name)
+		cmd = exec.Command(inst.rt, inst.main...)
+	}
+	cmd.Env = inst.env
+	cmd.Stdout, cmd.Stderr = &inst.logs, &inst.logs
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true} // (to terminate the entire group)
+	cmd.WaitDelay = time.Second
+
+	inst.mu.Lock()
+	defer inst.mu.Unlock()
+	if inst.stopping {
+		return errors.New(inst.String() + " is stopping")
+	}
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	inst.cmd, inst.started, inst.status, inst.exited = cmd, mono.NanoTime(), localRunning, false
+	inst.cpu.total, inst.cpu.at = 0, inst.started
+	return nil
+}
+
+// wait and restart when crashed (compare w/ K8s restartPolicy: Always)
+func (inst *localInst) monitor() {
+	defer close(inst.done)
+	backoff := time.Second
+	for {
+		inst.mu.Lock()
+		cmd, started := inst.cmd, inst.started
+		inst.mu.Unlock()
+
+		err := cmd.Wait()
+		inst.mu.Lock()
+		inst.exited = true
+		stopping := inst.stopping
+		inst.mu.Unlock()
+		if stopping {
+			return
+		}
+		uptime := mono.Since(started)
+		if uptime > time.Minute {
+			backoff = time.Second
+		}
+		nlog.Warningf("%s exited (uptime %v, err: %v) - restarting in %v\n%s", inst, uptime, err, backoff, inst.logs.tail(512))
+		inst.mu.Lock()
+		inst.status = localPending
+		inst.mu.Unlock()
+		for {
+			select {
+			case <-inst.stopCh:
+				return
+			case <-time.After(backoff):
+			}
+			backoff = min(backoff*2, localRestartMax)
+			if err := inst.launch(); err == nil {
+				break
+			} else if inst.isStopping() {
+				return
+			} else {
+				nlog.Errorln("failed to restart", inst.String()+":", err)
+			}
+		}
+	}
+}
+
+func (inst *localInst) isStopping() bool {
+	inst.mu.Lock()
+	stopping := inst.stopping
+	inst.mu.Unlock()
+	return stopping
+}
+
+func (inst *localInst) waitReady() error {
+	var (
+		c       = &inst.boot.pod.Spec.Containers[0]
+		timeout = inst.boot.msg.Timeout.D()
+		ival    = cos.ProbingFrequency(timeout)
+		u       = inst.uri() + c.ReadinessProbe.HTTPGet.Path
+		client  = &http.Client{Timeout: time.Duration(c.ReadinessProbe.TimeoutSeconds) * time.Second}
+		started = mono.NanoTime()
+		err     error
+	)
+	if cmn.Rom.FastV(4, cos.SmoduleETL) {
+		nlog.Infof("waiting %s ready (%s) timeout=%v ival=%v", inst, u, timeout, ival)
+	}
+	for {
+		var resp *http.Response
+		resp, err = client.Get(u) //nolint:noctx // (probe)
+		if err == nil {
+			cos.DrainReader(resp.Body)
+			resp.Body.Close()
+			if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+				return nil
+			}
+			err = fmt.Errorf("readiness probe returned status %d", resp.StatusCode)
+		}
+		if mono.Since(started) > timeout {
+			break
+		}
+		time.Sleep(ival)
+	}
+	return fmt.Errorf("%s failed to become ready in %v: %v\n%s", inst, timeout, err, inst.logs.tail(cos.KiB))
+}
+
+// (as seen by the target and, in case of Hpull, by the clients)
+func (inst *localInst) uri() string {
+	return "http://" + net.JoinHostPort(inst.host, strconv.Itoa(inst.port))
+}
+
+// loopback, unless the clients must reach the transformer directly (Hpull redirect) -
+// in which case the target's public interface (and not all interfaces)
+func pubHost(commType string) (string, error) {
+	const loopback = "127.0.0.1"
+	host := core.T.Snode().PubNet.Hostname
+	if commType != Hpull || host == "" {
+		return loopback, nil
+	}
+	if net.ParseIP(host) != nil {
+		return host, nil
+	}
+	addr, err := net.ResolveIPAddr("ip", host)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve %q: %v", host, err)
+	}
+	return addr.IP.String(), nil
+}
+
+// hostPath volume must be (or be located under) one of the env.AisETLHostPaths
+func allowedHostPath(path string) (string, error) {
+	if !filepath.IsAbs(path) {
+		return "", fmt.Errorf("host path %q must be absolute", path)
+	}
+	path = filepath.Clean(path)
+	if resolved, err := filepath.EvalSymlinks(path); err == nil {
+		path = resolved
+	}
+	for _, dir := range strings.Split(os.Getenv(env.AisETLHostPaths), ",") {
+		if dir = strings.TrimSpace(dir); dir == "" || !filepath.IsAbs(dir) {
+			continue
+		}
+		dir = filepath.Clean(dir)
+		if path == dir || strings.HasPrefix(path, dir+string(filepath.Separator)) || dir == string(filepath.Separator) {
+			return path, nil
+		}
+	}
+	return "", fmt.Errorf("host path %q is not allowed (see %s)", path, env.AisETLHostPaths)
+}
+
+func (inst *localInst) stop() {
+	inst.mu.Lock()
+	if inst.stopping {
+		inst.mu.Unlock()
+		return
+	}
+	inst.stopping = true
+	close(inst.stopCh)
+	cmd := inst.cmd
+	inst.mu.Unlock()
+
+	if inst.rt != RtProcess {
+		inst.rmContainer(inst.name)
+	}
+	if cmd != nil && cmd.Process != nil {
+		inst.kill(syscall.SIGTERM)
+		select {
+		case <-inst.done:
+		case <-time.After(localStopTimeout):
+			inst.kill(syscall.SIGKILL)
+			<-inst.done
+		}
+	}
+	inst.cleanup()
+	if cmn.Rom.FastV(4, cos.SmoduleETL) {
+		nlog.Infoln("stopped", inst.String())
+	}
+}
+
+// signal the entire process group - unless the process has already exited
+// (and got reaped), in which case the pid may now belong to someone else
+func (inst *localInst) kill(sig syscall.Signal) {
+	inst.mu.Lock()
+	if !inst.exited {
+		_ = syscall.Kill(-inst.cmd.Process.Pid, sig)
+	}
+	inst.mu.Unlock()
+}
+
+func (inst *localInst) cleanup() {
+	if err := os.RemoveAll(inst.workDir); err != nil {
+		nlog.Warningln(inst.String(), "failed to cleanup:", err)
+	}
+}
+
+func (inst *localInst) rmContainer(name string) {
+	// (ignoring errors, including "no such container")
+	_ = exec.Command(inst.rt, "rm", "-f", name).Run()
+}
+
+func (inst *localInst) health() string {
+	inst.mu.Lock()
+	status := inst.status
+	inst.mu.Unlock()
+	return status
+}
+
+// returns CPU (cores) and memory (bytes) usage
+func (inst *localInst) metrics() (float64, int64, error) {
+	if inst.rt != RtProcess {
+		return inst.containerMetrics()
+	}
+	inst.mu.Lock()
+	defer inst.mu.Unlock()
+	if inst.status != localRunning {
+		return 0, 0, cos.NewErrNotFound(core.T, "metrics for "+inst.String()+" (not running)")
+	}
+	stats, err := sys.ProcessStats(inst.cmd.Process.Pid)
+	if err != nil {
+		return 0, 0, err
+	}
+	var (
+		now   = mono.NanoTime()
+		cores float64
+	)
+	if elapsed := time.Duration(now - inst.cpu.at).Milliseconds(); elapsed > 0 && stats.CPU.Total >= inst.cpu.total {
+		cores = float64(stats.CPU.Total-inst.cpu.total) / float64(elapsed)
+	}
+	inst.cpu.total, inst.cpu.at = stats.CPU.Total, now
+	return cores, int64(stats.Mem.Resident), nil
+}
+
+// e.g.: "1.25% 10.5MiB / 7.6GiB"
+func (inst *localInst) containerMetrics() (float64, int64, error) {
+	out, err := exec.Command(inst.rt, "stats", "--no-stream", "--format", "{{.CPUPerc}} {{.MemUsage}}", inst.name).Output()
+	if err != nil {
+		return 0, 0, fmt.Errorf("%s: failed to get container stats: %v", inst, err)
+	}
+	fields := strings.Fields(string(out))
+	if len(fields) < 2 {
+		return 0, 0, fmt.Errorf("%s: unexpected container stats %q", inst, out)
+	}
+	pct, err := strconv.ParseFloat(strings.TrimSuffix(fields[0], "%"), 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%s: invalid CPU usage %q: %v", inst, fields[0], err)
+	}
+	mem, err := parseMemUsage(fields[1])
+	if err != nil {
+		return 0, 0, fmt.Errorf("%s: invalid memory usage %q: %v", inst, fields[1], err)
+	}
+	return pct / 100, mem, nil
+}
+
+func parseMemUsage(s string) (int64, error) {
+	units := [...]struct {
+		sfx  string
+		mult float64
+	}{
+		{"KiB", cos.KiB}, {"MiB", cos.MiB}, {"GiB", cos.GiB}, {"TiB", cos.TiB},
+		{"kB", 1e3}, {"KB", 1e3}, {"MB", 1e6}, {"GB", 1e9}, {"TB", 1e12}, {"B", 1},
+	}
+	for _, u := range units {
+		if num, ok := strings.CutSuffix(s, u.sfx); ok {
+			v, err := strconv.ParseFloat(num, 64)
+			return int64(v * u.mult), err
+		}
+	}
+	return strconv.ParseInt(s, 10, 64)
+}
+
+func freePort() (int, error) {
+	l, err := net.Listen("tcp", ":0")
+	if err != nil {
+		return 0, err
+	}
+	port := l.Addr().(*net.TCPAddr).Port
+	l.Close()
+	return port, nil
+}
+
+/////////////
+// logRing //
+/////////////
+
+func (lr *logRing) Write(b []byte) (int, error) {
+	lr.mu.Lock()
+	lr.buf = append(lr.buf, b...)
+	if over := len(lr.buf) - localLogSize; over > 0 {
+		lr.buf = append(lr.buf[:0], lr.buf[over:]...)
+	}
+	lr.mu.Unlock()
+	return len(b), nil
+}
+
+func (lr *logRing) get() []byte {
+	lr.mu.Lock()
+	b := slices.Clone(lr.buf)
+	lr.mu.Unlock()
+	return b
+}
+
+func (lr *logRing) tail(n int) string {
+	lr.mu.Lock()
+	b := lr.buf
+	if len(b) > n {
+		b = b[len(b)-n:]
+	}
+	s := string(b)
+	lr.mu.Unlock()
+	return s
+}
@@ -0,0 +1,94 @@
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/env"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+var _ = Describe("LocalRuntimeTest", func() {
+	It("should convert container spec to `docker run` arguments", func() {
+		inst := &localInst{rt: RtDocker, port: 51234, host: "127.0.0.1"}
+		c := &corev1.Container{
+			Name:            "server",
+			Image:           "aistorage/transformer_md5:latest",
+			ImagePullPolicy: corev1.PullAlways,
+			Command:         []string{"python", "server.py"},
+			Args:            []string{"--listen", "0.0.0.0"},
+			Ports:           []corev1.ContainerPort{{ContainerPort: 8000}},
+			Env:             []corev1.EnvVar{{Name: "AISTORE_CODE", Value: "line1\nline2"}},
+			VolumeMounts:    []corev1.VolumeMount{{Name: "code", MountPath: "/code", ReadOnly: true}},
+			Resources: corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("500m"),
+					corev1.ResourceMemory: resource.MustParse("1Gi"),
+				},
+			},
+		}
+		args, env, err := inst.runArgs(c, map[string]string{"code": "/tmp/code"}, "etl-md5", true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(strings.Join(args, " ")).To(Equal("run --rm --name etl-md5 -p 127.0.0.1:51234:8000 --pull=always -e AISTORE_CODE " +
+			"-v /tmp/code:/code:ro --cpus 0.5 --memory 1073741824 --entrypoint python " +
+			"aistorage/transformer_md5:latest server.py --listen 0.0.0.0"))
+		// (multi-line value via environment rather than command line)
+		Expect(env).To(ContainElement("AISTORE_CODE=line1\nline2"))
+
+		_, _, err = inst.runArgs(c, map[string]string{}, "etl-md5", true)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should only allow configured host paths", func() {
+		dir := GinkgoT().TempDir()
+		Expect(os.MkdirAll(filepath.Join(dir, "data", "sub"), 0o755)).To(Succeed())
+		Expect(os.Symlink("/etc", filepath.Join(dir, "data", "etc"))).To(Succeed())
+
+		GinkgoT().Setenv(env.AisETLHostPaths, "")
+		_, err := allowedHostPath(filepath.Join(dir, "data"))
+		Expect(err).To(HaveOccurred())
+
+		GinkgoT().Setenv(env.AisETLHostPaths, " /nonexistent, "+filepath.Join(dir, "data"))
+		for _, path := range []string{"data", "data/sub", "data/sub/../sub", "data/new"} {
+			_, err := allowedHostPath(filepath.Join(dir, path))
+			Expect(err).NotTo(HaveOccurred(), path)
+		}
+		for _, path := range []string{dir, filepath.Join(dir, "data-other"), filepath.Join(dir, "data", "etc"), "data/sub"} {
+			_, err := allowedHostPath(path)
+			Expect(err).To(HaveOccurred(), path)
+		}
+	})
+
+	It("should parse container memory usage", func() {
+		for s, expected := range map[string]int64{
+			"10.5MiB": int64(10.5 * cos.MiB),
+			"2GiB":    2 * cos.GiB,
+			"512kB":   512_000,
+			"100B":    100,
+			"4096":    4096,
+		} {
+			v, err := parseMemUsage(s)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(v).To(Equal(expected), s)
+		}
+		_, err := parseMemUsage("n/a")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should keep the tail of the logs", func() {
+		var lr logRing
+		lr.Write([]byte(strings.Repeat("a", localLogSize)))
+		lr.Write([]byte("bcd"))
+		Expect(lr.get()).To(HaveLen(localLogSize))
+		Expect(lr.tail(4)).To(Equal("abcd"))
+	})
+})
@@ -0,0 +1,273 @@
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// ETL pipeline: ordered list of named ETLs (stages), e.g. decode => augment => encode.
+//
+// The first stage transforms the object (any comm-type, see Communicator.OfflineTransform);
+// each subsequent stage transforms the output of the previous one as a stream
+// (see Communicator.TransformReader) - the data is never materialized in between.
+//
+// Per-stage metrics: each stage (ETL) counts, in its own xaction:
+// - objects and bytes received (in) and produced (out), and
+// - errors attributed to this stage (see ObjErr).
+// Failure to read the pipeline's input (e.g., PUT payload) is not attributed to any stage (see inputErr).
+
+type (
+	Pipeline []Communicator
+
+	// failure to transform a given object, attributed to a given stage
+	ObjErr struct {
+		err     error
+		ObjName string `json:"obj_name"`
+		ETLName string `json:"etl_name"` // failed stage
+		Message string `json:"msg"`
+		Stage   int    `json:"stage"`  // zero-based
+		Stages  int    `json:"stages"` // pipeline length
+	}
+
+	// failure to read pipeline's input (TransformReader) - not to blame any ETL
+	inputErr struct {
+		err error
+	}
+
+	// single object via pipeline
+	pipeRun struct {
+		err   error // the first one: *ObjErr or *inputErr
+		p     Pipeline
+		cname string
+		mu    sync.Mutex
+	}
+	// output of a given stage
+	stageReader struct {
+		r     cos.ReadCloseSizer
+		run   *pipeRun
+		err   error
+		n     int64
+		stage int
+	}
+)
+
+// interface guard
+var _ cos.ReadCloseSizer = (*stageReader)(nil)
+
+func GetPipeline(names []string) (Pipeline, error) {
+	if err := apc.ValidateETLPipeline(names); err != nil {
+		return nil, err
+	}
+	p := make(Pipeline, 0, len(names))
+	for _, name := range names {
+		comm, err := GetCommunicator(name)
+		if err != nil {
+			return nil, err
+		}
+		p = append(p, comm)
+	}
+	return p, nil
+}
+
+// whether a given ETL can transform streams - and therefore, serve as a non-first
+// pipeline stage or on-PUT ETL (see cmn.ETLConf)
+func CheckStream(msg InitMsg) error {
+	if msg.MsgType() == Wasm {
+		return nil
+	}
+	switch msg.CommType() {
+	case "", Hpush, HpushStdin:
+		if msg.ArgType() == ArgTypeDefault {
+			return nil
+		}
+	}
+	return fmt.Errorf("etl[%s]: comm-type %q with arg-type %q cannot transform streams (expecting %s or %s with default arg-type, or wasm)",
+		msg.Name(), msg.CommType(), msg.ArgType(), Hpush, HpushStdin)
+}
+
+func (p Pipeline) String() string {
+	if len(p) == 1 {
+		return "etl[" + p[0].Name() + "]"
+	}
+	var sb strings.Builder
+	sb.WriteString("etl-pipeline[")
+	for i, comm := range p {
+		if i > 0 {
+			sb.WriteString("=>")
+		}
+		sb.WriteString(comm.Name())
+	}
+	sb.WriteByte(']')
+	return sb.String()
+}
+
+// stream transformed object via all stages
+func (p Pipeline) OfflineTransform(lom *core.LOM, timeout time.Duration) (cos.ReadCloseSizer, error) {
+	run := &pipeRun{p: p, cname: lom.Cname()}
+	r, err := p[0].OfflineTransform(lom, timeout)
+	if err != nil {
+		return nil, run.fail(0, err)
+	}
+	p[0].Xact().InObjsAdd(1, lom.Lsize())
+	return run.chain(&stageReader{r: r, run: run}, 1, lom, timeout)
+}
+
+// stream new content (e.g., PUT) via all stages - each must be able to transform streams
+// (see CheckStream); `lom` names the object being written
+func (p Pipeline) TransformReader(r cos.ReadCloseSizer, lom *core.LOM, timeout time.Duration) (cos.ReadCloseSizer, error) {
+	run := &pipeRun{p: p, cname: lom.Cname()}
+	return run.chain(&stageReader{r: r, run: run, stage: -1 /*input*/}, 0, lom, timeout)
+}
+
+// (compare w/ pushComm.InlineTransform)
+func (p Pipeline) InlineTransform(w http.ResponseWriter, lom *core.LOM) error {
+	r, err := p.OfflineTransform(lom, 0 /*timeout*/)
+	if err != nil {
+		return err
+	}
+	size := r.Size()
+	if size < 0 {
+		size = memsys.DefaultBufSize
+	}
+	buf, slab := core.T.PageMM().AllocSize(size)
+	_, err = io.CopyBuffer(w, r, buf)
+	slab.Free(buf)
+	r.Close()
+	if cmn.Rom.FastV(5, cos.SmoduleETL) {
+		nlog.Infoln(p.String(), lom.Cname(), err)
+	}
+	return err
+}
+
+/////////////
+// pipeRun //
+/////////////
+
+// attribute failure to the stage that failed first - downstream stages fail as well
+// (e.g., upon reading truncated input) but they are not to blame;
+// same goes for all stages when the pipeline fails to read its input
+func (run *pipeRun) fail(stage int, err error) error {
+	run.mu.Lock()
+	if run.err != nil {
+		first := run.err
+		run.mu.Unlock()
+		return first
+	}
+	var (
+		inErr  *inputErr
+		objErr *ObjErr
+	)
+	if errors.As(err, &inErr) {
+		run.err = inErr
+		run.mu.Unlock()
+		return inErr
+	}
+	if !errors.As(err, &objErr) {
+		objErr = &ObjErr{
+			err:     err,
+			ObjName: run.cname,
+			ETLName: run.p[stage].Name(),
+			Message: err.Error(),
+			Stage:   stage,
+			Stages:  len(run.p),
+		}
+	}
+	run.err = objErr
+	run.mu.Unlock()
+
+	run.p[objErr.Stage].Xact().AddErr(objErr)
+	return objErr
+}
+
+func (run *pipeRun) chain(r cos.ReadCloseSizer, from int, lom *core.LOM, timeout time.Duration) (cos.ReadCloseSizer, error) {
+	var err error
+	for i := from; i < len(run.p); i++ {
+		in := r
+		if r, err = run.p[i].TransformReader(in, lom, timeout); err != nil {
+			return nil, run.fail(i, err)
+		}
+		r = &stageReader{r: r, run: run, stage: i}
+	}
+	return r, nil
+}
+
+func (run *pipeRun) failed() bool {
+	run.mu.Lock()
+	failed := run.err != nil
+	run.mu.Unlock()
+	return failed
+}
+
+/////////////////
+// stageReader //
+/////////////////
+
+func (sr *stageReader) Size() int64 { return sr.r.Size() }
+
+func (sr *stageReader) Read(b []byte) (n int, err error) {
+	n, err = sr.r.Read(b)
+	sr.n += int64(n)
+	if err != nil && err != io.EOF {
+		if sr.stage < 0 {
+			err = &inputErr{err} // (not to blame ETL for failing to read its input)
+		}
+		err = sr.run.fail(sr.stage, err)
+		sr.err = err
+	}
+	return n, err
+}
+
+// count stage's output (and the next stage's input)
+func (sr *stageReader) Close() error {
+	err := sr.r.Close()
+	if sr.err != nil || sr.run.failed() {
+		return err
+	}
+	p := sr.run.p
+	if sr.stage >= 0 {
+		xctn := p[sr.stage].Xact()
+		xctn.ObjsAdd(1, sr.n)
+		xctn.OutObjsAdd(1, sr.n)
+	}
+	if sr.stage < len(p)-1 {
+		p[sr.stage+1].Xact().InObjsAdd(1, sr.n)
+	}
+	return err
+}
+
+////////////
+// ObjErr //
+////////////
+
+func (e *ObjErr) Error() string {
+	if e.Stages <= 1 {
+		return fmt.Sprintf("etl[%s]: failed to transform %s: %s", e.ETLName, e.ObjName, e.Message)
+	}
+	return fmt.Sprintf("etl pipeline stage %d/%d [%s]: failed to transform %s: %s",
+		e.Stage+1, e.Stages, e.ETLName, e.ObjName, e.Message)
+}
+
+func (e *ObjErr) Unwrap() error { return e.err }
+
+//////////////
+// inputErr //
+//////////////
+
+func (e *inputErr) Error() string { return e.err.Error() }
+func (e *inputErr) Unwrap() error { return e.err }
@@ -0,0 +1,222 @@
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing/iotest"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("PipelineTest", func() {
+	var (
+		tmpDir   string
+		lom      *core.LOM
+		upper    *httptest.Server // hpush: to upper case
+		exclaim  *httptest.Server // hpush: append "!"
+		data     = []byte("decode, augment, encode")
+		expected = []byte("DECODE, AUGMENT, ENCODE!")
+
+		clusterBck = meta.NewBck("pipelineBck", apc.AIS, cmn.NsGlobal, &cmn.Bprops{Cksum: cmn.CksumConf{Type: cos.ChecksumNone}})
+		bmdMock    = mock.NewBaseBownerMock(clusterBck)
+	)
+
+	newPushComm := func(name, uri string) Communicator {
+		pod := &corev1.Pod{}
+		pod.SetName(name)
+		boot := &etlBootstrapper{
+			msg:             InitSpecMsg{InitMsgBase: InitMsgBase{IDX: name, CommTypeX: Hpush}},
+			pod:             pod,
+			uri:             uri,
+			xctn:            mock.NewXact(apc.ActETLInline),
+			originalPodName: name,
+		}
+		return newCommunicator(nil, boot)
+	}
+	newWasmComm := func(name string, module []byte, timeout time.Duration) Communicator {
+		msg := &InitWasmMsg{Module: module, ExecTimeout: cos.Duration(timeout)}
+		msg.IDX = name
+		Expect(msg.Validate()).NotTo(HaveOccurred())
+		mod, err := newWasmMod(msg)
+		Expect(err).NotTo(HaveOccurred())
+		comm := &wasmComm{}
+		comm.boot = &etlBootstrapper{wasm: mod, xctn: mock.NewXact(apc.ActETLInline), originalPodName: name}
+		return comm
+	}
+	transform := func(p Pipeline) ([]byte, error) {
+		r, err := p.OfflineTransform(lom, 0)
+		if err != nil {
+			return nil, err
+		}
+		b, err := cos.ReadAll(r)
+		r.Close()
+		return b, err
+	}
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = os.MkdirTemp("", "")
+		Expect(err).NotTo(HaveOccurred())
+		mpath := filepath.Join(tmpDir, "mpath")
+		Expect(cos.CreateDir(mpath)).NotTo(HaveOccurred())
+		fs.TestNew(nil)
+		_, err = fs.Add(mpath, "daeID")
+		Expect(err).NotTo(HaveOccurred())
+		_ = mock.NewTarget(bmdMock)
+
+		lom = &core.LOM{ObjName: "pipelineObj"}
+		Expect(lom.InitBck(clusterBck.Bucket())).NotTo(HaveOccurred())
+		f, err := cos.CreateFile(lom.FQN)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = f.Write(data)
+		Expect(err).NotTo(HaveOccurred())
+		f.Close()
+		lom.SetAtimeUnix(time.Now().UnixNano())
+		lom.SetSize(int64(len(data)))
+		Expect(lom.Persist()).NotTo(HaveOccurred())
+
+		upper = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			b, _ := cos.ReadAll(r.Body)
+			w.Write(bytes.ToUpper(b))
+		}))
+		exclaim = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			b, _ := cos.ReadAll(r.Body)
+			w.Write(append(b, '!'))
+		}))
+	})
+
+	AfterEach(func() {
+		_ = os.RemoveAll(tmpDir)
+		upper.Close()
+		exclaim.Close()
+	})
+
+	It("should stream object through all stages", func() {
+		p := Pipeline{
+			newPushComm("etl-upper", upper.URL),
+			newWasmComm("wasm-echo", wasmEcho, time.Minute),
+			newPushComm("etl-exclaim", exclaim.URL),
+		}
+		defer p[1].bootstrapper().wasm.close()
+
+		b, err := transform(p)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(b).To(Equal(expected))
+
+		// per-stage stats
+		for i, comm := range p {
+			xctn := comm.Xact()
+			Expect(xctn.Objs()).To(BeEquivalentTo(1), comm.Name())
+			Expect(xctn.InBytes()).To(BeEquivalentTo(len(data)), comm.Name())
+			out := len(data)
+			if i == len(p)-1 {
+				out = len(expected)
+			}
+			Expect(xctn.OutBytes()).To(BeEquivalentTo(out), comm.Name())
+		}
+	})
+
+	It("should attribute failure to the failed stage", func() {
+		p := Pipeline{
+			newPushComm("etl-upper", upper.URL),
+			newWasmComm("wasm-spin", wasmSpin, 100*time.Millisecond),
+			newPushComm("etl-exclaim", exclaim.URL),
+		}
+		defer p[1].bootstrapper().wasm.close()
+
+		_, err := transform(p)
+		Expect(err).To(HaveOccurred())
+		var objErr *ObjErr
+		Expect(errors.As(err, &objErr)).To(BeTrue(), err.Error())
+		Expect(objErr.Stage).To(Equal(1))
+		Expect(objErr.ETLName).To(Equal("wasm-spin"))
+		Expect(objErr.Stages).To(Equal(3))
+		Expect(p[1].Xact().(*mock.XactMock).ErrCnt()).To(Equal(1))
+		Expect(p[2].Xact().(*mock.XactMock).ErrCnt()).To(BeZero())
+	})
+
+	It("should transform new content via all stages", func() {
+		p := Pipeline{
+			newPushComm("etl-upper", upper.URL),
+			newPushComm("etl-exclaim", exclaim.URL),
+		}
+		in := cos.NewReaderWithArgs(cos.ReaderArgs{R: bytes.NewReader(data), Size: -1})
+		r, err := p.TransformReader(in, lom, 0)
+		Expect(err).NotTo(HaveOccurred())
+		b, err := cos.ReadAll(r)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(r.Close()).NotTo(HaveOccurred())
+		Expect(b).To(Equal(expected))
+
+		// (input gets closed by http transport)
+		Eventually(p[0].Xact().InBytes).Should(BeEquivalentTo(len(data)))
+		Expect(p[1].Xact().OutBytes()).To(BeEquivalentTo(len(expected)))
+	})
+
+	It("should not attribute failure to read the input to any stage", func() {
+		p := Pipeline{
+			newWasmComm("wasm-echo", wasmEcho, time.Minute),
+			newPushComm("etl-exclaim", exclaim.URL),
+		}
+		defer p[0].bootstrapper().wasm.close()
+
+		errRead := errors.New("client disconnected")
+		in := cos.NewReaderWithArgs(cos.ReaderArgs{R: io.MultiReader(bytes.NewReader(data), iotest.ErrReader(errRead)), Size: -1})
+		r, err := p.TransformReader(in, lom, 0)
+		if err == nil { // (hpush stage may fail right away)
+			_, err = cos.ReadAll(r)
+			r.Close()
+		}
+		Expect(err).To(HaveOccurred())
+		Expect(errors.Is(err, errRead)).To(BeTrue(), err.Error())
+		var objErr *ObjErr
+		Expect(errors.As(err, &objErr)).To(BeFalse(), err.Error())
+		for _, comm := range p {
+			Expect(comm.Xact().(*mock.XactMock).ErrCnt()).To(BeZero(), comm.Name())
+		}
+	})
+
+	It("should check whether ETL can transform streams", func() {
+		msg := &InitSpecMsg{InitMsgBase: InitMsgBase{IDX: "etl-stream", CommTypeX: Hpush}}
+		Expect(CheckStream(msg)).NotTo(HaveOccurred())
+		msg.CommTypeX = HpushStdin
+		Expect(CheckStream(msg)).NotTo(HaveOccurred())
+		msg.ArgTypeX = ArgTypeFQN
+		Expect(CheckStream(msg)).To(HaveOccurred())
+		msg.CommTypeX, msg.ArgTypeX = Hpull, ArgTypeDefault
+		Expect(CheckStream(msg)).To(HaveOccurred())
+		Expect(CheckStream(&InitWasmMsg{})).NotTo(HaveOccurred())
+	})
+
+	It("should reject stages that cannot transform streams", func() {
+		p := Pipeline{
+			newPushComm("etl-upper", upper.URL),
+			newPushComm("etl-exclaim", exclaim.URL),
+		}
+		p[1].bootstrapper().msg.ArgTypeX = ArgTypeFQN
+		_, err := transform(p)
+		Expect(err).To(HaveOccurred())
+		var objErr *ObjErr
+		Expect(errors.As(err, &objErr)).To(BeTrue())
+		Expect(objErr.Stage).To(Equal(1))
+	})
+})
@@ -147,7 +147,7 @@ func (e *Aborter) ListenSmapChanged() {
 // (common for both `InitCode` and `InitSpec` flows)
 func InitSpec(msg *InitSpecMsg, etlName string, opts StartOpts) error {
 	config := cmn.GCO.Get()
-	errCtx, podName, svcName, err := start(msg, etlName, opts, config)
+	boot, podName, svcName, err := start(msg, etlName, opts, config)
 	if err == nil {
 		if cmn.Rom.FastV(4, cos.SmoduleETL) {
 			nlog.Infof("started etl[%s], msg %s, pod %s", etlName, msg, podName)
@@ -156,8 +156,8 @@ func InitSpec(msg *InitSpecMsg, etlName string, opts StartOpts) error {
 	}
 	// cleanup
 	s := fmt.Sprintf("failed to start etl[%s], msg %s, err %v - cleaning up..", etlName, msg, err)
-	nlog.Warningln(cmn.NewErrETL(errCtx, s))
-	if errV := cleanupEntities(errCtx, podName, svcName); errV != nil {
+	nlog.Warningln(cmn.NewErrETL(boot.errCtx, s))
+	if errV := boot.cleanup(podName, svcName); errV != nil {
 		nlog.Errorln(errV)
 	}
 	return err
@@ -241,57 +241,68 @@ func cleanupEntities(errCtx *cmn.ETLErrCtx, podName, svcName string) (err error)
 
 // (does the heavy-lifting)
 // Returns:
-// * errCtx - ETL error context
+// * boot - ETL bootstrapper (including ETL error context)
 // * podName - non-empty if at least one attempt of creating pod was executed
 // * svcName - non-empty if at least one attempt of creating service was executed
 // * err - any error occurred that should be passed on.
-func start(msg *InitSpecMsg, xid string, opts StartOpts, config *cmn.Config) (errCtx *cmn.ETLErrCtx,
+func start(msg *InitSpecMsg, xid string, opts StartOpts, config *cmn.Config) (boot *etlBootstrapper,
 	podName, svcName string, err error) {
-	debug.Assert(k8s.NodeName != "") // checked above
-
-	errCtx = &cmn.ETLErrCtx{TID: core.T.SID(), ETLName: msg.IDX}
-	boot := &etlBootstrapper{errCtx: errCtx, config: config, env: opts.Env}
+	errCtx := &cmn.ETLErrCtx{TID: core.T.SID(), ETLName: msg.IDX}
+	boot = &etlBootstrapper{errCtx: errCtx, config: config, env: opts.Env, rt: Runtime()}
 	boot.msg = *msg
+	debug.Assert(boot.rt != "") // checked above
 
 	// Parse spec template and fill Pod object with necessary fields.
 	if err = boot.createPodSpec(); err != nil {
 		return
 	}
 
-	boot.createServiceSpec()
+	if boot.rt == RtK8s {
+		podName, svcName, err = boot.startK8s()
+	} else {
+		podName = boot.pod.GetName()
+		err = boot.startLocal()
+	}
+	if err != nil {
+		return
+	}
+
+	boot.setupXaction(msg, xid)
+
+	// finally, add Communicator to the runtime registry
+	comm := newCommunicator(newAborter(msg.IDX), boot)
+	if err = reg.add(msg.IDX, comm); err != nil {
+		return
+	}
+	core.T.Sowner().Listeners().Reg(comm)
+	return
+}
+
+// create K8s service and pod; wait for the latter to become ready
+func (b *etlBootstrapper) startK8s() (podName, svcName string, err error) {
+	b.createServiceSpec()
 
 	// 1. Cleanup previously started entities, if any.
-	errCleanup := cleanupEntities(errCtx, boot.pod.Name, boot.svc.Name)
+	errCleanup := cleanupEntities(b.errCtx, b.pod.Name, b.svc.Name)
 	debug.AssertNoErr(errCleanup)
 
 	// 2. Creating service.
-	svcName = boot.svc.GetName()
-	if err = boot.createEntity(k8s.Svc); err != nil {
+	svcName = b.svc.GetName()
+	if err = b.createEntity(k8s.Svc); err != nil {
 		return
 	}
 	// 3. Creating pod.
-	podName = boot.pod.GetName()
-	if err = boot.createEntity(k8s.Pod); err != nil {
+	podName = b.pod.GetName()
+	if err = b.createEntity(k8s.Pod); err != nil {
 		return
 	}
-	if err = boot.waitPodReady(); err != nil {
+	if err = b.waitPodReady(); err != nil {
 		return
 	}
 	if cmn.Rom.FastV(4, cos.SmoduleETL) {
-		nlog.Infof("pod %q is ready, %+v, %s", podName, msg, boot.errCtx)
-	}
-	if err = boot.setupConnection(); err != nil {
-		return
+		nlog.Infof("pod %q is ready, %+v, %s", podName, &b.msg, b.errCtx)
 	}
-
-	boot.setupXaction(xid)
-
-	// finally, add Communicator to the runtime registry
-	comm := newCommunicator(newAborter(msg.IDX), boot)
-	if err = reg.add(msg.IDX, comm); err != nil {
-		return
-	}
-	core.T.Sowner().Listeners().Reg(comm)
+	err = b.setupConnection()
 	return
 }
 
@@ -313,7 +324,7 @@ func Stop(id string, errCause error) error {
 	errCtx.PodName = c.PodName()
 	errCtx.SvcName = c.SvcName()
 
-	if err := cleanupEntities(errCtx, c.PodName(), c.SvcName()); err != nil {
+	if err := c.bootstrapper().cleanup(c.PodName(), c.SvcName()); err != nil {
 		return err
 	}
 
@@ -328,9 +339,6 @@ func Stop(id string, errCause error) error {
 
 // StopAll terminates all running ETLs.
 func StopAll() {
-	if !k8s.IsK8s() {
-		return
-	}
 	for _, e := range List() {
 		if err := Stop(e.Name, nil); err != nil {
 			nlog.Errorln(err)
@@ -353,6 +361,12 @@ func PodLogs(transformID string) (logs Logs, err error) {
 	if err != nil {
 		return logs, err
 	}
+	switch boot := c.bootstrapper(); {
+	case boot.wasm != nil:
+		return Logs{TargetID: core.T.SID(), Logs: boot.wasm.logs.get()}, nil
+	case boot.local != nil:
+		return Logs{TargetID: core.T.SID(), Logs: boot.local.logs.get()}, nil
+	}
 	client, err := k8s.GetClient()
 	if err != nil {
 		return logs, err
@@ -372,6 +386,12 @@ func PodHealth(etlName string) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	switch boot := c.bootstrapper(); {
+	case boot.wasm != nil:
+		return boot.wasm.health(), nil
+	case boot.local != nil:
+		return boot.local.health(), nil
+	}
 	client, err := k8s.GetClient()
 	if err != nil {
 		return "", err
@@ -384,6 +404,17 @@ func PodMetrics(etlName string) (*CPUMemUsed, error) {
 	if err != nil {
 		return nil, err
 	}
+	switch boot := c.bootstrapper(); {
+	case boot.wasm != nil:
+		cpuUsed, memUsed := boot.wasm.metrics()
+		return &CPUMemUsed{TargetID: core.T.SID(), CPU: cpuUsed, Mem: memUsed}, nil
+	case boot.local != nil:
+		cpuUsed, memUsed, err := boot.local.metrics()
+		if err != nil {
+			return nil, err
+		}
+		return &CPUMemUsed{TargetID: core.T.SID(), CPU: cpuUsed, Mem: memUsed}, nil
+	}
 	client, err := k8s.GetClient()
 	if err != nil {
 		return nil, err
@@ -0,0 +1,437 @@
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/sys"
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+	wasmsys "github.com/tetratelabs/wazero/sys"
+)
+
+// In-process WebAssembly (WASM) transforms.
+//
+// Each target compiles the user-provided module once (at init time) and then executes it
+// in a sandbox, one fresh module instance per transformed object - no HTTP hops, no containers.
+// Enforced limits:
+// * module: binary size (WasmMaxModuleSize);
+// * memory: linear memory of each instance (`mem_limit`);
+// * time:   per-object wall-clock execution time (`exec_timeout`) - the instance gets terminated
+//           (wazero: close on context done) when exceeded, whether computing or waiting on I/O;
+// * CPU:    there's no CPU-time accounting: the number of objects transformed concurrently
+//           (`concurrency`), each instance being single-threaded, bounds the CPUs in use.
+//
+// Host ABI - module "ais" exports (all pointers and lengths refer to the guest's linear memory):
+//   read(ptr, len u32) i32  - read up to len bytes of the input object; returns the number
+//                             of bytes read, zero at EOF, or -1 on error
+//   write(ptr, len u32) i32 - write len bytes of the transformed output; returns len, or -1 on error
+//   name(ptr, len u32) i32  - copy (up to len bytes of) "bucket/object" name; returns the full length
+//   log(ptr, len u32)       - append to ETL logs (see PodLogs)
+//
+// The module must export:
+//   memory
+//   transform() i32         - transform input => output; zero on success, non-zero otherwise
+// and may also export `_initialize` (called once upon instantiation).
+//
+// Also available: WASI (wasi_snapshot_preview1) without filesystem, network, or environment;
+// stdout and stderr go to ETL logs.
+
+const (
+	WasmMaxModuleSize  = 64 * cos.MiB
+	WasmDefMemLimit    = 64 * cos.MiB
+	WasmMaxMemLimit    = 4 * cos.GiB // (wasm32)
+	WasmDefExecTimeout = time.Minute
+	WasmMaxConcurrency = 1024
+
+	wasmMagic    = "\x00asm"
+	wasmPageSize = 64 * cos.KiB
+
+	wasmHostModule = "ais"
+	wasmTransform  = "transform"
+	wasmInitialize = "_initialize"
+)
+
+type (
+	// compiled module and its runtime
+	wasmMod struct {
+		rt       wazero.Runtime
+		compiled wazero.CompiledModule
+		cfg      wazero.ModuleConfig
+		sema     *cos.Semaphore
+		msg      *InitWasmMsg
+		logs     logRing
+		busy     atomic.Int64 // total execution time (ns)
+		mem      atomic.Int64 // linear memory of the running instances
+		cpu      struct {
+			busy int64
+			at   int64
+		}
+		closed atomic.Bool
+	}
+	// per-object invocation context
+	wasmCall struct {
+		r    io.Reader
+		w    io.Writer
+		name string
+		err  error // the first I/O error, if any
+	}
+	wasmCtxKey struct{}
+
+	wasmComm struct {
+		baseComm
+	}
+)
+
+// interface guard
+var _ Communicator = (*wasmComm)(nil)
+
+var errWasmAborted = errors.New("aborted")
+
+// Given user message `InitWasmMsg`, compile the module and register the corresponding communicator
+// (compare w/ `InitSpec` and `InitCode` - neither K8s nor Kubernetes-free runtime is required)
+func InitWasm(msg *InitWasmMsg, xid string) error {
+	errCtx := &cmn.ETLErrCtx{TID: core.T.SID(), ETLName: msg.IDX}
+	mod, err := newWasmMod(msg)
+	if err != nil {
+		return cmn.NewErrETL(errCtx, err.Error())
+	}
+	boot := &etlBootstrapper{errCtx: errCtx, config: cmn.GCO.Get(), wasm: mod}
+	boot.msg.InitMsgBase = msg.InitMsgBase
+	boot.originalPodName = msg.IDX
+	boot.setupXaction(msg, xid)
+
+	comm := &wasmComm{}
+	comm.listener, comm.boot = newAborter(msg.IDX), boot
+	if err := reg.add(msg.IDX, comm); err != nil {
+		comm.Stop()
+		mod.close()
+		return cmn.NewErrETL(errCtx, err.Error())
+	}
+	core.T.Sowner().Listeners().Reg(comm)
+	if cmn.Rom.FastV(4, cos.SmoduleETL) {
+		nlog.Infof("started etl[%s], msg %s", msg.IDX, msg)
+	}
+	return nil
+}
+
+/////////////
+// wasmMod //
+/////////////
+
+func newWasmMod(msg *InitWasmMsg) (*wasmMod, error) {
+	var (
+		ctx   = context.Background()
+		pages = uint32((msg.MemLimit + wasmPageSize - 1) / wasmPageSize)
+		rtcfg = wazero.NewRuntimeConfig().WithMemoryLimitPages(pages).WithCloseOnContextDone(true)
+		mod   = &wasmMod{msg: msg}
+	)
+	mod.rt = wazero.NewRuntimeWithConfig(ctx, rtcfg)
+	if _, err := wasi_snapshot_preview1.Instantiate(ctx, mod.rt); err != nil {
+		mod.close()
+		return nil, err
+	}
+	_, err := mod.rt.NewHostModuleBuilder(wasmHostModule).
+		NewFunctionBuilder().WithFunc(wasmRead).Export("read").
+		NewFunctionBuilder().WithFunc(wasmWrite).Export("write").
+		NewFunctionBuilder().WithFunc(wasmName).Export("name").
+		NewFunctionBuilder().WithFunc(mod.log).Export("log").
+		Instantiate(ctx)
+	if err != nil {
+		mod.close()
+		return nil, err
+	}
+	if mod.compiled, err = mod.rt.CompileModule(ctx, msg.Module); err != nil {
+		mod.close()
+		return nil, fmt.Errorf("failed to compile %s: %v", msg, err)
+	}
+	if err := mod.check(); err != nil {
+		mod.close()
+		return nil, err
+	}
+	mod.cfg = wazero.NewModuleConfig().
+		WithName(""). // anonymous, to instantiate concurrently
+		WithStartFunctions(wasmInitialize).
+		WithStdout(&mod.logs).
+		WithStderr(&mod.logs)
+
+	n := msg.Concurrency
+	if n == 0 {
+		n = sys.NumCPU()
+	}
+	mod.sema = cos.NewSemaphore(n)
+	mod.cpu.at = mono.NanoTime()
+	return mod, nil
+}
+
+func (mod *wasmMod) check() error {
+	def, ok := mod.compiled.ExportedFunctions()[wasmTransform]
+	if !ok {
+		return fmt.Errorf("%s: module does not export %q function", mod.msg, wasmTransform)
+	}
+	if len(def.ParamTypes()) != 0 || len(def.ResultTypes()) != 1 || def.ResultTypes()[0] != api.ValueTypeI32 {
+		return fmt.Errorf("%s: invalid %q signature (expecting `%s() i32`)", mod.msg, wasmTransform, wasmTransform)
+	}
+	if len(mod.compiled.ExportedMemories()) == 0 {
+		return fmt.Errorf("%s: module does not export memory", mod.msg)
+	}
+	return nil
+}
+
+// transform a single object: instantiate, call, and discard
+func (mod *wasmMod) run(ctx context.Context, r io.Reader, w io.Writer, name string) (err error) {
+	select {
+	case <-mod.sema.TryAcquire():
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	defer mod.sema.Release()
+	if mod.closed.Load() {
+		return errWasmAborted
+	}
+
+	var (
+		inst    api.Module
+		res     []uint64
+		call    = &wasmCall{r: r, w: w, name: name}
+		started = mono.NanoTime()
+		tctx    = context.WithValue(ctx, wasmCtxKey{}, call)
+	)
+	tctx, cancel := context.WithTimeout(tctx, mod.msg.ExecTimeout.D())
+	defer cancel()
+
+	if inst, err = mod.rt.InstantiateModule(tctx, mod.compiled, mod.cfg); err != nil {
+		return mod.callErr(tctx, call, err)
+	}
+	size := int64(inst.Memory().Size())
+	mod.mem.Add(size)
+
+	res, err = inst.ExportedFunction(wasmTransform).Call(tctx)
+
+	inst.Close(ctx)
+	mod.mem.Sub(size)
+	mod.busy.Add(mono.Since(started).Nanoseconds())
+
+	switch {
+	case err != nil:
+		return mod.callErr(tctx, call, err)
+	case call.err != nil:
+		return call.err
+	case int32(res[0]) != 0:
+		return fmt.Errorf("%s(%s) returned %d", wasmTransform, name, int32(res[0]))
+	}
+	return nil
+}
+
+func (mod *wasmMod) callErr(ctx context.Context, call *wasmCall, err error) error {
+	var exitErr *wasmsys.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() == 0 { // (WASI proc_exit(0))
+		return call.err
+	}
+	switch {
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		return fmt.Errorf("%s(%s): exceeded exec-timeout %v", wasmTransform, call.name, mod.msg.ExecTimeout)
+	case ctx.Err() != nil:
+		return fmt.Errorf("%s(%s): %w", wasmTransform, call.name, ctx.Err())
+	case call.err != nil:
+		return call.err
+	}
+	return fmt.Errorf("%s(%s): %v", wasmTransform, call.name, err)
+}
+
+func (mod *wasmMod) close() {
+	if !mod.closed.CAS(false, true) {
+		return
+	}
+	if err := mod.rt.Close(context.Background()); err != nil {
+		nlog.Warningln(mod.msg.String(), "close:", err)
+	}
+}
+
+func (mod *wasmMod) health() string {
+	if mod.closed.Load() {
+		return localPending
+	}
+	return localRunning
+}
+
+// CPU: cores used since the previous call; memory: linear memory of the running instances
+func (mod *wasmMod) metrics() (float64, int64) {
+	var (
+		cores float64
+		now   = mono.NanoTime()
+		busy  = mod.busy.Load()
+	)
+	if elapsed := now - mod.cpu.at; elapsed > 0 {
+		cores = float64(busy-mod.cpu.busy) / float64(elapsed)
+	}
+	mod.cpu.busy, mod.cpu.at = busy, now
+	return cores, mod.mem.Load()
+}
+
+//
+// host ABI
+//
+
+func wasmRead(ctx context.Context, m api.Module, ptr, size uint32) int32 {
+	call := ctx.Value(wasmCtxKey{}).(*wasmCall)
+	buf, ok := m.Memory().Read(ptr, size)
+	if !ok {
+		call.err = fmt.Errorf("%s(%s): read buffer out of range [%d, %d)", wasmTransform, call.name, ptr, ptr+size)
+		return -1
+	}
+	for len(buf) > 0 {
+		n, err := call.r.Read(buf)
+		if n > 0 {
+			return int32(n)
+		}
+		if err == io.EOF {
+			return 0
+		}
+		if err != nil {
+			call.err = err
+			return -1
+		}
+	}
+	return 0
+}
+
+func wasmWrite(ctx context.Context, m api.Module, ptr, size uint32) int32 {
+	call := ctx.Value(wasmCtxKey{}).(*wasmCall)
+	buf, ok := m.Memory().Read(ptr, size)
+	if !ok {
+		call.err = fmt.Errorf("%s(%s): write buffer out of range [%d, %d)", wasmTransform, call.name, ptr, ptr+size)
+		return -1
+	}
+	if _, err := call.w.Write(buf); err != nil {
+		if call.err == nil {
+			call.err = err
+		}
+		return -1
+	}
+	return int32(size)
+}
+
+func wasmName(ctx context.Context, m api.Module, ptr, size uint32) int32 {
+	call := ctx.Value(wasmCtxKey{}).(*wasmCall)
+	n := min(int(size), len(call.name))
+	if !m.Memory().Write(ptr, cos.UnsafeB(call.name[:n])) {
+		return -1
+	}
+	return int32(len(call.name))
+}
+
+func (mod *wasmMod) log(_ context.Context, m api.Module, ptr, size uint32) {
+	if b, ok := m.Memory().Read(ptr, size); ok {
+		mod.logs.Write(b)
+		if len(b) > 0 && b[len(b)-1] != '\n' {
+			mod.logs.Write([]byte{'\n'})
+		}
+	}
+}
+
+//////////////
+// wasmComm //
+//////////////
+
+func (wc *wasmComm) PodName() string { return "" }
+func (wc *wasmComm) SvcName() string { return "" }
+
+func (wc *wasmComm) String() string {
+	return fmt.Sprintf("%s[%s]-%s", wc.boot.originalPodName, wc.boot.xctn.ID(), Wasm)
+}
+
+func (wc *wasmComm) InlineTransform(w http.ResponseWriter, r *http.Request, lom *core.LOM) error {
+	fh, err := wc.open(lom)
+	if err != nil {
+		return err
+	}
+	err = wc.boot.wasm.run(r.Context(), fh, w, lom.Cname())
+	cos.Close(fh)
+	if cmn.Rom.FastV(5, cos.SmoduleETL) {
+		nlog.Infoln(Wasm, lom.Cname(), err)
+	}
+	return err
+}
+
+func (wc *wasmComm) OfflineTransform(lom *core.LOM, timeout time.Duration) (cos.ReadCloseSizer, error) {
+	clone := *lom
+	fh, err := wc.open(&clone)
+	if err != nil {
+		return nil, err
+	}
+	return wc.stream(fh, clone.Cname(), timeout), nil
+}
+
+func (wc *wasmComm) TransformReader(r cos.ReadCloseSizer, lom *core.LOM, timeout time.Duration) (cos.ReadCloseSizer, error) {
+	if err := wc.boot.xctn.AbortErr(); err != nil {
+		r.Close()
+		return nil, err
+	}
+	return wc.stream(r, lom.Cname(), timeout), nil
+}
+
+// stream transformed output via pipe; closing the returned reader terminates the transform
+func (wc *wasmComm) stream(fh io.ReadCloser, cname string, timeout time.Duration) cos.ReadCloseSizer {
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+		pr, pw = io.Pipe()
+	)
+	if timeout != 0 {
+		ctx, cancel = context.WithTimeout(context.Background(), timeout)
+	} else {
+		ctx, cancel = context.WithCancel(context.Background())
+	}
+	go func() {
+		err := wc.boot.wasm.run(ctx, fh, pw, cname)
+		cos.Close(fh)
+		pw.CloseWithError(err) // (nil => io.EOF)
+		if cmn.Rom.FastV(5, cos.SmoduleETL) {
+			nlog.Infoln(Wasm, cname, err)
+		}
+	}()
+	return cos.NewReaderWithArgs(cos.ReaderArgs{R: pr, Size: -1, DeferCb: cancel})
+}
+
+// open local replica (cold-GET remote object if need be); compare w/ pushComm.doRequest
+func (wc *wasmComm) open(lom *core.LOM) (cos.ReadOpenCloser, error) {
+	if err := wc.boot.xctn.AbortErr(); err != nil {
+		return nil, err
+	}
+	if err := lom.InitBck(lom.Bucket()); err != nil {
+		return nil, err
+	}
+	fh, err := wc._open(lom)
+	if err != nil && cos.IsNotExist(err, 0) && lom.Bucket().IsRemote() {
+		if _, err = core.T.GetCold(context.Background(), lom, cmn.OwtGetLock); err != nil {
+			return nil, err
+		}
+		fh, err = wc._open(lom)
+	}
+	return fh, err
+}
+
+func (*wasmComm) _open(lom *core.LOM) (cos.ReadOpenCloser, error) {
+	lom.Lock(false)
+	defer lom.Unlock(false)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return nil, err
+	}
+	return lom.NewHandle()
+}
@@ -0,0 +1,101 @@
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// hand-assembled modules importing "ais" read and write, and exporting memory and `transform() i32`:
+// - echo:   copy input to output, 64KiB at a time
+// - spin:   loop forever
+// - bigmem: same as spin, with initial memory of 64 pages (4MiB)
+var (
+	wasmEcho   = []byte("\x00\x61\x73\x6d\x01\x00\x00\x00\x01\x0b\x02\x60\x02\x7f\x7f\x01\x7f\x60\x00\x01\x7f\x02\x18\x02\x03\x61\x69\x73\x04\x72\x65\x61\x64\x00\x00\x03\x61\x69\x73\x05\x77\x72\x69\x74\x65\x00\x00\x03\x02\x01\x01\x05\x03\x01\x00\x01\x07\x16\x02\x06\x6d\x65\x6d\x6f\x72\x79\x02\x00\x09\x74\x72\x61\x6e\x73\x66\x6f\x72\x6d\x00\x02\x0a\x30\x01\x2e\x01\x01\x7f\x03\x40\x41\x00\x41\x80\x80\x04\x10\x00\x22\x00\x45\x04\x40\x41\x00\x0f\x0b\x20\x00\x41\x00\x48\x04\x40\x41\x01\x0f\x0b\x41\x00\x20\x00\x10\x01\x1a\x0c\x00\x0b\x41\x00\x0b")
+	wasmSpin   = []byte("\x00\x61\x73\x6d\x01\x00\x00\x00\x01\x0b\x02\x60\x02\x7f\x7f\x01\x7f\x60\x00\x01\x7f\x02\x18\x02\x03\x61\x69\x73\x04\x72\x65\x61\x64\x00\x00\x03\x61\x69\x73\x05\x77\x72\x69\x74\x65\x00\x00\x03\x02\x01\x01\x05\x03\x01\x00\x01\x07\x16\x02\x06\x6d\x65\x6d\x6f\x72\x79\x02\x00\x09\x74\x72\x61\x6e\x73\x66\x6f\x72\x6d\x00\x02\x0a\x0b\x01\x09\x00\x03\x40\x0c\x00\x0b\x41\x00\x0b")
+	wasmBigMem = []byte("\x00\x61\x73\x6d\x01\x00\x00\x00\x01\x0b\x02\x60\x02\x7f\x7f\x01\x7f\x60\x00\x01\x7f\x02\x18\x02\x03\x61\x69\x73\x04\x72\x65\x61\x64\x00\x00\x03\x61\x69\x73\x05\x77\x72\x69\x74\x65\x00\x00\x03\x02\x01\x01\x05\x03\x01\x00\x40\x07\x16\x02\x06\x6d\x65\x6d\x6f\x72\x79\x02\x00\x09\x74\x72\x61\x6e\x73\x66\x6f\x72\x6d\x00\x02\x0a\x0b\x01\x09\x00\x03\x40\x0c\x00\x0b\x41\x00\x0b")
+)
+
+var _ = Describe("WasmTest", func() {
+	newMsg := func(module []byte) *InitWasmMsg {
+		msg := &InitWasmMsg{Module: module}
+		msg.IDX = "wasm-test"
+		Expect(msg.Validate()).NotTo(HaveOccurred())
+		return msg
+	}
+
+	It("should validate init message", func() {
+		msg := newMsg(wasmEcho)
+		Expect(msg.MemLimit).To(BeEquivalentTo(WasmDefMemLimit))
+		Expect(msg.ExecTimeout.D()).To(Equal(WasmDefExecTimeout))
+
+		msg = &InitWasmMsg{Module: []byte("#!/bin/sh")}
+		msg.IDX = "wasm-test"
+		Expect(msg.Validate()).To(HaveOccurred())
+
+		msg = &InitWasmMsg{Module: wasmEcho, MemLimit: cos.KiB}
+		msg.IDX = "wasm-test"
+		Expect(msg.Validate()).To(HaveOccurred())
+
+		msg = &InitWasmMsg{Module: wasmEcho}
+		msg.IDX, msg.CommTypeX = "wasm-test", Hpull
+		Expect(msg.Validate()).To(HaveOccurred())
+
+		big := make([]byte, WasmMaxModuleSize+1)
+		copy(big, wasmEcho)
+		msg = &InitWasmMsg{Module: big}
+		msg.IDX = "wasm-test"
+		Expect(msg.Validate()).To(HaveOccurred())
+	})
+
+	It("should stream input to output", func() {
+		mod, err := newWasmMod(newMsg(wasmEcho))
+		Expect(err).NotTo(HaveOccurred())
+		defer mod.close()
+
+		for _, size := range []int{0, 1, 64 * cos.KiB, 3*cos.MiB + 17} {
+			in := make([]byte, size)
+			copy(in, strings.Repeat("0123456789", size/10+1))
+			out := &bytes.Buffer{}
+			Expect(mod.run(context.Background(), bytes.NewReader(in), out, "bck/obj")).NotTo(HaveOccurred())
+			Expect(out.Bytes()).To(Equal(in))
+		}
+		Expect(mod.mem.Load()).To(BeZero())
+	})
+
+	It("should enforce exec timeout", func() {
+		msg := newMsg(wasmSpin)
+		msg.ExecTimeout = cos.Duration(100 * time.Millisecond)
+		mod, err := newWasmMod(msg)
+		Expect(err).NotTo(HaveOccurred())
+		defer mod.close()
+
+		err = mod.run(context.Background(), bytes.NewReader(nil), &bytes.Buffer{}, "bck/obj")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("exec-timeout"))
+	})
+
+	It("should enforce memory limit", func() {
+		msg := newMsg(wasmBigMem)
+		msg.MemLimit = cos.MiB
+		_, err := newWasmMod(msg)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("memory"))
+	})
+
+	It("should reject modules that don't export transform", func() {
+		module := bytes.Replace(wasmEcho, []byte("transform"), []byte("transfore"), 1)
+		_, err := newWasmMod(newMsg(module))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(wasmTransform))
+	})
+})
@@ -34,10 +34,11 @@ import (
 const (
 	contentTypeLen = 2
 
-	ObjectType   = "ob"
-	WorkfileType = "wk"
-	ECSliceType  = "ec"
-	ECMetaType   = "mt"
+	ObjectType     = "ob"
+	WorkfileType   = "wk"
+	ECSliceType    = "ec"
+	ECMetaType     = "mt"
+	ObjVersionType = "vr" // retained prior versions (see cmn.VersionConf.Retain)
 )
 
 type (
@@ -69,10 +70,11 @@ type (
 )
 
 type (
-	ObjectContentResolver   struct{}
-	WorkfileContentResolver struct{}
-	ECSliceContentResolver  struct{}
-	ECMetaContentResolver   struct{}
+	ObjectContentResolver     struct{}
+	WorkfileContentResolver   struct{}
+	ECSliceContentResolver    struct{}
+	ECMetaContentResolver     struct{}
+	ObjVersionContentResolver struct{}
 )
 
 var CSM *contentSpecMgr
@@ -83,6 +85,7 @@ var (
 	_ ContentResolver = (*WorkfileContentResolver)(nil)
 	_ ContentResolver = (*ECSliceContentResolver)(nil)
 	_ ContentResolver = (*ECMetaContentResolver)(nil)
+	_ ContentResolver = (*ObjVersionContentResolver)(nil)
 )
 
 func (f *contentSpecMgr) Resolver(contentType string) ContentResolver {
@@ -217,3 +220,21 @@ func (*ECMetaContentResolver) GenUniqueFQN(base, _ string) string { return base
 func (*ECMetaContentResolver) ParseUniqueFQN(base string) (orig string, old, ok bool) {
 	return base, false, true
 }
+
+// prior version: <object-name>.<version> where version is a decimal number
+// (ais:// objects only)
+func (*ObjVersionContentResolver) GenUniqueFQN(base, ver string) string {
+	debug.Assert(ver != "")
+	return base + "." + ver
+}
+
+func (*ObjVersionContentResolver) ParseUniqueFQN(base string) (orig string, old, ok bool) {
+	i := strings.LastIndexByte(base, '.')
+	if i <= 0 || i == len(base)-1 {
+		return "", false, false
+	}
+	if _, err := strconv.ParseUint(base[i+1:], 10, 64); err != nil {
+		return "", false, false
+	}
+	return base[:i], false, true
+}
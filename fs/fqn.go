@@ -35,6 +35,9 @@ const (
 	WorkfileAppend       = "append"         // APPEND to object (as file)
 	WorkfileAppendToArch = "append-to-arch" // APPEND to existing archive
 	WorkfileCreateArch   = "create-arch"    // CREATE multi-object archive
+	WorkfileSSE          = "sse"            // encrypt (plaintext) work file
+	WorkfileETL          = "etl"            // transform new content (on-PUT ETL)
+	WorkfileInventory    = "inventory"      // bucket inventory (shard)
 )
 
 type ParsedFQN struct {
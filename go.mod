@@ -17,6 +17,7 @@ require (
 	github.com/golang-jwt/jwt/v4 v4.5.1
 	github.com/json-iterator/go v1.1.12
 	github.com/karrick/godirwalk v1.17.0
+	github.com/klauspost/compress v1.17.11
 	github.com/klauspost/reedsolomon v1.12.4
 	github.com/lufia/iostat v1.2.1
 	github.com/onsi/ginkgo/v2 v2.21.0
@@ -27,6 +28,7 @@ require (
 	github.com/prometheus/client_golang v1.20.5
 	github.com/seiflotfy/cuckoofilter v0.0.0-20240715131351-a2f2c23f1771
 	github.com/teris-io/shortid v0.0.0-20220617161101-71ec9f2aa569
+	github.com/tetratelabs/wazero v1.8.2
 	github.com/tidwall/buntdb v1.3.2
 	github.com/tinylib/msgp v1.2.4
 	github.com/valyala/fasthttp v1.57.0
@@ -103,7 +105,6 @@ require (
 	github.com/grpc-ecosystem/grpc-gateway/v2 v2.23.0 // indirect
 	github.com/imdario/mergo v0.3.16 // indirect
 	github.com/josharian/intern v1.0.0 // indirect
-	github.com/klauspost/compress v1.17.11 // indirect
 	github.com/klauspost/cpuid/v2 v2.2.9 // indirect
 	github.com/mailru/easyjson v0.7.7 // indirect
 	github.com/modern-go/concurrent v0.0.0-20180306012644-bacd9c7ef1dd // indirect
@@ -23,6 +23,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/fname"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/cmn/prob"
+	"github.com/NVIDIA/aistore/cmn/sse"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/ec"
@@ -845,6 +846,13 @@ func (rj *rebJogger) _lwalk(lom *core.LOM, fqn string) error {
 		return err
 	}
 
+	// retained prior versions, if any, precede the object (that, once acknowledged,
+	// gets removed together with its versions - see ackLomAck)
+	if err := rj.sendVersions(lom, tsi); err != nil {
+		cos.Close(roc)
+		return err
+	}
+
 	// transmit (unlock via transport completion => roc.Close)
 	rj.m.addLomAck(lom)
 	if err := rj.doSend(lom, tsi, roc); err != nil {
@@ -867,6 +875,9 @@ func _getReader(lom *core.LOM) (roc cos.ReadOpenCloser, err error) {
 		err = cmn.ErrSkip
 		return
 	}
+	if lom.IsEncrypted() {
+		return lom.NewDeferRawROC() // (ciphertext as is - see doSend)
+	}
 	if lom.Checksum() == nil {
 		if _, err = lom.ComputeSetCksum(); err != nil {
 			lom.Unlock(false)
@@ -888,6 +899,37 @@ func (rj *rebJogger) doSend(lom *core.LOM, tsi *meta.Snode, roc cos.ReadOpenClos
 	o.Hdr.ObjName = lom.ObjName
 	o.Hdr.Opaque = opaque
 	o.Hdr.ObjAttrs.CopyFrom(lom.ObjAttrs(), false /*skip cksum*/)
+	if lom.IsEncrypted() {
+		o.Hdr.ObjAttrs.Size = sse.EncSize(lom.Lsize()) // bytes on the wire (receiver restores plaintext size)
+	}
 	o.Callback, o.CmplArg = rj.objSentCallback, lom
 	return rj.m.dm.Send(o, roc, tsi)
 }
+
+func (rj *rebJogger) sendVersions(lom *core.LOM, tsi *meta.Snode) error {
+	if !lom.Bck().IsAIS() {
+		return nil
+	}
+	for _, ver := range lom.PriorVersions() {
+		roc, fsize, oa, err := lom.OpenVersion(ver)
+		if err != nil {
+			if cos.IsNotExist(err, 0) {
+				continue
+			}
+			return err
+		}
+		var (
+			ack = regularAck{rebID: rj.m.RebID(), daemonID: core.T.SID()}
+			o   = transport.AllocSend()
+		)
+		o.Hdr.Bck.Copy(lom.Bucket())
+		o.Hdr.ObjName = lom.ObjName
+		o.Hdr.Opaque = ack.NewVerPack(oa.Size)
+		o.Hdr.ObjAttrs = *oa
+		o.Hdr.ObjAttrs.Size = fsize
+		if err := rj.m.dm.Send(o, roc, tsi); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -14,6 +14,7 @@ const (
 	rebMsgRegular = iota // regular rebalance: acknowledge/Object
 	rebMsgEC             // EC rebalance: acknowledge/CT/Namespace
 	rebMsgNtfn           // stage transition notification (via DM's ack stream) _or_ EC md update (via data stream)
+	rebMsgVer            // regular rebalance: retained prior version of the object that follows (not acknowledged)
 )
 
 const rebMsgKindSize = 1
@@ -79,6 +80,16 @@ func (rack *regularAck) NewPack() []byte {
 	return packer.Bytes()
 }
 
+// prior version: same as above plus the version's (logical) size
+func (rack *regularAck) NewVerPack(lsize int64) []byte {
+	l := rebMsgKindSize + rack.PackedSize() + cos.SizeofI64
+	packer := cos.NewPacker(nil, l)
+	packer.WriteByte(rebMsgVer)
+	packer.WriteAny(rack)
+	packer.WriteInt64(lsize)
+	return packer.Bytes()
+}
+
 // rebID + len(DaemonID) + DaemonID
 func (rack *regularAck) PackedSize() int {
 	return cos.SizeofI64 + cos.SizeofLen + len(rack.daemonID)
@@ -15,6 +15,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/cmn/sse"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/ec"
@@ -50,9 +51,13 @@ func (reb *Reb) recvObj(hdr *transport.ObjHdr, objReader io.Reader, err error) e
 		nlog.Errorf("g[%d]: failed to recv recv-obj action (regular or EC): %v", reb.RebID(), err)
 		return reb._recvErr(err)
 	}
-	if act == rebMsgRegular {
+	switch act {
+	case rebMsgRegular:
 		err := reb.recvObjRegular(hdr, smap, unpacker, objReader)
 		return reb._recvErr(err)
+	case rebMsgVer:
+		err := reb.recvVer(hdr, unpacker, objReader)
+		return reb._recvErr(err)
 	}
 	debug.Assertf(act == rebMsgEC, "act=%d", act)
 	err = reb.recvECData(hdr, unpacker, objReader)
@@ -143,6 +148,9 @@ func (reb *Reb) recvObjRegular(hdr *transport.ObjHdr, smap *meta.Smap, unpacker
 		return nil
 	}
 	tsid := ack.daemonID // the sender
+	if _, ok := hdr.ObjAttrs.GetCustomKey(cmn.SSEAlgObjMD); ok {
+		hdr.ObjAttrs.Size = sse.PlainSize(hdr.ObjAttrs.Size) // (ciphertext on the wire - see doSend)
+	}
 	// Rx
 	lom := core.AllocLOM(hdr.ObjName)
 	defer core.FreeLOM(lom)
@@ -232,6 +240,48 @@ rx:
 	return reb.regACK(smap, hdr, tsid)
 }
 
+// retained prior version (see core/lom_ver.go) - precedes the current object
+// and is not acknowledged separately
+func (reb *Reb) recvVer(hdr *transport.ObjHdr, unpacker *cos.ByteUnpack, objReader io.Reader) error {
+	ack := &regularAck{}
+	if err := unpacker.ReadAny(ack); err != nil {
+		nlog.Errorf("g[%d]: failed to parse version header: %v", reb.RebID(), err)
+		return err
+	}
+	lsize, err := unpacker.ReadInt64()
+	if err != nil {
+		nlog.Errorf("g[%d]: failed to parse version header: %v", reb.RebID(), err)
+		return err
+	}
+	if ack.rebID != reb.RebID() {
+		nlog.Warningln("received", hdr.Cname(), "version", hdr.ObjAttrs.Version(), reb.warnID(ack.rebID, ack.daemonID))
+		return nil
+	}
+	xreb := reb.xctn()
+	if xreb.IsAborted() {
+		return nil
+	}
+	lom := core.AllocLOM(hdr.ObjName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(&hdr.Bck); err != nil {
+		nlog.Errorln(err)
+		return nil
+	}
+	var (
+		oa        = hdr.ObjAttrs
+		buf, slab = core.T.PageMM().Alloc()
+	)
+	oa.Size = lsize
+	err = lom.PutVersion(&oa, objReader, buf)
+	slab.Free(buf)
+	if err != nil {
+		nlog.Errorln(core.T.String(), "failed to store", lom.Cname(), "version", oa.Version(), "[", err, "]")
+		return err
+	}
+	xreb.InObjsAdd(1, hdr.ObjAttrs.Size)
+	return nil
+}
+
 func (reb *Reb) regACK(smap *meta.Smap, hdr *transport.ObjHdr, tsid string) error {
 	tsi := smap.GetTarget(tsid)
 	if tsi == nil {
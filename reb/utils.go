@@ -181,6 +181,7 @@ func (reb *Reb) ackLomAck(lom *core.LOM) {
 
 	debug.Assert(uname == lomOrig.Uname())
 	size := lomOrig.Lsize()
+	hasVers := len(lomOrig.PriorVersions()) > 0
 	core.FreeLOM(lomOrig)
 
 	// counting acknowledged migrations (as initiator)
@@ -191,6 +192,9 @@ func (reb *Reb) ackLomAck(lom *core.LOM) {
 	// TODO [feature]: mark "deleted" instead
 	if !cmn.Rom.Features().IsSet(feat.DontDeleteWhenRebalancing) {
 		lom.Lock(true)
+		if hasVers && lom.Load(false /*cache it*/, true /*locked*/) == nil {
+			lom.RemovePriorVersions() // (migrated as well)
+		}
 		err := lom.RemoveObj()
 		lom.Unlock(true)
 		debug.AssertNoErr(err)
@@ -304,6 +304,12 @@ redo:
 			}
 			return
 		}
+		// retained prior versions (if any) follow the object
+		if err := lom.MoveVersions(hlom, buf); err != nil {
+			errV := fmt.Errorf("%s: failed to move %s prior versions: %v", xname, lom, err)
+			nlog.Infoln("Warning:", errV)
+			jg.xres.AddErr(errV)
+		}
 		lom = hlom
 		copied = true
 	}
@@ -56,6 +56,7 @@ OPTIONS:
   --azure             Build with Azure Blob Storage backend
   --oci               Build with OCI Object Storage backend
   --ht                Build with ht:// backend (experimental)
+  --file              Build with file:// backend (local or shared filesystem; root: AIS_FILE_BACKEND_ROOT, default /tmp/ais_file)
   --loopback          Loopback device size, e.g. 10G, 100M (default: 0). Zero size means emulated mountpaths (with no loopback devices).
   --dir               The root directory of the aistore repository
   --https             Use HTTPS (note: X509 certificates may be required)
@@ -89,6 +90,7 @@ while (( "$#" )); do
     --gcp)   AIS_BACKEND_PROVIDERS="${AIS_BACKEND_PROVIDERS} gcp"; shift;;
     --oci)   AIS_BACKEND_PROVIDERS="${AIS_BACKEND_PROVIDERS} oci"; shift;;
     --ht)    AIS_BACKEND_PROVIDERS="${AIS_BACKEND_PROVIDERS} ht"; shift;;
+    --file)  AIS_BACKEND_PROVIDERS="${AIS_BACKEND_PROVIDERS} file"; shift;;
     --tracing) tracing="y\n${AIS_TRACING_ENDPOINT}\n${AIS_TRACING_AUTH_TOKEN_HEADER}\n${AIS_TRACING_AUTH_TOKEN_FILE}"; shift;;
 
     --loopback) loopback=$2;
@@ -67,6 +67,7 @@ type (
 		misplaced struct {
 			loms []*core.LOM
 			ec   []*core.CT // EC slices and replicas without corresponding metafiles (CT FQN -> Meta FQN)
+			vers []string   // retained prior versions not referenced by their (current) objects
 		}
 		bck cmn.Bck
 		now int64
@@ -369,7 +370,7 @@ func (j *clnJ) jogBck() (size int64, err error) {
 	opts := &fs.WalkOpts{
 		Mi:       j.mi,
 		Bck:      j.bck,
-		CTs:      []string{fs.WorkfileType, fs.ObjectType, fs.ECSliceType, fs.ECMetaType},
+		CTs:      []string{fs.WorkfileType, fs.ObjectType, fs.ECSliceType, fs.ECMetaType, fs.ObjVersionType},
 		Callback: j.walk,
 		Sorted:   false,
 	}
@@ -431,6 +432,22 @@ func (j *clnJ) visitCT(parsedFQN *fs.ParsedFQN, fqn string) {
 			return
 		}
 		j.oldWork = append(j.oldWork, fqn)
+	case fs.ObjVersionType:
+		// retained prior versions: remove those that are not referenced by
+		// the current object at its (hrw) location on this target
+		dir, base := filepath.Split(parsedFQN.ObjName)
+		orig, _, ok := fs.CSM.Resolver(fs.ObjVersionType).ParseUniqueFQN(base)
+		if !ok {
+			return
+		}
+		lom := core.AllocLOM(dir + orig)
+		if lom.InitBck(&j.bck) == nil && lom.TryLock(false) {
+			if !lom.RefsVersion(fqn) {
+				j.misplaced.vers = append(j.misplaced.vers, fqn)
+			}
+			lom.Unlock(false)
+		}
+		core.FreeLOM(lom)
 	default:
 		debug.Assert(false, "Unsupported content type: ", parsedFQN.ContentType)
 	}
@@ -491,7 +508,7 @@ func (j *clnJ) visitObj(fqn string, lom *core.LOM) {
 		if lom.HasCopies() {
 			j.rmExtraCopies(lom)
 		}
-		if lom.Lsize() == 0 {
+		if lom.Lsize() == 0 && lom.CheckObjLock(false /*bypass governance*/) == nil {
 			if j.ini.Args.Flags&xact.XrmZeroSize == xact.XrmZeroSize {
 				// remove in place
 				if ecode, err := core.T.DeleteObject(lom, false /*evict*/); err != nil {
@@ -512,6 +529,9 @@ func (j *clnJ) visitObj(fqn string, lom *core.LOM) {
 		// will be _visited_ separately (if not already)
 		return
 	}
+	if lom.CheckObjLock(false /*bypass governance*/) != nil {
+		return // WORM (see cmn/objlock.go)
+	}
 	if lom.ECEnabled() {
 		// misplaced EC
 		metaFQN := fs.CSM.Gen(lom, fs.ECMetaType, "")
@@ -672,7 +692,30 @@ func (j *clnJ) rmLeftovers() (size int64, err error) {
 	}
 	j.misplaced.loms = j.misplaced.loms[:0]
 
-	// 3. rm EC slices and replicas that are still without correcponding metafile
+	// 3. rm orphaned prior versions
+	if len(j.misplaced.vers) > 0 && j.p.rmMisplaced() {
+		for _, verFQN := range j.misplaced.vers {
+			finfo, erv := os.Stat(verFQN)
+			if erv != nil {
+				continue
+			}
+			if err := cos.RemoveFile(verFQN); err != nil {
+				nlog.Errorf("%s: failed to rm orphaned version %q: %v", j, verFQN, err)
+				continue
+			}
+			fevicted++
+			bevicted += finfo.Size()
+			if cmn.Rom.FastV(4, cos.SmoduleSpace) {
+				nlog.Infof("%s: rm orphaned version %q, size=%d", j, verFQN, finfo.Size())
+			}
+			if err = j.yieldTerm(); err != nil {
+				return
+			}
+		}
+	}
+	j.misplaced.vers = j.misplaced.vers[:0]
+
+	// 4. rm EC slices and replicas that are still without correcponding metafile
 	for _, ct := range j.misplaced.ec {
 		metaFQN := fs.CSM.Gen(ct, fs.ECMetaType, "")
 		if cos.Stat(metaFQN) == nil {
@@ -328,6 +328,12 @@ func (j *lruJ) _visit(lom *core.LOM) (pushed bool) {
 	if lom.HasCopies() && lom.IsCopy() {
 		return
 	}
+	if lom.CheckObjLock(false /*bypass governance*/) != nil {
+		return
+	}
+	if lom.WriteBackPending() {
+		return
+	}
 	// do nothing if the heap's curSize >= totalSize and
 	// the file is more recent then the the heap's newest.
 	if j.curSize >= j.totalSize && lom.AtimeUnix() > j.newest {
@@ -283,6 +283,40 @@ var _ = Describe("space evict/cleanup tests", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(len(files)).To(Equal(0))
 			})
+
+			It("should remove orphaned prior versions", func() {
+				const (
+					objName  = "obj.txt"
+					goneName = "gone.txt"
+				)
+				objFQN := path.Join(filesPath, objName)
+				saveRandomFile(objFQN, cos.KiB)
+				lom := &core.LOM{}
+				Expect(lom.InitFQN(objFQN, nil)).NotTo(HaveOccurred())
+				Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+				lom.SetCustomKey(cmn.PriorVersionsObjMD, "1")
+				Expect(lom.Persist()).NotTo(HaveOccurred())
+
+				gone := &core.LOM{}
+				Expect(gone.InitFQN(path.Join(filesPath, goneName), nil)).NotTo(HaveOccurred())
+
+				var (
+					referenced = lom.VerFQN("1")
+					orphans    = []string{lom.VerFQN("7"), gone.VerFQN("3")}
+				)
+				for _, fqn := range append(orphans, referenced) {
+					_, err := cos.SaveReader(fqn, rand.Reader, make([]byte, blockSize), cos.ChecksumNone, blockSize)
+					Expect(err).NotTo(HaveOccurred())
+				}
+
+				space.RunCleanup(ini)
+
+				Expect(objFQN).To(BeAnExistingFile())
+				Expect(referenced).To(BeAnExistingFile())
+				for _, fqn := range orphans {
+					Expect(fqn).NotTo(BeAnExistingFile())
+				}
+			})
 		})
 	})
 })
@@ -382,6 +416,7 @@ func createAndAddMountpath(path string) {
 
 	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}, true)
 	fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{}, true)
+	fs.CSM.Reg(fs.ObjVersionType, &fs.ObjVersionContentResolver{}, true)
 }
 
 func getRandomFileName(fileCounter int) string {
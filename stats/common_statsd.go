@@ -106,7 +106,7 @@ func (s *coreStats) add(name string, val int64) {
 	case KindThroughput:
 		ratomic.AddInt64(&v.Value, val)
 		ratomic.AddInt64(&v.cumulative, val)
-	case KindCounter, KindSize, KindTotal:
+	case KindCounter, KindSize, KindTotal, KindGauge:
 		ratomic.AddInt64(&v.Value, val)
 	default:
 		debug.Assert(false, v.kind)
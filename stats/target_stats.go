@@ -47,9 +47,40 @@ const (
 	VerChangeCount = "ver.change.n"
 	VerChangeSize  = "ver.change.size"
 
+	// remote requests throttled by the provider (429, 503 SlowDown), and
+	// total time spent waiting (client-side rate limiting and backoff)
+	ThrottleCount        = "throttle.n"
+	ThrottleLatencyTotal = "throttle.ns.total"
+
+	// write-back (data write policy "delayed")
+	WriteBackCount = "wb.put.n"
+	WriteBackSize  = "wb.put.size"
+
+	// KindGauge
+	WriteBackBacklogCount = "wb.backlog.n"
+	WriteBackBacklogSize  = "wb.backlog.size"
+
+	// cross-cluster replication (see cmn.ReplicationConf)
+	ReplPutCount      = "repl.put.n"
+	ReplPutSize       = "repl.put.size"
+	ReplDelCount      = "repl.del.n"
+	ReplConflictCount = "repl.conflict.n"
+
+	// KindGauge
+	ReplBacklogCount = "repl.backlog.n"
+	ReplLag          = "repl.lag.ns" // age of the oldest pending (not yet replicated) update
+
+	// remote HEAD cache (see cmn.HeadCacheConf)
+	HeadCacheHitCount  = "head.cache.hit.n"
+	HeadCacheMissCount = "head.cache.miss.n"
+
 	// errors
 	ErrPutCksumCount = errPrefix + "put.cksum.n"
 
+	ErrWriteBackCount = errPrefix + "wb.put.n"
+
+	ErrReplCount = errPrefix + "repl.n"
+
 	ErrFSHCCount = errPrefix + "fshc.n"
 
 	// IO errors (must have ioErrPrefix)
@@ -280,6 +311,18 @@ func (r *Trunner) RegMetrics(snode *meta.Snode) {
 			VarLabs: BckVarlabs,
 		},
 	)
+	r.reg(snode, HeadCacheHitCount, KindCounter,
+		&Extra{
+			Help:    "remote HEAD cache: number of HEAD(remote object) requests served from cache, including cached 'not found'",
+			VarLabs: BckVarlabs,
+		},
+	)
+	r.reg(snode, HeadCacheMissCount, KindCounter,
+		&Extra{
+			Help:    "remote HEAD cache: number of HEAD(remote object) requests (in buckets with configured cache) that went to remote backend",
+			VarLabs: BckVarlabs,
+		},
+	)
 	r.reg(snode, RemoteDeletedDelCount, KindCounter,
 		&Extra{
 			Help:    "number of out-of-band deletes (by a 3rd party remote DELETE(object) from outside this cluster)",
@@ -287,6 +330,86 @@ func (r *Trunner) RegMetrics(snode *meta.Snode) {
 		},
 	)
 
+	// write-back
+	r.reg(snode, WriteBackCount, KindCounter,
+		&Extra{
+			Help:    "write-back: number of objects written to remote backend asynchronously (data write policy 'delayed')",
+			VarLabs: BckVarlabs,
+		},
+	)
+	r.reg(snode, WriteBackSize, KindSize,
+		&Extra{
+			Help:    "write-back: total cumulative size (bytes) of objects written to remote backend asynchronously",
+			VarLabs: BckVarlabs,
+		},
+	)
+	r.reg(snode, ErrWriteBackCount, KindCounter,
+		&Extra{
+			Help:    "write-back: number of failed attempts to write objects to remote backend (to be retried)",
+			VarLabs: BckVarlabs,
+		},
+	)
+	r.reg(snode, WriteBackBacklogCount, KindGauge,
+		&Extra{
+			Help:    "write-back: number of objects stored in-cluster and not yet written to remote backend",
+			StrName: "wb_backlog_count",
+			VarLabs: BckVarlabs,
+		},
+	)
+	r.reg(snode, WriteBackBacklogSize, KindGauge,
+		&Extra{
+			Help:    "write-back: total size (bytes) of objects stored in-cluster and not yet written to remote backend",
+			StrName: "wb_backlog_bytes",
+			VarLabs: BckVarlabs,
+		},
+	)
+
+	// cross-cluster replication
+	r.reg(snode, ReplPutCount, KindCounter,
+		&Extra{
+			Help:    "replication: number of objects replicated to remote AIS cluster",
+			VarLabs: BckVarlabs,
+		},
+	)
+	r.reg(snode, ReplPutSize, KindSize,
+		&Extra{
+			Help:    "replication: total cumulative size (bytes) of objects replicated to remote AIS cluster",
+			VarLabs: BckVarlabs,
+		},
+	)
+	r.reg(snode, ReplDelCount, KindCounter,
+		&Extra{
+			Help:    "replication: number of deletions replicated to remote AIS cluster",
+			VarLabs: BckVarlabs,
+		},
+	)
+	r.reg(snode, ReplConflictCount, KindCounter,
+		&Extra{
+			Help:    "replication: number of updates not replicated because remote AIS cluster has a newer version (conflict)",
+			VarLabs: BckVarlabs,
+		},
+	)
+	r.reg(snode, ErrReplCount, KindCounter,
+		&Extra{
+			Help:    "replication: number of failed attempts to replicate (to be retried)",
+			VarLabs: BckVarlabs,
+		},
+	)
+	r.reg(snode, ReplBacklogCount, KindGauge,
+		&Extra{
+			Help:    "replication: number of journaled updates (PUTs and DELETEs) not yet replicated",
+			StrName: "repl_backlog_count",
+			VarLabs: BckVarlabs,
+		},
+	)
+	r.reg(snode, ReplLag, KindGauge,
+		&Extra{
+			Help:    "replication: lag (nanoseconds) - age of the oldest journaled update not yet replicated",
+			StrName: "repl_lag_ns",
+			VarLabs: BckVarlabs,
+		},
+	)
+
 	r.reg(snode, PutLatency, KindLatency,
 		&Extra{
 			Help:    "PUT: average time (milliseconds) over the last periodic.stats_time interval",
@@ -263,6 +263,48 @@ var Table = map[string]Descriptor{
 
 	// cache management, internal usage
 	apc.ActLoadLomCache: {DisplayName: "warm-up-metadata", Scope: ScopeB, Startable: true},
+
+	// periodic (and startable) enforcement of the bucket lifecycle rules
+	apc.ActLifecycle: {
+		DisplayName: "lifecycle",
+		Scope:       ScopeB,
+		Access:      apc.AceObjDELETE,
+		Startable:   true,
+		RefreshCap:  true,
+	},
+
+	// periodic (and startable) generation of the bucket inventory
+	apc.ActInventory: {
+		DisplayName: "inventory",
+		Scope:       ScopeB,
+		Access:      apc.AceObjLIST | apc.AcePUT,
+		Startable:   true,
+		RefreshCap:  true,
+	},
+
+	// flush (and wait for) the write-back backlog of a given remote bucket
+	apc.ActWriteBack: {
+		DisplayName: "write-back",
+		Scope:       ScopeB,
+		Access:      apc.AcePUT,
+		Startable:   true,
+	},
+
+	// move cold objects of a given ais:// bucket down its chain of remote tiers
+	apc.ActTierMigrate: {
+		DisplayName: "tier-migrate",
+		Scope:       ScopeB,
+		Access:      apc.AcePUT | apc.AceObjDELETE,
+		Startable:   true,
+	},
+
+	// replicate to remote AIS cluster the objects that are missing there (or older)
+	apc.ActReplResync: {
+		DisplayName: "repl-resync",
+		Scope:       ScopeB,
+		Access:      apc.AcePUT,
+		Startable:   true,
+	},
 }
 
 func GetDescriptor(kindOrName string) (string, Descriptor, error) {
@@ -6,6 +6,7 @@ package xreg
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
@@ -50,6 +51,25 @@ type (
 		Msg *apc.LsoMsg
 		Hdr http.Header
 	}
+	// (abort stale multipart uploads is done by the caller, on a per-rule basis)
+	LcyArgs struct {
+		AbortMpt func(bck *meta.Bck, rule *cmn.LifecycleRule, now time.Time) int
+	}
+	InvArgs struct {
+		Dst *meta.Bck // destination bucket
+		Run string    // scheduled time slot (empty when started on demand)
+	}
+	WbArgs struct {
+		// start uploading pending objects without delay; returns the start time (mono)
+		Flush func(bck *meta.Bck) int64
+		// remaining backlog, and the number of pending objects that failed since `since`
+		Backlog func(bck *meta.Bck, since int64) (num, size, failed int64)
+	}
+	ReplArgs struct {
+		// journal a given object for replication unless the remote copy is up to date;
+		// returns true when journaled
+		Resync func(lom *core.LOM) (bool, error)
+	}
 )
 
 //////////////
@@ -118,6 +138,26 @@ func RenewBckLoadLomCache(uuid string, bck *meta.Bck) RenewRes {
 	return RenewBucketXact(apc.ActLoadLomCache, bck, Args{UUID: uuid})
 }
 
+func RenewLifecycle(uuid string, bck *meta.Bck, args *LcyArgs) RenewRes {
+	return RenewBucketXact(apc.ActLifecycle, bck, Args{Custom: args, UUID: uuid})
+}
+
+func RenewInventory(uuid string, bck *meta.Bck, args *InvArgs) RenewRes {
+	return RenewBucketXact(apc.ActInventory, bck, Args{Custom: args, UUID: uuid})
+}
+
+func RenewWriteBack(uuid string, bck *meta.Bck, args *WbArgs) RenewRes {
+	return RenewBucketXact(apc.ActWriteBack, bck, Args{Custom: args, UUID: uuid})
+}
+
+func RenewTierMigrate(uuid string, bck *meta.Bck) RenewRes {
+	return RenewBucketXact(apc.ActTierMigrate, bck, Args{UUID: uuid})
+}
+
+func RenewReplResync(uuid string, bck *meta.Bck, args *ReplArgs) RenewRes {
+	return RenewBucketXact(apc.ActReplResync, bck, Args{Custom: args, UUID: uuid})
+}
+
 func RenewPutMirror(lom *core.LOM) RenewRes {
 	return RenewBucketXact(apc.ActPutCopies, lom.Bck(), Args{Custom: lom})
 }
@@ -605,7 +605,7 @@ func (wi *archwi) do(lom *core.LOM, lrit *lrit) {
 		}
 	}
 
-	fh, err := cos.NewFileHandle(lom.FQN)
+	fh, err := lom.NewHandle()
 	if err != nil {
 		wi.r.AddErr(err, 5, cos.SmoduleXs)
 		return
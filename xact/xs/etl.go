@@ -25,7 +25,7 @@ type (
 	}
 	xactETL struct {
 		xact.Base
-		msg *etl.InitSpecMsg
+		msg etl.InitMsg
 	}
 )
 
@@ -55,7 +55,7 @@ func (*etlFactory) WhenPrevIsRunning(xreg.Renewable) (xreg.WPR, error) {
 // (tests only)
 
 func newETL(p *etlFactory) *xactETL {
-	msg, ok := p.Args.Custom.(*etl.InitSpecMsg)
+	msg, ok := p.Args.Custom.(etl.InitMsg)
 	debug.Assert(ok)
 	xctn := &xactETL{msg: msg}
 	xctn.InitBase(p.Args.UUID, p.Kind(), msg.String(), nil)
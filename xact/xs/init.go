@@ -51,6 +51,11 @@ func Treg(coi COI) {
 	xreg.RegBckXact(&lsoFactory{streamingF: streamingF{kind: apc.ActList}})
 
 	xreg.RegBckXact(&blobFactory{})
+	xreg.RegBckXact(&lcyFactory{})
+	xreg.RegBckXact(&invFactory{})
+	xreg.RegBckXact(&wbFactory{})
+	xreg.RegBckXact(&tierFactory{})
+	xreg.RegBckXact(&replFactory{})
 }
 
 //
@@ -0,0 +1,402 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/cmn/parquet"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// x-inventory walks a given ais:// bucket and writes its (HRW-local) objects' metadata
+// into a sequence of CSV or Parquet shards (see cmn.InventoryConf for naming):
+// - each shard contains up to `conf.ShardSize` records, in no particular order
+// - a shard is first written locally (as a workfile) and then promoted into
+//   the destination bucket (which places it on the respective HRW target)
+// - upon successful completion, each target writes its own JSON manifest
+//   that lists the shards (cmn.InvManifestMD)
+
+const (
+	invBufSize      = 64 * 1024
+	invRowGroupSize = 16 * 1024
+)
+
+type (
+	invFactory struct {
+		xreg.RenewBase
+		xctn *XactInv
+	}
+	XactInv struct {
+		conf   *cmn.InventoryConf
+		dst    *meta.Bck
+		sw     *invShard // current shard
+		run    string
+		pfx    string // destination prefix of this run
+		fields []string
+		files  []string
+		total  int64
+		mu     sync.Mutex
+		xact.BckJog
+	}
+	invShard struct {
+		fh   *os.File
+		bw   *bufio.Writer
+		cw   *csv.Writer
+		pw   *parquet.Writer
+		fqn  string
+		name string
+		nrec int64
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*XactInv)(nil)
+	_ xreg.Renewable = (*invFactory)(nil)
+)
+
+////////////////
+// invFactory //
+////////////////
+
+func (*invFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	return &invFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+}
+
+func (p *invFactory) Start() error {
+	conf := p.Bck.Props.Inventory
+	if conf == nil {
+		return fmt.Errorf("%s: inventory is not configured", p.Bck.Cname(""))
+	}
+	args, ok := p.Args.Custom.(*xreg.InvArgs)
+	debug.Assert(ok && args.Dst != nil)
+	run := args.Run
+	if run == "" {
+		run = p.UUID()
+	}
+	p.xctn = newXactInv(p.UUID(), p.Bck, conf, args.Dst, run)
+	return nil
+}
+
+func (*invFactory) Kind() string     { return apc.ActInventory }
+func (p *invFactory) Get() core.Xact { return p.xctn }
+
+func (*invFactory) WhenPrevIsRunning(prevEntry xreg.Renewable) (xreg.WPR, error) {
+	return xreg.WprUse, cmn.NewErrXactUsePrev(prevEntry.Get().String())
+}
+
+/////////////
+// XactInv //
+/////////////
+
+func newXactInv(uuid string, bck *meta.Bck, conf *cmn.InventoryConf, dst *meta.Bck, run string) (r *XactInv) {
+	r = &XactInv{
+		conf:   conf,
+		dst:    dst,
+		run:    run,
+		pfx:    conf.RunPrefix(bck.Bucket(), run),
+		fields: conf.AllFields(),
+	}
+	mpopts := &mpather.JgroupOpts{
+		CTs:      []string{fs.ObjectType},
+		VisitObj: r.visit,
+		Prefix:   conf.ObjPrefix,
+		DoLoad:   mpather.Load,
+	}
+	mpopts.Bck.Copy(bck.Bucket())
+	ctlmsg := fmt.Sprintf("%s => %s, format: %s, run: %s", conf.ID, dst.Cname(r.pfx), conf.Format, run)
+	r.BckJog.Init(uuid, apc.ActInventory, ctlmsg, bck, mpopts, cmn.GCO.Get())
+	return
+}
+
+func (r *XactInv) Run(wg *sync.WaitGroup) {
+	if wg != nil {
+		wg.Done()
+	}
+	nlog.Infoln(r.Name(), r.run)
+	r.BckJog.Run()
+	if err := r.BckJog.Wait(); err != nil {
+		r.AddErr(err)
+	}
+	r.mu.Lock()
+	if r.sw != nil {
+		if r.IsAborted() || r.Err() != nil {
+			r.sw.cleanup()
+			r.sw = nil
+		} else if err := r.flush(); err != nil {
+			r.AddErr(err)
+		}
+	}
+	r.mu.Unlock()
+	if !r.IsAborted() && r.Err() == nil {
+		r.manifest()
+	}
+	r.Finish()
+}
+
+func (r *XactInv) visit(lom *core.LOM, _ []byte) error {
+	// skip inventories (when written into the source bucket)
+	if r.dst.Equal(lom.Bck(), true, true) && strings.HasPrefix(lom.ObjName, r.conf.Prefix) {
+		return nil
+	}
+	r.mu.Lock()
+	err := r.write(lom)
+	r.mu.Unlock()
+	if err != nil {
+		r.Abort(err)
+		return err
+	}
+	r.ObjsAdd(1, lom.Lsize())
+	return nil
+}
+
+// under lock
+func (r *XactInv) write(lom *core.LOM) (err error) {
+	if r.sw == nil {
+		if err = r.open(); err != nil {
+			return err
+		}
+	}
+	if r.sw.pw != nil {
+		err = r.sw.pw.WriteRow(r.row(lom, true)...)
+	} else {
+		rec := r.row(lom, false)
+		ss := make([]string, len(rec))
+		for i, v := range rec {
+			ss[i] = v.(string)
+		}
+		err = r.sw.cw.Write(ss)
+	}
+	if err != nil {
+		return err
+	}
+	r.sw.nrec++
+	r.total++
+	if r.sw.nrec >= r.conf.NumShardRecs() {
+		err = r.flush()
+	}
+	return err
+}
+
+func (r *XactInv) row(lom *core.LOM, typed bool) []any {
+	rec := make([]any, 0, len(r.fields))
+	for _, f := range r.fields {
+		switch f {
+		case cmn.InvFieldName:
+			rec = append(rec, lom.ObjName)
+		case cmn.InvFieldSize:
+			if typed {
+				rec = append(rec, lom.Lsize())
+			} else {
+				rec = append(rec, strconv.FormatInt(lom.Lsize(), 10))
+			}
+		case cmn.InvFieldChecksum:
+			var v string
+			if cksum := lom.Checksum(); cksum != nil {
+				v = cksum.Val()
+			}
+			rec = append(rec, v)
+		case cmn.InvFieldVersion:
+			rec = append(rec, lom.Version())
+		case cmn.InvFieldAtime:
+			atime := lom.AtimeUnix()
+			if typed {
+				rec = append(rec, atime/int64(time.Microsecond))
+			} else {
+				rec = append(rec, time.Unix(0, atime).UTC().Format(time.RFC3339Nano))
+			}
+		case cmn.InvFieldCustom:
+			rec = append(rec, invCustomMD(lom))
+		}
+	}
+	return rec
+}
+
+// user-visible custom metadata as JSON (see cmn.IsInternalObjMD)
+func invCustomMD(lom *core.LOM) string {
+	md := lom.GetCustomMD()
+	if len(md) == 0 {
+		return ""
+	}
+	out := make(cos.StrKVs, len(md))
+	for k, v := range md {
+		if cmn.IsInternalObjMD(k) {
+			continue
+		}
+		out[k] = v
+	}
+	if len(out) == 0 {
+		return ""
+	}
+	return cos.MustMarshalToString(out)
+}
+
+// under lock
+func (r *XactInv) open() error {
+	var (
+		ext  = "." + r.conf.Format
+		name = r.pfx + core.T.SID() + "-" + fmt.Sprintf("%05d", len(r.files)) + ext
+	)
+	fqn, err := r.workfile(name)
+	if err != nil {
+		return err
+	}
+	fh, err := cos.CreateFile(fqn)
+	if err != nil {
+		return err
+	}
+	sw := &invShard{fh: fh, fqn: fqn, name: name, bw: bufio.NewWriterSize(fh, invBufSize)}
+	if r.conf.Format == cmn.InvFormatParquet {
+		cols := make([]parquet.Column, len(r.fields))
+		for i, f := range r.fields {
+			cols[i].Name = f
+			switch f {
+			case cmn.InvFieldSize:
+				cols[i].Type = parquet.Int64
+			case cmn.InvFieldAtime:
+				cols[i].Type = parquet.TimestampMicros
+			default:
+				cols[i].Type = parquet.String
+			}
+		}
+		sw.pw, err = parquet.NewWriter(sw.bw, cols, invRowGroupSize)
+	} else {
+		sw.cw = csv.NewWriter(sw.bw)
+		err = sw.cw.Write(r.fields) // header
+	}
+	if err != nil {
+		sw.cleanup()
+		return err
+	}
+	r.sw = sw
+	return nil
+}
+
+// close the current shard and promote it into the destination bucket (under lock)
+func (r *XactInv) flush() (err error) {
+	sw := r.sw
+	r.sw = nil
+	if sw.pw != nil {
+		err = sw.pw.Close()
+	} else {
+		sw.cw.Flush()
+		err = sw.cw.Error()
+	}
+	if err == nil {
+		err = sw.bw.Flush()
+	}
+	if err != nil {
+		sw.cleanup()
+		return err
+	}
+	if err = sw.fh.Close(); err != nil {
+		sw.cleanup()
+		return err
+	}
+	if err = r.promote(sw.fqn, sw.name); err != nil {
+		return err
+	}
+	r.files = append(r.files, sw.name)
+	return nil
+}
+
+func (r *XactInv) manifest() {
+	md := &cmn.InvManifestMD{
+		Bucket:  r.Bck().Cname(""),
+		ID:      r.conf.ID,
+		Run:     r.run,
+		Format:  r.conf.Format,
+		Fields:  r.fields,
+		Files:   r.files,
+		Records: r.total,
+		Time:    time.Now().UTC().Format(time.RFC3339),
+	}
+	if md.Files == nil {
+		md.Files = []string{}
+	}
+	name := r.pfx + core.T.SID() + "-" + cmn.InvManifest
+	fqn, err := r.workfile(name)
+	if err == nil {
+		if err = os.WriteFile(fqn, cos.MustMarshal(md), cos.PermRWR); err == nil {
+			err = r.promote(fqn, name)
+		} else {
+			cos.RemoveFile(fqn)
+		}
+	}
+	if err != nil {
+		r.AddErr(err)
+	}
+}
+
+func (r *XactInv) workfile(objName string) (string, error) {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(r.dst.Bucket()); err != nil {
+		return "", err
+	}
+	return fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfileInventory), nil
+}
+
+func (r *XactInv) promote(fqn, objName string) error {
+	var size int64
+	if finfo, err := os.Stat(fqn); err == nil {
+		size = finfo.Size()
+	}
+	params := &core.PromoteParams{
+		Bck:    r.dst,
+		Config: r.Config,
+		PromoteArgs: apc.PromoteArgs{
+			SrcFQN:         fqn,
+			ObjName:        objName,
+			OverwriteDst:   true,
+			DeleteSrc:      true,
+			SrcIsNotFshare: true,
+		},
+	}
+	if _, err := core.T.Promote(params); err != nil {
+		cos.RemoveFile(fqn)
+		return err
+	}
+	r.OutObjsAdd(1, size)
+	return nil
+}
+
+func (r *XactInv) Snap() (snap *core.Snap) {
+	snap = &core.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	return
+}
+
+//////////////
+// invShard //
+//////////////
+
+func (sw *invShard) cleanup() {
+	sw.fh.Close()
+	if err := cos.RemoveFile(sw.fqn); err != nil {
+		nlog.Errorln("failed to remove inventory workfile", sw.fqn, "err:", err)
+	}
+}
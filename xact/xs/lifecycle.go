@@ -0,0 +1,219 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// x-lifecycle walks a given bucket and enforces its lifecycle rules (see cmn.LifecycleConf):
+// - all enabled rules with matching prefix apply
+// - expiration (delete) takes precedence over transition (evict)
+// - stale multipart uploads are aborted by the caller-provided callback (xreg.LcyArgs)
+// - noncurrent (retained prior) versions of ais:// objects expire based on the time they
+//   became noncurrent, i.e., the mtime of the next newer version (see core/lom_ver)
+
+type (
+	lcyFactory struct {
+		xreg.RenewBase
+		xctn *XactLcy
+	}
+	XactLcy struct {
+		args  *xreg.LcyArgs
+		conf  *cmn.LifecycleConf
+		now   time.Time
+		evict bool // transition => evict (remote buckets only)
+		xact.BckJog
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*XactLcy)(nil)
+	_ xreg.Renewable = (*lcyFactory)(nil)
+)
+
+////////////////
+// lcyFactory //
+////////////////
+
+func (*lcyFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	return &lcyFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+}
+
+func (p *lcyFactory) Start() error {
+	conf := p.Bck.Props.Lifecycle
+	if !conf.IsActive() {
+		return fmt.Errorf("%s: no active lifecycle rules", p.Bck.Cname(""))
+	}
+	args, _ := p.Args.Custom.(*xreg.LcyArgs)
+	p.xctn = newXactLcy(p.UUID(), p.Bck, conf, args)
+	return nil
+}
+
+func (*lcyFactory) Kind() string     { return apc.ActLifecycle }
+func (p *lcyFactory) Get() core.Xact { return p.xctn }
+
+func (*lcyFactory) WhenPrevIsRunning(prevEntry xreg.Renewable) (xreg.WPR, error) {
+	return xreg.WprUse, cmn.NewErrXactUsePrev(prevEntry.Get().String())
+}
+
+/////////////
+// XactLcy //
+/////////////
+
+func newXactLcy(uuid string, bck *meta.Bck, conf *cmn.LifecycleConf, args *xreg.LcyArgs) (r *XactLcy) {
+	r = &XactLcy{
+		args:  args,
+		conf:  conf,
+		now:   time.Now(),
+		evict: bck.IsRemote(),
+	}
+	mpopts := &mpather.JgroupOpts{
+		CTs:      []string{fs.ObjectType},
+		VisitObj: r.visit,
+		DoLoad:   mpather.Load,
+	}
+	mpopts.Bck.Copy(bck.Bucket())
+	ctlmsg := fmt.Sprintf("rules: %d", len(conf.Rules))
+	r.BckJog.Init(uuid, apc.ActLifecycle, ctlmsg, bck, mpopts, cmn.GCO.Get())
+	return
+}
+
+func (r *XactLcy) Run(wg *sync.WaitGroup) {
+	if wg != nil {
+		wg.Done()
+	}
+	nlog.Infoln(r.Name())
+	r.abortMpt()
+	r.BckJog.Run()
+	if err := r.BckJog.Wait(); err != nil {
+		r.AddErr(err)
+	}
+	r.Finish()
+}
+
+func (r *XactLcy) abortMpt() {
+	if r.args == nil || r.args.AbortMpt == nil {
+		return
+	}
+	for i := range r.conf.Rules {
+		rule := &r.conf.Rules[i]
+		if rule.Disabled || rule.AbortMptDays == 0 {
+			continue
+		}
+		if n := r.args.AbortMpt(r.Bck(), rule, r.now); n > 0 {
+			nlog.Infoln(r.Name(), "rule", rule.ID, "aborted", n, "stale multipart upload(s)")
+		}
+	}
+}
+
+func (r *XactLcy) visit(lom *core.LOM, _ []byte) error {
+	var (
+		mtime         time.Time
+		noncurrent    []*cmn.LifecycleRule
+		expire, evict bool
+		loaded        bool
+	)
+	for i := range r.conf.Rules {
+		rule := &r.conf.Rules[i]
+		if !rule.Match(lom.ObjName) {
+			continue
+		}
+		if rule.NoncurrentDays > 0 {
+			noncurrent = append(noncurrent, rule)
+		}
+		if rule.ExpirationDays == 0 && (rule.Transition == nil || !r.evict) {
+			continue
+		}
+		if !loaded {
+			_, _, mt, err := lom.Fstat(false)
+			if err != nil {
+				return nil // (benign race vs delete)
+			}
+			mtime, loaded = mt, true
+		}
+		if rule.Expired(mtime, r.now) {
+			expire = true
+			break
+		}
+		evict = evict || (r.evict && rule.Transitioned(mtime, r.now))
+	}
+	if !expire && !evict {
+		if len(noncurrent) > 0 && len(lom.PriorVersions()) > 0 {
+			r.expireNoncurrent(lom, noncurrent)
+		}
+		return nil
+	}
+	size := lom.Lsize()
+	ecode, err := core.T.DeleteObject(lom, !expire /*evict*/)
+	switch {
+	case err == nil:
+		r.ObjsAdd(1, size)
+	case cos.IsNotExist(err, ecode) || cmn.IsErrObjNought(err):
+	case cmn.IsErrObjLocked(err): // (WORM)
+	default:
+		r.AddErr(err, 5, cos.SmoduleXs)
+	}
+	return nil
+}
+
+func (r *XactLcy) expireNoncurrent(lom *core.LOM, rules []*cmn.LifecycleRule) {
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return // (benign race vs delete)
+	}
+	_, _, since, err := lom.Fstat(false)
+	if err != nil {
+		return
+	}
+	for _, ver := range lom.PriorVersions() {
+		size, mtime, err := lom.StatVersion(ver)
+		if err != nil {
+			continue
+		}
+		for _, rule := range rules {
+			if !rule.NoncurrentExpired(since, r.now) {
+				continue
+			}
+			err := lom.CheckVersionLock(ver, false /*bypass governance*/)
+			if err == nil {
+				err = lom.RemoveVersion(ver)
+			}
+			switch {
+			case err == nil:
+				r.ObjsAdd(1, size)
+			case cmn.IsErrObjLocked(err): // (WORM)
+			default:
+				r.AddErr(err, 5, cos.SmoduleXs)
+			}
+			break
+		}
+		since = mtime
+	}
+}
+
+func (r *XactLcy) Snap() (snap *core.Snap) {
+	snap = &core.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	return
+}
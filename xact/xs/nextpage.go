@@ -137,8 +137,17 @@ func (npg *npgCtx) filterAddLmeta(lst *cmn.LsoRes) error {
 			goto keep
 		}
 		if err := lom.Load(true /* cache it*/, false /*locked*/); err != nil {
+			if npg.wi.msg.TagFilter != "" {
+				// not present in the cluster - cannot be tagged
+				core.FreeLOM(lom)
+				continue
+			}
 			goto keep
 		}
+		if !npg.wi.matchTags(lom) {
+			core.FreeLOM(lom)
+			continue
+		}
 
 		npg.wi.setWanted(en, lom)
 		en.SetFlag(apc.EntryIsCached) // formerly, SetPresent
@@ -0,0 +1,126 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// x-repl-resync walks a given (replicated) ais:// bucket and journals for replication
+// all objects that are missing in the destination bucket or are older there
+// (see cmn.ReplicationConf):
+// - the objects get shipped by the target's replication journal, not by the xaction itself
+// - counts journaled objects
+
+type (
+	replFactory struct {
+		xreg.RenewBase
+		xctn *XactReplResync
+	}
+	XactReplResync struct {
+		args *xreg.ReplArgs
+		xact.BckJog
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*XactReplResync)(nil)
+	_ xreg.Renewable = (*replFactory)(nil)
+)
+
+/////////////////
+// replFactory //
+/////////////////
+
+func (*replFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	return &replFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+}
+
+func (p *replFactory) Start() error {
+	conf := p.Bck.Props.Replication
+	if !conf.IsActive() {
+		return fmt.Errorf("%s: replication is not configured", p.Bck.Cname(""))
+	}
+	args, ok := p.Args.Custom.(*xreg.ReplArgs)
+	debug.Assert(ok && args.Resync != nil)
+	p.xctn = newXactReplResync(p.UUID(), p.Bck, args, conf)
+	return nil
+}
+
+func (*replFactory) Kind() string     { return apc.ActReplResync }
+func (p *replFactory) Get() core.Xact { return p.xctn }
+
+func (*replFactory) WhenPrevIsRunning(prevEntry xreg.Renewable) (xreg.WPR, error) {
+	return xreg.WprUse, cmn.NewErrXactUsePrev(prevEntry.Get().String())
+}
+
+////////////////////
+// XactReplResync //
+////////////////////
+
+func newXactReplResync(uuid string, bck *meta.Bck, args *xreg.ReplArgs, conf *cmn.ReplicationConf) (r *XactReplResync) {
+	r = &XactReplResync{args: args}
+	mpopts := &mpather.JgroupOpts{
+		CTs:      []string{fs.ObjectType},
+		VisitObj: r.visit,
+		DoLoad:   mpather.Load,
+		Throttle: true,
+	}
+	mpopts.Bck.Copy(bck.Bucket())
+	if len(conf.Prefixes) == 1 {
+		mpopts.Prefix = conf.Prefixes[0]
+	}
+	r.BckJog.Init(uuid, apc.ActReplResync, conf.Dst.Cname(""), bck, mpopts, cmn.GCO.Get())
+	return
+}
+
+func (r *XactReplResync) Run(wg *sync.WaitGroup) {
+	if wg != nil {
+		wg.Done()
+	}
+	nlog.Infoln(r.Name())
+	r.BckJog.Run()
+	if err := r.BckJog.Wait(); err != nil {
+		r.AddErr(err)
+	}
+	r.Finish()
+}
+
+func (r *XactReplResync) visit(lom *core.LOM, _ []byte) error {
+	queued, err := r.args.Resync(lom)
+	switch {
+	case err != nil:
+		r.AddErr(err, 5, cos.SmoduleXs)
+		if cmn.IsErrBckNotFound(err) {
+			return err // (no point in continuing)
+		}
+	case queued:
+		r.ObjsAdd(1, lom.Lsize())
+	}
+	return nil
+}
+
+func (r *XactReplResync) Snap() (snap *core.Snap) {
+	snap = &core.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	return
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/archive"
 	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
@@ -269,7 +270,7 @@ func (r *XactTCB) qcb(tot time.Duration) core.QuiRes {
 func (r *XactTCB) do(lom *core.LOM, buf []byte) (err error) {
 	var (
 		args   = r.p.args // TCBArgs
-		toName = args.Msg.ToName(lom.ObjName)
+		toName = tcbName(args.Msg, lom.ObjName)
 	)
 	if cmn.Rom.FastV(5, cos.SmoduleXs) {
 		nlog.Infoln(r.Base.Name()+":", lom.Cname(), "=>", args.BckTo.Cname(toName))
@@ -362,6 +363,17 @@ func (r *XactTCB) _recv(hdr *transport.ObjHdr, objReader io.Reader, lom *core.LO
 
 func (r *XactTCB) Args() *xreg.TCBArgs { return r.p.args }
 
+// destination name; shards re-packed into a different format (apc.ArchTransform)
+// get the respective extension
+func tcbName(msg *apc.TCBMsg, name string) string {
+	if arch := msg.Transform.Archive; arch != nil && arch.Mime != "" {
+		if mime, err := archive.Mime(arch.Mime, ""); err == nil {
+			name = archive.ChangeExt(name, mime)
+		}
+	}
+	return msg.ToName(name)
+}
+
 func (r *XactTCB) String() string { return r.str }
 func (r *XactTCB) Name() string   { return r.nam }
 
@@ -323,7 +323,7 @@ func (r *XactTCObjs) _put(hdr *transport.ObjHdr, objReader io.Reader, lom *core.
 
 func (wi *tcowi) do(lom *core.LOM, lrit *lrit) {
 	var (
-		objNameTo = wi.msg.ToName(lom.ObjName)
+		objNameTo = tcbName(&wi.msg.TCBMsg, lom.ObjName)
 		buf, slab = core.T.PageMM().Alloc()
 	)
 
@@ -0,0 +1,196 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// x-tier-migrate walks a given ais:// bucket and moves its cold objects down the chain
+// of remote tiers (see cmn.TieringConf):
+// - the object's age is the time since it was last accessed (atime)
+// - the object gets written to its (new) tier from the in-cluster replica, and then
+//   deleted from its current tier
+// - objects that are busy (locked) or yet to be written back are skipped until next time
+// - objects that never get cold-read and are not present in-cluster do not migrate
+
+type (
+	tierFactory struct {
+		xreg.RenewBase
+		xctn *XactTier
+	}
+	XactTier struct {
+		conf *cmn.TieringConf
+		now  int64
+		xact.BckJog
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*XactTier)(nil)
+	_ xreg.Renewable = (*tierFactory)(nil)
+)
+
+/////////////////
+// tierFactory //
+/////////////////
+
+func (*tierFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	return &tierFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+}
+
+func (p *tierFactory) Start() error {
+	conf := p.Bck.Props.Tiering
+	if !conf.HasMigration() {
+		return fmt.Errorf("%s: no tiers to migrate to (tiering is not configured or disabled)", p.Bck.Cname(""))
+	}
+	p.xctn = newXactTier(p.UUID(), p.Bck, conf)
+	return nil
+}
+
+func (*tierFactory) Kind() string     { return apc.ActTierMigrate }
+func (p *tierFactory) Get() core.Xact { return p.xctn }
+
+func (*tierFactory) WhenPrevIsRunning(prevEntry xreg.Renewable) (xreg.WPR, error) {
+	return xreg.WprUse, cmn.NewErrXactUsePrev(prevEntry.Get().String())
+}
+
+//////////////
+// XactTier //
+//////////////
+
+func newXactTier(uuid string, bck *meta.Bck, conf *cmn.TieringConf) (r *XactTier) {
+	r = &XactTier{conf: conf, now: time.Now().UnixNano()}
+	mpopts := &mpather.JgroupOpts{
+		CTs:      []string{fs.ObjectType},
+		VisitObj: r.visit,
+		DoLoad:   mpather.Load,
+		Throttle: true,
+	}
+	mpopts.Bck.Copy(bck.Bucket())
+	ctlmsg := fmt.Sprintf("tiers: %d", len(conf.Tiers))
+	r.BckJog.Init(uuid, apc.ActTierMigrate, ctlmsg, bck, mpopts, cmn.GCO.Get())
+	return
+}
+
+func (r *XactTier) Run(wg *sync.WaitGroup) {
+	if wg != nil {
+		wg.Done()
+	}
+	nlog.Infoln(r.Name())
+	r.BckJog.Run()
+	if err := r.BckJog.Wait(); err != nil {
+		r.AddErr(err)
+	}
+	r.Finish()
+}
+
+func (r *XactTier) visit(lom *core.LOM, _ []byte) error {
+	to := r.conf.MigrateTo(time.Duration(r.now - lom.AtimeUnix()))
+	if to == 0 || r.conf.Index(lom.Bprops(), lom.Tier()) >= to {
+		return nil
+	}
+	// (cold object - not expecting contention)
+	if !lom.TryLock(true) {
+		return nil
+	}
+	err := r.migrate(lom, &r.conf.Tiers[to-1].Bck)
+	lom.Unlock(true)
+	if err != nil {
+		r.AddErr(err, 5, cos.SmoduleXs)
+	}
+	return nil
+}
+
+// under wlock
+func (r *XactTier) migrate(lom *core.LOM, tier *cmn.Bck) error {
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return nil // (benign race vs delete)
+	}
+	if lom.WriteBackPending() {
+		return nil
+	}
+	cur := lom.Tier()
+	if _, ok := lom.GetCustomKey(cmn.TierObjMD); ok && cur == nil {
+		return nil // (the tier is no longer in the chain)
+	}
+
+	// 1. write
+	tlom, err := lom.TierLOM(tier)
+	if err != nil {
+		return err
+	}
+	defer core.FreeLOM(tlom)
+	fh, err := lom.Open()
+	if err != nil {
+		return err
+	}
+	tlom.CopyAttrs(lom, false /*skip cksum*/)
+	tlom.ObjAttrs().DelStdCustom() // (backend.PutObj will set updated values)
+	tlom.SetTier(nil)
+	backend := core.T.Backend(tlom.Bck())
+	_, err = backend.PutObj(fh, tlom, nil /*origReq*/)
+	fh.Close()
+	if err != nil {
+		return cmn.NewErrFailedTo(r, "migrate", lom.Cname(), fmt.Errorf("%s: %w", tier.Cname(""), err))
+	}
+
+	// 2. delete from the current tier
+	olom := lom
+	if cur != nil {
+		if olom, err = lom.TierLOM(cur); err != nil {
+			return err
+		}
+		defer core.FreeLOM(olom)
+	}
+	if ecode, err := core.T.Backend(olom.Bck()).DeleteObj(olom); err != nil && !cos.IsNotExist(err, ecode) {
+		// (not fatal - the chain is tried in order)
+		nlog.Warningln(r.Name(), "failed to delete migrated", olom.Cname(), "[", err, ecode, "]")
+	}
+	core.HcacheDel(lom)
+
+	// 3. update in-cluster metadata (compare w/ ais/tgtwb.go finalize)
+	lom.CopyVersion(tlom)
+	for _, k := range [...]string{cmn.VersionObjMD, cmn.ETag, cmn.MD5ObjMD, cmn.CRC32CObjMD, cmn.LastModified} {
+		if v, ok := tlom.GetCustomKey(k); ok {
+			lom.SetCustomKey(k, v)
+		} else {
+			delete(lom.GetCustomMD(), k)
+		}
+	}
+	lom.SetCustomKey(cmn.SourceObjMD, backend.Provider())
+	lom.SetTier(tier)
+	if err := lom.Persist(); err != nil {
+		return err
+	}
+	r.ObjsAdd(1, lom.Lsize())
+	if cmn.Rom.FastV(5, cos.SmoduleXs) {
+		nlog.Infoln(r.Name(), lom.Cname(), "=>", tier.Cname(""))
+	}
+	return nil
+}
+
+func (r *XactTier) Snap() (snap *core.Snap) {
+	snap = &core.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	return
+}
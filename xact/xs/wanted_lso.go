@@ -81,6 +81,8 @@ func (wi *walkInfo) setWanted(en *cmn.LsoEnt, lom *core.LOM) {
 					checkVchanged = false
 				}
 			}
+		case apc.GetPropsTags:
+			en.Tags = lom.Tags()
 		default:
 			debug.Assert(false, name)
 		}
@@ -0,0 +1,115 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// x-write-back flushes the write-back backlog of a given remote bucket (see apc.WriteDelayed):
+// - pending objects are uploaded by the target's write-back queue, not by the xaction itself
+// - the xaction triggers immediate (no backoff) upload of all pending objects and then
+//   waits for the backlog to drain
+// - it fails if all remaining objects failed to upload at least once since the start
+//   (in which case the queue keeps retrying in the background)
+
+const wbPollIval = time.Second
+
+type (
+	wbFactory struct {
+		xreg.RenewBase
+		xctn *XactWb
+	}
+	XactWb struct {
+		args *xreg.WbArgs
+		xact.Base
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*XactWb)(nil)
+	_ xreg.Renewable = (*wbFactory)(nil)
+)
+
+///////////////
+// wbFactory //
+///////////////
+
+func (*wbFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	return &wbFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+}
+
+func (p *wbFactory) Start() error {
+	args, ok := p.Args.Custom.(*xreg.WbArgs)
+	debug.Assert(ok && args.Flush != nil && args.Backlog != nil)
+	r := &XactWb{args: args}
+	r.InitBase(p.UUID(), apc.ActWriteBack, "" /*ctlmsg*/, p.Bck)
+	p.xctn = r
+	return nil
+}
+
+func (*wbFactory) Kind() string     { return apc.ActWriteBack }
+func (p *wbFactory) Get() core.Xact { return p.xctn }
+
+func (*wbFactory) WhenPrevIsRunning(prevEntry xreg.Renewable) (xreg.WPR, error) {
+	return xreg.WprUse, cmn.NewErrXactUsePrev(prevEntry.Get().String())
+}
+
+////////////
+// XactWb //
+////////////
+
+func (r *XactWb) Run(wg *sync.WaitGroup) {
+	if wg != nil {
+		wg.Done()
+	}
+	var (
+		bck   = r.Bck()
+		since = r.args.Flush(bck)
+	)
+	prevNum, prevSiz, _ := r.args.Backlog(bck, since)
+	nlog.Infoln(r.Name(), "backlog:", prevNum, cos.ToSizeIEC(prevSiz, 2))
+	for prevNum > 0 {
+		select {
+		case <-r.ChanAbort():
+			r.Finish()
+			return
+		case <-time.After(wbPollIval):
+		}
+		num, size, failed := r.args.Backlog(bck, since)
+		if num < prevNum {
+			r.ObjsAdd(int(prevNum-num), max(prevSiz-size, 0))
+		}
+		prevNum, prevSiz = num, size
+		if num > 0 && failed >= num {
+			r.AddErr(fmt.Errorf("%s: failed to write back %d object%s (%s) - will keep retrying in the background",
+				r, num, cos.Plural(int(num)), cos.ToSizeIEC(size, 2)))
+			break
+		}
+	}
+	r.Finish()
+}
+
+func (r *XactWb) Snap() (snap *core.Snap) {
+	snap = &core.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	return
+}
@@ -87,6 +87,11 @@ func (wi *walkInfo) match(objName string) bool {
 	return wi.msg.ContinuationToken == "" || !cmn.TokenGreaterEQ(wi.msg.ContinuationToken, objName)
 }
 
+// (requires loaded lom)
+func (wi *walkInfo) matchTags(lom *core.LOM) bool {
+	return wi.msg.TagFilter == "" || cmn.MatchObjTags(lom.Tags(), wi.msg.TagFilter)
+}
+
 // new entry to be added to the listed page (note: slow path)
 func (wi *walkInfo) ls(lom *core.LOM, status uint16) (en *cmn.LsoEnt) {
 	en = &cmn.LsoEnt{Name: lom.ObjName, Flags: status | apc.EntryIsCached}
@@ -105,6 +110,9 @@ func (wi *walkInfo) ls(lom *core.LOM, status uint16) (en *cmn.LsoEnt) {
 		// may set en.custom and en.version
 		checkRemoteMD(lom, en)
 	}
+	if wi.msg.IsFlagSet(apc.LsVersions) {
+		en.Versions = wi.lsVersions(lom)
+	}
 	if wi.msg.IsFlagSet(apc.LsNameOnly) {
 		return
 	}
@@ -116,6 +124,23 @@ func (wi *walkInfo) ls(lom *core.LOM, status uint16) (en *cmn.LsoEnt) {
 	return
 }
 
+// retained prior versions (requires loaded lom)
+func (wi *walkInfo) lsVersions(lom *core.LOM) []cmn.LsoVer {
+	vers := lom.PriorVersions()
+	if len(vers) == 0 {
+		return nil
+	}
+	out := make([]cmn.LsoVer, 0, len(vers))
+	for _, ver := range vers {
+		size, mtime, err := lom.StatVersion(ver)
+		if err != nil {
+			continue // (removed in the meantime)
+		}
+		out = append(out, cmn.LsoVer{Version: ver, Size: size, Mtime: cos.FormatTime(mtime, wi.msg.TimeFormat)})
+	}
+	return out
+}
+
 // NOTE: slow path if lom.Bck is remote
 func checkRemoteMD(lom *core.LOM, en *cmn.LsoEnt) {
 	res := lom.CheckRemoteMD(false /*locked*/, false /*sync*/, nil /*origReq*/)
@@ -175,7 +200,8 @@ func (wi *walkInfo) _cb(lom *core.LOM, fqn string) (*cmn.LsoEnt, error) {
 	}
 
 	// [shortcut]: name-only optimizes-out loading md (NOTE: won't show misplaced and copies)
-	if wi.msg.IsFlagSet(apc.LsNameOnly) && !fs.HasPrefixFntl(lom.ObjName) {
+	if wi.msg.IsFlagSet(apc.LsNameOnly) && wi.msg.TagFilter == "" && !wi.msg.IsFlagSet(apc.LsVersions) &&
+		!fs.HasPrefixFntl(lom.ObjName) {
 		if !isOK(status) {
 			return nil, nil
 		}
@@ -189,6 +215,9 @@ func (wi *walkInfo) _cb(lom *core.LOM, fqn string) (*cmn.LsoEnt, error) {
 		}
 		return nil, err
 	}
+	if !wi.matchTags(lom) {
+		return nil, nil
+	}
 	if lom.IsFntl() {
 		// FIXME: revisit
 		status = apc.LocOK